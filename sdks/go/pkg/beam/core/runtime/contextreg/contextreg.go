@@ -47,6 +47,12 @@ type Registry struct {
 type TransformMetadata struct {
 	Annotations map[string][]byte
 	// DisplayData []*pipepb.DisplayData
+
+	// URN and Payload set the spec of the composite transform. Runners that recognize
+	// the URN may substitute their own implementation, while others execute the
+	// composite's subtransforms.
+	URN     string
+	Payload []byte
 }
 
 // EnvironmentMetadata represent additional information on environmental requirements to be added to the Pipeline
@@ -90,6 +96,9 @@ func (r *Registry) ExtractTransformMetadata(ctx context.Context) TransformMetada
 	for _, ext := range r.transforms {
 		k := ext(ctx)
 		maps.Copy(ret.Annotations, k.Annotations)
+		if k.URN != "" {
+			ret.URN, ret.Payload = k.URN, k.Payload
+		}
 	}
 	if len(ret.Annotations) == 0 {
 		ret.Annotations = nil
@@ -77,6 +77,40 @@ func TestPTransformExtractor(t *testing.T) {
 	}
 }
 
+func TestPTransformExtractor_Spec(t *testing.T) {
+	reg := &Registry{}
+
+	type keyType string
+	key := keyType("spec")
+
+	reg.TransformExtractor(func(ctx context.Context) TransformMetadata {
+		return TransformMetadata{URN: "beam:test:never_seen:v1"}
+	})
+	reg.TransformExtractor(func(ctx context.Context) TransformMetadata {
+		v, ok := ctx.Value(key).(string)
+		if !ok {
+			return TransformMetadata{}
+		}
+		return TransformMetadata{URN: "beam:test:spec:v1", Payload: []byte(v)}
+	})
+	// An extractor without a spec doesn't clear an earlier one.
+	reg.TransformExtractor(func(ctx context.Context) TransformMetadata {
+		return TransformMetadata{}
+	})
+
+	want := "payload"
+	ptrans := reg.ExtractTransformMetadata(context.WithValue(context.Background(), key, want))
+	if got, want := ptrans.URN, "beam:test:spec:v1"; got != want {
+		t.Errorf("extracted URN = %q, want %q", got, want)
+	}
+	if got := string(ptrans.Payload); got != want {
+		t.Errorf("extracted payload = %q, want %q", got, want)
+	}
+	if ptrans.Annotations != nil {
+		t.Errorf("extracted annotations = %v, want nil", ptrans.Annotations)
+	}
+}
+
 func TestHintExtractor(t *testing.T) {
 	reg := &Registry{}
 
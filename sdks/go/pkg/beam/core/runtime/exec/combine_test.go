@@ -116,6 +116,62 @@ func TestLiftedCombine(t *testing.T) {
 
 }
 
+// TestCombine_pane verifies that the combine nodes output their results in the
+// pane of their input.
+func TestCombine_pane(t *testing.T) {
+	pane := typex.PaneInfo{Timing: typex.PaneLate, Index: 2, NonSpeculativeIndex: 1}
+	withPane := func(in []MainInput) []MainInput {
+		for i := range in {
+			in[i].Key.Pane = pane
+		}
+		return in
+	}
+	tests := []struct {
+		name string
+		in   []MainInput
+		node func(c *Combine) Node
+	}{
+		{
+			name: "Combine",
+			in:   withPane(makeKeyedInput(42, 1, 2, 3)),
+			node: func(c *Combine) Node { return c },
+		},
+		{
+			name: "MergeAccumulators",
+			in:   withPane(makeKeyedInput(42, 1, 2, 3)),
+			node: func(c *Combine) Node { return &MergeAccumulators{Combine: c} },
+		},
+		{
+			name: "ExtractOutput",
+			in:   withPane(makeKVInput(42, 6)),
+			node: func(c *Combine) Node { return &ExtractOutput{Combine: c} },
+		},
+		{
+			name: "ConvertToAccumulators",
+			in:   withPane(makeKVInput(42, 6)),
+			node: func(c *Combine) Node { return &ConvertToAccumulators{Combine: c} },
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			edge := getCombineEdge(t, mergeFn, reflectx.Int, intCoder(reflectx.Int))
+
+			out := &CaptureNode{UID: 1}
+			node := test.node(&Combine{UID: 2, Fn: edge.CombineFn, Out: out})
+			n := &FixedRoot{UID: 3, Elements: test.in, Out: node}
+
+			constructAndExecutePlan(t, []Unit{n, node, out})
+
+			if len(out.Elements) != 1 {
+				t.Fatalf("%v output %v elements, want 1", test.name, len(out.Elements))
+			}
+			if got := out.Elements[0].Pane; got != pane {
+				t.Errorf("%v output pane = %+v, want %+v", test.name, got, pane)
+			}
+		})
+	}
+}
+
 // pigeonHasher only returns 0 for even hashes, and 1 for odd hashes
 // nearly guaranteeing that overflow behavior must be tested for small sets.
 type pigeonHasher struct {
@@ -35,6 +35,15 @@ type ReusableEmitter interface {
 	Value() any
 }
 
+// PaneEmitter is a ReusableEmitter that can also hold the pane of the element being
+// processed. The elements emitted by emitters that don't implement it are put in the
+// pane by the node they are output to.
+type PaneEmitter interface {
+	ReusableEmitter
+	// SetPane sets the pane of the emitted values. Can be called multiple times.
+	SetPane(pn typex.PaneInfo)
+}
+
 // ReusableTimestampObservingWatermarkEmitter is a resettable value needed to hold
 // the implicit context and emit event time. It also has the ability to have a
 // watermark estimator attached.
@@ -86,6 +95,54 @@ func makeEmit(t reflect.Type, n ElementProcessor) ReusableEmitter {
 	return ret
 }
 
+// makePaneEmit returns a reusable emitter of the given type whose emitted values are in
+// the pane set with SetPane. If the emitter registered for the type isn't a PaneEmitter,
+// it outputs to a node that puts the values in the pane instead.
+func makePaneEmit(t reflect.Type, n ElementProcessor) PaneEmitter {
+	if e, ok := makeEmit(t, n).(PaneEmitter); ok {
+		return e
+	}
+	out := &paneOutput{ElementProcessor: n}
+	e := makeEmit(t, out)
+	pe := &paneEmitter{ReusableEmitter: e, out: out}
+	if we, ok := e.(ReusableTimestampObservingWatermarkEmitter); ok {
+		return &paneWatermarkEmitter{paneEmitter: pe, we: we}
+	}
+	return pe
+}
+
+// paneEmitter is a PaneEmitter for an emitter that doesn't hold the pane itself.
+type paneEmitter struct {
+	ReusableEmitter
+	out *paneOutput
+}
+
+func (e *paneEmitter) SetPane(pn typex.PaneInfo) {
+	e.out.pane = pn
+}
+
+// paneWatermarkEmitter is a paneEmitter for an emitter that can have a watermark
+// estimator attached.
+type paneWatermarkEmitter struct {
+	*paneEmitter
+	we ReusableTimestampObservingWatermarkEmitter
+}
+
+func (e *paneWatermarkEmitter) AttachEstimator(est *sdf.WatermarkEstimator) {
+	e.we.AttachEstimator(est)
+}
+
+// paneOutput puts the values emitted to it in a pane before outputting them.
+type paneOutput struct {
+	ElementProcessor
+	pane typex.PaneInfo
+}
+
+func (o *paneOutput) ProcessElement(ctx context.Context, elm *FullValue, values ...ReStream) error {
+	elm.Pane = o.pane
+	return o.ElementProcessor.ProcessElement(ctx, elm, values...)
+}
+
 // TODO(herohde) 1/19/2018: we could have an emitter for each arity in the reflection case.
 
 // emitValue is the reflection-based default emitter implementation.
@@ -96,6 +153,7 @@ type emitValue struct {
 	est   *sdf.WatermarkEstimator
 
 	ctx context.Context
+	pn  typex.PaneInfo
 	ws  []typex.Window
 	et  typex.EventTime
 }
@@ -107,6 +165,10 @@ func (e *emitValue) Init(ctx context.Context, ws []typex.Window, et typex.EventT
 	return nil
 }
 
+func (e *emitValue) SetPane(pn typex.PaneInfo) {
+	e.pn = pn
+}
+
 func (e *emitValue) Value() any {
 	return e.fn
 }
@@ -116,7 +178,7 @@ func (e *emitValue) AttachEstimator(est *sdf.WatermarkEstimator) {
 }
 
 func (e *emitValue) invoke(args []reflect.Value) []reflect.Value {
-	value := &FullValue{Windows: e.ws, Timestamp: e.et}
+	value := &FullValue{Pane: e.pn, Windows: e.ws, Timestamp: e.et}
 	isKey := true
 	for i, t := range e.types {
 		switch {
@@ -482,7 +482,7 @@ func makeSideInputs(ctx context.Context, w typex.Window, side []SideInputAdapter
 	return ret, nil
 }
 
-func makeEmitters(fn *funcx.Fn, nodes []Node) ([]ReusableEmitter, error) {
+func makeEmitters(fn *funcx.Fn, nodes []Node) ([]PaneEmitter, error) {
 	if len(nodes) == 0 {
 		return nil, nil // ok: no output nodes
 	}
@@ -496,10 +496,10 @@ func makeEmitters(fn *funcx.Fn, nodes []Node) ([]ReusableEmitter, error) {
 		return nil, errors.Errorf("found %v emitters, want %v", len(out), len(nodes)-offset)
 	}
 
-	var ret []ReusableEmitter
+	var ret []PaneEmitter
 	for i := 0; i < len(out); i++ {
 		param := fn.Param[out[i]]
-		ret = append(ret, makeEmit(param.T, nodes[i+offset]))
+		ret = append(ret, makePaneEmit(param.T, nodes[i+offset]))
 	}
 	return ret, nil
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -219,6 +220,123 @@ func TestParDo_WindowObservation(t *testing.T) {
 	}
 }
 
+func emitTwiceFn(word string, emit func(string)) {
+	emit(word)
+	emit(word)
+}
+
+// paneTestWord has an emitter registered that doesn't implement PaneEmitter, like those
+// of shims generated before PaneEmitter was added.
+type paneTestWord string
+
+type paneTestEmitter struct {
+	n  ElementProcessor
+	fn any
+
+	ctx context.Context
+	ws  []typex.Window
+	et  typex.EventTime
+}
+
+func (e *paneTestEmitter) Init(ctx context.Context, ws []typex.Window, et typex.EventTime) error {
+	e.ctx = ctx
+	e.ws = ws
+	e.et = et
+	return nil
+}
+
+func (e *paneTestEmitter) Value() any {
+	return e.fn
+}
+
+func (e *paneTestEmitter) invoke(val paneTestWord) {
+	value := &FullValue{Windows: e.ws, Timestamp: e.et, Elm: val}
+	if err := e.n.ProcessElement(e.ctx, value); err != nil {
+		panic(err)
+	}
+}
+
+func init() {
+	RegisterEmitter(reflect.TypeOf((*func(paneTestWord))(nil)).Elem(), func(n ElementProcessor) ReusableEmitter {
+		e := &paneTestEmitter{n: n}
+		e.fn = e.invoke
+		return e
+	})
+}
+
+func emitTwicePaneTestFn(word paneTestWord, emit func(paneTestWord)) {
+	emit(word)
+	emit(word)
+}
+
+// TestParDo_PanePropagation verifies that emitted elements are in the pane of the element
+// being processed, whether or not their emitter is a PaneEmitter.
+func TestParDo_PanePropagation(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   any
+		t    reflect.Type
+		in   []any
+	}{
+		{
+			name: "PaneEmitter",
+			fn:   emitTwiceFn,
+			t:    reflectx.String,
+			in:   []any{"a", "b"},
+		},
+		{
+			name: "ReusableEmitter",
+			fn:   emitTwicePaneTestFn,
+			t:    reflect.TypeOf(paneTestWord("")),
+			in:   []any{paneTestWord("a"), paneTestWord("b")},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fn, err := graph.NewDoFn(test.fn)
+			if err != nil {
+				t.Fatalf("invalid function: %v", err)
+			}
+
+			g := graph.New()
+			nN := g.NewNode(typex.New(test.t), window.DefaultWindowingStrategy(), true)
+
+			edge, err := graph.NewParDo(g, g.Root(), fn, []*graph.Node{nN}, nil, nil)
+			if err != nil {
+				t.Fatalf("invalid pardo: %v", err)
+			}
+
+			late := typex.PaneInfo{Timing: typex.PaneLate, IsLast: true, Index: 2, NonSpeculativeIndex: 1}
+			in := makeWindowedInput(window.SingleGlobalWindow, test.in...)
+			in[1].Key.Pane = late
+
+			out := &CaptureNode{UID: 1}
+			pardo := &ParDo{UID: 2, Fn: edge.DoFn, Inbound: edge.Input, Out: []Node{out}}
+			n := &FixedRoot{UID: 3, Elements: in, Out: pardo}
+
+			p, err := NewPlan("a", []Unit{n, pardo, out})
+			if err != nil {
+				t.Fatalf("failed to construct plan: %v", err)
+			}
+			if err := p.Execute(context.Background(), "1", DataContext{}); err != nil {
+				t.Fatalf("execute failed: %v", err)
+			}
+			if err := p.Down(context.Background()); err != nil {
+				t.Fatalf("down failed: %v", err)
+			}
+
+			var got []typex.PaneInfo
+			for _, elm := range out.Elements {
+				got = append(got, elm.Pane)
+			}
+			want := []typex.PaneInfo{{}, {}, late, late}
+			if !cmp.Equal(got, want) {
+				t.Errorf("pardo(%v) panes = %v, want %v", test.name, got, want)
+			}
+		})
+	}
+}
+
 func TestProcessSingleWindow_withOutputs(t *testing.T) {
 	tests := []struct {
 		name           string
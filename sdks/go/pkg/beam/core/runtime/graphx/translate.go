@@ -297,6 +297,9 @@ func (m *marshaller) addScopeTree(s *ScopeTree) (string, error) {
 		Annotations:   metadata.Annotations,
 		// DisplayData: metadata.DisplayData,
 	}
+	if metadata.URN != "" {
+		transform.Spec = &pipepb.FunctionSpec{Urn: metadata.URN, Payload: metadata.Payload}
+	}
 
 	if err := m.updateIfCombineComposite(s, transform); err != nil {
 		return "", errors.Wrapf(err, "failed to add scope tree: %v", s)
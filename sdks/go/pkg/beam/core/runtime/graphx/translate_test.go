@@ -16,6 +16,7 @@
 package graphx_test
 
 import (
+	"bytes"
 	"context"
 	"reflect"
 	"testing"
@@ -266,6 +267,53 @@ func TestMarshal_PTransformAnnotations(t *testing.T) {
 	}
 }
 
+func TestMarshal_PTransformCompositeSpec(t *testing.T) {
+	var creg contextreg.Registry
+
+	const urn = "beam:transform:test_composite:v1"
+
+	// An extractor that sets a spec on composites with a context attached.
+	creg.TransformExtractor(func(ctx context.Context) contextreg.TransformMetadata {
+		return contextreg.TransformMetadata{URN: urn, Payload: []byte{42}}
+	})
+
+	g := graph.New()
+	in := newIntInput(g)
+	side := newIntInput(g)
+	s := g.NewScope(g.Root(), "sub")
+	s.Context = context.Background()
+	addDoFn(t, g, pickSideFn, s, []*graph.Node{in, side}, []*coder.Coder{intCoder(), intCoder()}, nil)
+
+	edges, _, err := g.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := graphx.Marshal(edges, &graphx.Options{Environment: &pipepb.Environment{Urn: "beam:env:process:v1"}, ContextReg: &creg})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var composites int
+	for _, pt := range p.GetComponents().GetTransforms() {
+		if len(pt.GetSubtransforms()) == 0 {
+			if got := pt.GetSpec().GetUrn(); got == urn {
+				t.Errorf("unexpected composite spec on leaf transform: %v", pt)
+			}
+			continue
+		}
+		composites++
+		if got, want := pt.GetSpec().GetUrn(), urn; got != want {
+			t.Errorf("composite spec URN = %q, want %q", got, want)
+		}
+		if got, want := pt.GetSpec().GetPayload(), []byte{42}; !bytes.Equal(got, want) {
+			t.Errorf("composite spec payload = %v, want %v", got, want)
+		}
+	}
+	if composites != 1 {
+		t.Errorf("got %d composites, want 1: %v", composites, proto.MarshalTextString(p))
+	}
+}
+
 // testRT's methods can all be no-ops, we just need it to implement sdf.RTracker.
 type testRT struct {
 }
@@ -287,7 +287,7 @@ func (s *Combining[T1, T2, T3]) Add(p Provider, val T2) error {
 		if f, ok := ai.(reflectx.Func2x1); ok {
 			newVal = f.Call2x1(acc, val)
 		} else {
-			newVal = f.Call([]any{acc, val})[0]
+			newVal = ai.Call([]any{acc, val})[0]
 		}
 		return p.WriteValueState(Transaction{
 			Key:  s.Key,
@@ -301,7 +301,7 @@ func (s *Combining[T1, T2, T3]) Add(p Provider, val T2) error {
 		if f, ok := ma.(reflectx.Func2x1); ok {
 			newVal = f.Call2x1(acc, val)
 		} else {
-			newVal = f.Call([]any{acc, val})[0]
+			newVal = ma.Call([]any{acc, val})[0]
 		}
 		return p.WriteValueState(Transaction{
 			Key:  s.Key,
@@ -328,7 +328,7 @@ func (s *Combining[T1, T2, T3]) Read(p Provider) (T3, bool, error) {
 		if ok {
 			return f.Call1x1(acc).(T3), true, nil
 		}
-		return f.Call([]any{acc})[0].(T3), true, nil
+		return eo.Call([]any{acc})[0].(T3), true, nil
 	}
 
 	return acc.(T3), true, nil
@@ -364,7 +364,7 @@ func (s *Combining[T1, T2, T3]) readAccumulator(p Provider) (any, bool, error) {
 			if ok {
 				return f.Call0x1(), true, nil
 			}
-			return f.Call([]any{})[0], true, nil
+			return ca.Call([]any{})[0], true, nil
 		}
 		var val T1
 		return val, false, nil
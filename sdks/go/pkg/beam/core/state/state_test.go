@@ -687,6 +687,82 @@ func TestCombiningAdd(t *testing.T) {
 	}
 }
 
+// plainFunc hides any optimized call interfaces of the wrapped function.
+type plainFunc struct {
+	reflectx.Func
+}
+
+func plain(f reflectx.Func) reflectx.Func {
+	if f == nil {
+		return nil
+	}
+	return plainFunc{f}
+}
+
+// plainFuncProvider returns combining functions that only implement
+// reflectx.Func, as is the case for unregistered CombineFns.
+type plainFuncProvider struct {
+	fakeProvider
+}
+
+func (s *plainFuncProvider) CreateAccumulatorFn(userStateID string) reflectx.Func {
+	return plain(s.fakeProvider.CreateAccumulatorFn(userStateID))
+}
+
+func (s *plainFuncProvider) AddInputFn(userStateID string) reflectx.Func {
+	return plain(s.fakeProvider.AddInputFn(userStateID))
+}
+
+func (s *plainFuncProvider) MergeAccumulatorsFn(userStateID string) reflectx.Func {
+	return plain(s.fakeProvider.MergeAccumulatorsFn(userStateID))
+}
+
+func (s *plainFuncProvider) ExtractOutputFn(userStateID string) reflectx.Func {
+	return plain(s.fakeProvider.ExtractOutputFn(userStateID))
+}
+
+func TestCombining_PlainFuncs(t *testing.T) {
+	var (
+		createAccum = map[string]bool{"addInput": true, "mergeAccum": true, "readOnly": true}
+		addInput    = map[string]bool{"addInput": true}
+		mergeAccum  = map[string]bool{"mergeAccum": true}
+		extractOut  = map[string]bool{"addInput": true, "readOnly": true}
+	)
+	tests := []struct {
+		key  string
+		adds []int
+		want int
+	}{
+		{key: "addInput", adds: []int{2, 3}, want: 600},
+		{key: "mergeAccum", adds: []int{2, 3}, want: 6},
+		{key: "readOnly", want: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			f := &plainFuncProvider{fakeProvider{
+				initialState:      map[string]any{},
+				transactions:      map[string][]Transaction{},
+				err:               map[string]error{},
+				createAccumForKey: createAccum,
+				addInputForKey:    addInput,
+				mergeAccumForKey:  mergeAccum,
+				extractOutForKey:  extractOut,
+			}}
+			vs := MakeCombiningState[int, int, int](tt.key, func(a, b int) int {
+				return a + b
+			})
+			for _, val := range tt.adds {
+				if err := vs.Add(f, val); err != nil {
+					t.Fatalf("Combining.Add(%v) failed: %v", val, err)
+				}
+			}
+			if val, ok, err := vs.Read(f); err != nil || !ok || val != tt.want {
+				t.Errorf("Combining.Read()=%v,%v,%v, want %v,true,nil", val, ok, err, tt.want)
+			}
+		})
+	}
+}
+
 func TestMapGet(t *testing.T) {
 	is := make(map[string]any)
 	im := make(map[string]map[string]any)
@@ -19,21 +19,26 @@ package avroio
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"reflect"
 
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/sdf"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/fileio"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/filesystem"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/rtrackers/offsetrange"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/log"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
 	"github.com/linkedin/goavro/v2"
 )
 
 func init() {
-	register.DoFn3x1[context.Context, fileio.ReadableFile, func(beam.X), error]((*avroReadFn)(nil))
-	register.DoFn3x1[context.Context, int, func(*string) bool, error]((*writeAvroFn)(nil))
+	register.DoFn4x1[context.Context, *sdf.LockRTracker, fileio.ReadableFile, func(beam.X), error]((*avroReadFn)(nil))
+	register.DoFn3x1[context.Context, int, func(*beam.X) bool, error]((*writeAvroFn)(nil))
 	register.Emitter1[beam.X]()
-	register.Iter1[string]()
+	register.Iter1[beam.X]()
 }
 
 // Read reads a set of files and returns lines as a PCollection<elem>
@@ -41,92 +46,258 @@ func init() {
 // A type - reflect.TypeOf( YourType{} ) -  with
 // JSON tags can be defined or if you wish to return the raw JSON string,
 // use - reflect.TypeOf("") -
-func Read(s beam.Scope, glob string, t reflect.Type) beam.PCollection {
+//
+// Records are decoded directly into the fields of struct types. A field is
+// matched to the avro field named by its "avro" tag, or by its "json" tag if it
+// has no "avro" tag, or by its Go name otherwise. Nullable unions can be
+// decoded into pointer fields, which are nil for null values.
+//
+// Files are split on the boundaries of their blocks, allowing runners that
+// support splitting to read large files in parallel.
+//
+// Read accepts a variadic number of ReadOptionFn to configure the read:
+//   - ReadSchema: a reader schema to resolve the data of all files against,
+//     for reading files written with different versions of a schema.
+func Read(s beam.Scope, glob string, t reflect.Type, opts ...ReadOptionFn) beam.PCollection {
 	s = s.Scope("avroio.Read")
 	filesystem.ValidateScheme(glob)
-	return read(s, t, beam.Create(s, glob))
+	return read(s, t, beam.Create(s, glob), opts...)
+}
+
+// ReadAll expands and reads the filenames given as globs by the incoming
+// PCollection<string>. It otherwise behaves like Read.
+func ReadAll(s beam.Scope, t reflect.Type, col beam.PCollection, opts ...ReadOptionFn) beam.PCollection {
+	s = s.Scope("avroio.ReadAll")
+	return read(s, t, col, opts...)
 }
 
-func read(s beam.Scope, t reflect.Type, col beam.PCollection) beam.PCollection {
+func read(s beam.Scope, t reflect.Type, col beam.PCollection, opts ...ReadOptionFn) beam.PCollection {
+	option := &readOption{}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("avroio.Read: invalid option: %v", err))
+		}
+	}
+
 	matches := fileio.MatchAll(s, col, fileio.MatchEmptyAllow())
 	files := fileio.ReadMatches(s, matches, fileio.ReadUncompressed())
 	return beam.ParDo(s,
-		&avroReadFn{Type: beam.EncodedType{T: t}},
+		&avroReadFn{Type: beam.EncodedType{T: t}, ReaderSchema: option.ReaderSchema},
 		files,
 		beam.TypeDefinition{Var: beam.XType, T: t},
 	)
 }
 
+const (
+	// splitSize is the desired size of each restriction for initial splits.
+	splitSize int64 = 64 * 1024 * 1024 // 64 MB
+	// tooSmall is the size limit for a restriction. If the last restriction is
+	// smaller than this, it gets merged with the previous one.
+	tooSmall = splitSize / 4
+)
+
+// avroReadFn is an SDF that reads the records of an avro file. Its restriction
+// is a range of byte offsets, and it reads the blocks that begin within that
+// range. Blocks begin after the header of the file and after each sync marker.
 type avroReadFn struct {
 	// Avro schema type
 	Type beam.EncodedType
+	// ReaderSchema is the schema to resolve data against, if any.
+	ReaderSchema string
+
+	readerSchema *avroSchema
+}
+
+func (f *avroReadFn) Setup() error {
+	if f.ReaderSchema == "" {
+		return nil
+	}
+	s, err := parseSchema(f.ReaderSchema)
+	if err != nil {
+		return err
+	}
+	f.readerSchema = s
+	return nil
+}
+
+// CreateInitialRestriction creates an offset range restriction representing
+// the file's size in bytes.
+func (f *avroReadFn) CreateInitialRestriction(file fileio.ReadableFile) offsetrange.Restriction {
+	return offsetrange.Restriction{
+		Start: 0,
+		End:   file.Metadata.Size,
+	}
 }
 
-func (f *avroReadFn) ProcessElement(ctx context.Context, file fileio.ReadableFile, emit func(beam.X)) (err error) {
+// SplitRestriction splits each file restriction into blocks of a predetermined
+// size, with some checks to avoid having small remainders.
+func (f *avroReadFn) SplitRestriction(_ fileio.ReadableFile, rest offsetrange.Restriction) []offsetrange.Restriction {
+	splits := rest.SizedSplits(splitSize)
+	numSplits := len(splits)
+	if numSplits > 1 {
+		last := splits[numSplits-1]
+		if last.End-last.Start <= tooSmall {
+			// Last restriction is too small, so merge it with previous one.
+			splits[numSplits-2].End = last.End
+			splits = splits[:numSplits-1]
+		}
+	}
+	return splits
+}
+
+// RestrictionSize returns the size of each restriction as its range.
+func (f *avroReadFn) RestrictionSize(_ fileio.ReadableFile, rest offsetrange.Restriction) float64 {
+	return rest.Size()
+}
+
+// CreateTracker creates sdf.LockRTrackers wrapping offsetRange.Trackers for
+// each restriction.
+func (f *avroReadFn) CreateTracker(rest offsetrange.Restriction) *sdf.LockRTracker {
+	return sdf.NewLockRTracker(offsetrange.NewTracker(rest))
+}
+
+func (f *avroReadFn) ProcessElement(ctx context.Context, rt *sdf.LockRTracker, file fileio.ReadableFile, emit func(beam.X)) error {
 	log.Infof(ctx, "Reading AVRO from %v", file.Metadata.Path)
 
 	fd, err := file.Open(ctx)
 	if err != nil {
-		return
+		return err
 	}
 	defer fd.Close()
 
-	ar, err := goavro.NewOCFReader(fd)
+	r := newPositionReader(fd)
+	header, err := readHeader(r)
 	if err != nil {
-		log.Errorf(ctx, "error reading avro: %v", err)
-		return
+		return errors.WithContextf(err, "reading %v", file.Metadata.Path)
+	}
+	codec, err := goavro.NewCodec(header.Schema)
+	if err != nil {
+		return errors.Wrapf(err, "invalid schema in %v", file.Metadata.Path)
+	}
+	writerSchema, err := parseSchema(header.Schema)
+	if err != nil {
+		return errors.Wrapf(err, "invalid schema in %v", file.Metadata.Path)
+	}
+	schema := writerSchema
+	if f.readerSchema != nil {
+		schema = f.readerSchema
 	}
 
-	for ar.Scan() {
-		val := reflect.New(f.Type.T).Interface()
-		var i any
-		i, err = ar.Read()
-		if err != nil {
-			log.Errorf(ctx, "error reading avro row: %v", err)
-			continue
+	rest := rt.GetRestriction().(offsetrange.Restriction)
+	if rest.Start > r.pos {
+		// The first block of the restriction is the one following the first
+		// sync marker that ends at or after the start of the restriction.
+		if err := r.skipTo(rest.Start - syncSize); err != nil && err != io.EOF {
+			return err
+		}
+		if err := r.skipPastSync(header.Sync); err != nil {
+			if err == io.EOF {
+				// No blocks begin in the restriction, so finish claiming
+				// before returning to avoid errors.
+				rt.TryClaim(rest.End)
+				return nil
+			}
+			return err
 		}
+	}
 
-		// marshal interface to bytes
-		var b []byte
-		b, err = json.Marshal(i)
+	for rt.TryClaim(r.pos) {
+		count, data, err := readBlock(r, header)
+		if err == io.EOF {
+			rt.TryClaim(rest.End)
+			return nil
+		}
 		if err != nil {
-			log.Errorf(ctx, "error unmarshalling avro data: %v", err)
-			return
+			return errors.WithContextf(err, "reading %v", file.Metadata.Path)
 		}
-
-		switch reflect.New(f.Type.T).Interface().(type) {
-		case *string:
-			emit(string(b))
-		default:
-			if err = json.Unmarshal(b, val); err != nil {
-				log.Errorf(ctx, "error unmashalling avro to type: %v", err)
-				return
+		for i := int64(0); i < count; i++ {
+			var native any
+			native, data, err = codec.NativeFromBinary(data)
+			if err != nil {
+				return errors.Wrapf(err, "failed to decode avro record in %v", file.Metadata.Path)
+			}
+			if f.readerSchema != nil {
+				if native, err = resolve(writerSchema, f.readerSchema, native); err != nil {
+					return errors.Wrapf(err, "failed to resolve avro record in %v against reader schema", file.Metadata.Path)
+				}
 			}
-			emit(reflect.ValueOf(val).Elem().Interface())
+			val, err := f.decode(schema, native)
+			if err != nil {
+				return errors.WithContextf(err, "decoding avro record in %v", file.Metadata.Path)
+			}
+			emit(val)
+		}
+		if len(data) != 0 {
+			return errors.Errorf("avro block in %v has %d trailing bytes", file.Metadata.Path, len(data))
 		}
 	}
+	return nil
+}
 
-	return ar.Err()
+// decode converts a native goavro value into a value of the output type.
+func (f *avroReadFn) decode(schema *avroSchema, native any) (any, error) {
+	if f.Type.T.Kind() == reflect.String {
+		b, err := json.Marshal(native)
+		if err != nil {
+			return nil, errors.Wrap(err, "error marshalling avro data to JSON")
+		}
+		return string(b), nil
+	}
+	val := reflect.New(f.Type.T).Elem()
+	if err := toGo(schema, native, val); err != nil {
+		return nil, errors.Wrapf(err, "cannot decode avro data into %v", f.Type.T)
+	}
+	return val.Interface(), nil
 }
 
-// Write writes a PCollection<string> to an AVRO file.
-// Write expects a JSON string with a matching AVRO schema.
-// the process will fail if the schema does not match the JSON
-// provided
-func Write(s beam.Scope, filename, schema string, col beam.PCollection) {
+// Write writes a PCollection to an AVRO file with the given schema.
+//
+// If the PCollection is a PCollection<string>, Write expects each element to
+// be a JSON string matching the AVRO schema. Otherwise, elements are converted
+// from Go values to records of the schema, matching struct fields to avro
+// fields in the same way as Read. The process will fail if the schema does not
+// match the elements provided.
+//
+// Write accepts a variadic number of WriteOptionFn to configure the write:
+//   - WriteCodec: the compression codec of the file. Defaults to CodecSnappy.
+//   - WriteBlockSize: the approximate size of each block. Defaults to 64 KB.
+func Write(s beam.Scope, filename, schema string, col beam.PCollection, opts ...WriteOptionFn) {
 	s = s.Scope("avroio.Write")
 	filesystem.ValidateScheme(filename)
+
+	option := &writeOption{
+		Codec:     CodecSnappy,
+		BlockSize: defaultBlockSize,
+	}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("avroio.Write: invalid option: %v", err))
+		}
+	}
+	if _, err := parseSchema(schema); err != nil {
+		panic(fmt.Sprintf("avroio.Write: %v", err))
+	}
+
 	pre := beam.AddFixedKey(s, col)
 	post := beam.GroupByKey(s, pre)
-	beam.ParDo0(s, &writeAvroFn{Schema: schema, Filename: filename}, post)
+	beam.ParDo0(s, &writeAvroFn{
+		Schema:    schema,
+		Filename:  filename,
+		Type:      beam.EncodedType{T: col.Type().Type()},
+		Codec:     option.Codec,
+		BlockSize: option.BlockSize,
+	}, post)
 }
 
 type writeAvroFn struct {
-	Schema   string `json:"schema"`
-	Filename string `json:"filename"`
+	Schema    string `json:"schema"`
+	Filename  string `json:"filename"`
+	Type      beam.EncodedType
+	Codec     Codec
+	BlockSize int
 }
 
-func (w *writeAvroFn) ProcessElement(ctx context.Context, _ int, lines func(*string) bool) (err error) {
+func (w *writeAvroFn) ProcessElement(ctx context.Context, _ int, elems func(*beam.X) bool) (err error) {
 	log.Infof(ctx, "writing AVRO to %s", w.Filename)
 	fs, err := filesystem.New(ctx, w.Filename)
 	if err != nil {
@@ -139,39 +310,49 @@ func (w *writeAvroFn) ProcessElement(ctx context.Context, _ int, lines func(*str
 		return
 	}
 
-	defer fd.Close()
+	defer func() {
+		if closeErr := fd.Close(); err == nil {
+			err = closeErr
+		}
+	}()
 
 	codec, err := goavro.NewCodec(w.Schema)
 	if err != nil {
 		log.Errorf(ctx, "error creating avro codec: %v", err)
 		return
 	}
+	schema, err := parseSchema(w.Schema)
+	if err != nil {
+		return
+	}
 
-	ocfw, err := goavro.NewOCFWriter(goavro.OCFConfig{
-		Codec:           codec,
-		CompressionName: goavro.CompressionSnappyLabel,
-		Schema:          w.Schema,
-		W:               fd,
-	})
-
+	ocfw, err := newOCFWriter(fd, codec, w.Codec, w.BlockSize)
 	if err != nil {
 		log.Errorf(ctx, "error creating avro writer: %v", err)
 		return
 	}
 
-	var j string
-	for lines(&j) {
-		native, _, err := codec.NativeFromTextual([]byte(j))
-		if err != nil {
-			log.Errorf(ctx, "error reading native avro: %v", err)
-			return err
+	var elem beam.X
+	for elems(&elem) {
+		var native any
+		if j, ok := elem.(string); ok {
+			native, _, err = codec.NativeFromTextual([]byte(j))
+			if err != nil {
+				log.Errorf(ctx, "error reading native avro: %v", err)
+				return err
+			}
+		} else {
+			native, err = fromGo(schema, reflect.ValueOf(elem))
+			if err != nil {
+				return errors.Wrapf(err, "cannot encode %v as avro", reflect.TypeOf(elem))
+			}
 		}
 
-		if err := ocfw.Append([]any{native}); err != nil {
+		if err := ocfw.Append(native); err != nil {
 			log.Errorf(ctx, "error writing avro: %v", err)
 			return err
 		}
 	}
 
-	return
+	return ocfw.Flush()
 }
@@ -17,18 +17,24 @@ package avroio
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/fileio"
 	_ "github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/filesystem/local"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/rtrackers/offsetrange"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/linkedin/goavro/v2"
 )
 
@@ -41,6 +47,8 @@ func init() {
 	beam.RegisterType(reflect.TypeOf((*NullableFloat64)(nil)).Elem())
 	beam.RegisterType(reflect.TypeOf((*NullableString)(nil)).Elem())
 	beam.RegisterType(reflect.TypeOf((*NullableTweet)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*Event)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*EventV2)(nil)).Elem())
 	register.Function2x0(toJSONString)
 }
 
@@ -189,3 +197,203 @@ func TestWrite(t *testing.T) {
 		t.Fatalf("User.User=%v, want %v", got, want)
 	}
 }
+
+type Event struct {
+	ID     int64             `avro:"id"`
+	Name   string            `avro:"name"`
+	Score  *float64          `avro:"score"`
+	Tags   []string          `avro:"tags"`
+	Attrs  map[string]string `avro:"attrs"`
+	Status string            `avro:"status"`
+}
+
+const eventSchema = `{
+	"type": "record",
+	"name": "Event",
+	"namespace": "events",
+	"fields": [
+		{ "name": "id", "type": "long" },
+		{ "name": "name", "type": "string" },
+		{ "name": "score", "type": ["null", "double"] },
+		{ "name": "tags", "type": { "type": "array", "items": "string" } },
+		{ "name": "attrs", "type": { "type": "map", "values": "string" } },
+		{ "name": "status", "type": { "type": "enum", "name": "Status", "symbols": ["ACTIVE", "INACTIVE"] } }
+	]
+}`
+
+func testEvents(n int) []Event {
+	var events []Event
+	for i := 0; i < n; i++ {
+		e := Event{
+			ID:     int64(i),
+			Name:   fmt.Sprintf("event%d", i),
+			Tags:   []string{"a", "b"},
+			Attrs:  map[string]string{"k": fmt.Sprint(i)},
+			Status: "ACTIVE",
+		}
+		if i%2 == 0 {
+			score := float64(i) / 2
+			e.Score = &score
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+func TestWrite_typed(t *testing.T) {
+	events := testEvents(50)
+	for _, codec := range []Codec{CodecNull, CodecDeflate, CodecSnappy, CodecZstandard} {
+		t.Run(string(codec), func(t *testing.T) {
+			avroFile := filepath.Join(t.TempDir(), "events.avro")
+
+			p, s, col := ptest.CreateList(events)
+			Write(s, avroFile, eventSchema, col, WriteCodec(codec), WriteBlockSize(128))
+			ptest.RunAndValidate(t, p)
+
+			p, s = beam.NewPipelineWithRoot()
+			got := Read(s, avroFile, reflect.TypeOf(Event{}))
+			passert.Equals(s, got, toAnys(events)...)
+			ptest.RunAndValidate(t, p)
+		})
+	}
+}
+
+func TestWrite_invalidOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		opts   []WriteOptionFn
+	}{
+		{name: "invalid schema", schema: `{"type": "record"}`},
+		{name: "invalid codec", schema: eventSchema, opts: []WriteOptionFn{WriteCodec("lzo")}},
+		{name: "invalid block size", schema: eventSchema, opts: []WriteOptionFn{WriteBlockSize(0)}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Write() did not panic")
+				}
+			}()
+			_, s, col := ptest.CreateList(testEvents(1))
+			Write(s, "events.avro", test.schema, col, test.opts...)
+		})
+	}
+}
+
+type EventV2 struct {
+	ID       int64   `avro:"id"`
+	Label    string  `avro:"label"`
+	Score    float64 `avro:"score"`
+	Priority int32   `avro:"priority"`
+}
+
+const eventV2Schema = `{
+	"type": "record",
+	"name": "Event",
+	"namespace": "events",
+	"fields": [
+		{ "name": "id", "type": "long" },
+		{ "name": "label", "aliases": ["name"], "type": "string" },
+		{ "name": "score", "type": ["null", "double"], "default": null },
+		{ "name": "priority", "type": "int", "default": 3 }
+	]
+}`
+
+func TestRead_readerSchema(t *testing.T) {
+	avroFile := filepath.Join(t.TempDir(), "events.avro")
+	events := testEvents(3)
+
+	p, s, col := ptest.CreateList(events)
+	Write(s, avroFile, eventSchema, col)
+	ptest.RunAndValidate(t, p)
+
+	p, s = beam.NewPipelineWithRoot()
+	got := Read(s, avroFile, reflect.TypeOf(EventV2{}), ReadSchema(eventV2Schema))
+	passert.Equals(s, got,
+		EventV2{ID: 0, Label: "event0", Score: 0, Priority: 3},
+		EventV2{ID: 1, Label: "event1", Priority: 3},
+		EventV2{ID: 2, Label: "event2", Score: 1, Priority: 3},
+	)
+	ptest.RunAndValidate(t, p)
+}
+
+func TestRead_invalidReadSchema(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Read() did not panic")
+		}
+	}()
+	_, s := beam.NewPipelineWithRoot()
+	Read(s, "events.avro", reflect.TypeOf(EventV2{}), ReadSchema(`{"type": "unknown"}`))
+}
+
+func TestAvroReadFn_splits(t *testing.T) {
+	ctx := context.Background()
+	avroFile := filepath.Join(t.TempDir(), "users.avro")
+	fd, err := os.Create(avroFile)
+	if err != nil {
+		t.Fatalf("Failed to create file: %v", err)
+	}
+	codec, err := goavro.NewCodec(userSchema)
+	if err != nil {
+		t.Fatalf("goavro.NewCodec() failed: %v", err)
+	}
+	w, err := newOCFWriter(fd, codec, CodecSnappy, 64)
+	if err != nil {
+		t.Fatalf("newOCFWriter() failed: %v", err)
+	}
+	var want []TwitterUser
+	for i := 0; i < 40; i++ {
+		u := TwitterUser{User: fmt.Sprintf("user%d", i), Info: "info"}
+		want = append(want, u)
+		if err := w.Append(map[string]any{"username": u.User, "info": u.Info}); err != nil {
+			t.Fatalf("Append() failed: %v", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() failed: %v", err)
+	}
+	if err := fd.Close(); err != nil {
+		t.Fatalf("Failed to close file: %v", err)
+	}
+	info, err := os.Stat(avroFile)
+	if err != nil {
+		t.Fatalf("Failed to stat file: %v", err)
+	}
+
+	file := fileio.ReadableFile{Metadata: fileio.FileMetadata{Path: avroFile, Size: info.Size()}}
+	fn := &avroReadFn{Type: beam.EncodedType{T: reflect.TypeOf(TwitterUser{})}}
+	if err := fn.Setup(); err != nil {
+		t.Fatalf("Setup() failed: %v", err)
+	}
+	rest := fn.CreateInitialRestriction(file)
+
+	// Every split point must yield all records exactly once.
+	for split := rest.Start; split <= rest.End; split += 7 {
+		var got []TwitterUser
+		for _, r := range []offsetrange.Restriction{{Start: rest.Start, End: split}, {Start: split, End: rest.End}} {
+			rt := fn.CreateTracker(r)
+			err := fn.ProcessElement(ctx, rt, file, func(x beam.X) {
+				got = append(got, x.(TwitterUser))
+			})
+			if err != nil {
+				t.Fatalf("ProcessElement(%v) failed: %v", r, err)
+			}
+			if !rt.IsDone() {
+				t.Errorf("ProcessElement(%v) did not complete its restriction", r)
+			}
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Fatalf("records split at %d mismatch (-want +got):\n%s", split, diff)
+		}
+	}
+}
+
+func toAnys[T any](values []T) []any {
+	var res []any
+	for _, v := range values {
+		res = append(res, v)
+	}
+	return res
+}
@@ -0,0 +1,625 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avroio
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeOf(time.Time{})
+	durationType = reflect.TypeOf(time.Duration(0))
+	ratType      = reflect.TypeOf(big.Rat{})
+)
+
+// avroFieldName returns the Avro field name of a struct field. The name is
+// taken from the "avro" tag, then the "json" tag, and defaults to the Go
+// field name. It returns false for fields that should be skipped.
+func avroFieldName(f reflect.StructField) (string, bool) {
+	if f.PkgPath != "" {
+		return "", false
+	}
+	for _, key := range []string{"avro", "json"} {
+		if tag, ok := f.Tag.Lookup(key); ok {
+			name := strings.Split(tag, ",")[0]
+			if name == "-" {
+				return "", false
+			}
+			if name != "" {
+				return name, true
+			}
+		}
+	}
+	return f.Name, true
+}
+
+// structField returns the field of the struct value with the given Avro name.
+func structField(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if n, ok := avroFieldName(t.Field(i)); ok && n == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// toGo stores the goavro native value v of schema s in dst.
+func toGo(s *avroSchema, v any, dst reflect.Value) error {
+	if dst.Kind() == reflect.Interface {
+		if v == nil {
+			dst.Set(reflect.Zero(dst.Type()))
+			return nil
+		}
+		rv := reflect.ValueOf(v)
+		if !rv.Type().AssignableTo(dst.Type()) {
+			return fmt.Errorf("cannot assign %T to %v", v, dst.Type())
+		}
+		dst.Set(rv)
+		return nil
+	}
+	if v == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+	if s.Type == "union" {
+		name, inner, err := unionValue(v)
+		if err != nil {
+			return err
+		}
+		b, ok := s.branch(name)
+		if !ok {
+			return fmt.Errorf("union value of unknown type %v", name)
+		}
+		if f, ok := wrapperField(b, name, dst); ok {
+			return toGo(b, inner, f)
+		}
+		return toGo(b, inner, dst)
+	}
+	if dst.Kind() == reflect.Ptr {
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		return toGo(s, v, dst.Elem())
+	}
+
+	switch s.Type {
+	case "null":
+		return nil
+	case "record":
+		m, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("invalid record value %v", v)
+		}
+		switch dst.Kind() {
+		case reflect.Struct:
+			for _, f := range s.Fields {
+				fv, ok := structField(dst, f.Name)
+				if !ok {
+					continue
+				}
+				if err := toGo(f.Schema, m[f.Name], fv); err != nil {
+					return fmt.Errorf("field %v: %v", f.Name, err)
+				}
+			}
+			return nil
+		case reflect.Map:
+			if dst.Type().Key().Kind() != reflect.String {
+				return fmt.Errorf("cannot decode record %v into %v", s.Name, dst.Type())
+			}
+			if dst.IsNil() {
+				dst.Set(reflect.MakeMap(dst.Type()))
+			}
+			for _, f := range s.Fields {
+				e := reflect.New(dst.Type().Elem()).Elem()
+				if err := toGo(f.Schema, m[f.Name], e); err != nil {
+					return fmt.Errorf("field %v: %v", f.Name, err)
+				}
+				dst.SetMapIndex(reflect.ValueOf(f.Name).Convert(dst.Type().Key()), e)
+			}
+			return nil
+		}
+	case "map":
+		m, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("invalid map value %v", v)
+		}
+		if dst.Kind() == reflect.Map && dst.Type().Key().Kind() == reflect.String {
+			res := reflect.MakeMapWithSize(dst.Type(), len(m))
+			for k, e := range m {
+				ev := reflect.New(dst.Type().Elem()).Elem()
+				if err := toGo(s.Values, e, ev); err != nil {
+					return fmt.Errorf("map key %v: %v", k, err)
+				}
+				res.SetMapIndex(reflect.ValueOf(k).Convert(dst.Type().Key()), ev)
+			}
+			dst.Set(res)
+			return nil
+		}
+	case "array":
+		list, ok := v.([]any)
+		if !ok {
+			return fmt.Errorf("invalid array value %v", v)
+		}
+		switch dst.Kind() {
+		case reflect.Slice:
+			res := reflect.MakeSlice(dst.Type(), len(list), len(list))
+			for i, e := range list {
+				if err := toGo(s.Items, e, res.Index(i)); err != nil {
+					return fmt.Errorf("array index %d: %v", i, err)
+				}
+			}
+			dst.Set(res)
+			return nil
+		case reflect.Array:
+			if len(list) != dst.Len() {
+				return fmt.Errorf("cannot decode array of length %d into %v", len(list), dst.Type())
+			}
+			for i, e := range list {
+				if err := toGo(s.Items, e, dst.Index(i)); err != nil {
+					return fmt.Errorf("array index %d: %v", i, err)
+				}
+			}
+			return nil
+		}
+	case "enum":
+		sym, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("invalid enum value %v", v)
+		}
+		switch dst.Kind() {
+		case reflect.String:
+			dst.SetString(sym)
+			return nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			for i, s := range s.Symbols {
+				if s == sym {
+					dst.SetInt(int64(i))
+					return nil
+				}
+			}
+			return fmt.Errorf("unknown enum symbol %v", sym)
+		}
+	default:
+		if r, ok := v.(*big.Rat); ok {
+			return setRat(r, dst)
+		}
+		if b, ok := v.([]byte); ok {
+			return setBytes(b, dst)
+		}
+		return setPrimitive(v, dst)
+	}
+	return fmt.Errorf("cannot decode avro %v into %v", s.unionName(), dst.Type())
+}
+
+// unionValue unpacks a non-nil goavro union value.
+func unionValue(v any) (string, any, error) {
+	m, ok := v.(map[string]any)
+	if !ok || len(m) != 1 {
+		return "", nil, fmt.Errorf("invalid union value %v", v)
+	}
+	for name, inner := range m {
+		return name, inner, nil
+	}
+	panic("unreachable")
+}
+
+// wrapperField supports structs that wrap a union value in a field named
+// after the type of the value, mirroring the JSON encoding of Avro unions:
+//
+//	type NullableString struct {
+//		Value string `json:"string"`
+//	}
+//
+// It returns the field of dst that should receive a union value of branch b.
+func wrapperField(b *avroSchema, name string, dst reflect.Value) (reflect.Value, bool) {
+	if b.Type == "record" {
+		return reflect.Value{}, false
+	}
+	t := dst.Type()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == timeType || t == ratType {
+		return reflect.Value{}, false
+	}
+	for dst.Kind() == reflect.Ptr {
+		if dst.IsNil() {
+			dst.Set(reflect.New(dst.Type().Elem()))
+		}
+		dst = dst.Elem()
+	}
+	return structField(dst, name)
+}
+
+func setRat(r *big.Rat, dst reflect.Value) error {
+	switch {
+	case dst.Type() == ratType:
+		dst.Set(reflect.ValueOf(*r))
+	case dst.Kind() == reflect.Float32 || dst.Kind() == reflect.Float64:
+		f, _ := r.Float64()
+		dst.SetFloat(f)
+	case dst.Kind() == reflect.String:
+		dst.SetString(r.RatString())
+	default:
+		return fmt.Errorf("cannot decode decimal into %v", dst.Type())
+	}
+	return nil
+}
+
+func setBytes(b []byte, dst reflect.Value) error {
+	switch {
+	case dst.Kind() == reflect.Slice && dst.Type().Elem().Kind() == reflect.Uint8:
+		dst.SetBytes(append([]byte(nil), b...))
+	case dst.Kind() == reflect.Array && dst.Type().Elem().Kind() == reflect.Uint8:
+		if dst.Len() != len(b) {
+			return fmt.Errorf("cannot decode %d bytes into %v", len(b), dst.Type())
+		}
+		reflect.Copy(dst, reflect.ValueOf(b))
+	case dst.Kind() == reflect.String:
+		dst.SetString(string(b))
+	default:
+		return fmt.Errorf("cannot decode bytes into %v", dst.Type())
+	}
+	return nil
+}
+
+func setPrimitive(v any, dst reflect.Value) error {
+	rv := reflect.ValueOf(v)
+	if rv.Type().AssignableTo(dst.Type()) {
+		dst.Set(rv)
+		return nil
+	}
+	switch x := v.(type) {
+	case time.Time:
+		switch dst.Kind() {
+		case reflect.Int64:
+			dst.SetInt(x.UnixMilli())
+			return nil
+		}
+	case time.Duration:
+		if dst.Type() == durationType || isInt(dst.Kind()) {
+			dst.SetInt(int64(x))
+			return nil
+		}
+	case bool:
+		if dst.Kind() == reflect.Bool {
+			dst.SetBool(x)
+			return nil
+		}
+	case string:
+		if dst.Kind() == reflect.String {
+			dst.SetString(x)
+			return nil
+		}
+	case int32, int64:
+		i := rv.Int()
+		switch {
+		case isInt(dst.Kind()):
+			if dst.OverflowInt(i) {
+				return fmt.Errorf("value %d overflows %v", i, dst.Type())
+			}
+			dst.SetInt(i)
+			return nil
+		case isUint(dst.Kind()):
+			if i < 0 || dst.OverflowUint(uint64(i)) {
+				return fmt.Errorf("value %d overflows %v", i, dst.Type())
+			}
+			dst.SetUint(uint64(i))
+			return nil
+		case dst.Kind() == reflect.Float32 || dst.Kind() == reflect.Float64:
+			dst.SetFloat(float64(i))
+			return nil
+		}
+	case float32, float64:
+		f := rv.Float()
+		switch {
+		case dst.Kind() == reflect.Float32 || dst.Kind() == reflect.Float64:
+			dst.SetFloat(f)
+			return nil
+		case isInt(dst.Kind()) && f == math.Trunc(f):
+			// Integral floating point values may be decoded into integers,
+			// as was supported when records were decoded through JSON.
+			if f < math.MinInt64 || f >= math.MaxInt64 || dst.OverflowInt(int64(f)) {
+				return fmt.Errorf("value %v overflows %v", f, dst.Type())
+			}
+			dst.SetInt(int64(f))
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot decode %T into %v", v, dst.Type())
+}
+
+func isInt(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	default:
+		return false
+	}
+}
+
+func isUint(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// fromGo converts the Go value v to the goavro native value of schema s.
+func fromGo(s *avroSchema, v reflect.Value) (any, error) {
+	for v.IsValid() && v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if s.Type == "union" {
+		return unionFromGo(s, v)
+	}
+	if v.IsValid() && v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v = reflect.Value{}
+		} else {
+			v = v.Elem()
+		}
+	}
+	if !v.IsValid() {
+		if s.Type == "null" {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("nil value for non-nullable avro %v", s.unionName())
+	}
+
+	switch s.Type {
+	case "null":
+		return nil, nil
+	case "boolean":
+		if v.Kind() == reflect.Bool {
+			return v.Bool(), nil
+		}
+	case "int", "long":
+		switch {
+		case v.Type() == timeType || v.Type() == durationType:
+			if s.LogicalType == "" {
+				break
+			}
+			return v.Interface(), nil
+		case isInt(v.Kind()):
+			if s.Type == "int" {
+				if v.Int() < -1<<31 || v.Int() > 1<<31-1 {
+					return nil, fmt.Errorf("value %d overflows avro int", v.Int())
+				}
+				return integerToLogical(s, int32(v.Int())), nil
+			}
+			return integerToLogical(s, v.Int()), nil
+		case isUint(v.Kind()):
+			if s.Type == "int" {
+				if v.Uint() > 1<<31-1 {
+					return nil, fmt.Errorf("value %d overflows avro int", v.Uint())
+				}
+				return integerToLogical(s, int32(v.Uint())), nil
+			}
+			if v.Uint() > 1<<63-1 {
+				return nil, fmt.Errorf("value %d overflows avro long", v.Uint())
+			}
+			return integerToLogical(s, int64(v.Uint())), nil
+		}
+	case "float":
+		if v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
+			return float32(v.Float()), nil
+		}
+	case "double":
+		if v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64 {
+			return v.Float(), nil
+		}
+	case "string", "enum":
+		if v.Kind() == reflect.String {
+			return v.String(), nil
+		}
+		if s.Type == "enum" && isInt(v.Kind()) {
+			i := v.Int()
+			if i < 0 || i >= int64(len(s.Symbols)) {
+				return nil, fmt.Errorf("enum index %d out of range for %v", i, s.Name)
+			}
+			return s.Symbols[i], nil
+		}
+		if s.Type == "string" && isByteSlice(v.Type()) {
+			return string(v.Bytes()), nil
+		}
+	case "bytes", "fixed":
+		if s.LogicalType == "decimal" {
+			switch {
+			case v.Type() == ratType:
+				r := v.Interface().(big.Rat)
+				return &r, nil
+			case v.Kind() == reflect.Float32 || v.Kind() == reflect.Float64:
+				return new(big.Rat).SetFloat64(v.Float()), nil
+			}
+		}
+		switch {
+		case isByteSlice(v.Type()):
+			return append([]byte(nil), v.Bytes()...), nil
+		case v.Kind() == reflect.Array && v.Type().Elem().Kind() == reflect.Uint8:
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return b, nil
+		case v.Kind() == reflect.String:
+			return []byte(v.String()), nil
+		}
+	case "array":
+		if (v.Kind() == reflect.Slice && !isByteSlice(v.Type())) || v.Kind() == reflect.Array {
+			res := make([]any, v.Len())
+			for i := range res {
+				e, err := fromGo(s.Items, v.Index(i))
+				if err != nil {
+					return nil, fmt.Errorf("array index %d: %v", i, err)
+				}
+				res[i] = e
+			}
+			return res, nil
+		}
+	case "map":
+		if v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String {
+			res := make(map[string]any, v.Len())
+			iter := v.MapRange()
+			for iter.Next() {
+				e, err := fromGo(s.Values, iter.Value())
+				if err != nil {
+					return nil, fmt.Errorf("map key %v: %v", iter.Key(), err)
+				}
+				res[iter.Key().String()] = e
+			}
+			return res, nil
+		}
+	case "record":
+		return recordFromGo(s, v)
+	}
+	return nil, fmt.Errorf("cannot encode %v as avro %v", v.Type(), s.unionName())
+}
+
+func recordFromGo(s *avroSchema, v reflect.Value) (any, error) {
+	res := make(map[string]any, len(s.Fields))
+	switch {
+	case v.Kind() == reflect.Struct && v.Type() != timeType && v.Type() != ratType:
+		for _, f := range s.Fields {
+			fv, ok := structField(v, f.Name)
+			if !ok {
+				if f.HasDefault {
+					// goavro fills in the default.
+					continue
+				}
+				return nil, fmt.Errorf("%v has no field for avro field %v of record %v", v.Type(), f.Name, s.Name)
+			}
+			e, err := fromGo(f.Schema, fv)
+			if err != nil {
+				return nil, fmt.Errorf("field %v: %v", f.Name, err)
+			}
+			res[f.Name] = e
+		}
+		return res, nil
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String:
+		for _, f := range s.Fields {
+			fv := v.MapIndex(reflect.ValueOf(f.Name).Convert(v.Type().Key()))
+			if !fv.IsValid() {
+				if f.HasDefault {
+					continue
+				}
+				return nil, fmt.Errorf("map has no key for avro field %v of record %v", f.Name, s.Name)
+			}
+			e, err := fromGo(f.Schema, fv)
+			if err != nil {
+				return nil, fmt.Errorf("field %v: %v", f.Name, err)
+			}
+			res[f.Name] = e
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("cannot encode %v as avro record %v", v.Type(), s.Name)
+	}
+}
+
+func unionFromGo(s *avroSchema, v reflect.Value) (any, error) {
+	for v.IsValid() && v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v = reflect.Value{}
+			break
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		if _, ok := s.branch("null"); ok {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("nil value for union without null")
+	}
+	// Wrapped union values, either in goavro's native form or as a wrapper
+	// struct with a single set field named after the branch.
+	if m, ok := v.Interface().(map[string]any); ok && len(m) == 1 {
+		for name, inner := range m {
+			if b, ok := s.branch(name); ok {
+				e, err := fromGo(b, reflect.ValueOf(inner))
+				if err != nil {
+					return nil, err
+				}
+				return map[string]any{name: e}, nil
+			}
+		}
+	}
+	for _, b := range s.Branches {
+		if b.Type == "null" {
+			continue
+		}
+		if f, ok := wrapperField(b, b.unionName(), v); ok && !f.IsZero() {
+			e, err := fromGo(b, f)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]any{b.unionName(): e}, nil
+		}
+	}
+	for _, b := range s.Branches {
+		if b.Type == "null" || !compatible(b, v.Type()) {
+			continue
+		}
+		e, err := fromGo(b, v)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{b.unionName(): e}, nil
+	}
+	return nil, fmt.Errorf("%v matches no branch of avro union", v.Type())
+}
+
+// compatible reports whether values of the Go type t can be encoded as s.
+func compatible(s *avroSchema, t reflect.Type) bool {
+	switch s.Type {
+	case "boolean":
+		return t.Kind() == reflect.Bool
+	case "int", "long":
+		if t == timeType || t == durationType {
+			return s.LogicalType != ""
+		}
+		return isInt(t.Kind()) || isUint(t.Kind())
+	case "float", "double":
+		return t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+	case "string":
+		return t.Kind() == reflect.String
+	case "enum":
+		return t.Kind() == reflect.String
+	case "bytes":
+		return isByteSlice(t) || (s.LogicalType == "decimal" && t == ratType)
+	case "fixed":
+		return isByteSlice(t) || (t.Kind() == reflect.Array && t.Elem().Kind() == reflect.Uint8 && t.Len() == s.Size)
+	case "array":
+		return (t.Kind() == reflect.Slice && !isByteSlice(t)) || t.Kind() == reflect.Array
+	case "map":
+		return t.Kind() == reflect.Map && t.Key().Kind() == reflect.String
+	case "record":
+		return (t.Kind() == reflect.Struct && t != timeType && t != ratType) ||
+			(t.Kind() == reflect.Map && t.Key().Kind() == reflect.String)
+	default:
+		return false
+	}
+}
+
+func isByteSlice(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+}
@@ -0,0 +1,360 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avroio
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/linkedin/goavro/v2"
+)
+
+// This file implements the Avro object container file format. goavro provides
+// an implementation as well, but it neither exposes block boundaries, which
+// are needed to split files, nor supports zstandard compression.
+
+const (
+	syncSize = 16
+
+	metaSchema = "avro.schema"
+	metaCodec  = "avro.codec"
+)
+
+var ocfMagic = [4]byte{'O', 'b', 'j', 1}
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// ocfHeader is the header of an object container file.
+type ocfHeader struct {
+	Schema string
+	Codec  Codec
+	Sync   [syncSize]byte
+}
+
+// positionReader is a buffered reader that keeps track of its position.
+type positionReader struct {
+	r   *bufio.Reader
+	pos int64
+}
+
+func newPositionReader(r io.Reader) *positionReader {
+	return &positionReader{r: bufio.NewReaderSize(r, 1<<20)}
+}
+
+func (r *positionReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.pos += int64(n)
+	return n, err
+}
+
+func (r *positionReader) ReadByte() (byte, error) {
+	b, err := r.r.ReadByte()
+	if err == nil {
+		r.pos++
+	}
+	return b, err
+}
+
+// skipTo discards input up to the given position.
+func (r *positionReader) skipTo(pos int64) error {
+	for r.pos < pos {
+		n := pos - r.pos
+		if n > 1<<30 {
+			n = 1 << 30
+		}
+		d, err := r.r.Discard(int(n))
+		r.pos += int64(d)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// skipPastSync discards input up to and including the next occurrence of the
+// sync marker. It returns io.EOF if there is none.
+func (r *positionReader) skipPastSync(sync [syncSize]byte) error {
+	var window [syncSize]byte
+	n := 0
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return err
+		}
+		if n < syncSize {
+			window[n] = b
+			n++
+		} else {
+			copy(window[:], window[1:])
+			window[syncSize-1] = b
+		}
+		if n == syncSize && window == sync {
+			return nil
+		}
+	}
+}
+
+func (r *positionReader) readLong() (int64, error) {
+	u, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, err
+	}
+	return int64(u>>1) ^ -int64(u&1), nil
+}
+
+func (r *positionReader) readBytes() ([]byte, error) {
+	n, err := r.readLong()
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("invalid length %d", n)
+	}
+	b := make([]byte, n)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// readHeader reads the header of an object container file.
+func readHeader(r *positionReader) (*ocfHeader, error) {
+	var magic [4]byte
+	if _, err := io.ReadFull(r, magic[:]); err != nil {
+		return nil, fmt.Errorf("failed to read avro header: %v", err)
+	}
+	if magic != ocfMagic {
+		return nil, fmt.Errorf("not an avro object container file")
+	}
+
+	meta := make(map[string][]byte)
+	for {
+		count, err := r.readLong()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read avro metadata: %v", err)
+		}
+		if count == 0 {
+			break
+		}
+		if count < 0 {
+			// A negative count is followed by the size of the block in bytes.
+			count = -count
+			if _, err := r.readLong(); err != nil {
+				return nil, fmt.Errorf("failed to read avro metadata: %v", err)
+			}
+		}
+		for i := int64(0); i < count; i++ {
+			k, err := r.readBytes()
+			if err != nil {
+				return nil, fmt.Errorf("failed to read avro metadata: %v", err)
+			}
+			v, err := r.readBytes()
+			if err != nil {
+				return nil, fmt.Errorf("failed to read avro metadata: %v", err)
+			}
+			meta[string(k)] = v
+		}
+	}
+
+	h := &ocfHeader{Schema: string(meta[metaSchema]), Codec: Codec(meta[metaCodec])}
+	if h.Schema == "" {
+		return nil, fmt.Errorf("avro header has no schema")
+	}
+	if h.Codec == "" {
+		h.Codec = CodecNull
+	}
+	if _, err := io.ReadFull(r, h.Sync[:]); err != nil {
+		return nil, fmt.Errorf("failed to read avro sync marker: %v", err)
+	}
+	return h, nil
+}
+
+// readBlock reads a data block, returning its number of objects and its
+// decompressed data. It returns io.EOF if there are no more blocks.
+func readBlock(r *positionReader, h *ocfHeader) (int64, []byte, error) {
+	count, err := r.readLong()
+	if err != nil {
+		return 0, nil, err
+	}
+	data, err := r.readBytes()
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read avro block: %v", unexpectedEOF(err))
+	}
+	var sync [syncSize]byte
+	if _, err := io.ReadFull(r, sync[:]); err != nil {
+		return 0, nil, fmt.Errorf("failed to read avro sync marker: %v", unexpectedEOF(err))
+	}
+	if sync != h.Sync {
+		return 0, nil, fmt.Errorf("avro sync marker mismatch, the file may be corrupt")
+	}
+	data, err = decompress(h.Codec, data)
+	if err != nil {
+		return 0, nil, err
+	}
+	return count, data, nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func decompress(codec Codec, data []byte) ([]byte, error) {
+	switch codec {
+	case CodecNull:
+		return data, nil
+	case CodecDeflate:
+		out, err := io.ReadAll(flate.NewReader(bytes.NewReader(data)))
+		if err != nil {
+			return nil, fmt.Errorf("failed to inflate avro block: %v", err)
+		}
+		return out, nil
+	case CodecSnappy:
+		if len(data) < 4 {
+			return nil, fmt.Errorf("snappy avro block is too short")
+		}
+		out, err := snappy.Decode(nil, data[:len(data)-4])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode snappy avro block: %v", err)
+		}
+		if crc32.ChecksumIEEE(out) != binary.BigEndian.Uint32(data[len(data)-4:]) {
+			return nil, fmt.Errorf("snappy avro block checksum mismatch")
+		}
+		return out, nil
+	case CodecZstandard:
+		out, err := zstdDecoder.DecodeAll(data, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode zstandard avro block: %v", err)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported avro codec %q", codec)
+	}
+}
+
+func compress(codec Codec, data []byte) ([]byte, error) {
+	switch codec {
+	case CodecNull:
+		return data, nil
+	case CodecDeflate:
+		var buf bytes.Buffer
+		w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(data); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CodecSnappy:
+		out := snappy.Encode(nil, data)
+		return binary.BigEndian.AppendUint32(out, crc32.ChecksumIEEE(data)), nil
+	case CodecZstandard:
+		return zstdEncoder.EncodeAll(data, nil), nil
+	default:
+		return nil, fmt.Errorf("unsupported avro codec %q", codec)
+	}
+}
+
+func appendLong(b []byte, v int64) []byte {
+	return binary.AppendUvarint(b, uint64(v<<1)^uint64(v>>63))
+}
+
+func appendBytes(b, v []byte) []byte {
+	return append(appendLong(b, int64(len(v))), v...)
+}
+
+// ocfWriter writes objects to an object container file.
+type ocfWriter struct {
+	w         io.Writer
+	codec     *goavro.Codec
+	header    *ocfHeader
+	blockSize int
+
+	block []byte
+	count int64
+}
+
+// newOCFWriter writes the file header and returns a writer for the objects of
+// the file. Objects are written in blocks of about blockSize bytes before
+// compression.
+func newOCFWriter(w io.Writer, codec *goavro.Codec, compression Codec, blockSize int) (*ocfWriter, error) {
+	h := &ocfHeader{Schema: codec.Schema(), Codec: compression}
+	if _, err := rand.Read(h.Sync[:]); err != nil {
+		return nil, err
+	}
+	b := append([]byte(nil), ocfMagic[:]...)
+	b = appendLong(b, 2)
+	b = appendBytes(b, []byte(metaSchema))
+	b = appendBytes(b, []byte(h.Schema))
+	b = appendBytes(b, []byte(metaCodec))
+	b = appendBytes(b, []byte(h.Codec))
+	b = appendLong(b, 0)
+	b = append(b, h.Sync[:]...)
+	if _, err := w.Write(b); err != nil {
+		return nil, err
+	}
+	return &ocfWriter{w: w, codec: codec, header: h, blockSize: blockSize}, nil
+}
+
+// Append adds a goavro native value to the file.
+func (w *ocfWriter) Append(native any) error {
+	var err error
+	if w.block, err = w.codec.BinaryFromNative(w.block, native); err != nil {
+		return err
+	}
+	w.count++
+	if len(w.block) >= w.blockSize {
+		return w.Flush()
+	}
+	return nil
+}
+
+// Flush writes out the current block, if any.
+func (w *ocfWriter) Flush() error {
+	if w.count == 0 {
+		return nil
+	}
+	data, err := compress(w.header.Codec, w.block)
+	if err != nil {
+		return err
+	}
+	b := appendLong(nil, w.count)
+	b = appendBytes(b, data)
+	b = append(b, w.header.Sync[:]...)
+	if _, err := w.w.Write(b); err != nil {
+		return err
+	}
+	w.block = w.block[:0]
+	w.count = 0
+	return nil
+}
@@ -0,0 +1,188 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avroio
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/linkedin/goavro/v2"
+)
+
+func writeOCF(t *testing.T, codec *goavro.Codec, compression Codec, blockSize int, records []any) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := newOCFWriter(&buf, codec, compression, blockSize)
+	if err != nil {
+		t.Fatalf("newOCFWriter() failed: %v", err)
+	}
+	for _, r := range records {
+		if err := w.Append(r); err != nil {
+			t.Fatalf("Append(%v) failed: %v", r, err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() failed: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func userRecords(n int) []any {
+	var records []any
+	for i := 0; i < n; i++ {
+		records = append(records, map[string]any{
+			"username": string(rune('a' + i%26)),
+			"info":     "info about a user",
+		})
+	}
+	return records
+}
+
+func TestOCF_roundTrip(t *testing.T) {
+	codec, err := goavro.NewCodec(userSchema)
+	if err != nil {
+		t.Fatalf("goavro.NewCodec() failed: %v", err)
+	}
+	records := userRecords(100)
+
+	for _, compression := range []Codec{CodecNull, CodecDeflate, CodecSnappy, CodecZstandard} {
+		t.Run(string(compression), func(t *testing.T) {
+			data := writeOCF(t, codec, compression, 64, records)
+
+			r := newPositionReader(bytes.NewReader(data))
+			h, err := readHeader(r)
+			if err != nil {
+				t.Fatalf("readHeader() failed: %v", err)
+			}
+			if got, want := h.Codec, compression; got != want {
+				t.Errorf("header codec = %v, want %v", got, want)
+			}
+
+			var got []any
+			blocks := 0
+			for {
+				count, block, err := readBlock(r, h)
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("readBlock() failed: %v", err)
+				}
+				blocks++
+				for i := int64(0); i < count; i++ {
+					var native any
+					if native, block, err = codec.NativeFromBinary(block); err != nil {
+						t.Fatalf("NativeFromBinary() failed: %v", err)
+					}
+					got = append(got, native)
+				}
+			}
+			if blocks < 2 {
+				t.Errorf("got %d blocks, want several", blocks)
+			}
+			if diff := cmp.Diff(records, got); diff != "" {
+				t.Errorf("records mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOCF_goavroCompatible(t *testing.T) {
+	codec, err := goavro.NewCodec(userSchema)
+	if err != nil {
+		t.Fatalf("goavro.NewCodec() failed: %v", err)
+	}
+	records := userRecords(30)
+
+	// goavro does not support zstandard.
+	for _, compression := range []Codec{CodecNull, CodecDeflate, CodecSnappy} {
+		t.Run(string(compression), func(t *testing.T) {
+			data := writeOCF(t, codec, compression, 100, records)
+
+			ocf, err := goavro.NewOCFReader(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("goavro.NewOCFReader() failed: %v", err)
+			}
+			var got []any
+			for ocf.Scan() {
+				datum, err := ocf.Read()
+				if err != nil {
+					t.Fatalf("Read() failed: %v", err)
+				}
+				got = append(got, datum)
+			}
+			if err := ocf.Err(); err != nil {
+				t.Fatalf("goavro OCF reader failed: %v", err)
+			}
+			if diff := cmp.Diff(records, got); diff != "" {
+				t.Errorf("records mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPositionReader_skipPastSync(t *testing.T) {
+	codec, err := goavro.NewCodec(userSchema)
+	if err != nil {
+		t.Fatalf("goavro.NewCodec() failed: %v", err)
+	}
+	data := writeOCF(t, codec, CodecNull, 1, userRecords(3))
+
+	r := newPositionReader(bytes.NewReader(data))
+	h, err := readHeader(r)
+	if err != nil {
+		t.Fatalf("readHeader() failed: %v", err)
+	}
+	// Record the start of each block.
+	starts := []int64{r.pos}
+	for {
+		if _, _, err := readBlock(r, h); err != nil {
+			break
+		}
+		starts = append(starts, r.pos)
+	}
+	// The last start is the end of the file.
+	starts = starts[:len(starts)-1]
+	if len(starts) != 3 {
+		t.Fatalf("got %d blocks, want 3", len(starts))
+	}
+
+	for i := 1; i < len(starts); i++ {
+		for _, offset := range []int64{starts[i-1] + 1, starts[i] - 1} {
+			r := newPositionReader(bytes.NewReader(data))
+			if err := r.skipTo(offset - syncSize); err != nil {
+				t.Fatalf("skipTo(%d) failed: %v", offset-syncSize, err)
+			}
+			if err := r.skipPastSync(h.Sync); err != nil {
+				t.Fatalf("skipPastSync() failed: %v", err)
+			}
+			if got, want := r.pos, starts[i]; got != want {
+				t.Errorf("skipPastSync() from %d ended at %d, want %d", offset, got, want)
+			}
+		}
+	}
+
+	r = newPositionReader(bytes.NewReader(data))
+	// Skip into the sync marker that ends the last block.
+	if err := r.skipTo(int64(len(data) - syncSize + 1)); err != nil {
+		t.Fatalf("skipTo() failed: %v", err)
+	}
+	if err := r.skipPastSync(h.Sync); err != io.EOF {
+		t.Errorf("skipPastSync() past the last block = %v, want io.EOF", err)
+	}
+}
@@ -0,0 +1,101 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avroio
+
+import (
+	"errors"
+	"fmt"
+)
+
+var errInvalidBlockSize = errors.New("block size must be greater than 0")
+
+type readOption struct {
+	ReaderSchema string
+}
+
+// ReadOptionFn is a function that can be passed to Read or ReadAll to configure options for
+// reading avro files.
+type ReadOptionFn func(*readOption) error
+
+// ReadSchema sets the reader schema that data is resolved against. Data in the files is
+// decoded with the schema it was written with, then converted to the reader schema following
+// the Avro schema resolution rules: fields are matched by name or alias, fields missing from
+// the written data take their default value, and numeric types are promoted. This allows
+// reading files written with older or newer versions of an evolving schema into a single type.
+//
+// By default, data is read with the schema it was written with.
+func ReadSchema(schema string) ReadOptionFn {
+	return func(o *readOption) error {
+		if _, err := parseSchema(schema); err != nil {
+			return err
+		}
+
+		o.ReaderSchema = schema
+		return nil
+	}
+}
+
+// Codec is a compression codec for the blocks of an avro file.
+type Codec string
+
+const (
+	// CodecNull leaves blocks uncompressed.
+	CodecNull Codec = "null"
+	// CodecDeflate compresses blocks with deflate.
+	CodecDeflate Codec = "deflate"
+	// CodecSnappy compresses blocks with snappy.
+	CodecSnappy Codec = "snappy"
+	// CodecZstandard compresses blocks with zstandard.
+	CodecZstandard Codec = "zstandard"
+)
+
+const defaultBlockSize = 64 * 1024 // 64 KB
+
+type writeOption struct {
+	Codec     Codec
+	BlockSize int
+}
+
+// WriteOptionFn is a function that can be passed to Write to configure options for writing
+// avro files.
+type WriteOptionFn func(*writeOption) error
+
+// WriteCodec sets the compression codec of the written file. Defaults to CodecSnappy.
+func WriteCodec(codec Codec) WriteOptionFn {
+	return func(o *writeOption) error {
+		switch codec {
+		case CodecNull, CodecDeflate, CodecSnappy, CodecZstandard:
+		default:
+			return fmt.Errorf("unsupported codec %q", codec)
+		}
+
+		o.Codec = codec
+		return nil
+	}
+}
+
+// WriteBlockSize sets the approximate size in bytes of the blocks of the written file, before
+// compression. Blocks are the units that reads of the file are split on. Defaults to 64 KB.
+func WriteBlockSize(size int) WriteOptionFn {
+	return func(o *writeOption) error {
+		if size <= 0 {
+			return errInvalidBlockSize
+		}
+
+		o.BlockSize = size
+		return nil
+	}
+}
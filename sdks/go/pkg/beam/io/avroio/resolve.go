@@ -0,0 +1,451 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avroio
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// resolve converts a goavro native value decoded with the writer schema into
+// the native value for the reader schema, following the schema resolution
+// rules of the Avro specification: record fields are matched by name or
+// alias, fields missing from the writer are filled from reader defaults,
+// numeric types are promoted, and unions are resolved by branch.
+func resolve(writer, reader *avroSchema, v any) (any, error) {
+	if writer.Type == "union" {
+		if v == nil {
+			if reader.Type == "null" {
+				return nil, nil
+			}
+			if reader.Type == "union" {
+				if _, ok := reader.branch("null"); ok {
+					return nil, nil
+				}
+			}
+			return nil, fmt.Errorf("null value written for non-nullable reader type %v", reader.unionName())
+		}
+		name, inner, err := unionValue(v)
+		if err != nil {
+			return nil, err
+		}
+		wb, ok := writer.branch(name)
+		if !ok {
+			return nil, fmt.Errorf("union value of unknown type %v", name)
+		}
+		return resolve(wb, reader, inner)
+	}
+
+	if reader.Type == "union" {
+		for _, rb := range reader.Branches {
+			if !matches(writer, rb) {
+				continue
+			}
+			res, err := resolve(writer, rb, v)
+			if err != nil {
+				return nil, err
+			}
+			if rb.Type == "null" {
+				return nil, nil
+			}
+			return map[string]any{rb.unionName(): res}, nil
+		}
+		return nil, fmt.Errorf("writer type %v matches no branch of reader union", writer.unionName())
+	}
+
+	if !matches(writer, reader) {
+		return nil, fmt.Errorf("writer type %v cannot be read as %v", writer.unionName(), reader.unionName())
+	}
+
+	switch reader.Type {
+	case "record":
+		return resolveRecord(writer, reader, v)
+	case "enum":
+		sym, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid enum value %v", v)
+		}
+		for _, s := range reader.Symbols {
+			if s == sym {
+				return sym, nil
+			}
+		}
+		if reader.EnumDefault != nil {
+			return *reader.EnumDefault, nil
+		}
+		return nil, fmt.Errorf("symbol %q is not defined in reader enum %v", sym, reader.Name)
+	case "array":
+		list, ok := v.([]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid array value %v", v)
+		}
+		res := make([]any, len(list))
+		for i, e := range list {
+			r, err := resolve(writer.Items, reader.Items, e)
+			if err != nil {
+				return nil, err
+			}
+			res[i] = r
+		}
+		return res, nil
+	case "map":
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid map value %v", v)
+		}
+		res := make(map[string]any, len(m))
+		for k, e := range m {
+			r, err := resolve(writer.Values, reader.Values, e)
+			if err != nil {
+				return nil, err
+			}
+			res[k] = r
+		}
+		return res, nil
+	default:
+		return promote(writer, reader, v)
+	}
+}
+
+func resolveRecord(writer, reader *avroSchema, v any) (any, error) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("invalid record value %v", v)
+	}
+	res := make(map[string]any, len(reader.Fields))
+	for _, rf := range reader.Fields {
+		wf, ok := writer.field(rf.Name)
+		for _, alias := range rf.Aliases {
+			if ok {
+				break
+			}
+			wf, ok = writer.field(alias)
+		}
+		if !ok {
+			if !rf.HasDefault {
+				return nil, fmt.Errorf("field %v of reader record %v has no value in writer record %v and no default",
+					rf.Name, reader.Name, writer.Name)
+			}
+			d, err := defaultValue(rf.Schema, rf.Default)
+			if err != nil {
+				return nil, fmt.Errorf("invalid default for field %v of record %v: %v", rf.Name, reader.Name, err)
+			}
+			res[rf.Name] = d
+			continue
+		}
+		r, err := resolve(wf.Schema, rf.Schema, m[wf.Name])
+		if err != nil {
+			return nil, fmt.Errorf("field %v of record %v: %v", rf.Name, reader.Name, err)
+		}
+		res[rf.Name] = r
+	}
+	return res, nil
+}
+
+// matches reports whether data written with the writer schema can be read
+// with the reader schema, without looking into the contents of named and
+// container types.
+func matches(writer, reader *avroSchema) bool {
+	switch reader.Type {
+	case "record", "enum", "fixed":
+		if writer.Type != reader.Type {
+			return false
+		}
+		if reader.Type == "fixed" && writer.Size != reader.Size {
+			return false
+		}
+		return namesMatch(writer, reader)
+	case "array", "map", "null", "boolean", "int":
+		return writer.Type == reader.Type
+	case "long":
+		return writer.Type == "int" || writer.Type == "long"
+	case "float":
+		return writer.Type == "int" || writer.Type == "long" || writer.Type == "float"
+	case "double":
+		return writer.Type == "int" || writer.Type == "long" || writer.Type == "float" || writer.Type == "double"
+	case "bytes", "string":
+		return writer.Type == "bytes" || writer.Type == "string"
+	case "union":
+		if writer.Type == "union" {
+			return true
+		}
+		for _, b := range reader.Branches {
+			if matches(writer, b) {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// namesMatch reports whether the unqualified names of two named types match,
+// either directly or through an alias of the reader.
+func namesMatch(writer, reader *avroSchema) bool {
+	if shortName(writer.Name) == shortName(reader.Name) {
+		return true
+	}
+	for _, a := range reader.Aliases {
+		if a == writer.Name || shortName(a) == shortName(writer.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// promote converts a primitive native value to the native representation of
+// the reader type.
+func promote(writer, reader *avroSchema, v any) (any, error) {
+	if writer.Type == reader.Type && writer.LogicalType == reader.LogicalType {
+		return v, nil
+	}
+	if t, ok := v.(time.Time); ok {
+		// goavro decodes some logical types to time.Time; go back to the
+		// underlying integer before promoting.
+		v = timeToInteger(writer, t)
+	}
+	if d, ok := v.(time.Duration); ok {
+		if writer.LogicalType == "time-micros" {
+			v = d.Microseconds()
+		} else {
+			v = int32(d.Milliseconds())
+		}
+	}
+	var res any
+	switch reader.Type {
+	case "int":
+		i, ok := v.(int32)
+		if !ok {
+			return nil, fmt.Errorf("invalid int value %v", v)
+		}
+		res = i
+	case "long":
+		switch x := v.(type) {
+		case int32:
+			res = int64(x)
+		case int64:
+			res = x
+		default:
+			return nil, fmt.Errorf("invalid long value %v", v)
+		}
+	case "float":
+		switch x := v.(type) {
+		case int32:
+			res = float32(x)
+		case int64:
+			res = float32(x)
+		case float32:
+			res = x
+		default:
+			return nil, fmt.Errorf("invalid float value %v", v)
+		}
+	case "double":
+		switch x := v.(type) {
+		case int32:
+			res = float64(x)
+		case int64:
+			res = float64(x)
+		case float32:
+			res = float64(x)
+		case float64:
+			res = x
+		default:
+			return nil, fmt.Errorf("invalid double value %v", v)
+		}
+	case "string":
+		switch x := v.(type) {
+		case string:
+			res = x
+		case []byte:
+			res = string(x)
+		default:
+			return nil, fmt.Errorf("invalid string value %v", v)
+		}
+	case "bytes":
+		switch x := v.(type) {
+		case string:
+			res = []byte(x)
+		case []byte:
+			res = x
+		default:
+			return nil, fmt.Errorf("invalid bytes value %v", v)
+		}
+	default:
+		res = v
+	}
+	return integerToLogical(reader, res), nil
+}
+
+func timeToInteger(s *avroSchema, t time.Time) any {
+	switch s.LogicalType {
+	case "timestamp-micros":
+		return t.UnixMicro()
+	case "date":
+		return int32(t.Unix() / (24 * 60 * 60))
+	default:
+		return t.UnixMilli()
+	}
+}
+
+// integerToLogical converts an integer to the native representation goavro
+// uses for the logical type of s, if any.
+func integerToLogical(s *avroSchema, v any) any {
+	switch s.Type + "." + s.LogicalType {
+	case "long.timestamp-millis":
+		return time.UnixMilli(v.(int64)).UTC()
+	case "long.timestamp-micros":
+		return time.UnixMicro(v.(int64)).UTC()
+	case "long.time-micros":
+		return time.Duration(v.(int64)) * time.Microsecond
+	case "int.time-millis":
+		return time.Duration(v.(int32)) * time.Millisecond
+	case "int.date":
+		return time.Unix(int64(v.(int32))*24*60*60, 0).UTC()
+	default:
+		return v
+	}
+}
+
+// defaultValue converts the JSON default value of a field to its goavro native
+// representation.
+func defaultValue(s *avroSchema, d any) (any, error) {
+	switch s.Type {
+	case "union":
+		// Defaults of unions correspond to the first branch.
+		b := s.Branches[0]
+		if b.Type == "null" {
+			if d != nil {
+				return nil, fmt.Errorf("non-null default %v for union starting with null", d)
+			}
+			return nil, nil
+		}
+		v, err := defaultValue(b, d)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{b.unionName(): v}, nil
+	case "null":
+		if d != nil {
+			return nil, fmt.Errorf("non-null default %v for null", d)
+		}
+		return nil, nil
+	case "boolean":
+		b, ok := d.(bool)
+		if !ok {
+			return nil, fmt.Errorf("invalid boolean default %v", d)
+		}
+		return b, nil
+	case "int", "long":
+		n, ok := d.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("invalid %v default %v", s.Type, d)
+		}
+		i, err := n.Int64()
+		if err != nil {
+			return nil, err
+		}
+		if s.Type == "int" {
+			return integerToLogical(s, int32(i)), nil
+		}
+		return integerToLogical(s, i), nil
+	case "float", "double":
+		n, ok := d.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("invalid %v default %v", s.Type, d)
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return nil, err
+		}
+		if s.Type == "float" {
+			return float32(f), nil
+		}
+		return f, nil
+	case "string", "enum":
+		str, ok := d.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid %v default %v", s.Type, d)
+		}
+		return str, nil
+	case "bytes", "fixed":
+		str, ok := d.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid %v default %v", s.Type, d)
+		}
+		// Bytes defaults map unicode code points 0-255 to byte values.
+		b := make([]byte, 0, len(str))
+		for _, r := range str {
+			if r > 255 {
+				return nil, fmt.Errorf("invalid %v default %q", s.Type, str)
+			}
+			b = append(b, byte(r))
+		}
+		return b, nil
+	case "array":
+		list, ok := d.([]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid array default %v", d)
+		}
+		res := make([]any, len(list))
+		for i, e := range list {
+			v, err := defaultValue(s.Items, e)
+			if err != nil {
+				return nil, err
+			}
+			res[i] = v
+		}
+		return res, nil
+	case "map":
+		m, ok := d.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid map default %v", d)
+		}
+		res := make(map[string]any, len(m))
+		for k, e := range m {
+			v, err := defaultValue(s.Values, e)
+			if err != nil {
+				return nil, err
+			}
+			res[k] = v
+		}
+		return res, nil
+	case "record":
+		m, ok := d.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid record default %v", d)
+		}
+		res := make(map[string]any, len(s.Fields))
+		for _, f := range s.Fields {
+			fd, ok := m[f.Name]
+			if !ok {
+				if !f.HasDefault {
+					return nil, fmt.Errorf("record default is missing field %v", f.Name)
+				}
+				fd = f.Default
+			}
+			v, err := defaultValue(f.Schema, fd)
+			if err != nil {
+				return nil, err
+			}
+			res[f.Name] = v
+		}
+		return res, nil
+	default:
+		return nil, fmt.Errorf("unsupported default for type %v", s.Type)
+	}
+}
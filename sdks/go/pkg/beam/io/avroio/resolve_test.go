@@ -0,0 +1,179 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avroio
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name   string
+		writer string
+		reader string
+		value  any
+		want   any
+	}{
+		{
+			name:   "int promoted to long",
+			writer: `"int"`,
+			reader: `"long"`,
+			value:  int32(5),
+			want:   int64(5),
+		},
+		{
+			name:   "long promoted to double",
+			writer: `"long"`,
+			reader: `"double"`,
+			value:  int64(5),
+			want:   float64(5),
+		},
+		{
+			name:   "string read as bytes",
+			writer: `"string"`,
+			reader: `"bytes"`,
+			value:  "abc",
+			want:   []byte("abc"),
+		},
+		{
+			name:   "value read into union",
+			writer: `"string"`,
+			reader: `["null", "string"]`,
+			value:  "abc",
+			want:   map[string]any{"string": "abc"},
+		},
+		{
+			name:   "union read as value",
+			writer: `["null", "int"]`,
+			reader: `"long"`,
+			value:  map[string]any{"int": int32(1)},
+			want:   int64(1),
+		},
+		{
+			name:   "null union read into union",
+			writer: `["null", "int"]`,
+			reader: `["long", "null"]`,
+			value:  nil,
+			want:   nil,
+		},
+		{
+			name:   "enum default",
+			writer: `{"type": "enum", "name": "E", "symbols": ["A", "B", "C"]}`,
+			reader: `{"type": "enum", "name": "E", "symbols": ["A", "B"], "default": "A"}`,
+			value:  "C",
+			want:   "A",
+		},
+		{
+			name: "record evolution",
+			writer: `{"type": "record", "name": "r", "namespace": "n", "fields": [
+				{"name": "id", "type": "int"},
+				{"name": "old", "type": "string"},
+				{"name": "removed", "type": "string"}
+			]}`,
+			reader: `{"type": "record", "name": "renamed", "namespace": "n", "aliases": ["r"], "fields": [
+				{"name": "id", "type": "long"},
+				{"name": "new", "aliases": ["old"], "type": "string"},
+				{"name": "added", "type": ["null", "string"], "default": null},
+				{"name": "count", "type": "int", "default": 7}
+			]}`,
+			value: map[string]any{"id": int32(1), "old": "x", "removed": "y"},
+			want:  map[string]any{"id": int64(1), "new": "x", "added": nil, "count": int32(7)},
+		},
+		{
+			name:   "array of promoted values",
+			writer: `{"type": "array", "items": "float"}`,
+			reader: `{"type": "array", "items": "double"}`,
+			value:  []any{float32(1.5)},
+			want:   []any{float64(1.5)},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			writer, err := parseSchema(test.writer)
+			if err != nil {
+				t.Fatalf("parseSchema(%v) failed: %v", test.writer, err)
+			}
+			reader, err := parseSchema(test.reader)
+			if err != nil {
+				t.Fatalf("parseSchema(%v) failed: %v", test.reader, err)
+			}
+			got, err := resolve(writer, reader, test.value)
+			if err != nil {
+				t.Fatalf("resolve() failed: %v", err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("resolve() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResolve_incompatible(t *testing.T) {
+	tests := []struct {
+		name   string
+		writer string
+		reader string
+		value  any
+	}{
+		{
+			name:   "long read as int",
+			writer: `"long"`,
+			reader: `"int"`,
+			value:  int64(1),
+		},
+		{
+			name:   "null read as value",
+			writer: `["null", "int"]`,
+			reader: `"int"`,
+			value:  nil,
+		},
+		{
+			name:   "unknown enum symbol",
+			writer: `{"type": "enum", "name": "E", "symbols": ["A", "B"]}`,
+			reader: `{"type": "enum", "name": "E", "symbols": ["A"]}`,
+			value:  "B",
+		},
+		{
+			name:   "missing field without default",
+			writer: `{"type": "record", "name": "r", "fields": []}`,
+			reader: `{"type": "record", "name": "r", "fields": [{"name": "f", "type": "int"}]}`,
+			value:  map[string]any{},
+		},
+		{
+			name:   "different record names",
+			writer: `{"type": "record", "name": "a", "fields": []}`,
+			reader: `{"type": "record", "name": "b", "fields": []}`,
+			value:  map[string]any{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			writer, err := parseSchema(test.writer)
+			if err != nil {
+				t.Fatalf("parseSchema(%v) failed: %v", test.writer, err)
+			}
+			reader, err := parseSchema(test.reader)
+			if err != nil {
+				t.Fatalf("parseSchema(%v) failed: %v", test.reader, err)
+			}
+			if got, err := resolve(writer, reader, test.value); err == nil {
+				t.Errorf("resolve() = %v, want error", got)
+			}
+		})
+	}
+}
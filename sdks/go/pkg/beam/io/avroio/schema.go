@@ -0,0 +1,336 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avroio
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// avroSchema is a parsed Avro schema. It holds the information needed to
+// resolve data written with one schema against another, and to map data to
+// and from Go values. Encoding and decoding of the binary format itself is
+// left to goavro.
+type avroSchema struct {
+	// Type is the Avro type: a primitive type name, "record", "enum",
+	// "array", "map", "fixed" or "union".
+	Type string
+	// Name is the full name of named types.
+	Name string
+	// Aliases are the full alias names of named types.
+	Aliases     []string
+	LogicalType string
+
+	Fields      []*avroField  // record
+	Symbols     []string      // enum
+	EnumDefault *string       // enum
+	Items       *avroSchema   // array
+	Values      *avroSchema   // map
+	Branches    []*avroSchema // union
+	Size        int           // fixed
+}
+
+// avroField is a field of a record schema.
+type avroField struct {
+	Name       string
+	Aliases    []string
+	Schema     *avroSchema
+	Default    any
+	HasDefault bool
+}
+
+var primitiveTypes = map[string]bool{
+	"null":    true,
+	"boolean": true,
+	"int":     true,
+	"long":    true,
+	"float":   true,
+	"double":  true,
+	"bytes":   true,
+	"string":  true,
+}
+
+// goavroLogicalTypes are the logical types goavro decodes to native Go types
+// such as time.Time, keyed by their underlying type.
+var goavroLogicalTypes = map[string]bool{
+	"long.timestamp-millis": true,
+	"long.timestamp-micros": true,
+	"int.time-millis":       true,
+	"long.time-micros":      true,
+	"int.date":              true,
+	"bytes.decimal":         true,
+}
+
+// parseSchema parses an Avro schema from its JSON representation.
+func parseSchema(spec string) (*avroSchema, error) {
+	dec := json.NewDecoder(bytes.NewReader([]byte(spec)))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("invalid avro schema: %v", err)
+	}
+	p := &schemaParser{named: make(map[string]*avroSchema)}
+	return p.parse(v, "")
+}
+
+type schemaParser struct {
+	named map[string]*avroSchema
+}
+
+func (p *schemaParser) parse(v any, namespace string) (*avroSchema, error) {
+	switch t := v.(type) {
+	case string:
+		return p.parseName(t, namespace)
+	case []any:
+		s := &avroSchema{Type: "union"}
+		for _, b := range t {
+			bs, err := p.parse(b, namespace)
+			if err != nil {
+				return nil, err
+			}
+			if bs.Type == "union" {
+				return nil, fmt.Errorf("invalid avro schema: unions may not immediately contain other unions")
+			}
+			s.Branches = append(s.Branches, bs)
+		}
+		return s, nil
+	case map[string]any:
+		return p.parseComplex(t, namespace)
+	default:
+		return nil, fmt.Errorf("invalid avro schema: unexpected %T %v", v, v)
+	}
+}
+
+func (p *schemaParser) parseName(name, namespace string) (*avroSchema, error) {
+	if primitiveTypes[name] {
+		return &avroSchema{Type: name}, nil
+	}
+	if s, ok := p.named[fullName(name, namespace)]; ok {
+		return s, nil
+	}
+	if s, ok := p.named[name]; ok {
+		return s, nil
+	}
+	return nil, fmt.Errorf("invalid avro schema: unknown type %q", name)
+}
+
+func (p *schemaParser) parseComplex(m map[string]any, namespace string) (*avroSchema, error) {
+	typ, ok := m["type"].(string)
+	if !ok {
+		// The type is itself a schema, e.g. {"type": {"type": "array", ...}}.
+		if inner, ok := m["type"]; ok {
+			return p.parse(inner, namespace)
+		}
+		return nil, fmt.Errorf("invalid avro schema: missing type in %v", m)
+	}
+	logicalType, _ := m["logicalType"].(string)
+
+	switch typ {
+	case "record", "error", "enum", "fixed":
+		s, err := p.register(m, namespace)
+		if err != nil {
+			return nil, err
+		}
+		s.LogicalType = logicalType
+		inner := namespaceOf(s.Name)
+		switch typ {
+		case "record", "error":
+			s.Type = "record"
+			fields, ok := m["fields"].([]any)
+			if !ok {
+				return nil, fmt.Errorf("invalid avro schema: record %v has no fields", s.Name)
+			}
+			for _, f := range fields {
+				fm, ok := f.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("invalid avro schema: invalid field %v in record %v", f, s.Name)
+				}
+				field, err := p.parseField(fm, inner)
+				if err != nil {
+					return nil, fmt.Errorf("%v in record %v", err, s.Name)
+				}
+				s.Fields = append(s.Fields, field)
+			}
+		case "enum":
+			s.Type = "enum"
+			symbols, ok := m["symbols"].([]any)
+			if !ok {
+				return nil, fmt.Errorf("invalid avro schema: enum %v has no symbols", s.Name)
+			}
+			for _, sym := range symbols {
+				str, ok := sym.(string)
+				if !ok {
+					return nil, fmt.Errorf("invalid avro schema: invalid symbol %v in enum %v", sym, s.Name)
+				}
+				s.Symbols = append(s.Symbols, str)
+			}
+			if def, ok := m["default"].(string); ok {
+				s.EnumDefault = &def
+			}
+		case "fixed":
+			s.Type = "fixed"
+			size, ok := m["size"].(json.Number)
+			if !ok {
+				return nil, fmt.Errorf("invalid avro schema: fixed %v has no size", s.Name)
+			}
+			n, err := size.Int64()
+			if err != nil {
+				return nil, fmt.Errorf("invalid avro schema: fixed %v has invalid size %v", s.Name, size)
+			}
+			s.Size = int(n)
+		}
+		return s, nil
+	case "array":
+		items, err := p.parse(m["items"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroSchema{Type: "array", Items: items, LogicalType: logicalType}, nil
+	case "map":
+		values, err := p.parse(m["values"], namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroSchema{Type: "map", Values: values, LogicalType: logicalType}, nil
+	default:
+		s, err := p.parseName(typ, namespace)
+		if err != nil {
+			return nil, err
+		}
+		if logicalType == "" {
+			return s, nil
+		}
+		if s.Name != "" {
+			return nil, fmt.Errorf("invalid avro schema: logical type %q on reference to %v", logicalType, s.Name)
+		}
+		ls := *s
+		ls.LogicalType = logicalType
+		return &ls, nil
+	}
+}
+
+func (p *schemaParser) register(m map[string]any, namespace string) (*avroSchema, error) {
+	name, ok := m["name"].(string)
+	if !ok || name == "" {
+		return nil, fmt.Errorf("invalid avro schema: named type without name: %v", m)
+	}
+	if ns, ok := m["namespace"].(string); ok && !strings.Contains(name, ".") {
+		namespace = ns
+	}
+	full := fullName(name, namespace)
+	if _, ok := p.named[full]; ok {
+		return nil, fmt.Errorf("invalid avro schema: type %v is defined twice", full)
+	}
+	s := &avroSchema{Name: full}
+	for _, a := range stringList(m["aliases"]) {
+		s.Aliases = append(s.Aliases, fullName(a, namespaceOf(full)))
+	}
+	p.named[full] = s
+	return s, nil
+}
+
+func (p *schemaParser) parseField(m map[string]any, namespace string) (*avroField, error) {
+	name, ok := m["name"].(string)
+	if !ok || name == "" {
+		return nil, fmt.Errorf("invalid avro schema: field without name")
+	}
+	s, err := p.parse(m["type"], namespace)
+	if err != nil {
+		return nil, fmt.Errorf("%v for field %v", err, name)
+	}
+	f := &avroField{Name: name, Schema: s, Aliases: stringList(m["aliases"])}
+	f.Default, f.HasDefault = m["default"]
+	return f, nil
+}
+
+func stringList(v any) []string {
+	list, _ := v.([]any)
+	var res []string
+	for _, e := range list {
+		if s, ok := e.(string); ok {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+func fullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+func namespaceOf(full string) string {
+	if i := strings.LastIndex(full, "."); i >= 0 {
+		return full[:i]
+	}
+	return ""
+}
+
+func shortName(full string) string {
+	return full[strings.LastIndex(full, ".")+1:]
+}
+
+// unionName returns the name goavro uses as the key of a union value holding
+// a value of this schema.
+func (s *avroSchema) unionName() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	if s.LogicalType != "" && goavroLogicalTypes[s.Type+"."+s.LogicalType] {
+		return s.Type + "." + s.LogicalType
+	}
+	return s.Type
+}
+
+// branch returns the union branch with the given goavro union name.
+func (s *avroSchema) branch(name string) (*avroSchema, bool) {
+	for _, b := range s.Branches {
+		if b.unionName() == name {
+			return b, true
+		}
+	}
+	return nil, false
+}
+
+// field returns the record field with the given name.
+func (s *avroSchema) field(name string) (*avroField, bool) {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// nullable returns the single non-null branch of a union with null, if the
+// schema is such a union.
+func (s *avroSchema) nullable() (*avroSchema, bool) {
+	if s.Type != "union" || len(s.Branches) != 2 {
+		return nil, false
+	}
+	switch {
+	case s.Branches[0].Type == "null":
+		return s.Branches[1], true
+	case s.Branches[1].Type == "null":
+		return s.Branches[0], true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,289 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigqueryio
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+)
+
+var (
+	typeOfTime     = reflect.TypeOf(time.Time{})
+	typeOfDate     = reflect.TypeOf(civil.Date{})
+	typeOfCivil    = reflect.TypeOf(civil.Time{})
+	typeOfDateTime = reflect.TypeOf(civil.DateTime{})
+	typeOfRat      = reflect.TypeOf((*big.Rat)(nil))
+)
+
+// avroType is the part of an Avro schema, as served by the Storage Read API, needed to
+// populate Go values from the rows decoded by goavro.
+type avroType struct {
+	// Name is the Avro type name, such as "long", "record" or "array".
+	Name string
+	// Nullable is set for unions with "null", which goavro decodes as single entry maps.
+	Nullable bool
+	// Fields are the fields of a record.
+	Fields []avroField
+	// Items is the element type of an array.
+	Items *avroType
+}
+
+type avroField struct {
+	Name string
+	Type *avroType
+}
+
+func parseAvroType(data json.RawMessage) (*avroType, error) {
+	if len(data) == 0 {
+		return nil, errors.New("missing Avro type")
+	}
+
+	switch data[0] {
+	case '"':
+		var name string
+		if err := json.Unmarshal(data, &name); err != nil {
+			return nil, err
+		}
+		return &avroType{Name: name}, nil
+
+	case '[':
+		var union []json.RawMessage
+		if err := json.Unmarshal(data, &union); err != nil {
+			return nil, err
+		}
+		for _, member := range union {
+			if string(member) == `"null"` {
+				continue
+			}
+			t, err := parseAvroType(member)
+			if err != nil {
+				return nil, err
+			}
+			t.Nullable = true
+			return t, nil
+		}
+		return nil, errors.Errorf("unsupported Avro union: %s", data)
+
+	default:
+		var schema struct {
+			Type   json.RawMessage `json:"type"`
+			Fields []struct {
+				Name string          `json:"name"`
+				Type json.RawMessage `json:"type"`
+			} `json:"fields"`
+			Items json.RawMessage `json:"items"`
+		}
+		if err := json.Unmarshal(data, &schema); err != nil {
+			return nil, err
+		}
+		t, err := parseAvroType(schema.Type)
+		if err != nil {
+			return nil, err
+		}
+		switch t.Name {
+		case "record":
+			for _, field := range schema.Fields {
+				ft, err := parseAvroType(field.Type)
+				if err != nil {
+					return nil, errors.Wrapf(err, "field %v", field.Name)
+				}
+				t.Fields = append(t.Fields, avroField{Name: field.Name, Type: ft})
+			}
+		case "array":
+			if t.Items, err = parseAvroType(schema.Items); err != nil {
+				return nil, err
+			}
+		}
+		return t, nil
+	}
+}
+
+// setAvroValue sets dst to the native goavro value v of type t. The supported Go types are
+// those of bigquery.InferSchema, so a type valid for Write can also be read.
+func setAvroValue(dst reflect.Value, t *avroType, v any) error {
+	if t.Nullable {
+		if v == nil {
+			dst.Set(reflect.Zero(dst.Type()))
+			return nil
+		}
+		union, ok := v.(map[string]any)
+		if !ok || len(union) != 1 {
+			return errors.Errorf("invalid Avro union value %v", v)
+		}
+		for _, value := range union {
+			v = value
+		}
+		t = &avroType{Name: t.Name, Fields: t.Fields, Items: t.Items}
+	}
+
+	switch dst.Type() {
+	case typeOfTime:
+		if tv, ok := v.(time.Time); ok {
+			dst.Set(reflect.ValueOf(tv))
+			return nil
+		}
+	case typeOfDate:
+		if tv, ok := v.(time.Time); ok {
+			dst.Set(reflect.ValueOf(civil.DateOf(tv.UTC())))
+			return nil
+		}
+	case typeOfCivil:
+		if d, ok := v.(time.Duration); ok {
+			dst.Set(reflect.ValueOf(civil.TimeOf(time.Unix(0, 0).UTC().Add(d))))
+			return nil
+		}
+	case typeOfDateTime:
+		if s, ok := v.(string); ok {
+			dt, err := civil.ParseDateTime(s)
+			if err != nil {
+				return err
+			}
+			dst.Set(reflect.ValueOf(dt))
+			return nil
+		}
+	case typeOfRat:
+		if r, ok := v.(*big.Rat); ok {
+			dst.Set(reflect.ValueOf(r))
+			return nil
+		}
+	}
+
+	if isNullType(dst.Type()) {
+		if err := setAvroValue(dst.Field(0), t, v); err != nil {
+			return err
+		}
+		dst.FieldByName("Valid").SetBool(true)
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(dst.Type().Elem())
+		if err := setAvroValue(elem.Elem(), t, v); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch n := v.(type) {
+		case int64:
+			dst.SetInt(n)
+			return nil
+		case int32:
+			dst.SetInt(int64(n))
+			return nil
+		}
+
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		switch n := v.(type) {
+		case int64:
+			dst.SetUint(uint64(n))
+			return nil
+		case int32:
+			dst.SetUint(uint64(n))
+			return nil
+		}
+
+	case reflect.Float32, reflect.Float64:
+		switch n := v.(type) {
+		case float64:
+			dst.SetFloat(n)
+			return nil
+		case float32:
+			dst.SetFloat(float64(n))
+			return nil
+		}
+
+	case reflect.Bool:
+		if b, ok := v.(bool); ok {
+			dst.SetBool(b)
+			return nil
+		}
+
+	case reflect.String:
+		if s, ok := v.(string); ok {
+			dst.SetString(s)
+			return nil
+		}
+
+	case reflect.Slice:
+		if b, ok := v.([]byte); ok && dst.Type().Elem().Kind() == reflect.Uint8 {
+			dst.SetBytes(b)
+			return nil
+		}
+		if items, ok := v.([]any); ok && t.Items != nil {
+			list := reflect.MakeSlice(dst.Type(), len(items), len(items))
+			for i, item := range items {
+				if err := setAvroValue(list.Index(i), t.Items, item); err != nil {
+					return err
+				}
+			}
+			dst.Set(list)
+			return nil
+		}
+
+	case reflect.Struct:
+		if record, ok := v.(map[string]any); ok && t.Name == "record" {
+			return setAvroRecord(dst, t, record)
+		}
+	}
+	return errors.Errorf("cannot set %v from Avro %v value %v", dst.Type(), t.Name, v)
+}
+
+// setAvroRecord sets the fields of the struct dst from the record. Fields are matched by their
+// bigquery tag or name, ignoring case as bigquery does. Columns without a field are ignored.
+func setAvroRecord(dst reflect.Value, t *avroType, record map[string]any) error {
+	fields := make(map[string]int)
+	for i := 0; i < dst.NumField(); i++ {
+		field := dst.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := strings.Split(field.Tag.Get(bigQueryTag), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[strings.ToLower(name)] = i
+	}
+
+	for _, column := range t.Fields {
+		i, ok := fields[strings.ToLower(column.Name)]
+		if !ok {
+			continue
+		}
+		if err := setAvroValue(dst.Field(i), column.Type, record[column.Name]); err != nil {
+			return errors.Wrapf(err, "column %v", column.Name)
+		}
+	}
+	return nil
+}
+
+// isNullType returns whether t is one of the bigquery.Null* types, whose first field holds the
+// value if the Valid field is set.
+func isNullType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.PkgPath() == "cloud.google.com/go/bigquery" &&
+		strings.HasPrefix(t.Name(), "Null") && t.NumField() == 2 && t.Field(1).Name == "Valid"
+}
@@ -0,0 +1,134 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigqueryio
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"github.com/google/go-cmp/cmp"
+	"github.com/linkedin/goavro/v2"
+)
+
+// testAvroSchema is the schema the Storage Read API serves for a table of avroRow.
+const testAvroSchema = `{
+	"type": "record",
+	"name": "__root__",
+	"fields": [
+		{"name": "name", "type": ["null", "string"]},
+		{"name": "count", "type": "long"},
+		{"name": "score", "type": ["null", "double"]},
+		{"name": "created", "type": ["null", {"type": "long", "logicalType": "timestamp-micros"}]},
+		{"name": "day", "type": ["null", {"type": "int", "logicalType": "date"}]},
+		{"name": "at", "type": ["null", {"type": "long", "logicalType": "time-micros"}]},
+		{"name": "local", "type": ["null", {"type": "string", "sqlType": "DATETIME"}]},
+		{"name": "amount", "type": ["null", {"type": "bytes", "logicalType": "decimal", "precision": 38, "scale": 9}]},
+		{"name": "tags", "type": {"type": "array", "items": "string"}},
+		{"name": "nested", "type": ["null", {
+			"type": "record",
+			"name": "__nested__",
+			"fields": [{"name": "flag", "type": ["null", "boolean"]}]
+		}]},
+		{"name": "missing", "type": ["null", "long"]},
+		{"name": "ignored", "type": ["null", "string"]}
+	]
+}`
+
+type avroNested struct {
+	Flag bool `bigquery:"flag"`
+}
+
+type avroRow struct {
+	Name    string               `bigquery:"name"`
+	Count   int32                `bigquery:"count"`
+	Score   bigquery.NullFloat64 `bigquery:"score"`
+	Created time.Time            `bigquery:"created"`
+	Day     civil.Date           `bigquery:"day"`
+	At      civil.Time           `bigquery:"at"`
+	Local   civil.DateTime       `bigquery:"local"`
+	Amount  *big.Rat             `bigquery:"amount"`
+	Tags    []string             `bigquery:"tags"`
+	Nested  *avroNested          `bigquery:"nested"`
+	Missing bigquery.NullInt64   `bigquery:"missing"`
+}
+
+func TestSetAvroValue(t *testing.T) {
+	codec, err := goavro.NewCodec(testAvroSchema)
+	if err != nil {
+		t.Fatalf("goavro.NewCodec() failed: %v", err)
+	}
+	record, err := parseAvroType([]byte(testAvroSchema))
+	if err != nil {
+		t.Fatalf("parseAvroType() failed: %v", err)
+	}
+
+	created := time.Date(2024, 3, 4, 5, 6, 7, 8000, time.UTC)
+	data, err := codec.BinaryFromNative(nil, map[string]any{
+		"name":    goavro.Union("string", "alice"),
+		"count":   int64(7),
+		"score":   goavro.Union("double", 1.5),
+		"created": goavro.Union("long.timestamp-micros", created),
+		"day":     goavro.Union("int.date", time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)),
+		"at":      goavro.Union("long.time-micros", 5*time.Hour+6*time.Minute),
+		"local":   goavro.Union("string", "2024-03-04T05:06:07.5"),
+		"amount":  goavro.Union("bytes.decimal", big.NewRat(5, 2)),
+		"tags":    []any{"a", "b"},
+		"nested":  goavro.Union("__nested__", map[string]any{"flag": goavro.Union("boolean", true)}),
+		"missing": nil,
+		"ignored": goavro.Union("string", "x"),
+	})
+	if err != nil {
+		t.Fatalf("BinaryFromNative() failed: %v", err)
+	}
+	native, _, err := codec.NativeFromBinary(data)
+	if err != nil {
+		t.Fatalf("NativeFromBinary() failed: %v", err)
+	}
+
+	var got avroRow
+	if err := setAvroValue(reflect.ValueOf(&got).Elem(), record, native); err != nil {
+		t.Fatalf("setAvroValue() failed: %v", err)
+	}
+	want := avroRow{
+		Name:    "alice",
+		Count:   7,
+		Score:   bigquery.NullFloat64{Float64: 1.5, Valid: true},
+		Created: created,
+		Day:     civil.Date{Year: 2024, Month: time.March, Day: 4},
+		At:      civil.Time{Hour: 5, Minute: 6},
+		Local:   civil.DateTime{Date: civil.Date{Year: 2024, Month: time.March, Day: 4}, Time: civil.Time{Hour: 5, Minute: 6, Second: 7, Nanosecond: 500000000}},
+		Amount:  big.NewRat(5, 2),
+		Tags:    []string{"a", "b"},
+		Nested:  &avroNested{Flag: true},
+	}
+	if diff := cmp.Diff(want, got, cmp.Comparer(func(a, b *big.Rat) bool { return a.Cmp(b) == 0 })); diff != "" {
+		t.Errorf("setAvroValue() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSetAvroValue_typeMismatch(t *testing.T) {
+	var got struct {
+		Count string `bigquery:"count"`
+	}
+	record := &avroType{Name: "record", Fields: []avroField{{Name: "count", Type: &avroType{Name: "long"}}}}
+	if err := setAvroValue(reflect.ValueOf(&got).Elem(), record, map[string]any{"count": int64(1)}); err == nil {
+		t.Errorf("setAvroValue() succeeded for a long column into a string field, want error")
+	}
+}
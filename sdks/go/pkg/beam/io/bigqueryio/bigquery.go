@@ -84,29 +84,126 @@ func NewQualifiedTableName(s string) (QualifiedTableName, error) {
 	return QualifiedTableName{Project: project, Dataset: dataset, Table: table}, nil
 }
 
+// ReadMethod specifies how Read reads a table.
+type ReadMethod int
+
+const (
+	// QueryRead reads the table by querying it in a single worker. It is the default.
+	QueryRead ReadMethod = iota
+	// DirectRead reads the table with the BigQuery Storage Read API. The rows are
+	// split across the read streams of a session, which are read in parallel.
+	DirectRead
+)
+
+// readOptions represents additional options for executing a read.
+type readOptions struct {
+	// Method specifies how the table is read.
+	Method ReadMethod `json:"method"`
+	// SelectedFields are the columns to read. If empty, the columns of the schema type are read.
+	SelectedFields []string `json:"selectedFields,omitempty"`
+	// RowRestriction is a SQL predicate that filters the rows read. DirectRead only.
+	RowRestriction string `json:"rowRestriction,omitempty"`
+	// MaxStreams bounds the number of streams of a DirectRead session. If zero, the
+	// service picks the number of streams.
+	MaxStreams int `json:"maxStreams,omitempty"`
+}
+
+// ReadOption represents a function that sets options for executing a read.
+type ReadOption func(*readOptions) error
+
+// WithReadMethod specifies how the table is read. The default is QueryRead.
+func WithReadMethod(m ReadMethod) ReadOption {
+	return func(ro *readOptions) error {
+		if m != QueryRead && m != DirectRead {
+			return errors.Errorf("invalid read method: %v", m)
+		}
+		ro.Method = m
+		return nil
+	}
+}
+
+// WithSelectedFields specifies the columns to read, overriding the columns inferred
+// from the schema type. Nested columns of a DirectRead may be selected as "a.b".
+func WithSelectedFields(fields ...string) ReadOption {
+	return func(ro *readOptions) error {
+		if len(fields) == 0 {
+			return errors.New("no fields selected")
+		}
+		ro.SelectedFields = fields
+		return nil
+	}
+}
+
+// WithRowRestriction specifies a SQL predicate, such as "num > 10", that the rows read
+// must satisfy. It requires the DirectRead method.
+func WithRowRestriction(restriction string) ReadOption {
+	return func(ro *readOptions) error {
+		ro.RowRestriction = restriction
+		return nil
+	}
+}
+
+// WithMaxStreams bounds the number of read streams, and hence the parallelism, of a
+// DirectRead.
+func WithMaxStreams(n int) ReadOption {
+	return func(ro *readOptions) error {
+		if n <= 0 {
+			return errors.Errorf("max streams must be positive, got %v", n)
+		}
+		ro.MaxStreams = n
+		return nil
+	}
+}
+
 // Read reads all rows from the given table. The table must have a schema
 // compatible with the given type, t, and Read returns a PCollection<t>. If the
 // table has more rows than t, then Read is implicitly a projection.
-func Read(s beam.Scope, project, table string, t reflect.Type) beam.PCollection {
-	mustParseTable(table)
+//
+// By default the table is read with a query. Use WithReadMethod(DirectRead) to read
+// large tables in parallel with the Storage Read API.
+func Read(s beam.Scope, project, table string, t reflect.Type, options ...ReadOption) beam.PCollection {
+	qn := mustParseTable(table)
 
 	s = s.Scope("bigquery.Read")
 
+	var readOptions readOptions
+	for _, opt := range options {
+		if err := opt(&readOptions); err != nil {
+			panic(err)
+		}
+	}
+	if readOptions.Method == DirectRead {
+		mustInferSchema(t)
+		if len(readOptions.SelectedFields) == 0 {
+			readOptions.SelectedFields = mustInferColumns(t, bigQueryTag)
+		}
+		return readStorage(s, project, qn, t, readOptions)
+	}
+	if readOptions.RowRestriction != "" {
+		panic("bigqueryio.Read: row restriction requires the DirectRead method")
+	}
+
 	stmt := constructSelectStatement(t, bigQueryTag, table)
+	if len(readOptions.SelectedFields) > 0 {
+		stmt = fmt.Sprintf("SELECT %v FROM [%v]", strings.Join(readOptions.SelectedFields, ", "), table)
+	}
 
 	return query(s, project, stmt, t)
 }
 
 func constructSelectStatement(t reflect.Type, tagKey string, table string) string {
+	columnStr := strings.Join(mustInferColumns(t, tagKey), ", ")
+
+	return fmt.Sprintf("SELECT %v FROM [%v]", columnStr, table)
+}
+
+func mustInferColumns(t reflect.Type, tagKey string) []string {
 	columns := structx.InferFieldNames(t, tagKey)
 
 	if len(columns) == 0 {
 		panic(fmt.Sprintf("bigqueryio.Read: type %v has no columns to select", t))
 	}
-
-	columnStr := strings.Join(columns, ", ")
-
-	return fmt.Sprintf("SELECT %v FROM [%v]", columnStr, table)
+	return columns
 }
 
 // QueryOptions represents additional options for executing a query.
@@ -208,10 +305,30 @@ func mustParseTable(table string) QualifiedTableName {
 // TODO(herohde) 7/14/2017: allow WriteDispositions. The default
 // is not quite what the Dataflow examples do.
 
+// WriteMethod specifies how Write writes rows to a table.
+type WriteMethod int
+
+const (
+	// StreamingInserts writes rows with the legacy streaming insert API. It is the default.
+	StreamingInserts WriteMethod = iota
+	// StorageWriteAPI writes rows exactly once with the BigQuery Storage Write API. The
+	// rows of a bundle are appended at explicit offsets to a pending stream, which is
+	// finalized when the bundle finishes. The finalized streams are reshuffled and then
+	// committed by a separate step, so retried appends and failed bundles don't
+	// duplicate rows.
+	StorageWriteAPI
+	// StorageWriteAPIAtLeastOnce writes rows to the default stream of the BigQuery
+	// Storage Write API. Rows are visible as soon as they are appended, but may be
+	// duplicated if a bundle is retried.
+	StorageWriteAPIAtLeastOnce
+)
+
 // writeOptions represents additional options for executing a write
 type writeOptions struct {
 	// CreateDisposition specifies the circumstances under which destination table will be created
 	CreateDisposition bigquery.TableCreateDisposition
+	// Method specifies how rows are written.
+	Method WriteMethod
 }
 
 // newWriteOptions creates a new instance of WriteOptions
@@ -231,6 +348,17 @@ func WithCreateDisposition(cd bigquery.TableCreateDisposition) WriteOption {
 	}
 }
 
+// WithWriteMethod specifies how rows are written. The default is StreamingInserts.
+func WithWriteMethod(m WriteMethod) WriteOption {
+	return func(wo *writeOptions) error {
+		if m < StreamingInserts || m > StorageWriteAPIAtLeastOnce {
+			return errors.Errorf("invalid write method: %v", m)
+		}
+		wo.Method = m
+		return nil
+	}
+}
+
 // Write writes the elements of the given PCollection<T> to bigquery. T is required
 // to be the schema type.
 func Write(s beam.Scope, project, table string, col beam.PCollection, options ...func(*writeOptions) error) {
@@ -247,6 +375,16 @@ func Write(s beam.Scope, project, table string, col beam.PCollection, options ..
 		}
 	}
 
+	if writeOptions.Method != StreamingInserts {
+		streams := beam.ParDo(s, &storageWriteFn{Project: project, Table: qn, Type: beam.EncodedType{T: t}, Options: writeOptions}, col)
+		if writeOptions.Method == StorageWriteAPI {
+			// Reshuffle checkpoints the finalized streams, so that they are only committed
+			// once the bundles that wrote them have succeeded.
+			beam.ParDo0(s, &commitStreamsFn{Project: project, Table: qn}, beam.Reshuffle(s, streams))
+		}
+		return
+	}
+
 	// TODO(BEAM-3860) 3/15/2018: use side input instead of GBK.
 	pre := beam.AddFixedKey(s, col)
 	post := beam.GroupByKey(s, pre)
@@ -299,25 +437,10 @@ func (f *writeFn) ProcessElement(ctx context.Context, _ int, iter func(*beam.X)
 	}
 	defer client.Close()
 
-	// TODO(herohde) 7/14/2017: should we create datasets? For now, "no".
-
-	dataset := client.DatasetInProject(f.Table.Project, f.Table.Dataset)
-	if _, err := dataset.Metadata(ctx); err != nil {
-		return err
-	}
-
 	schema := mustInferSchema(f.Type.T)
-	table := dataset.Table(f.Table.Table)
-	if _, err := table.Metadata(ctx); err != nil {
-		if !isNotFound(err) {
-			return err
-		}
-		if f.Options.CreateDisposition == bigquery.CreateNever {
-			return fmt.Errorf("table does not exist and create disposition is 'CreateNever': %v", err)
-		}
-		if err := table.Create(ctx, &bigquery.TableMetadata{Schema: schema}); err != nil {
-			return err
-		}
+	table, err := ensureTable(ctx, client, f.Table, schema, f.Options.CreateDisposition)
+	if err != nil {
+		return err
 	}
 
 	var data []reflect.Value
@@ -350,6 +473,31 @@ func (f *writeFn) ProcessElement(ctx context.Context, _ int, iter func(*beam.X)
 	return nil
 }
 
+// ensureTable returns the table to write, creating it with the given schema if it
+// doesn't exist and the create disposition allows it.
+func ensureTable(ctx context.Context, client *bigquery.Client, qn QualifiedTableName, schema bigquery.Schema, cd bigquery.TableCreateDisposition) (*bigquery.Table, error) {
+	// TODO(herohde) 7/14/2017: should we create datasets? For now, "no".
+
+	dataset := client.DatasetInProject(qn.Project, qn.Dataset)
+	if _, err := dataset.Metadata(ctx); err != nil {
+		return nil, err
+	}
+
+	table := dataset.Table(qn.Table)
+	if _, err := table.Metadata(ctx); err != nil {
+		if !isNotFound(err) {
+			return nil, err
+		}
+		if cd == bigquery.CreateNever {
+			return nil, fmt.Errorf("table does not exist and create disposition is 'CreateNever': %v", err)
+		}
+		if err := table.Create(ctx, &bigquery.TableMetadata{Schema: schema}); err != nil {
+			return nil, err
+		}
+	}
+	return table, nil
+}
+
 func put(ctx context.Context, table *bigquery.Table, t reflect.Type, data []reflect.Value) error {
 	// list : []T to allow Put to infer the schema
 	list := reflectx.MakeSlice(t, data...).Interface()
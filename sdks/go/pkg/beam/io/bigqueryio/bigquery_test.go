@@ -18,6 +18,10 @@ package bigqueryio
 import (
 	"reflect"
 	"testing"
+
+	"cloud.google.com/go/bigquery/storage/apiv1/storagepb"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestNewQualifiedTableName(t *testing.T) {
@@ -76,3 +80,60 @@ func Test_constructSelectStatementPanic(t *testing.T) {
 		constructSelectStatement(typ, tagKey, table)
 	})
 }
+
+func TestReadSessionRequest(t *testing.T) {
+	var opts readOptions
+	for _, opt := range []ReadOption{
+		WithReadMethod(DirectRead),
+		WithSelectedFields("a", "b.c"),
+		WithRowRestriction("a > 10"),
+		WithMaxStreams(4),
+	} {
+		if err := opt(&opts); err != nil {
+			t.Fatalf("option failed: %v", err)
+		}
+	}
+
+	got := readSessionRequest("billing", QualifiedTableName{Project: "p", Dataset: "d", Table: "t"}, opts)
+	want := &storagepb.CreateReadSessionRequest{
+		Parent: "projects/billing",
+		ReadSession: &storagepb.ReadSession{
+			Table:      "projects/p/datasets/d/tables/t",
+			DataFormat: storagepb.DataFormat_AVRO,
+			ReadOptions: &storagepb.ReadSession_TableReadOptions{
+				SelectedFields: []string{"a", "b.c"},
+				RowRestriction: "a > 10",
+			},
+		},
+		MaxStreamCount: 4,
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("readSessionRequest() = %v, want %v", got, want)
+	}
+}
+
+func TestReadOptions_invalid(t *testing.T) {
+	for name, opt := range map[string]ReadOption{
+		"method":     WithReadMethod(ReadMethod(7)),
+		"fields":     WithSelectedFields(),
+		"maxStreams": WithMaxStreams(0),
+	} {
+		if err := opt(&readOptions{}); err == nil {
+			t.Errorf("%v option succeeded, want error", name)
+		}
+	}
+}
+
+func TestRead_rowRestrictionPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Read() with a row restriction and the query method does not panic")
+		}
+	}()
+
+	_, s := beam.NewPipelineWithRoot()
+	typ := reflect.TypeOf(struct {
+		Col1 string `bigquery:"col1"`
+	}{})
+	Read(s, "p", "p:d.t", typ, WithRowRestriction("col1 = 'a'"))
+}
@@ -0,0 +1,176 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigqueryio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+
+	bqStorage "cloud.google.com/go/bigquery/storage/apiv1"
+	"cloud.google.com/go/bigquery/storage/apiv1/storagepb"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/linkedin/goavro/v2"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*readStream)(nil)).Elem())
+	register.DoFn3x1[context.Context, []byte, func(readStream), error](&createReadSessionFn{})
+	register.Emitter1[readStream]()
+	register.DoFn3x1[context.Context, readStream, func(beam.X), error](&readStreamFn{})
+	register.Emitter1[beam.X]()
+}
+
+// readStream is a single stream of a Storage Read API session, together with the Avro schema
+// of the rows it serves.
+type readStream struct {
+	Name   string
+	Schema string
+}
+
+// readStorage reads the table with the BigQuery Storage Read API. A single read session is
+// created for the table and its streams are read in parallel.
+func readStorage(s beam.Scope, project string, table QualifiedTableName, t reflect.Type, opts readOptions) beam.PCollection {
+	imp := beam.Impulse(s)
+	streams := beam.ParDo(s, &createReadSessionFn{Project: project, Table: table, Options: opts}, imp)
+	streams = beam.Reshuffle(s, streams)
+	return beam.ParDo(s, &readStreamFn{Type: beam.EncodedType{T: t}}, streams, beam.TypeDefinition{Var: beam.XType, T: t})
+}
+
+type createReadSessionFn struct {
+	// Project is the project billed for the read session.
+	Project string `json:"project"`
+	// Table is the qualified table identifier.
+	Table QualifiedTableName `json:"table"`
+	// Options specifies the column selection and row restriction of the session.
+	Options readOptions `json:"options"`
+}
+
+func (f *createReadSessionFn) ProcessElement(ctx context.Context, _ []byte, emit func(readStream)) error {
+	client, err := bqStorage.NewBigQueryReadClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	session, err := client.CreateReadSession(ctx, readSessionRequest(f.Project, f.Table, f.Options))
+	if err != nil {
+		return errors.Wrapf(err, "bigquery read session for %v", f.Table)
+	}
+
+	schema := session.GetAvroSchema().GetSchema()
+	for _, stream := range session.GetStreams() {
+		emit(readStream{Name: stream.GetName(), Schema: schema})
+	}
+	return nil
+}
+
+func readSessionRequest(project string, table QualifiedTableName, opts readOptions) *storagepb.CreateReadSessionRequest {
+	return &storagepb.CreateReadSessionRequest{
+		Parent: fmt.Sprintf("projects/%v", project),
+		ReadSession: &storagepb.ReadSession{
+			Table:      fmt.Sprintf("projects/%v/datasets/%v/tables/%v", table.Project, table.Dataset, table.Table),
+			DataFormat: storagepb.DataFormat_AVRO,
+			ReadOptions: &storagepb.ReadSession_TableReadOptions{
+				SelectedFields: opts.SelectedFields,
+				RowRestriction: opts.RowRestriction,
+			},
+		},
+		MaxStreamCount: int32(opts.MaxStreams),
+	}
+}
+
+type readStreamFn struct {
+	// Type is the encoded schema type.
+	Type beam.EncodedType `json:"type"`
+
+	client *bqStorage.BigQueryReadClient
+	schema string
+	codec  *goavro.Codec
+	record *avroType
+}
+
+func (f *readStreamFn) Setup(ctx context.Context) error {
+	client, err := bqStorage.NewBigQueryReadClient(ctx)
+	if err != nil {
+		return err
+	}
+	f.client = client
+	return nil
+}
+
+func (f *readStreamFn) Teardown() error {
+	if f.client == nil {
+		return nil
+	}
+	return f.client.Close()
+}
+
+func (f *readStreamFn) ProcessElement(ctx context.Context, stream readStream, emit func(beam.X)) error {
+	if err := f.setSchema(stream.Schema); err != nil {
+		return err
+	}
+
+	rows, err := f.client.ReadRows(ctx, &storagepb.ReadRowsRequest{ReadStream: stream.Name})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := rows.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "bigquery read of stream %v", stream.Name)
+		}
+
+		buf := resp.GetAvroRows().GetSerializedBinaryRows()
+		for len(buf) > 0 {
+			var native any
+			native, buf, err = f.codec.NativeFromBinary(buf)
+			if err != nil {
+				return errors.Wrapf(err, "bigquery read of stream %v: invalid Avro row", stream.Name)
+			}
+
+			val := reflect.New(f.Type.T).Elem() // val : T
+			if err := setAvroValue(val, f.record, native); err != nil {
+				return errors.Wrapf(err, "bigquery read of stream %v", stream.Name)
+			}
+			emit(val.Interface())
+		}
+	}
+}
+
+// setSchema prepares the codec for the given Avro schema. All streams of a session share
+// a schema, so the codec is only rebuilt when a stream of another session is read.
+func (f *readStreamFn) setSchema(schema string) error {
+	if f.codec != nil && f.schema == schema {
+		return nil
+	}
+	codec, err := goavro.NewCodec(schema)
+	if err != nil {
+		return errors.Wrap(err, "invalid Avro schema of read session")
+	}
+	record, err := parseAvroType([]byte(schema))
+	if err != nil {
+		return errors.Wrap(err, "invalid Avro schema of read session")
+	}
+	f.schema, f.codec, f.record = schema, codec, record
+	return nil
+}
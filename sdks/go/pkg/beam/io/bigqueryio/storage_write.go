@@ -0,0 +1,438 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigqueryio
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/bigquery/storage/apiv1/storagepb"
+	"cloud.google.com/go/bigquery/storage/managedwriter"
+	"cloud.google.com/go/bigquery/storage/managedwriter/adapt"
+	"cloud.google.com/go/civil"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func init() {
+	register.DoFn3x1[context.Context, beam.X, func(string), error](&storageWriteFn{})
+	register.DoFn2x0[context.Context, string](&commitStreamsFn{})
+	register.Emitter1[string]()
+}
+
+// storageWriteFn writes rows with the BigQuery Storage Write API. For StorageWriteAPI, each
+// bundle writes to its own pending stream, which is finalized in FinishBundle and emitted
+// for commitStreamsFn to commit. For StorageWriteAPIAtLeastOnce, all bundles of a worker
+// share the default stream and nothing is emitted.
+type storageWriteFn struct {
+	// Project is the project
+	Project string `json:"project"`
+	// Table is the qualified table identifier.
+	Table QualifiedTableName `json:"table"`
+	// Type is the encoded schema type.
+	Type beam.EncodedType `json:"type"`
+	// Options specifies additional write options.
+	Options writeOptions `json:"options"`
+
+	schema     bigquery.Schema
+	descriptor protoreflect.MessageDescriptor
+	client     *managedwriter.Client
+	stream     *managedwriter.ManagedStream
+
+	rows    [][]byte
+	size    int
+	offset  int64
+	results []*managedwriter.AppendResult
+}
+
+func (f *storageWriteFn) Setup(ctx context.Context) error {
+	f.schema = mustInferSchema(f.Type.T)
+
+	bq, err := bigquery.NewClient(ctx, f.Project)
+	if err != nil {
+		return err
+	}
+	defer bq.Close()
+	if _, err := ensureTable(ctx, bq, f.Table, f.schema, f.Options.CreateDisposition); err != nil {
+		return err
+	}
+
+	if f.descriptor, err = storageDescriptor(f.schema); err != nil {
+		return errors.Wrapf(err, "bigquery write error: unsupported schema type %v", f.Type.T)
+	}
+	if f.client, err = managedwriter.NewClient(ctx, f.Project); err != nil {
+		return err
+	}
+	if f.Options.Method == StorageWriteAPIAtLeastOnce {
+		f.stream, err = f.newStream(ctx, managedwriter.DefaultStream)
+	}
+	return err
+}
+
+func (f *storageWriteFn) StartBundle(ctx context.Context, _ func(string)) error {
+	if f.Options.Method != StorageWriteAPI {
+		return nil
+	}
+	stream, err := f.newStream(ctx, managedwriter.PendingStream)
+	if err != nil {
+		return err
+	}
+	f.stream = stream
+	f.offset = 0
+	return nil
+}
+
+func (f *storageWriteFn) newStream(ctx context.Context, st managedwriter.StreamType) (*managedwriter.ManagedStream, error) {
+	dp, err := adapt.NormalizeDescriptor(f.descriptor)
+	if err != nil {
+		return nil, err
+	}
+	return f.client.NewManagedStream(ctx,
+		managedwriter.WithDestinationTable(managedwriter.TableParentFromParts(f.Table.Project, f.Table.Dataset, f.Table.Table)),
+		managedwriter.WithType(st),
+		managedwriter.WithSchemaDescriptor(dp))
+}
+
+func (f *storageWriteFn) ProcessElement(ctx context.Context, val beam.X, _ func(string)) error {
+	row, err := storageRow(f.descriptor, f.schema, val)
+	if err != nil {
+		return errors.Wrapf(err, "bigquery write error")
+	}
+	if len(f.rows)+1 > writeRowLimit || f.size+len(row) > writeSizeLimit {
+		// Append rows in batches to comply with BQ limits.
+		if err := f.flush(ctx); err != nil {
+			return err
+		}
+	}
+	f.rows = append(f.rows, row)
+	f.size += len(row)
+	return nil
+}
+
+func (f *storageWriteFn) flush(ctx context.Context) error {
+	if len(f.rows) == 0 {
+		return nil
+	}
+
+	var opts []managedwriter.AppendOption
+	if f.Options.Method == StorageWriteAPI {
+		// Explicit offsets make the service reject appends that were already applied.
+		opts = append(opts, managedwriter.WithOffset(f.offset))
+	}
+	res, err := f.stream.AppendRows(ctx, f.rows, opts...)
+	if err != nil {
+		return errors.Wrapf(err, "bigquery write error [len=%d, size=%d]", len(f.rows), f.size)
+	}
+	f.results = append(f.results, res)
+	f.offset += int64(len(f.rows))
+	f.rows = nil
+	f.size = writeOverheadBytes
+	return nil
+}
+
+// FinishBundle waits for the appends of the bundle and, for StorageWriteAPI, finalizes the
+// pending stream and emits its name. The stream is not committed here: if the bundle is
+// retried after a commit, the retry would write its rows a second time.
+func (f *storageWriteFn) FinishBundle(ctx context.Context, emit func(string)) error {
+	if err := f.flush(ctx); err != nil {
+		return err
+	}
+	for _, res := range f.results {
+		if _, err := res.GetResult(ctx); err != nil {
+			return errors.Wrapf(err, "bigquery write error")
+		}
+	}
+	f.results = nil
+
+	if f.Options.Method != StorageWriteAPI {
+		return nil
+	}
+	defer func() {
+		f.stream.Close()
+		f.stream = nil
+	}()
+
+	if f.offset == 0 {
+		// Nothing was appended, so there is nothing to commit.
+		return nil
+	}
+	if _, err := f.stream.Finalize(ctx); err != nil {
+		return errors.Wrapf(err, "bigquery write error: finalizing stream %v", f.stream.StreamName())
+	}
+	emit(f.stream.StreamName())
+	return nil
+}
+
+func (f *storageWriteFn) Teardown() error {
+	if f.stream != nil {
+		f.stream.Close()
+	}
+	if f.client == nil {
+		return nil
+	}
+	return f.client.Close()
+}
+
+// commitStreamsFn commits the finalized pending streams written by storageWriteFn. It runs
+// after a Reshuffle, so the stream names of a bundle are only committed once the bundle
+// that wrote them has succeeded. Committing a stream again is a no-op, so retried
+// bundles of commitStreamsFn don't duplicate rows either.
+type commitStreamsFn struct {
+	// Project is the project
+	Project string `json:"project"`
+	// Table is the qualified table identifier.
+	Table QualifiedTableName `json:"table"`
+
+	client  *managedwriter.Client
+	streams []string
+}
+
+func (f *commitStreamsFn) Setup(ctx context.Context) error {
+	var err error
+	f.client, err = managedwriter.NewClient(ctx, f.Project)
+	return err
+}
+
+func (f *commitStreamsFn) ProcessElement(ctx context.Context, stream string) {
+	f.streams = append(f.streams, stream)
+}
+
+func (f *commitStreamsFn) FinishBundle(ctx context.Context) error {
+	if len(f.streams) == 0 {
+		return nil
+	}
+	resp, err := f.client.BatchCommitWriteStreams(ctx, &storagepb.BatchCommitWriteStreamsRequest{
+		Parent:       managedwriter.TableParentFromParts(f.Table.Project, f.Table.Dataset, f.Table.Table),
+		WriteStreams: f.streams,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "bigquery write error: committing streams %v", f.streams)
+	}
+	if err := commitError(resp); err != nil {
+		return err
+	}
+	f.streams = nil
+	return nil
+}
+
+func (f *commitStreamsFn) Teardown() error {
+	if f.client == nil {
+		return nil
+	}
+	return f.client.Close()
+}
+
+// commitError returns an error for the first stream of a commit response that failed for a
+// reason other than already being committed.
+func commitError(resp *storagepb.BatchCommitWriteStreamsResponse) error {
+	for _, e := range resp.GetStreamErrors() {
+		if e.GetCode() == storagepb.StorageError_STREAM_ALREADY_COMMITTED {
+			continue
+		}
+		return errors.Errorf("bigquery write error: committing stream %v: %v", e.GetEntity(), e.GetErrorMessage())
+	}
+	return nil
+}
+
+// storageDescriptor returns a proto2 message descriptor for rows of the given schema. Types
+// without a native proto representation, such as NUMERIC, DATETIME and TIME, use the string
+// form the Storage Write API accepts for them.
+func storageDescriptor(schema bigquery.Schema) (protoreflect.MessageDescriptor, error) {
+	root, err := storageMessage("Row", ".Row", schema)
+	if err != nil {
+		return nil, err
+	}
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:        proto.String("bigqueryio_row.proto"),
+		Syntax:      proto.String("proto2"),
+		MessageType: []*descriptorpb.DescriptorProto{root},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return fd.Messages().Get(0), nil
+}
+
+func storageMessage(name, fullName string, schema bigquery.Schema) (*descriptorpb.DescriptorProto, error) {
+	msg := &descriptorpb.DescriptorProto{Name: proto.String(name)}
+	for i, field := range schema {
+		fdp := &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(field.Name),
+			Number: proto.Int32(int32(i + 1)),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		}
+		switch {
+		case field.Repeated:
+			fdp.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		case field.Required:
+			fdp.Label = descriptorpb.FieldDescriptorProto_LABEL_REQUIRED.Enum()
+		}
+
+		switch field.Type {
+		case bigquery.IntegerFieldType, bigquery.TimestampFieldType:
+			fdp.Type = descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum()
+		case bigquery.DateFieldType:
+			fdp.Type = descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum()
+		case bigquery.FloatFieldType:
+			fdp.Type = descriptorpb.FieldDescriptorProto_TYPE_DOUBLE.Enum()
+		case bigquery.BooleanFieldType:
+			fdp.Type = descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum()
+		case bigquery.BytesFieldType:
+			fdp.Type = descriptorpb.FieldDescriptorProto_TYPE_BYTES.Enum()
+		case bigquery.StringFieldType, bigquery.NumericFieldType, bigquery.BigNumericFieldType,
+			bigquery.DateTimeFieldType, bigquery.TimeFieldType, bigquery.GeographyFieldType,
+			bigquery.JSONFieldType, bigquery.IntervalFieldType:
+			fdp.Type = descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()
+		case bigquery.RecordFieldType:
+			nestedName := "Record_" + field.Name
+			nested, err := storageMessage(nestedName, fullName+"."+nestedName, field.Schema)
+			if err != nil {
+				return nil, err
+			}
+			msg.NestedType = append(msg.NestedType, nested)
+			fdp.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+			fdp.TypeName = proto.String(fullName + "." + nestedName)
+		default:
+			return nil, errors.Errorf("field %v has unsupported type %v", field.Name, field.Type)
+		}
+		msg.Field = append(msg.Field, fdp)
+	}
+	return msg, nil
+}
+
+// storageRow encodes v as a row message of the given descriptor. The values are taken from
+// bigquery.StructSaver, so fields are matched and omitted exactly as for streaming inserts.
+func storageRow(md protoreflect.MessageDescriptor, schema bigquery.Schema, v any) ([]byte, error) {
+	row, _, err := (&bigquery.StructSaver{Struct: v, Schema: schema}).Save()
+	if err != nil {
+		return nil, err
+	}
+	msg := dynamicpb.NewMessage(md)
+	if err := setStorageMessage(msg, schema, row); err != nil {
+		return nil, err
+	}
+	return proto.Marshal(msg)
+}
+
+func setStorageMessage(msg *dynamicpb.Message, schema bigquery.Schema, row map[string]bigquery.Value) error {
+	fields := msg.Descriptor().Fields()
+	for _, field := range schema {
+		v, ok := row[field.Name]
+		if !ok || v == nil {
+			continue
+		}
+		fd := fields.ByName(protoreflect.Name(field.Name))
+
+		if !field.Repeated {
+			pv, ok, err := storageValue(fd, field, v)
+			if err != nil {
+				return errors.Wrapf(err, "field %v", field.Name)
+			}
+			if ok {
+				msg.Set(fd, pv)
+			}
+			continue
+		}
+
+		list := msg.Mutable(fd).List()
+		rv := reflect.ValueOf(v)
+		for i := 0; i < rv.Len(); i++ {
+			pv, ok, err := storageValue(fd, field, rv.Index(i).Interface())
+			if err != nil {
+				return errors.Wrapf(err, "field %v[%d]", field.Name, i)
+			}
+			if ok {
+				list.Append(pv)
+			}
+		}
+	}
+	return nil
+}
+
+// storageValue converts a value saved by bigquery.StructSaver to the representation of the
+// field. It returns false for invalid bigquery.Null* values.
+func storageValue(fd protoreflect.FieldDescriptor, field *bigquery.FieldSchema, v any) (protoreflect.Value, bool, error) {
+	if rv := reflect.ValueOf(v); isNullType(rv.Type()) {
+		if !rv.FieldByName("Valid").Bool() {
+			return protoreflect.Value{}, false, nil
+		}
+		switch x := rv.Field(0).Interface().(type) {
+		case civil.Time:
+			v = bigquery.CivilTimeString(x)
+		case civil.DateTime:
+			v = bigquery.CivilDateTimeString(x)
+		default:
+			v = x
+		}
+	}
+
+	rv := reflect.ValueOf(v)
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		if m, ok := v.(map[string]bigquery.Value); ok {
+			nested := dynamicpb.NewMessage(fd.Message())
+			if err := setStorageMessage(nested, field.Schema, m); err != nil {
+				return protoreflect.Value{}, false, err
+			}
+			return protoreflect.ValueOfMessage(nested), true, nil
+		}
+
+	case protoreflect.Int64Kind:
+		if t, ok := v.(time.Time); ok {
+			return protoreflect.ValueOfInt64(t.UnixMicro()), true, nil
+		}
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return protoreflect.ValueOfInt64(rv.Int()), true, nil
+		case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+			return protoreflect.ValueOfInt64(int64(rv.Uint())), true, nil
+		}
+
+	case protoreflect.Int32Kind:
+		if d, ok := v.(civil.Date); ok {
+			return protoreflect.ValueOfInt32(int32(d.DaysSince(civil.Date{Year: 1970, Month: time.January, Day: 1}))), true, nil
+		}
+
+	case protoreflect.DoubleKind:
+		if rv.Kind() == reflect.Float32 || rv.Kind() == reflect.Float64 {
+			return protoreflect.ValueOfFloat64(rv.Float()), true, nil
+		}
+
+	case protoreflect.BoolKind:
+		if rv.Kind() == reflect.Bool {
+			return protoreflect.ValueOfBool(rv.Bool()), true, nil
+		}
+
+	case protoreflect.StringKind:
+		if rv.Kind() == reflect.String {
+			return protoreflect.ValueOfString(rv.String()), true, nil
+		}
+
+	case protoreflect.BytesKind:
+		if b, ok := v.([]byte); ok {
+			return protoreflect.ValueOfBytes(b), true, nil
+		}
+	}
+	return protoreflect.Value{}, false, errors.Errorf("cannot write %T value %v as %v", v, v, fd.Kind())
+}
@@ -0,0 +1,200 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigqueryio
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/bigquery/storage/apiv1/storagepb"
+	"cloud.google.com/go/civil"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+type storageNested struct {
+	Flag bool `bigquery:"flag"`
+}
+
+type storageRowType struct {
+	Name    string               `bigquery:"name"`
+	Count   int32                `bigquery:"count"`
+	Score   bigquery.NullFloat64 `bigquery:"score"`
+	Created time.Time            `bigquery:"created"`
+	Day     civil.Date           `bigquery:"day"`
+	At      bigquery.NullTime    `bigquery:"at"`
+	Amount  *big.Rat             `bigquery:"amount"`
+	Tags    []string             `bigquery:"tags"`
+	Nested  []storageNested      `bigquery:"nested"`
+	Missing bigquery.NullInt64   `bigquery:"missing"`
+}
+
+func TestStorageRow(t *testing.T) {
+	schema := mustInferSchema(reflect.TypeOf(storageRowType{}))
+	md, err := storageDescriptor(schema)
+	if err != nil {
+		t.Fatalf("storageDescriptor() failed: %v", err)
+	}
+
+	row := storageRowType{
+		Name:    "alice",
+		Count:   7,
+		Score:   bigquery.NullFloat64{Float64: 1.5, Valid: true},
+		Created: time.Date(2024, 3, 4, 5, 6, 7, 8000, time.UTC),
+		Day:     civil.Date{Year: 1970, Month: time.January, Day: 11},
+		At:      bigquery.NullTime{Time: civil.Time{Hour: 5, Minute: 6}, Valid: true},
+		Amount:  big.NewRat(5, 2),
+		Tags:    []string{"a", "b"},
+		Nested:  []storageNested{{Flag: true}, {Flag: false}},
+	}
+	data, err := storageRow(md, schema, row)
+	if err != nil {
+		t.Fatalf("storageRow() failed: %v", err)
+	}
+
+	msg := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(data, msg); err != nil {
+		t.Fatalf("proto.Unmarshal() failed: %v", err)
+	}
+	want := dynamicpb.NewMessage(md)
+	if err := protojson.Unmarshal([]byte(`{
+		"name": "alice",
+		"count": "7",
+		"score": 1.5,
+		"created": "1709528767000008",
+		"day": 10,
+		"at": "05:06:00",
+		"amount": "2.500000000",
+		"tags": ["a", "b"],
+		"nested": [{"flag": true}, {"flag": false}]
+	}`), want); err != nil {
+		t.Fatalf("protojson.Unmarshal() failed: %v", err)
+	}
+	if !proto.Equal(want, msg) {
+		t.Errorf("storageRow() = %v, want %v", msg, want)
+	}
+}
+
+func TestStorageRow_unsupportedValue(t *testing.T) {
+	schema := bigquery.Schema{{Name: "count", Type: bigquery.IntegerFieldType}}
+	md, err := storageDescriptor(schema)
+	if err != nil {
+		t.Fatalf("storageDescriptor() failed: %v", err)
+	}
+	if _, err := storageRow(md, schema, struct {
+		Count string `bigquery:"count"`
+	}{Count: "seven"}); err == nil {
+		t.Errorf("storageRow() succeeded for a string value of an INTEGER column, want error")
+	}
+}
+
+func TestStorageDescriptor(t *testing.T) {
+	schema := mustInferSchema(reflect.TypeOf(storageRowType{}))
+	md, err := storageDescriptor(schema)
+	if err != nil {
+		t.Fatalf("storageDescriptor() failed: %v", err)
+	}
+	var got []string
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		got = append(got, string(fields.Get(i).Name())+":"+fields.Get(i).Kind().String())
+	}
+	want := []string{
+		"name:string", "count:int64", "score:double", "created:int64", "day:int32",
+		"at:string", "amount:string", "tags:string", "nested:message", "missing:int64",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("storageDescriptor() fields mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWrite_storageCommit(t *testing.T) {
+	tests := []struct {
+		method WriteMethod
+		commit bool
+	}{
+		{StorageWriteAPI, true},
+		{StorageWriteAPIAtLeastOnce, false},
+	}
+	for _, test := range tests {
+		p, s := beam.NewPipelineWithRoot()
+		col := beam.Create(s, storageNested{Flag: true})
+		Write(s, "project", "project:dataset.table", col, WithWriteMethod(test.method))
+		edges, _, err := p.Build()
+		if err != nil {
+			t.Fatalf("Build() failed: %v", err)
+		}
+
+		var write, reshuffle, commit *graph.MultiEdge
+		for _, e := range edges {
+			switch {
+			case e.Op == graph.Reshuffle:
+				reshuffle = e
+			case e.Name() == fnName(storageWriteFn{}):
+				write = e
+			case e.Name() == fnName(commitStreamsFn{}):
+				commit = e
+			}
+		}
+		if write == nil {
+			t.Fatalf("Write(%v) has no storageWriteFn", test.method)
+		}
+		if !test.commit {
+			if commit != nil || reshuffle != nil {
+				t.Errorf("Write(%v) commits streams, want no commit", test.method)
+			}
+			continue
+		}
+		if commit == nil || reshuffle == nil {
+			t.Fatalf("Write(%v) has commit %v after reshuffle %v, want both", test.method, commit, reshuffle)
+		}
+		if reshuffle.Input[0].From != write.Output[0].To || commit.Input[0].From != reshuffle.Output[0].To {
+			t.Errorf("Write(%v) = %v -> %v -> %v, want streams committed after a reshuffle", test.method, write, reshuffle, commit)
+		}
+	}
+}
+
+func fnName(fn any) string {
+	t := reflect.TypeOf(fn)
+	return t.PkgPath() + "." + t.Name()
+}
+
+func TestCommitError(t *testing.T) {
+	tests := []struct {
+		errs    []*storagepb.StorageError
+		wantErr bool
+	}{
+		{nil, false},
+		{[]*storagepb.StorageError{{Code: storagepb.StorageError_STREAM_ALREADY_COMMITTED, Entity: "a"}}, false},
+		{[]*storagepb.StorageError{
+			{Code: storagepb.StorageError_STREAM_ALREADY_COMMITTED, Entity: "a"},
+			{Code: storagepb.StorageError_STREAM_NOT_FOUND, Entity: "b"},
+		}, true},
+	}
+	for _, test := range tests {
+		err := commitError(&storagepb.BatchCommitWriteStreamsResponse{StreamErrors: test.errs})
+		if got := err != nil; got != test.wantErr {
+			t.Errorf("commitError(%v) = %v, want error %v", test.errs, err, test.wantErr)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtableio
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"cloud.google.com/go/bigtable"
+)
+
+type filterKind int
+
+const (
+	filterFamily filterKind = iota
+	filterColumn
+	filterValue
+	filterLatestN
+	filterTimestampRange
+	filterCellsPerRowLimit
+	filterStripValue
+)
+
+// Filter is a serializable filter of the cells of the rows read from Bigtable. Filters are
+// created with FamilyFilter, ColumnFilter, ValueFilter, LatestNFilter, TimestampRangeFilter,
+// CellsPerRowLimitFilter and StripValueFilter, and passed to Read with ReadFilter. They have
+// the semantics of the filters of the same name of the bigtable package.
+type Filter struct {
+	Kind    filterKind
+	Pattern string
+	N       int
+	Start   bigtable.Timestamp
+	End     bigtable.Timestamp
+
+	err error
+}
+
+func newPatternFilter(kind filterKind, pattern string) Filter {
+	var err error
+	if _, rerr := regexp.Compile(pattern); rerr != nil {
+		err = fmt.Errorf("invalid filter pattern %q: %v", pattern, rerr)
+	}
+	return Filter{Kind: kind, Pattern: pattern, err: err}
+}
+
+func newCountFilter(kind filterKind, n int) Filter {
+	var err error
+	if n <= 0 {
+		err = fmt.Errorf("filter count must be greater than 0, got %v", n)
+	}
+	return Filter{Kind: kind, N: n, err: err}
+}
+
+// FamilyFilter returns a Filter matching cells whose family name matches the regular
+// expression pattern.
+func FamilyFilter(pattern string) Filter {
+	return newPatternFilter(filterFamily, pattern)
+}
+
+// ColumnFilter returns a Filter matching cells whose column qualifier matches the regular
+// expression pattern.
+func ColumnFilter(pattern string) Filter {
+	return newPatternFilter(filterColumn, pattern)
+}
+
+// ValueFilter returns a Filter matching cells whose value matches the regular expression
+// pattern.
+func ValueFilter(pattern string) Filter {
+	return newPatternFilter(filterValue, pattern)
+}
+
+// LatestNFilter returns a Filter matching the n most recent cells of each column.
+func LatestNFilter(n int) Filter {
+	return newCountFilter(filterLatestN, n)
+}
+
+// CellsPerRowLimitFilter returns a Filter matching the first n cells of each row.
+func CellsPerRowLimitFilter(n int) Filter {
+	return newCountFilter(filterCellsPerRowLimit, n)
+}
+
+// TimestampRangeFilter returns a Filter matching cells with a timestamp from start inclusive
+// to end exclusive. A zero end time means no upper bound.
+func TimestampRangeFilter(start, end time.Time) Filter {
+	f := Filter{Kind: filterTimestampRange, Start: bigtable.Time(start)}
+	if !end.IsZero() {
+		f.End = bigtable.Time(end)
+		if f.End <= f.Start {
+			f.err = fmt.Errorf("filter start time %v must be before end time %v", start, end)
+		}
+	}
+	return f
+}
+
+// StripValueFilter returns a Filter replacing the values of cells with empty values.
+func StripValueFilter() Filter {
+	return Filter{Kind: filterStripValue}
+}
+
+func (f Filter) filter() bigtable.Filter {
+	switch f.Kind {
+	case filterFamily:
+		return bigtable.FamilyFilter(f.Pattern)
+	case filterColumn:
+		return bigtable.ColumnFilter(f.Pattern)
+	case filterValue:
+		return bigtable.ValueFilter(f.Pattern)
+	case filterLatestN:
+		return bigtable.LatestNFilter(f.N)
+	case filterCellsPerRowLimit:
+		return bigtable.CellsPerRowLimitFilter(f.N)
+	case filterTimestampRange:
+		return bigtable.TimestampRangeFilterMicros(f.Start, f.End)
+	case filterStripValue:
+		return bigtable.StripValueFilter()
+	default:
+		panic(fmt.Sprintf("bigtableio: unknown filter kind %d", f.Kind))
+	}
+}
+
+// chainFilters returns a bigtable.Filter applying all filters in sequence, or nil if there
+// are none.
+func chainFilters(filters []Filter) bigtable.Filter {
+	switch len(filters) {
+	case 0:
+		return nil
+	case 1:
+		return filters[0].filter()
+	}
+	chain := make([]bigtable.Filter, len(filters))
+	for i, f := range filters {
+		chain[i] = f.filter()
+	}
+	return bigtable.ChainFilters(chain...)
+}
@@ -0,0 +1,170 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtableio
+
+import (
+	"bytes"
+	"math/big"
+
+	"cloud.google.com/go/bigtable"
+)
+
+// keyRange is a range of row keys from Start inclusive to End exclusive. An empty End
+// denotes the end of the table.
+type keyRange struct {
+	Start []byte
+	End   []byte
+}
+
+// newPrefixRange returns the keyRange of the row keys starting with the prefix.
+func newPrefixRange(prefix string) keyRange {
+	return keyRange{Start: []byte(prefix), End: prefixSuccessor([]byte(prefix))}
+}
+
+// prefixSuccessor returns the smallest key greater than all keys starting with the prefix,
+// or nil if there is none.
+func prefixSuccessor(prefix []byte) []byte {
+	n := len(prefix) - 1
+	for n >= 0 && prefix[n] == 0xff {
+		n--
+	}
+	if n < 0 {
+		return nil
+	}
+	ret := append([]byte{}, prefix[:n+1]...)
+	ret[n]++
+	return ret
+}
+
+// keySuccessor returns the smallest key greater than the key.
+func keySuccessor(key []byte) []byte {
+	return append(append([]byte{}, key...), 0)
+}
+
+// contains reports whether the key is in the range.
+func (r keyRange) contains(key []byte) bool {
+	return bytes.Compare(key, r.Start) >= 0 && r.beforeEnd(key)
+}
+
+// beforeEnd reports whether the key is before the end of the range.
+func (r keyRange) beforeEnd(key []byte) bool {
+	return len(r.End) == 0 || bytes.Compare(key, r.End) < 0
+}
+
+// isEmpty reports whether the range contains no keys.
+func (r keyRange) isEmpty() bool {
+	return !r.beforeEnd(r.Start)
+}
+
+// intersect returns the intersection of the ranges, reporting whether it is non-empty.
+func (r keyRange) intersect(o keyRange) (keyRange, bool) {
+	ret := r
+	if bytes.Compare(o.Start, ret.Start) > 0 {
+		ret.Start = o.Start
+	}
+	if len(o.End) > 0 && ret.beforeEnd(o.End) {
+		ret.End = o.End
+	}
+	return ret, !ret.isEmpty()
+}
+
+// rowRange returns the range as a bigtable.RowRange.
+func (r keyRange) rowRange() bigtable.RowRange {
+	if len(r.End) == 0 {
+		return bigtable.InfiniteRange(string(r.Start))
+	}
+	return bigtable.NewRange(string(r.Start), string(r.End))
+}
+
+// splitAt splits the range at the given keys, which must be sorted. Keys outside of the
+// range are ignored.
+func (r keyRange) splitAt(keys []string) []keyRange {
+	var ret []keyRange
+	start := r.Start
+	for _, k := range keys {
+		key := []byte(k)
+		if bytes.Compare(key, start) <= 0 || !r.beforeEnd(key) {
+			continue
+		}
+		ret = append(ret, keyRange{Start: start, End: key})
+		start = key
+	}
+	return append(ret, keyRange{Start: start, End: r.End})
+}
+
+// keyInt returns the key as an integer of the given number of bytes, padding it with zeros.
+func keyInt(key []byte, length int) *big.Int {
+	padded := make([]byte, length)
+	copy(padded, key)
+	return new(big.Int).SetBytes(padded)
+}
+
+// bounds returns the start and end of the range as integers of a common length, with the
+// end of the table represented as the integer following the largest key of that length.
+func (r keyRange) bounds(keys ...[]byte) (start, end *big.Int, length int) {
+	length = len(r.Start)
+	for _, k := range append(keys, r.End) {
+		if len(k) > length {
+			length = len(k)
+		}
+	}
+	// An extra byte allows interpolating between adjacent keys.
+	length++
+	start = keyInt(r.Start, length)
+	if len(r.End) == 0 {
+		end = new(big.Int).Lsh(big.NewInt(1), uint(8*length))
+	} else {
+		end = keyInt(r.End, length)
+	}
+	return start, end, length
+}
+
+// interpolate returns a key at approximately the given fraction of the range, which is
+// greater than Start and before End unless the range is too narrow to split, in which case
+// it returns nil.
+func (r keyRange) interpolate(fraction float64) []byte {
+	start, end, length := r.bounds()
+	diff := new(big.Float).SetInt(new(big.Int).Sub(end, start))
+	offset, _ := diff.Mul(diff, big.NewFloat(fraction)).Int(nil)
+	key := new(big.Int).Add(start, offset).FillBytes(make([]byte, length))
+	// Prefer the shorter key without the padding, if it is still in the range.
+	for _, k := range [][]byte{bytes.TrimRight(key, "\x00"), key} {
+		if bytes.Compare(k, r.Start) > 0 && r.beforeEnd(k) {
+			return k
+		}
+	}
+	return nil
+}
+
+// fraction returns the approximate fraction of the range that is before the key.
+func (r keyRange) fraction(key []byte) float64 {
+	start, end, length := r.bounds(key)
+	if end.Cmp(start) <= 0 {
+		return 1
+	}
+	pos := keyInt(key, length)
+	f, _ := new(big.Float).Quo(
+		new(big.Float).SetInt(new(big.Int).Sub(pos, start)),
+		new(big.Float).SetInt(new(big.Int).Sub(end, start)),
+	).Float64()
+	switch {
+	case f < 0:
+		return 0
+	case f > 1:
+		return 1
+	}
+	return f
+}
@@ -0,0 +1,164 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtableio
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPrefixSuccessor(t *testing.T) {
+	tests := []struct {
+		prefix string
+		want   string
+	}{
+		{prefix: "abc", want: "abd"},
+		{prefix: "ab\xff", want: "ac"},
+		{prefix: "\xff\xff", want: ""},
+		{prefix: "", want: ""},
+	}
+	for _, test := range tests {
+		if got := prefixSuccessor([]byte(test.prefix)); string(got) != test.want {
+			t.Errorf("prefixSuccessor(%q) = %q, want %q", test.prefix, got, test.want)
+		}
+	}
+}
+
+func TestKeyRange_intersect(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   keyRange
+		want   keyRange
+		wantOK bool
+	}{
+		{
+			name:   "overlapping",
+			a:      keyRange{Start: []byte("a"), End: []byte("m")},
+			b:      keyRange{Start: []byte("f"), End: []byte("z")},
+			want:   keyRange{Start: []byte("f"), End: []byte("m")},
+			wantOK: true,
+		},
+		{
+			name:   "infinite end",
+			a:      keyRange{Start: []byte("a")},
+			b:      keyRange{Start: []byte("f")},
+			want:   keyRange{Start: []byte("f")},
+			wantOK: true,
+		},
+		{
+			name:   "bounded by infinite",
+			a:      keyRange{Start: []byte("c")},
+			b:      keyRange{Start: []byte("a"), End: []byte("f")},
+			want:   keyRange{Start: []byte("c"), End: []byte("f")},
+			wantOK: true,
+		},
+		{
+			name: "disjoint",
+			a:    keyRange{Start: []byte("a"), End: []byte("c")},
+			b:    keyRange{Start: []byte("c"), End: []byte("f")},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := test.a.intersect(test.b)
+			if ok != test.wantOK {
+				t.Fatalf("intersect() ok = %v, want %v", ok, test.wantOK)
+			}
+			if ok && !cmp.Equal(got, test.want) {
+				t.Errorf("intersect() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestKeyRange_splitAt(t *testing.T) {
+	r := keyRange{Start: []byte("b"), End: []byte("y")}
+	got := r.splitAt([]string{"a", "b", "g", "m", "y", "z"})
+	want := []keyRange{
+		{Start: []byte("b"), End: []byte("g")},
+		{Start: []byte("g"), End: []byte("m")},
+		{Start: []byte("m"), End: []byte("y")},
+	}
+	if !cmp.Equal(got, want) {
+		t.Errorf("splitAt() = %q, want %q", got, want)
+	}
+}
+
+func TestKeyRange_interpolate(t *testing.T) {
+	tests := []struct {
+		name     string
+		r        keyRange
+		fraction float64
+		want     []byte
+	}{
+		{
+			name:     "midpoint",
+			r:        keyRange{Start: []byte("a"), End: []byte("c")},
+			fraction: 0.5,
+			want:     []byte("b"),
+		},
+		{
+			name:     "adjacent keys",
+			r:        keyRange{Start: []byte("a"), End: []byte("b")},
+			fraction: 0.5,
+			want:     []byte("a\x80"),
+		},
+		{
+			name:     "infinite end",
+			r:        keyRange{Start: []byte{0x40}},
+			fraction: 0.5,
+			want:     []byte{0xa0},
+		},
+		{
+			name:     "whole table",
+			r:        keyRange{},
+			fraction: 0.25,
+			want:     []byte{0x40},
+		},
+		{
+			name:     "too narrow",
+			r:        keyRange{Start: []byte("a"), End: []byte("a\x00")},
+			fraction: 0.5,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.r.interpolate(test.fraction); !bytes.Equal(got, test.want) {
+				t.Errorf("interpolate(%v) = %q, want %q", test.fraction, got, test.want)
+			}
+		})
+	}
+}
+
+func TestKeyRange_fraction(t *testing.T) {
+	r := keyRange{Start: []byte("a"), End: []byte("c")}
+	tests := []struct {
+		key  string
+		want float64
+	}{
+		{key: "a", want: 0},
+		{key: "b", want: 0.5},
+		{key: "c", want: 1},
+		{key: "z", want: 1},
+	}
+	for _, test := range tests {
+		if got := r.fraction([]byte(test.key)); got != test.want {
+			t.Errorf("fraction(%q) = %v, want %v", test.key, got, test.want)
+		}
+	}
+}
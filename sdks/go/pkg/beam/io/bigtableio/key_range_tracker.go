@@ -0,0 +1,149 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtableio
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*keyRangeTracker)(nil)))
+}
+
+// rowResult is the position claimed by a keyRangeTracker: the key of the next row to emit,
+// or the exhaustion of the rows of the restriction.
+type rowResult struct {
+	key         []byte
+	isExhausted bool
+}
+
+// keyRangeTracker is a tracker of a keyRange, claiming row keys in increasing order.
+type keyRangeTracker struct {
+	rest    keyRange
+	claimed []byte
+	stopped bool
+	err     error
+}
+
+// newKeyRangeTracker creates a new keyRangeTracker tracking the provided keyRange.
+func newKeyRangeTracker(rest keyRange) *keyRangeTracker {
+	return &keyRangeTracker{rest: rest}
+}
+
+// TryClaim accepts a position representing a rowResult. The position is successfully claimed
+// if the key is within the restriction and the rows have not been exhausted. Keys must be
+// claimed in increasing order.
+func (rt *keyRangeTracker) TryClaim(pos any) bool {
+	result, ok := pos.(rowResult)
+	if !ok {
+		rt.err = fmt.Errorf("invalid pos type: %T", pos)
+		return false
+	}
+
+	if rt.IsDone() {
+		return false
+	}
+
+	if result.isExhausted {
+		rt.stopped = true
+		return false
+	}
+
+	if bytes.Compare(result.key, rt.rest.Start) < 0 || (rt.claimed != nil && bytes.Compare(result.key, rt.claimed) <= 0) {
+		rt.err = fmt.Errorf("cannot claim key %q, keys must be claimed in increasing order from %q", result.key, rt.rest.Start)
+		return false
+	}
+
+	if !rt.rest.beforeEnd(result.key) {
+		rt.stopped = true
+		return false
+	}
+
+	rt.claimed = result.key
+	return true
+}
+
+// GetError returns the error associated with the tracker, if any.
+func (rt *keyRangeTracker) GetError() error {
+	return rt.err
+}
+
+// remaining returns the range of keys that have not been claimed.
+func (rt *keyRangeTracker) remaining() keyRange {
+	rem := rt.rest
+	if rt.claimed != nil {
+		rem.Start = keySuccessor(rt.claimed)
+	}
+	return rem
+}
+
+// TrySplit splits the remaining keys of the restriction at the given fraction. If the fraction
+// is 0, the primary ends after the last claimed key and the residual contains all remaining
+// keys. If the remaining keys are too few to split, the full restriction is returned as the
+// primary and the residual is nil.
+func (rt *keyRangeTracker) TrySplit(fraction float64) (primary, residual any, err error) {
+	if fraction < 0 || fraction > 1 {
+		return nil, nil, errors.New("fraction must be between 0 and 1")
+	}
+
+	rem := rt.remaining()
+	if rt.stopped || fraction == 1 || rem.isEmpty() {
+		return rt.rest, nil, nil
+	}
+
+	split := rem.Start
+	if fraction > 0 {
+		split = rem.interpolate(fraction)
+	}
+	if len(split) == 0 {
+		// An empty End denotes the end of the table, so the range cannot end before the
+		// first key.
+		return rt.rest, nil, nil
+	}
+
+	residual = keyRange{Start: split, End: rt.rest.End}
+	rt.rest.End = split
+	return rt.rest, residual, nil
+}
+
+// GetProgress returns the fractions of the restriction that have been claimed and remain.
+func (rt *keyRangeTracker) GetProgress() (done float64, remaining float64) {
+	if rt.claimed == nil {
+		return 0, 1
+	}
+	done = rt.rest.fraction(keySuccessor(rt.claimed))
+	return done, 1 - done
+}
+
+// IsDone returns true if all keys within the tracker's restriction have been claimed.
+func (rt *keyRangeTracker) IsDone() bool {
+	return rt.stopped || rt.remaining().isEmpty()
+}
+
+// GetRestriction returns a copy of the restriction the tracker is tracking.
+func (rt *keyRangeTracker) GetRestriction() any {
+	return rt.rest
+}
+
+// IsBounded returns whether the tracker is tracking a restriction with a finite amount of work.
+func (*keyRangeTracker) IsBounded() bool {
+	return true
+}
@@ -0,0 +1,161 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtableio
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestKeyRangeTracker_TryClaim(t *testing.T) {
+	rt := newKeyRangeTracker(keyRange{Start: []byte("b"), End: []byte("y")})
+
+	if !rt.TryClaim(rowResult{key: []byte("c")}) {
+		t.Fatal("TryClaim(c) = false, want true")
+	}
+	if rt.TryClaim(rowResult{key: []byte("c")}) {
+		t.Error("TryClaim(c) twice = true, want false")
+	}
+	if rt.GetError() == nil {
+		t.Error("GetError() = nil after claiming a key twice, want error")
+	}
+
+	rt = newKeyRangeTracker(keyRange{Start: []byte("b"), End: []byte("y")})
+	if !rt.TryClaim(rowResult{key: []byte("x")}) {
+		t.Fatal("TryClaim(x) = false, want true")
+	}
+	if rt.TryClaim(rowResult{key: []byte("y")}) {
+		t.Error("TryClaim(y) = true for the end of the range, want false")
+	}
+	if !rt.IsDone() {
+		t.Error("IsDone() = false after claiming past the end, want true")
+	}
+	if err := rt.GetError(); err != nil {
+		t.Errorf("GetError() = %v, want nil", err)
+	}
+
+	rt = newKeyRangeTracker(keyRange{Start: []byte("b")})
+	if rt.IsDone() {
+		t.Error("IsDone() = true before claiming, want false")
+	}
+	if rt.TryClaim(rowResult{isExhausted: true}) {
+		t.Error("TryClaim(exhausted) = true, want false")
+	}
+	if !rt.IsDone() {
+		t.Error("IsDone() = false after exhaustion, want true")
+	}
+}
+
+func TestKeyRangeTracker_TrySplit(t *testing.T) {
+	tests := []struct {
+		name         string
+		rest         keyRange
+		claimed      string
+		fraction     float64
+		wantPrimary  keyRange
+		wantResidual any
+	}{
+		{
+			name:         "checkpoint",
+			rest:         keyRange{Start: []byte("a"), End: []byte("z")},
+			claimed:      "c",
+			fraction:     0,
+			wantPrimary:  keyRange{Start: []byte("a"), End: []byte("c\x00")},
+			wantResidual: keyRange{Start: []byte("c\x00"), End: []byte("z")},
+		},
+		{
+			name:         "fraction",
+			rest:         keyRange{Start: []byte("a"), End: []byte("e")},
+			fraction:     0.5,
+			wantPrimary:  keyRange{Start: []byte("a"), End: []byte("c")},
+			wantResidual: keyRange{Start: []byte("c"), End: []byte("e")},
+		},
+		{
+			name:         "fraction of infinite range",
+			rest:         keyRange{Start: []byte{0x40}},
+			fraction:     0.5,
+			wantPrimary:  keyRange{Start: []byte{0x40}, End: []byte{0xa0}},
+			wantResidual: keyRange{Start: []byte{0xa0}},
+		},
+		{
+			name:        "all claimed",
+			rest:        keyRange{Start: []byte("a"), End: []byte("c\x00")},
+			claimed:     "c",
+			fraction:    0.5,
+			wantPrimary: keyRange{Start: []byte("a"), End: []byte("c\x00")},
+		},
+		{
+			name:        "checkpoint before claiming from the first key",
+			rest:        keyRange{},
+			fraction:    0,
+			wantPrimary: keyRange{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rt := newKeyRangeTracker(test.rest)
+			if test.claimed != "" && !rt.TryClaim(rowResult{key: []byte(test.claimed)}) {
+				t.Fatalf("TryClaim(%q) = false, want true", test.claimed)
+			}
+			primary, residual, err := rt.TrySplit(test.fraction)
+			if err != nil {
+				t.Fatalf("TrySplit(%v) failed: %v", test.fraction, err)
+			}
+			if !cmp.Equal(primary, test.wantPrimary) {
+				t.Errorf("TrySplit(%v) primary = %q, want %q", test.fraction, primary, test.wantPrimary)
+			}
+			if !cmp.Equal(residual, test.wantResidual) {
+				t.Errorf("TrySplit(%v) residual = %q, want %q", test.fraction, residual, test.wantResidual)
+			}
+		})
+	}
+}
+
+func TestKeyRangeTracker_TrySplit_invalidFraction(t *testing.T) {
+	rt := newKeyRangeTracker(keyRange{})
+	if _, _, err := rt.TrySplit(1.5); err == nil {
+		t.Error("TrySplit(1.5) succeeded, want error")
+	}
+}
+
+func TestKeyRangeTracker_checkpointDone(t *testing.T) {
+	rt := newKeyRangeTracker(keyRange{Start: []byte("a")})
+	if !rt.TryClaim(rowResult{key: []byte("b")}) {
+		t.Fatal("TryClaim(b) = false, want true")
+	}
+	if _, _, err := rt.TrySplit(0); err != nil {
+		t.Fatalf("TrySplit(0) failed: %v", err)
+	}
+	if !rt.IsDone() {
+		t.Error("IsDone() = false after checkpointing, want true")
+	}
+	if rt.TryClaim(rowResult{key: []byte("c")}) {
+		t.Error("TryClaim(c) = true after checkpointing, want false")
+	}
+}
+
+func TestKeyRangeTracker_GetProgress(t *testing.T) {
+	rt := newKeyRangeTracker(keyRange{Start: []byte("a"), End: []byte("c")})
+	if done, remaining := rt.GetProgress(); done != 0 || remaining != 1 {
+		t.Errorf("GetProgress() = (%v, %v) before claiming, want (0, 1)", done, remaining)
+	}
+	rt.TryClaim(rowResult{key: []byte("b")})
+	done, remaining := rt.GetProgress()
+	if done < 0.5 || done > 0.51 || done+remaining != 1 {
+		t.Errorf("GetProgress() = (%v, %v) after claiming the midpoint, want approximately (0.5, 0.5)", done, remaining)
+	}
+}
@@ -0,0 +1,242 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtableio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/bigtable"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/sdf"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+func init() {
+	register.DoFn4x1[context.Context, *sdf.LockRTracker, []byte, func(Row), error](&readFn{})
+	register.Emitter1[Row]()
+	beam.RegisterType(reflect.TypeOf((*Row)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*Cell)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*keyRange)(nil)).Elem())
+}
+
+// Row is a row read from Bigtable.
+type Row struct {
+	Key   string
+	Cells []Cell
+}
+
+// Cell is the value of a column of a row at a timestamp. Cells of a Row are ordered by
+// family, column and decreasing timestamp.
+type Cell struct {
+	Family string
+	Column string
+	Ts     bigtable.Timestamp
+	Value  []byte
+}
+
+func newRow(r bigtable.Row) Row {
+	families := make([]string, 0, len(r))
+	for family := range r {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+
+	row := Row{Key: r.Key()}
+	for _, family := range families {
+		for _, item := range r[family] {
+			row.Cells = append(row.Cells, Cell{
+				Family: family,
+				Column: strings.TrimPrefix(item.Column, family+":"),
+				Ts:     item.Timestamp,
+				Value:  item.Value,
+			})
+		}
+	}
+	return row
+}
+
+// Read reads the rows of a Bigtable table and returns a PCollection<bigtableio.Row>.
+//
+// The rows are read by a splittable DoFn over ranges of row keys. The table is initially
+// split at the row keys sampled by Bigtable, and runners that support dynamic splitting
+// may split the ranges further while they are read.
+//
+// Read accepts a variadic number of ReadOptionFn to configure the read:
+//   - ReadRowRange: restrict the read to a range of row keys.
+//   - ReadRowPrefix: restrict the read to the row keys starting with a prefix.
+//   - ReadFilter: filter the cells of the rows that are read.
+func Read(s beam.Scope, project, instanceID, table string, opts ...ReadOptionFn) beam.PCollection {
+	s = s.Scope("bigtable.Read")
+
+	option := &readOption{}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("bigtableio.Read: invalid option: %v", err))
+		}
+	}
+
+	imp := beam.Impulse(s)
+	return beam.ParDo(s, newReadFn(project, instanceID, table, option), imp)
+}
+
+type readFn struct {
+	// Project is the project
+	Project string `json:"project"`
+	// InstanceID is the bigtable instanceID
+	InstanceID string `json:"instanceId"`
+	// TableName is the qualified table identifier.
+	TableName string `json:"tableName"`
+	// Ranges are the ranges of row keys to read, if empty the whole table is read.
+	Ranges []keyRange `json:"ranges"`
+	// Filters are applied in sequence to the cells of the rows.
+	Filters []Filter `json:"filters"`
+
+	client *bigtable.Client
+	table  *bigtable.Table
+	filter bigtable.Filter
+}
+
+func newReadFn(project, instanceID, table string, option *readOption) *readFn {
+	return &readFn{
+		Project:    project,
+		InstanceID: instanceID,
+		TableName:  table,
+		Ranges:     option.Ranges,
+		Filters:    option.Filters,
+	}
+}
+
+func (f *readFn) Setup(ctx context.Context) error {
+	if f.client != nil {
+		return nil
+	}
+
+	var err error
+	f.client, err = bigtable.NewClient(ctx, f.Project, f.InstanceID)
+	if err != nil {
+		return fmt.Errorf("could not create data operations client: %v", err)
+	}
+
+	f.table = f.client.Open(f.TableName)
+	f.filter = chainFilters(f.Filters)
+	return nil
+}
+
+func (f *readFn) Teardown() error {
+	if f.client == nil {
+		return nil
+	}
+	if err := f.client.Close(); err != nil {
+		return fmt.Errorf("could not close data operations client: %v", err)
+	}
+	f.client = nil
+	return nil
+}
+
+// CreateInitialRestriction returns the smallest range containing all ranges to read.
+func (f *readFn) CreateInitialRestriction(_ []byte) keyRange {
+	if len(f.Ranges) == 0 {
+		return keyRange{}
+	}
+	rest := f.Ranges[0]
+	for _, r := range f.Ranges[1:] {
+		if bytes.Compare(r.Start, rest.Start) < 0 {
+			rest.Start = r.Start
+		}
+		if len(rest.End) > 0 && (len(r.End) == 0 || bytes.Compare(r.End, rest.End) > 0) {
+			rest.End = r.End
+		}
+	}
+	return rest
+}
+
+// SplitRestriction splits the restriction at the row keys sampled by Bigtable, dropping the
+// splits that do not overlap the ranges to read.
+func (f *readFn) SplitRestriction(ctx context.Context, _ []byte, rest keyRange) ([]keyRange, error) {
+	if err := f.Setup(ctx); err != nil {
+		return nil, err
+	}
+
+	keys, err := f.table.SampleRowKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not sample row keys of table %v: %v", f.TableName, err)
+	}
+
+	var splits []keyRange
+	for _, split := range rest.splitAt(keys) {
+		if len(f.rowRanges(split)) > 0 {
+			splits = append(splits, split)
+		}
+	}
+	if len(splits) == 0 {
+		return []keyRange{rest}, nil
+	}
+	return splits, nil
+}
+
+// RestrictionSize returns a constant, as the sizes of key ranges are unknown.
+func (f *readFn) RestrictionSize(_ []byte, _ keyRange) float64 {
+	return 1
+}
+
+func (f *readFn) CreateTracker(rest keyRange) *sdf.LockRTracker {
+	return sdf.NewLockRTracker(newKeyRangeTracker(rest))
+}
+
+// rowRanges returns the parts of the ranges to read that are within the restriction.
+func (f *readFn) rowRanges(rest keyRange) bigtable.RowRangeList {
+	if len(f.Ranges) == 0 {
+		return bigtable.RowRangeList{rest.rowRange()}
+	}
+	var ret bigtable.RowRangeList
+	for _, r := range f.Ranges {
+		if in, ok := r.intersect(rest); ok {
+			ret = append(ret, in.rowRange())
+		}
+	}
+	return ret
+}
+
+func (f *readFn) ProcessElement(ctx context.Context, rt *sdf.LockRTracker, _ []byte, emit func(Row)) error {
+	rest := rt.GetRestriction().(keyRange)
+
+	// An empty list of ranges would read the whole table.
+	if ranges := f.rowRanges(rest); len(ranges) > 0 {
+		var opts []bigtable.ReadOption
+		if f.filter != nil {
+			opts = append(opts, bigtable.RowFilter(f.filter))
+		}
+
+		err := f.table.ReadRows(ctx, ranges, func(r bigtable.Row) bool {
+			if !rt.TryClaim(rowResult{key: []byte(r.Key())}) {
+				return false
+			}
+			emit(newRow(r))
+			return true
+		}, opts...)
+		if err != nil {
+			return fmt.Errorf("could not read rows of table %v: %v", f.TableName, err)
+		}
+	}
+
+	rt.TryClaim(rowResult{isExhausted: true})
+	return nil
+}
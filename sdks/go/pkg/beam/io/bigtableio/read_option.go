@@ -0,0 +1,69 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtableio
+
+import (
+	"errors"
+	"fmt"
+)
+
+type readOption struct {
+	Ranges  []keyRange
+	Filters []Filter
+}
+
+// ReadOptionFn is a function that can be passed to Read to configure options for reading
+// from Bigtable.
+type ReadOptionFn func(*readOption) error
+
+// ReadRowRange restricts the read to the rows with keys from start inclusive to end exclusive.
+// An empty end reads to the end of the table. ReadRowRange and ReadRowPrefix may be given
+// multiple times to read the union of the ranges.
+func ReadRowRange(start, end string) ReadOptionFn {
+	return func(o *readOption) error {
+		r := keyRange{Start: []byte(start), End: []byte(end)}
+		if r.isEmpty() {
+			return fmt.Errorf("row range start %q must be before end %q", start, end)
+		}
+		o.Ranges = append(o.Ranges, r)
+		return nil
+	}
+}
+
+// ReadRowPrefix restricts the read to the rows with keys starting with the prefix.
+func ReadRowPrefix(prefix string) ReadOptionFn {
+	return func(o *readOption) error {
+		if prefix == "" {
+			return errors.New("row prefix must not be empty")
+		}
+		o.Ranges = append(o.Ranges, newPrefixRange(prefix))
+		return nil
+	}
+}
+
+// ReadFilter filters the cells of the rows that are read. Multiple filters are applied in
+// sequence, and rows without any matching cells are not read.
+func ReadFilter(filters ...Filter) ReadOptionFn {
+	return func(o *readOption) error {
+		for _, f := range filters {
+			if f.err != nil {
+				return f.err
+			}
+		}
+		o.Filters = append(o.Filters, filters...)
+		return nil
+	}
+}
@@ -0,0 +1,277 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtableio
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cloud.google.com/go/bigtable"
+	"cloud.google.com/go/bigtable/bttest"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+)
+
+const (
+	testProject  = "project"
+	testInstance = "instance"
+	testTable    = "table"
+)
+
+// newTestTable starts an in-memory Bigtable server, which the clients of the package
+// connect to through the emulator environment variable, and creates a table with the
+// families cf1 and cf2 containing the given rows.
+func newTestTable(t *testing.T, rows ...Row) {
+	t.Helper()
+	srv, err := bttest.NewServer("localhost:0")
+	if err != nil {
+		t.Fatalf("failed to start server: %v", err)
+	}
+	t.Cleanup(srv.Close)
+	t.Setenv("BIGTABLE_EMULATOR_HOST", srv.Addr)
+
+	ctx := context.Background()
+	admin, err := bigtable.NewAdminClient(ctx, testProject, testInstance)
+	if err != nil {
+		t.Fatalf("failed to create admin client: %v", err)
+	}
+	defer admin.Close()
+	if err := admin.CreateTable(ctx, testTable); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	for _, family := range []string{"cf1", "cf2"} {
+		if err := admin.CreateColumnFamily(ctx, testTable, family); err != nil {
+			t.Fatalf("failed to create column family %v: %v", family, err)
+		}
+	}
+
+	client, err := bigtable.NewClient(ctx, testProject, testInstance)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	defer client.Close()
+	table := client.Open(testTable)
+	for _, row := range rows {
+		mut := bigtable.NewMutation()
+		for _, cell := range row.Cells {
+			mut.Set(cell.Family, cell.Column, cell.Ts, cell.Value)
+		}
+		if err := table.Apply(ctx, row.Key, mut); err != nil {
+			t.Fatalf("failed to write row %v: %v", row.Key, err)
+		}
+	}
+}
+
+func testRow(key string) Row {
+	return Row{
+		Key: key,
+		Cells: []Cell{
+			{Family: "cf1", Column: "a", Ts: 2000, Value: []byte(key + "-a2")},
+			{Family: "cf1", Column: "a", Ts: 1000, Value: []byte(key + "-a1")},
+			{Family: "cf2", Column: "b", Ts: 1000, Value: []byte(key + "-b1")},
+		},
+	}
+}
+
+func TestRead(t *testing.T) {
+	rows := []Row{testRow("apple"), testRow("apricot"), testRow("banana"), testRow("cherry")}
+	newTestTable(t, rows...)
+
+	tests := []struct {
+		name string
+		opts []ReadOptionFn
+		want []any
+	}{
+		{
+			name: "whole table",
+			want: []any{rows[0], rows[1], rows[2], rows[3]},
+		},
+		{
+			name: "prefix",
+			opts: []ReadOptionFn{ReadRowPrefix("ap")},
+			want: []any{rows[0], rows[1]},
+		},
+		{
+			name: "ranges",
+			opts: []ReadOptionFn{ReadRowRange("apricot", "banana"), ReadRowRange("cherry", "")},
+			want: []any{rows[1], rows[3]},
+		},
+		{
+			name: "filters",
+			opts: []ReadOptionFn{ReadRowPrefix("banana"), ReadFilter(FamilyFilter("cf1"), LatestNFilter(1))},
+			want: []any{Row{Key: "banana", Cells: []Cell{{Family: "cf1", Column: "a", Ts: 2000, Value: []byte("banana-a2")}}}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, s := beam.NewPipelineWithRoot()
+			out := Read(s, testProject, testInstance, testTable, test.opts...)
+			passert.Equals(s, out, test.want...)
+			ptest.RunAndValidate(t, p)
+		})
+	}
+}
+
+func TestRead_invalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  ReadOptionFn
+	}{
+		{name: "empty range", opt: ReadRowRange("b", "a")},
+		{name: "empty prefix", opt: ReadRowPrefix("")},
+		{name: "invalid pattern", opt: ReadFilter(ColumnFilter("["))},
+		{name: "invalid count", opt: ReadFilter(LatestNFilter(0))},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Read() succeeded, want panic")
+				}
+			}()
+			_, s := beam.NewPipelineWithRoot()
+			Read(s, testProject, testInstance, testTable, test.opt)
+		})
+	}
+}
+
+func TestReadFn_CreateInitialRestriction(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges []keyRange
+		want   keyRange
+	}{
+		{
+			name: "whole table",
+			want: keyRange{},
+		},
+		{
+			name: "bounded ranges",
+			ranges: []keyRange{
+				{Start: []byte("d"), End: []byte("f")},
+				{Start: []byte("b"), End: []byte("c")},
+			},
+			want: keyRange{Start: []byte("b"), End: []byte("f")},
+		},
+		{
+			name: "unbounded range",
+			ranges: []keyRange{
+				{Start: []byte("d")},
+				{Start: []byte("b"), End: []byte("c")},
+			},
+			want: keyRange{Start: []byte("b")},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fn := &readFn{Ranges: test.ranges}
+			got := fn.CreateInitialRestriction(nil)
+			if string(got.Start) != string(test.want.Start) || string(got.End) != string(test.want.End) {
+				t.Errorf("CreateInitialRestriction() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestReadFn_SplitRestriction(t *testing.T) {
+	var rows []Row
+	for i := 0; i < 500; i++ {
+		rows = append(rows, Row{Key: fmt.Sprintf("row%03d", i), Cells: []Cell{{Family: "cf1", Column: "a", Ts: 1000, Value: []byte{1}}}})
+	}
+	newTestTable(t, rows...)
+
+	ctx := context.Background()
+	fn := newReadFn(testProject, testInstance, testTable, &readOption{
+		Ranges: []keyRange{newPrefixRange("row1"), newPrefixRange("row3")},
+	})
+	if err := fn.Setup(ctx); err != nil {
+		t.Fatalf("Setup() failed: %v", err)
+	}
+	defer fn.Teardown()
+
+	rest := fn.CreateInitialRestriction(nil)
+	splits, err := fn.SplitRestriction(ctx, nil, rest)
+	if err != nil {
+		t.Fatalf("SplitRestriction() failed: %v", err)
+	}
+	if len(splits) == 0 {
+		t.Fatal("SplitRestriction() returned no splits")
+	}
+	if got, want := string(splits[0].Start), "row1"; got != want {
+		t.Errorf("first split starts at %q, want %q", got, want)
+	}
+	if got, want := string(splits[len(splits)-1].End), "row4"; got != want {
+		t.Errorf("last split ends at %q, want %q", got, want)
+	}
+	for i, split := range splits {
+		if i > 0 && string(split.Start) < string(splits[i-1].End) {
+			t.Errorf("split %v starts at %q before the end of the previous split %q", i, split.Start, splits[i-1].End)
+		}
+		if len(fn.rowRanges(split)) == 0 {
+			t.Errorf("split %q does not overlap the ranges to read", split)
+		}
+	}
+}
+
+func TestReadFn_ProcessElement_split(t *testing.T) {
+	var rows []Row
+	for i := 0; i < 10; i++ {
+		rows = append(rows, testRow(fmt.Sprintf("row%d", i)))
+	}
+	newTestTable(t, rows...)
+
+	ctx := context.Background()
+	fn := newReadFn(testProject, testInstance, testTable, &readOption{})
+	if err := fn.Setup(ctx); err != nil {
+		t.Fatalf("Setup() failed: %v", err)
+	}
+	defer fn.Teardown()
+
+	// Checkpoint after the third row, as a runner would when splitting dynamically.
+	rt := fn.CreateTracker(fn.CreateInitialRestriction(nil))
+	var got []string
+	var residual any
+	emit := func(r Row) {
+		got = append(got, r.Key)
+		if len(got) == 3 {
+			var err error
+			if _, residual, err = rt.TrySplit(0); err != nil {
+				t.Fatalf("TrySplit(0) failed: %v", err)
+			}
+		}
+	}
+	if err := fn.ProcessElement(ctx, rt, nil, emit); err != nil {
+		t.Fatalf("ProcessElement() failed: %v", err)
+	}
+	if !rt.IsDone() {
+		t.Error("tracker is not done after ProcessElement")
+	}
+
+	rt = fn.CreateTracker(residual.(keyRange))
+	if err := fn.ProcessElement(ctx, rt, nil, func(r Row) { got = append(got, r.Key) }); err != nil {
+		t.Fatalf("ProcessElement() of residual failed: %v", err)
+	}
+	if len(got) != len(rows) {
+		t.Fatalf("read %v rows, want %v: %v", len(got), len(rows), got)
+	}
+	for i, row := range rows {
+		if got[i] != row.Key {
+			t.Errorf("row %v = %v, want %v", i, got[i], row.Key)
+		}
+	}
+}
@@ -21,8 +21,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math/rand"
 	"reflect"
-	"strings"
 
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
@@ -31,30 +31,71 @@ import (
 
 func init() {
 	beam.RegisterType(reflect.TypeOf((*queryFn)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*partitionFn)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*partitionQueryFn)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*readPartition)(nil)).Elem())
 	beam.RegisterType(reflect.TypeOf((*writeFn)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*addShardFn)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*shardedWriteFn)(nil)).Elem())
 }
 
 // writeSizeLimit is the maximum number of rows allowed to a write.
 const writeRowLimit = 1000
 
+// writeMaxRetries is the default number of times a failed batch is retried.
+const writeMaxRetries = 3
+
 // Read reads all rows from the given table. The table must have a schema
 // compatible with the given type, t, and Read returns a PCollection<t>. If the
 // table has more rows than t, then Read is implicitly a projection.
-func Read(s beam.Scope, driver, dsn, table string, t reflect.Type) beam.PCollection {
+//
+// Read accepts a variadic number of ReadOptionFn to configure the read:
+//   - ReadPartitions: split the read into parallel queries over ranges of a column.
+//   - ReadPartitionBounds: the bounds of the partition column, instead of querying them.
+func Read(s beam.Scope, driver, dsn, table string, t reflect.Type, opts ...ReadOptionFn) beam.PCollection {
 	s = s.Scope(driver + ".Read")
-	return query(s, driver, dsn, fmt.Sprintf("SELECT * from %v", table), t)
+	return query(s, driver, dsn, table, fmt.Sprintf("SELECT * from %v", table), t, opts...)
 }
 
 // Query executes a query. The output must have a schema compatible with the given
-// type, t. It returns a PCollection<t>.
-func Query(s beam.Scope, driver, dsn, q string, t reflect.Type) beam.PCollection {
+// type, t. It returns a PCollection<t>. Query accepts the same options as Read; a
+// partitioned query is executed as a subquery of the partition queries.
+func Query(s beam.Scope, driver, dsn, q string, t reflect.Type, opts ...ReadOptionFn) beam.PCollection {
 	s = s.Scope(driver + ".Query")
-	return query(s, driver, dsn, q, t)
+	return query(s, driver, dsn, fmt.Sprintf("(%v) t", q), q, t, opts...)
 }
 
-func query(s beam.Scope, driver, dsn, query string, t reflect.Type) beam.PCollection {
+func query(s beam.Scope, driver, dsn, source, query string, t reflect.Type, opts ...ReadOptionFn) beam.PCollection {
+	option := &readOption{}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("databaseio.Read: invalid option: %v", err))
+		}
+	}
+	if option.Bounds != nil && option.PartitionColumn == "" {
+		panic("databaseio.Read: invalid option: ReadPartitionBounds requires ReadPartitions")
+	}
+
 	imp := beam.Impulse(s)
-	return beam.ParDo(s, &queryFn{Driver: driver, Dsn: dsn, Query: query, Type: beam.EncodedType{T: t}}, imp, beam.TypeDefinition{Var: beam.XType, T: t})
+	if option.PartitionColumn == "" {
+		return beam.ParDo(s, &queryFn{Driver: driver, Dsn: dsn, Query: query, Type: beam.EncodedType{T: t}}, imp, beam.TypeDefinition{Var: beam.XType, T: t})
+	}
+	parts := beam.ParDo(s, &partitionFn{
+		Driver:     driver,
+		Dsn:        dsn,
+		Source:     source,
+		Column:     option.PartitionColumn,
+		Partitions: option.Partitions,
+		Bounds:     option.Bounds,
+	}, imp)
+	parts = beam.Reshuffle(s, parts)
+	return beam.ParDo(s, &partitionQueryFn{
+		Driver: driver,
+		Dsn:    dsn,
+		Source: source,
+		Column: option.PartitionColumn,
+		Type:   beam.EncodedType{T: t},
+	}, parts, beam.TypeDefinition{Var: beam.XType, T: t})
 }
 
 type queryFn struct {
@@ -75,20 +116,25 @@ func (f *queryFn) ProcessElement(ctx context.Context, _ []byte, emit func(beam.X
 		return errors.Wrapf(err, "failed to open database: %v", f.Driver)
 	}
 	defer db.Close()
-	statement, err := db.PrepareContext(ctx, f.Query)
+	return readRows(ctx, db, f.Query, nil, f.Type.T, emit)
+}
+
+// readRows executes the query and emits each row as a value of the given type.
+func readRows(ctx context.Context, db *sql.DB, query string, args []any, t reflect.Type, emit func(beam.X)) error {
+	statement, err := db.PrepareContext(ctx, query)
 	if err != nil {
-		return errors.Wrapf(err, "failed to prepare query: %v", f.Query)
+		return errors.Wrapf(err, "failed to prepare query: %v", query)
 	}
 	defer statement.Close()
-	rows, err := statement.QueryContext(ctx)
+	rows, err := statement.QueryContext(ctx, args...)
 	if err != nil {
-		return errors.Wrapf(err, "failed to run query: %v", f.Query)
+		return errors.Wrapf(err, "failed to run query: %v", query)
 	}
 	defer rows.Close()
 	var mapper rowMapper
 	var columns []string
 	for rows.Next() {
-		reflectRow := reflect.New(f.Type.T)
+		reflectRow := reflect.New(t)
 		row := reflectRow.Interface() // row : *T
 		if mapper == nil {
 			columns, err = rows.Columns()
@@ -96,7 +142,7 @@ func (f *queryFn) ProcessElement(ctx context.Context, _ []byte, emit func(beam.X
 				return err
 			}
 			columnsTypes, _ := rows.ColumnTypes()
-			if mapper, err = newQueryMapper(columns, columnsTypes, f.Type.T); err != nil {
+			if mapper, err = newQueryMapper(columns, columnsTypes, t); err != nil {
 				return errors.WithContext(err, "creating rowValues mapper")
 			}
 		}
@@ -106,7 +152,7 @@ func (f *queryFn) ProcessElement(ctx context.Context, _ []byte, emit func(beam.X
 		}
 		err = rows.Scan(rowValues...)
 		if err != nil {
-			return errors.Wrapf(err, "failed to scan %v", f.Query)
+			return errors.Wrapf(err, "failed to scan %v", query)
 		}
 		if loader, ok := row.(MapLoader); ok {
 			asDereferenceSlice(rowValues)
@@ -117,25 +163,58 @@ func (f *queryFn) ProcessElement(ctx context.Context, _ []byte, emit func(beam.X
 		}
 		emit(reflect.ValueOf(row).Elem().Interface()) // emit(*row)
 	}
-	return nil
+	return rows.Err()
 }
 
 // Write writes the elements of the given PCollection<T> to database, if columns left empty all table columns are used to insert into, otherwise selected
-func Write(s beam.Scope, driver, dsn, table string, columns []string, col beam.PCollection) {
-	t := col.Type().Type()
+//
+// Write accepts a variadic number of WriteOptionFn to configure the write:
+//   - WriteBatchSize: the maximum number of rows written by a statement. Defaults to 1000.
+//   - WriteUpsert: update existing rows conflicting on the given key columns.
+//   - WriteMaxRetries: the number of times a failed batch is retried. Defaults to 3.
+//   - WriteShards: write from the given number of shards as bundles complete, which
+//     allows writing unbounded PCollections.
+func Write(s beam.Scope, driver, dsn, table string, columns []string, col beam.PCollection, opts ...WriteOptionFn) {
 	s = s.Scope(driver + ".Write")
-	pre := beam.AddFixedKey(s, col)
-	post := beam.GroupByKey(s, pre)
-	beam.ParDo0(s, &writeFn{Driver: driver, Dsn: dsn, Table: table, Columns: columns, BatchSize: writeRowLimit, Type: beam.EncodedType{T: t}}, post)
+	option := &writeOption{BatchSize: writeRowLimit, MaxRetries: writeMaxRetries}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("databaseio.Write: invalid option: %v", err))
+		}
+	}
+	write(s, driver, dsn, table, columns, col, option)
 }
 
 // WriteWithBatchSize writes the elements of the given PCollection<T> to database with custom batch size. Batch size control number of elements in the batch INSERT statement.
 func WriteWithBatchSize(s beam.Scope, batchSize int, driver, dsn, table string, columns []string, col beam.PCollection) {
-	t := col.Type().Type()
 	s = s.Scope(driver + ".Write")
+	write(s, driver, dsn, table, columns, col, &writeOption{BatchSize: batchSize, MaxRetries: writeMaxRetries})
+}
+
+func write(s beam.Scope, driver, dsn, table string, columns []string, col beam.PCollection, option *writeOption) {
+	if len(option.Upsert) > 0 {
+		if _, err := upsertClause(driver, option.Upsert, option.Upsert); err != nil {
+			panic(fmt.Sprintf("databaseio.Write: invalid option: %v", err))
+		}
+	}
+	fn := writeFn{
+		Driver:     driver,
+		Dsn:        dsn,
+		Table:      table,
+		Columns:    columns,
+		BatchSize:  option.BatchSize,
+		Type:       beam.EncodedType{T: col.Type().Type()},
+		Upsert:     option.Upsert,
+		MaxRetries: option.MaxRetries,
+	}
+	if option.Shards > 0 {
+		keyed := beam.ParDo(s, &addShardFn{Shards: option.Shards}, col)
+		beam.ParDo0(s, &shardedWriteFn{Fn: fn}, beam.Reshuffle(s, keyed))
+		return
+	}
 	pre := beam.AddFixedKey(s, col)
 	post := beam.GroupByKey(s, pre)
-	beam.ParDo0(s, &writeFn{Driver: driver, Dsn: dsn, Table: table, Columns: columns, BatchSize: batchSize, Type: beam.EncodedType{T: t}}, post)
+	beam.ParDo0(s, &fn, post)
 }
 
 type writeFn struct {
@@ -151,71 +230,158 @@ type writeFn struct {
 	BatchSize int `json:"batchSize"`
 	// Type is the encoded schema type.
 	Type beam.EncodedType `json:"type"`
+	// Upsert are the key columns of upserts, if empty rows are inserted.
+	Upsert []string `json:"upsert"`
+	// MaxRetries is the number of times a failed batch is retried.
+	MaxRetries int `json:"maxRetries"`
+
+	db      *sql.DB
+	columns []string
+	mapper  rowMapper
 }
 
-func (f *writeFn) ProcessElement(ctx context.Context, _ int, iter func(*beam.X) bool) error {
-	//TODO move DB Open and Close to Setup and Teardown methods or StartBundle and FinishBundle
+func (f *writeFn) Setup(ctx context.Context) error {
 	db, err := sql.Open(f.Driver, f.Dsn)
 	if err != nil {
 		return errors.Wrapf(err, "failed to open database: %v", f.Driver)
 	}
-	defer db.Close()
-	projection := "*"
+	f.db = db
+	if f.columns, err = f.discoverColumns(ctx); err != nil {
+		return err
+	}
+	if f.mapper, err = newWriterRowMapper(f.columns, f.Type.T); err != nil {
+		return errors.WithContext(err, "creating row mapper")
+	}
+	return nil
+}
+
+// discoverColumns returns the columns to write, querying the columns of the table if none
+// were given.
+func (f *writeFn) discoverColumns(ctx context.Context) ([]string, error) {
 	if len(f.Columns) > 0 {
-		projection = strings.Join(f.Columns, ",")
+		return f.Columns, nil
 	}
-	dql := fmt.Sprintf("SELECT %v FROM  %v WHERE 1 = 0", projection, f.Table)
-	query, err := db.Prepare(dql)
+	dql := fmt.Sprintf("SELECT * FROM  %v WHERE 1 = 0", f.Table)
+	query, err := f.db.PrepareContext(ctx, dql)
 	if err != nil {
-		return errors.Wrapf(err, "failed to prepare query: %v", f.Table)
+		return nil, errors.Wrapf(err, "failed to prepare query: %v", f.Table)
 	}
 	defer query.Close()
-	rows, err := query.Query()
+	rows, err := query.QueryContext(ctx)
 	if err != nil {
-		return errors.Wrapf(err, "failed to query: %v", f.Table)
+		return nil, errors.Wrapf(err, "failed to query: %v", f.Table)
 	}
+	defer rows.Close()
 	columns, err := rows.Columns()
 	if err != nil {
-		return errors.Wrapf(err, "failed to discover column: %v", f.Table)
+		return nil, errors.Wrapf(err, "failed to discover column: %v", f.Table)
 	}
-	//TODO move to Setup methods
-	mapper, err := newWriterRowMapper(columns, f.Type.T)
-	if err != nil {
-		return errors.WithContext(err, "creating row mapper")
-	}
-	writer, err := newWriter(f.Driver, f.BatchSize, f.Table, columns)
+	return columns, nil
+}
+
+func (f *writeFn) ProcessElement(ctx context.Context, _ int, iter func(*beam.X) bool) error {
+	writer, err := f.newWriter()
 	if err != nil {
 		return err
 	}
 	var val beam.X
 	for iter(&val) {
-		var row []any
-		var data map[string]any
-		if writer, ok := val.(Writer); ok {
-			if data, err = writer.SaveData(); err == nil {
-				row = make([]any, len(columns))
-				for i, column := range columns {
-					row[i] = data[column]
-				}
-			}
-		} else {
-			row, err = mapper(reflect.ValueOf(val))
-		}
-		if err != nil {
-			return errors.Wrapf(err, "failed to map row %T", val)
-		}
-		if err = writer.add(row); err != nil {
-			return err
-		}
-		if err := writer.writeBatchIfNeeded(ctx, db, f.Driver); err != nil {
+		if err := f.add(ctx, writer, val); err != nil {
 			return err
 		}
 	}
 
-	if err := writer.writeIfNeeded(ctx, db, f.Driver); err != nil {
+	if err := writer.writeIfNeeded(ctx, f.db, f.Driver); err != nil {
 		return err
 	}
 
 	log.Infof(ctx, "written %v row(s) into %v", writer.totalCount, f.Table)
 	return nil
 }
+
+func (f *writeFn) Teardown() error {
+	if f.db == nil {
+		return nil
+	}
+	return f.db.Close()
+}
+
+func (f *writeFn) newWriter() (*writer, error) {
+	return newWriter(f.Driver, f.BatchSize, f.Table, f.columns, f.Upsert, f.MaxRetries)
+}
+
+// add maps the value to a row and adds it to the writer, writing the batch once it is full.
+func (f *writeFn) add(ctx context.Context, writer *writer, val beam.X) error {
+	var row []any
+	var err error
+	if w, ok := val.(Writer); ok {
+		var data map[string]any
+		if data, err = w.SaveData(); err == nil {
+			row = make([]any, len(f.columns))
+			for i, column := range f.columns {
+				row[i] = data[column]
+			}
+		}
+	} else {
+		row, err = f.mapper(reflect.ValueOf(val))
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to map row %T", val)
+	}
+	if err = writer.add(row); err != nil {
+		return err
+	}
+	return writer.writeBatchIfNeeded(ctx, f.db, f.Driver)
+}
+
+// addShardFn keys elements by shard, assigning shards round robin.
+type addShardFn struct {
+	// Shards is the number of shards.
+	Shards int `json:"shards"`
+
+	shard int
+}
+
+func (f *addShardFn) Setup() {
+	f.shard = rand.Intn(f.Shards)
+}
+
+func (f *addShardFn) ProcessElement(val beam.X) (int, beam.X) {
+	f.shard = (f.shard + 1) % f.Shards
+	return f.shard, val
+}
+
+// shardedWriteFn writes the elements of each bundle of a shard in batches, so that it does
+// not depend on a GroupByKey and can write unbounded PCollections.
+type shardedWriteFn struct {
+	// Fn is the write configuration.
+	Fn writeFn `json:"fn"`
+
+	writer *writer
+}
+
+func (f *shardedWriteFn) Setup(ctx context.Context) error {
+	return f.Fn.Setup(ctx)
+}
+
+func (f *shardedWriteFn) StartBundle(_ context.Context) error {
+	var err error
+	f.writer, err = f.Fn.newWriter()
+	return err
+}
+
+func (f *shardedWriteFn) ProcessElement(ctx context.Context, _ int, val beam.X) error {
+	return f.Fn.add(ctx, f.writer, val)
+}
+
+func (f *shardedWriteFn) FinishBundle(ctx context.Context) error {
+	if err := f.writer.writeIfNeeded(ctx, f.Fn.db, f.Fn.Driver); err != nil {
+		return err
+	}
+	log.Infof(ctx, "written %v row(s) into %v", f.writer.totalCount, f.Fn.Table)
+	return nil
+}
+
+func (f *shardedWriteFn) Teardown() error {
+	return f.Fn.Teardown()
+}
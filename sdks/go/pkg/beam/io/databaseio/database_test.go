@@ -74,6 +74,114 @@ func TestQuery(t *testing.T) {
 	ptest.RunAndValidate(t, p)
 }
 
+func TestRead_Partitioned(t *testing.T) {
+	db, err := sql.Open("ramsql", "user:password@/dbname3")
+	if err != nil {
+		t.Fatalf("Test infra failure: Failed to open database with error %v", err)
+	}
+	defer db.Close()
+	if err = insertTestData(db); err != nil {
+		t.Fatalf("Test infra failure: Failed to create/populate table with error %v", err)
+	}
+
+	// A single partition covers all rows, as the bounds only decide the stride.
+	p, s := beam.NewPipelineWithRoot()
+	elements := Read(s, "ramsql", "user:password@/dbname3", "address", reflect.TypeOf(Address{}),
+		ReadPartitions("street_number", 1), ReadPartitionBounds(0, 1000))
+	passert.Equals(s, elements, Address{Street: "orchard lane", Street_number: 1}, Address{Street: "morris st", Street_number: 200})
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestRead_invalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ReadOptionFn
+	}{
+		{name: "empty column", opts: []ReadOptionFn{ReadPartitions("", 2)}},
+		{name: "no partitions", opts: []ReadOptionFn{ReadPartitions("id", 0)}},
+		{name: "bounds without partitions", opts: []ReadOptionFn{ReadPartitionBounds(1, 2)}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Read() succeeded, want panic")
+				}
+			}()
+			_, s := beam.NewPipelineWithRoot()
+			Read(s, "ramsql", "dsn", "address", reflect.TypeOf(Address{}), test.opts...)
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	tests := []struct {
+		name string
+		dsn  string
+		opts []WriteOptionFn
+	}{
+		{name: "batched", dsn: "user:password@/write", opts: []WriteOptionFn{WriteBatchSize(2)}},
+		{name: "sharded", dsn: "user:password@/write_sharded", opts: []WriteOptionFn{WriteShards(3), WriteBatchSize(2)}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			db, err := sql.Open("ramsql", test.dsn)
+			if err != nil {
+				t.Fatalf("Test infra failure: Failed to open database with error %v", err)
+			}
+			defer db.Close()
+			if _, err = db.Exec("CREATE TABLE address (street TEXT, street_number INT);"); err != nil {
+				t.Fatalf("Test infra failure: Failed to create table with error %v", err)
+			}
+
+			addresses := []any{
+				Address{Street: "orchard lane", Street_number: 1},
+				Address{Street: "morris st", Street_number: 200},
+				Address{Street: "main st", Street_number: 5},
+				Address{Street: "elm st", Street_number: 12},
+				Address{Street: "oak ave", Street_number: 33},
+			}
+			p, s := beam.NewPipelineWithRoot()
+			Write(s, "ramsql", test.dsn, "address", []string{"street", "street_number"}, beam.Create(s, addresses...), test.opts...)
+			ptest.RunAndValidate(t, p)
+
+			var count int
+			if err := db.QueryRow("SELECT COUNT(street) FROM address").Scan(&count); err != nil {
+				t.Fatalf("failed to count rows: %v", err)
+			}
+			if count != len(addresses) {
+				t.Errorf("got %v rows, want %v", count, len(addresses))
+			}
+		})
+	}
+}
+
+func TestWrite_invalidOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		driver string
+		opts   []WriteOptionFn
+	}{
+		{name: "batch size", driver: "mysql", opts: []WriteOptionFn{WriteBatchSize(0)}},
+		{name: "retries", driver: "mysql", opts: []WriteOptionFn{WriteMaxRetries(-1)}},
+		{name: "shards", driver: "mysql", opts: []WriteOptionFn{WriteShards(0)}},
+		{name: "upsert keys", driver: "mysql", opts: []WriteOptionFn{WriteUpsert()}},
+		{name: "upsert driver", driver: "godror", opts: []WriteOptionFn{WriteUpsert("id")}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Write() succeeded, want panic")
+				}
+			}()
+			_, s := beam.NewPipelineWithRoot()
+			Write(s, test.driver, "dsn", "address", nil, beam.Create(s, Address{}), test.opts...)
+		})
+	}
+}
+
 func insertTestData(db *sql.DB) error {
 	_, err := db.Exec("CREATE TABLE address (street TEXT, street_number INT);")
 	if err != nil {
@@ -0,0 +1,139 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package databaseio
+
+import (
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+)
+
+type readOption struct {
+	PartitionColumn string
+	Partitions      int
+	Bounds          *partitionBounds
+}
+
+// ReadOptionFn is a function that can be passed to Read or Query to configure options for
+// reading from a database.
+type ReadOptionFn func(*readOption) error
+
+// ReadPartitions splits the read into the given number of queries over ranges of the given
+// column, which are executed in parallel. The column must be of an integer or timestamp type.
+// Unless ReadPartitionBounds is also given, the bounds of the ranges are determined by
+// querying the minimum and maximum values of the column when the pipeline is executed.
+//
+// The bounds only decide the stride of the ranges: the first and last ranges are open ended,
+// and rows where the column is NULL are read by the first range, so every row is read exactly
+// once.
+func ReadPartitions(column string, partitions int) ReadOptionFn {
+	return func(o *readOption) error {
+		if column == "" {
+			return errors.New("partition column must not be empty")
+		}
+		if partitions <= 0 {
+			return errors.Errorf("number of partitions must be greater than 0, got %v", partitions)
+		}
+		o.PartitionColumn = column
+		o.Partitions = partitions
+		return nil
+	}
+}
+
+// ReadPartitionBounds sets the bounds of the partition column used by ReadPartitions, avoiding
+// the query for the minimum and maximum values. The bounds must both be integers or both be
+// time.Time values, and lower must be less than upper.
+func ReadPartitionBounds(lower, upper any) ReadOptionFn {
+	return func(o *readOption) error {
+		l, lt, err := toBound(lower)
+		if err != nil {
+			return errors.WithContext(err, "lower partition bound")
+		}
+		u, ut, err := toBound(upper)
+		if err != nil {
+			return errors.WithContext(err, "upper partition bound")
+		}
+		if lt != ut {
+			return errors.Errorf("partition bounds must have the same type, got %T and %T", lower, upper)
+		}
+		if l >= u {
+			return errors.Errorf("lower partition bound %v must be less than upper bound %v", lower, upper)
+		}
+		o.Bounds = &partitionBounds{Lower: l, Upper: u, Time: lt}
+		return nil
+	}
+}
+
+type writeOption struct {
+	BatchSize  int
+	Upsert     []string
+	MaxRetries int
+	Shards     int
+}
+
+// WriteOptionFn is a function that can be passed to Write to configure options for writing
+// to a database.
+type WriteOptionFn func(*writeOption) error
+
+// WriteBatchSize sets the maximum number of rows written by a single statement. Defaults to
+// 1000.
+func WriteBatchSize(size int) WriteOptionFn {
+	return func(o *writeOption) error {
+		if size <= 0 {
+			return errors.Errorf("batch size must be greater than 0, got %v", size)
+		}
+		o.BatchSize = size
+		return nil
+	}
+}
+
+// WriteUpsert writes rows with an upsert statement, so that rows conflicting with existing rows
+// on the given key columns update the existing rows instead of failing. The key columns must
+// be covered by a primary key or unique constraint. Upserts are supported for the postgres,
+// pgx, mysql, sqlite and sqlite3 drivers.
+func WriteUpsert(keyColumns ...string) WriteOptionFn {
+	return func(o *writeOption) error {
+		if len(keyColumns) == 0 {
+			return errors.New("upsert requires at least one key column")
+		}
+		o.Upsert = keyColumns
+		return nil
+	}
+}
+
+// WriteMaxRetries sets the number of times a batch is retried after a failure. Each batch is
+// written in its own transaction, which is rolled back before the batch is retried. Defaults
+// to 3.
+func WriteMaxRetries(n int) WriteOptionFn {
+	return func(o *writeOption) error {
+		if n < 0 {
+			return errors.Errorf("max retries must not be negative, got %v", n)
+		}
+		o.MaxRetries = n
+		return nil
+	}
+}
+
+// WriteShards distributes the rows over the given number of shards, each of which writes its
+// rows in batches as bundles complete, instead of grouping all rows under a single key. This
+// allows writing unbounded PCollections, and bounded PCollections in parallel.
+func WriteShards(n int) WriteOptionFn {
+	return func(o *writeOption) error {
+		if n <= 0 {
+			return errors.Errorf("number of shards must be greater than 0, got %v", n)
+		}
+		o.Shards = n
+		return nil
+	}
+}
@@ -0,0 +1,274 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package databaseio
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+)
+
+// partitionBounds are the bounds of a partition column. Timestamps are represented as
+// nanoseconds since the epoch.
+type partitionBounds struct {
+	Lower int64 `json:"lower"`
+	Upper int64 `json:"upper"`
+	Time  bool  `json:"time"`
+}
+
+// readPartition is a range of a partition column. A range without a lower bound also
+// contains the rows where the column is NULL.
+type readPartition struct {
+	Lower    int64
+	Upper    int64
+	HasLower bool
+	HasUpper bool
+	Time     bool
+}
+
+// partitions splits the bounds into at most n ranges of equal width. The first and last
+// ranges are open ended, so that together the ranges cover all values of the column.
+func partitions(b partitionBounds, n int) []readPartition {
+	span := uint64(b.Upper - b.Lower)
+	if uint64(n) > span {
+		n = int(span)
+	}
+	if n <= 1 {
+		return []readPartition{{Time: b.Time}}
+	}
+	step, rem := span/uint64(n), span%uint64(n)
+	boundary := func(i int) int64 {
+		return b.Lower + int64(uint64(i)*step+uint64(i)*rem/uint64(n))
+	}
+	ret := make([]readPartition, n)
+	for i := range ret {
+		p := readPartition{Time: b.Time}
+		if i > 0 {
+			p.Lower, p.HasLower = boundary(i), true
+		}
+		if i < n-1 {
+			p.Upper, p.HasUpper = boundary(i+1), true
+		}
+		ret[i] = p
+	}
+	return ret
+}
+
+// where returns the condition selecting the rows of the partition from the given column, and
+// the arguments of its placeholders.
+func (p readPartition) where(driver, column string) (string, []any) {
+	var conditions []string
+	var args []any
+	if p.HasLower {
+		args = append(args, p.arg(p.Lower))
+		conditions = append(conditions, fmt.Sprintf("%v >= %v", column, placeholder(driver, len(args))))
+	}
+	if p.HasUpper {
+		args = append(args, p.arg(p.Upper))
+		conditions = append(conditions, fmt.Sprintf("%v < %v", column, placeholder(driver, len(args))))
+	}
+	where := strings.Join(conditions, " AND ")
+	if !p.HasLower && p.HasUpper {
+		where = fmt.Sprintf("(%v OR %v IS NULL)", where, column)
+	}
+	return where, args
+}
+
+func (p readPartition) arg(v int64) any {
+	if p.Time {
+		return time.Unix(0, v).UTC()
+	}
+	return v
+}
+
+// placeholder returns the placeholder of the nth argument of a statement.
+func placeholder(driver string, n int) string {
+	switch driver {
+	case "postgres", "pgx":
+		return fmt.Sprintf("$%d", n)
+	case "godror", "oracle":
+		return fmt.Sprintf(":%d", n)
+	default:
+		return "?"
+	}
+}
+
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999", "2006-01-02"}
+
+// toBound converts a value of a partition column to a bound, reporting whether it is a
+// timestamp.
+func toBound(v any) (int64, bool, error) {
+	switch v := v.(type) {
+	case time.Time:
+		return v.UnixNano(), true, nil
+	case int:
+		return int64(v), false, nil
+	case int8:
+		return int64(v), false, nil
+	case int16:
+		return int64(v), false, nil
+	case int32:
+		return int64(v), false, nil
+	case int64:
+		return v, false, nil
+	case uint:
+		return int64(v), false, nil
+	case uint8:
+		return int64(v), false, nil
+	case uint16:
+		return int64(v), false, nil
+	case uint32:
+		return int64(v), false, nil
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, false, errors.Errorf("value %v overflows int64", v)
+		}
+		return int64(v), false, nil
+	case float32:
+		return int64(math.Floor(float64(v))), false, nil
+	case float64:
+		return int64(math.Floor(v)), false, nil
+	case []byte:
+		return toBound(string(v))
+	case string:
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return i, false, nil
+		}
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return int64(math.Floor(f)), false, nil
+		}
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t.UnixNano(), true, nil
+			}
+		}
+		return 0, false, errors.Errorf("value %q is neither a number nor a timestamp", v)
+	default:
+		return 0, false, errors.Errorf("unsupported partition column type %T", v)
+	}
+}
+
+// partitionFn emits the ranges of a partitioned read, querying the bounds of the partition
+// column if they were not given.
+type partitionFn struct {
+	// Driver is the database driver name.
+	Driver string `json:"driver"`
+	// Dsn is the data source name.
+	Dsn string `json:"dsn"`
+	// Source is the table or parenthesized query to read from.
+	Source string `json:"source"`
+	// Column is the partition column.
+	Column string `json:"column"`
+	// Partitions is the maximum number of ranges.
+	Partitions int `json:"partitions"`
+	// Bounds are the bounds of the partition column, if nil they are queried.
+	Bounds *partitionBounds `json:"bounds"`
+}
+
+func (f *partitionFn) ProcessElement(ctx context.Context, _ []byte, emit func(readPartition)) error {
+	bounds := f.Bounds
+	if bounds == nil {
+		var err error
+		if bounds, err = f.queryBounds(ctx); err != nil {
+			return err
+		}
+	}
+	if bounds == nil {
+		// The source has no rows with a value in the partition column.
+		emit(readPartition{})
+		return nil
+	}
+	for _, p := range partitions(*bounds, f.Partitions) {
+		emit(p)
+	}
+	return nil
+}
+
+func (f *partitionFn) queryBounds(ctx context.Context) (*partitionBounds, error) {
+	db, err := sql.Open(f.Driver, f.Dsn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open database: %v", f.Driver)
+	}
+	defer db.Close()
+	q := fmt.Sprintf("SELECT MIN(%v), MAX(%v) FROM %v", f.Column, f.Column, f.Source)
+	var lower, upper any
+	if err := db.QueryRowContext(ctx, q).Scan(&lower, &upper); err != nil {
+		return nil, errors.Wrapf(err, "failed to query partition bounds: %v", q)
+	}
+	if lower == nil || upper == nil {
+		return nil, nil
+	}
+	l, lt, err := toBound(lower)
+	if err != nil {
+		return nil, errors.WithContextf(err, "converting lower bound of %v", f.Column)
+	}
+	u, ut, err := toBound(upper)
+	if err != nil {
+		return nil, errors.WithContextf(err, "converting upper bound of %v", f.Column)
+	}
+	if lt != ut {
+		return nil, errors.Errorf("bounds of %v have different types: %T and %T", f.Column, lower, upper)
+	}
+	return &partitionBounds{Lower: l, Upper: u, Time: lt}, nil
+}
+
+// partitionQueryFn reads the rows of a range of the partition column.
+type partitionQueryFn struct {
+	// Driver is the database driver name.
+	Driver string `json:"driver"`
+	// Dsn is the data source name.
+	Dsn string `json:"dsn"`
+	// Source is the table or parenthesized query to read from.
+	Source string `json:"source"`
+	// Column is the partition column.
+	Column string `json:"column"`
+	// Type is the encoded schema type.
+	Type beam.EncodedType `json:"type"`
+
+	db *sql.DB
+}
+
+func (f *partitionQueryFn) Setup() error {
+	db, err := sql.Open(f.Driver, f.Dsn)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open database: %v", f.Driver)
+	}
+	f.db = db
+	return nil
+}
+
+func (f *partitionQueryFn) ProcessElement(ctx context.Context, p readPartition, emit func(beam.X)) error {
+	q := fmt.Sprintf("SELECT * FROM %v", f.Source)
+	where, args := p.where(f.Driver, f.Column)
+	if where != "" {
+		q += " WHERE " + where
+	}
+	return readRows(ctx, f.db, q, args, f.Type.T, emit)
+}
+
+func (f *partitionQueryFn) Teardown() error {
+	if f.db == nil {
+		return nil
+	}
+	return f.db.Close()
+}
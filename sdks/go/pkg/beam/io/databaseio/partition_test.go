@@ -0,0 +1,244 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package databaseio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPartitions(t *testing.T) {
+	tests := []struct {
+		name   string
+		bounds partitionBounds
+		n      int
+		want   []readPartition
+	}{
+		{
+			name:   "single partition",
+			bounds: partitionBounds{Lower: 0, Upper: 100},
+			n:      1,
+			want:   []readPartition{{}},
+		},
+		{
+			name:   "even split",
+			bounds: partitionBounds{Lower: 0, Upper: 100},
+			n:      4,
+			want: []readPartition{
+				{Upper: 25, HasUpper: true},
+				{Lower: 25, Upper: 50, HasLower: true, HasUpper: true},
+				{Lower: 50, Upper: 75, HasLower: true, HasUpper: true},
+				{Lower: 75, HasLower: true},
+			},
+		},
+		{
+			name:   "uneven split",
+			bounds: partitionBounds{Lower: -5, Upper: 5},
+			n:      3,
+			want: []readPartition{
+				{Upper: -2, HasUpper: true},
+				{Lower: -2, Upper: 1, HasLower: true, HasUpper: true},
+				{Lower: 1, HasLower: true},
+			},
+		},
+		{
+			name:   "more partitions than values",
+			bounds: partitionBounds{Lower: 10, Upper: 12},
+			n:      8,
+			want: []readPartition{
+				{Upper: 11, HasUpper: true},
+				{Lower: 11, HasLower: true},
+			},
+		},
+		{
+			name:   "equal bounds",
+			bounds: partitionBounds{Lower: 7, Upper: 7, Time: true},
+			n:      8,
+			want:   []readPartition{{Time: true}},
+		},
+		{
+			name:   "full range",
+			bounds: partitionBounds{Lower: -1 << 63, Upper: 1<<63 - 1},
+			n:      2,
+			want: []readPartition{
+				{Upper: -1, HasUpper: true},
+				{Lower: -1, HasLower: true},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := partitions(test.bounds, test.n)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("partitions(%+v, %v) mismatch (-want +got):\n%s", test.bounds, test.n, diff)
+			}
+		})
+	}
+}
+
+func TestReadPartition_where(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name      string
+		driver    string
+		partition readPartition
+		wantWhere string
+		wantArgs  []any
+	}{
+		{
+			name:      "unbounded",
+			driver:    "mysql",
+			partition: readPartition{},
+			wantWhere: "",
+		},
+		{
+			name:      "first",
+			driver:    "mysql",
+			partition: readPartition{Upper: 10, HasUpper: true},
+			wantWhere: "(id < ? OR id IS NULL)",
+			wantArgs:  []any{int64(10)},
+		},
+		{
+			name:      "middle",
+			driver:    "postgres",
+			partition: readPartition{Lower: 10, Upper: 20, HasLower: true, HasUpper: true},
+			wantWhere: "id >= $1 AND id < $2",
+			wantArgs:  []any{int64(10), int64(20)},
+		},
+		{
+			name:      "last",
+			driver:    "godror",
+			partition: readPartition{Lower: 20, HasLower: true},
+			wantWhere: "id >= :1",
+			wantArgs:  []any{int64(20)},
+		},
+		{
+			name:      "timestamp",
+			driver:    "pgx",
+			partition: readPartition{Lower: ts.UnixNano(), HasLower: true, Time: true},
+			wantWhere: "id >= $1",
+			wantArgs:  []any{ts},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			where, args := test.partition.where(test.driver, "id")
+			if where != test.wantWhere {
+				t.Errorf("where() = %q, want %q", where, test.wantWhere)
+			}
+			if diff := cmp.Diff(test.wantArgs, args); diff != "" {
+				t.Errorf("where() args mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestToBound(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)
+	tests := []struct {
+		value    any
+		want     int64
+		wantTime bool
+	}{
+		{value: 42, want: 42},
+		{value: int32(-3), want: -3},
+		{value: uint16(7), want: 7},
+		{value: 2.5, want: 2},
+		{value: []byte("123"), want: 123},
+		{value: "-1.5", want: -2},
+		{value: ts, want: ts.UnixNano(), wantTime: true},
+		{value: "2024-01-02 03:04:05.000000006", want: ts.UnixNano(), wantTime: true},
+		{value: []byte("2024-01-02T03:04:05.000000006Z"), want: ts.UnixNano(), wantTime: true},
+	}
+	for _, test := range tests {
+		got, gotTime, err := toBound(test.value)
+		if err != nil {
+			t.Errorf("toBound(%v) failed: %v", test.value, err)
+			continue
+		}
+		if got != test.want || gotTime != test.wantTime {
+			t.Errorf("toBound(%v) = (%v, %v), want (%v, %v)", test.value, got, gotTime, test.want, test.wantTime)
+		}
+	}
+}
+
+func TestToBound_invalid(t *testing.T) {
+	for _, value := range []any{"abc", true, uint64(1 << 63)} {
+		if _, _, err := toBound(value); err == nil {
+			t.Errorf("toBound(%v) succeeded, want error", value)
+		}
+	}
+}
+
+func TestReadPartitionBounds(t *testing.T) {
+	tests := []struct {
+		name         string
+		lower, upper any
+		want         *partitionBounds
+		wantErr      bool
+	}{
+		{
+			name:  "integers",
+			lower: 1,
+			upper: int64(10),
+			want:  &partitionBounds{Lower: 1, Upper: 10},
+		},
+		{
+			name:  "timestamps",
+			lower: time.Unix(1, 0),
+			upper: time.Unix(2, 0),
+			want:  &partitionBounds{Lower: 1e9, Upper: 2e9, Time: true},
+		},
+		{
+			name:    "mixed types",
+			lower:   1,
+			upper:   time.Unix(2, 0),
+			wantErr: true,
+		},
+		{
+			name:    "empty range",
+			lower:   5,
+			upper:   5,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported type",
+			lower:   "a",
+			upper:   "b",
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			o := &readOption{}
+			err := ReadPartitionBounds(test.lower, test.upper)(o)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("ReadPartitionBounds(%v, %v) succeeded, want error", test.lower, test.upper)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReadPartitionBounds(%v, %v) failed: %v", test.lower, test.upper, err)
+			}
+			if diff := cmp.Diff(test.want, o.Bounds); diff != "" {
+				t.Errorf("ReadPartitionBounds(%v, %v) mismatch (-want +got):\n%s", test.lower, test.upper, diff)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"golang.org/x/net/context"
 
@@ -38,11 +39,13 @@ type writer struct {
 	batchSize              int
 	table                  string
 	sqlTemplate            string
+	upsertClause           string
 	valueTemplateGenerator *valueTemplateGenerator
 	binding                []any
 	columnCount            int
 	rowCount               int
 	totalCount             int
+	maxRetries             int
 }
 
 func (w *writer) add(row []any) error {
@@ -73,21 +76,54 @@ func (w *writer) write(ctx context.Context, db *sql.DB, driver string) error {
 		}
 		SQL += " SELECT 1 FROM dual"
 	default:
-		SQL = w.sqlTemplate + values
+		SQL = w.sqlTemplate + values + w.upsertClause
 	}
-	resultSet, err := db.ExecContext(ctx, SQL, w.binding...)
-	if err != nil {
-		return err
-	}
-	affected, _ := resultSet.RowsAffected()
-	if int(affected) != w.rowCount {
-		return errors.Errorf("expected to write: %v, but written: %v", w.rowCount, affected)
+	for attempt := 0; ; attempt++ {
+		err := w.exec(ctx, db, SQL)
+		if err == nil {
+			break
+		}
+		if attempt >= w.maxRetries {
+			return errors.Wrapf(err, "failed to write %v row(s) into %v after %v attempt(s)", w.rowCount, w.table, attempt+1)
+		}
+		backoff := retryBackoff[len(retryBackoff)-1]
+		if attempt < len(retryBackoff) {
+			backoff = retryBackoff[attempt]
+		}
+		log.Warnf(ctx, "failed to write %v row(s) into %v, retrying in %v: %v", w.rowCount, w.table, backoff, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
 	}
 	w.binding = []any{}
 	w.rowCount = 0
 	return nil
 }
 
+// exec executes the statement in a transaction, which is rolled back if the statement fails.
+func (w *writer) exec(ctx context.Context, db *sql.DB, SQL string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	resultSet, err := tx.ExecContext(ctx, SQL, w.binding...)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	// Drivers count upserted rows differently, e.g. MySQL counts an updated row twice.
+	if w.upsertClause == "" {
+		affected, _ := resultSet.RowsAffected()
+		if int(affected) != w.rowCount {
+			tx.Rollback()
+			return errors.Errorf("expected to write: %v, but written: %v", w.rowCount, affected)
+		}
+	}
+	return tx.Commit()
+}
+
 func (w *writer) writeBatchIfNeeded(ctx context.Context, db *sql.DB, driver string) error {
 	if w.rowCount >= w.batchSize {
 		return w.write(ctx, db, driver)
@@ -102,20 +138,77 @@ func (w *writer) writeIfNeeded(ctx context.Context, db *sql.DB, driver string) e
 	return nil
 }
 
-func newWriter(driver string, batchSize int, table string, columns []string) (*writer, error) {
+func newWriter(driver string, batchSize int, table string, columns []string, upsert []string, maxRetries int) (*writer, error) {
 	if len(columns) == 0 {
 		return nil, errors.New("columns were empty")
 	}
+	var clause string
+	if len(upsert) > 0 {
+		var err error
+		if clause, err = upsertClause(driver, columns, upsert); err != nil {
+			return nil, err
+		}
+	}
 	return &writer{
 		batchSize:              batchSize,
 		columnCount:            len(columns),
 		table:                  table,
 		binding:                make([]any, 0),
 		sqlTemplate:            fmt.Sprintf("INSERT INTO %v(%v) VALUES", table, strings.Join(columns, ",")),
+		upsertClause:           clause,
 		valueTemplateGenerator: &valueTemplateGenerator{driver, columns},
+		maxRetries:             maxRetries,
 	}, nil
 }
 
+// retryBackoff is the time to wait before each retry of a failed batch. The last duration is
+// used for any further retries.
+var retryBackoff = []time.Duration{time.Second, 5 * time.Second, 10 * time.Second}
+
+// upsertClause returns the clause appended to an INSERT statement of the given columns to
+// update the existing rows conflicting on the key columns.
+func upsertClause(driver string, columns, keys []string) (string, error) {
+	isKey := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		isKey[strings.ToLower(key)] = true
+	}
+	for _, key := range keys {
+		found := false
+		for _, column := range columns {
+			found = found || strings.EqualFold(column, key)
+		}
+		if !found {
+			return "", errors.Errorf("upsert key column %v is not written", key)
+		}
+	}
+	var updates []string
+	switch driver {
+	case "postgres", "pgx", "sqlite", "sqlite3":
+		for _, column := range columns {
+			if !isKey[strings.ToLower(column)] {
+				updates = append(updates, fmt.Sprintf("%v = excluded.%v", column, column))
+			}
+		}
+		if len(updates) == 0 {
+			return fmt.Sprintf(" ON CONFLICT (%v) DO NOTHING", strings.Join(keys, ",")), nil
+		}
+		return fmt.Sprintf(" ON CONFLICT (%v) DO UPDATE SET %v", strings.Join(keys, ","), strings.Join(updates, ",")), nil
+	case "mysql":
+		for _, column := range columns {
+			if !isKey[strings.ToLower(column)] {
+				updates = append(updates, fmt.Sprintf("%v = VALUES(%v)", column, column))
+			}
+		}
+		if len(updates) == 0 {
+			// Assigning a key to itself ignores the conflicting row.
+			updates = append(updates, fmt.Sprintf("%v = %v", keys[0], keys[0]))
+		}
+		return " ON DUPLICATE KEY UPDATE " + strings.Join(updates, ","), nil
+	default:
+		return "", errors.Errorf("upsert is not supported for driver %v", driver)
+	}
+}
+
 type valueTemplateGenerator struct {
 	driver  string
 	columns []string // Added field to store column names
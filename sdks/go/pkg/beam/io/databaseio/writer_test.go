@@ -16,8 +16,12 @@
 package databaseio
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestValueTemplateGenerator_generate(t *testing.T) {
@@ -77,3 +81,99 @@ func TestValueTemplateGenerator_generate(t *testing.T) {
 		})
 	}
 }
+
+func TestUpsertClause(t *testing.T) {
+	tests := []struct {
+		driver   string
+		columns  []string
+		keys     []string
+		expected string
+	}{
+		{
+			driver:   "postgres",
+			columns:  []string{"id", "name", "value"},
+			keys:     []string{"id"},
+			expected: " ON CONFLICT (id) DO UPDATE SET name = excluded.name,value = excluded.value",
+		},
+		{
+			driver:   "sqlite3",
+			columns:  []string{"ID", "name"},
+			keys:     []string{"id"},
+			expected: " ON CONFLICT (id) DO UPDATE SET name = excluded.name",
+		},
+		{
+			driver:   "pgx",
+			columns:  []string{"a", "b"},
+			keys:     []string{"a", "b"},
+			expected: " ON CONFLICT (a,b) DO NOTHING",
+		},
+		{
+			driver:   "mysql",
+			columns:  []string{"id", "name", "value"},
+			keys:     []string{"id"},
+			expected: " ON DUPLICATE KEY UPDATE name = VALUES(name),value = VALUES(value)",
+		},
+		{
+			driver:   "mysql",
+			columns:  []string{"id"},
+			keys:     []string{"id"},
+			expected: " ON DUPLICATE KEY UPDATE id = id",
+		},
+	}
+	for _, test := range tests {
+		got, err := upsertClause(test.driver, test.columns, test.keys)
+		if err != nil {
+			t.Errorf("upsertClause(%v, %v, %v) failed: %v", test.driver, test.columns, test.keys, err)
+			continue
+		}
+		if got != test.expected {
+			t.Errorf("upsertClause(%v, %v, %v) = %q, want %q", test.driver, test.columns, test.keys, got, test.expected)
+		}
+	}
+}
+
+func TestUpsertClause_invalid(t *testing.T) {
+	if _, err := upsertClause("godror", []string{"id"}, []string{"id"}); err == nil {
+		t.Error("upsertClause for unsupported driver succeeded, want error")
+	}
+	if _, err := upsertClause("postgres", []string{"id"}, []string{"name"}); err == nil {
+		t.Error("upsertClause with unwritten key column succeeded, want error")
+	}
+}
+
+func TestWriter_retries(t *testing.T) {
+	backoff := retryBackoff
+	retryBackoff = []time.Duration{time.Millisecond}
+	defer func() { retryBackoff = backoff }()
+
+	db, err := sql.Open("ramsql", "TestWriter_retries")
+	if err != nil {
+		t.Fatalf("Test infra failure: Failed to open database with error %v", err)
+	}
+	defer db.Close()
+
+	w, err := newWriter("ramsql", 10, "missing", []string{"id"}, nil, 2)
+	if err != nil {
+		t.Fatalf("newWriter failed: %v", err)
+	}
+	if err := w.add([]any{1}); err != nil {
+		t.Fatalf("add failed: %v", err)
+	}
+	err = w.write(context.Background(), db, "ramsql")
+	if err == nil {
+		t.Fatal("write to a missing table succeeded, want error")
+	}
+	if want := "after 3 attempt(s)"; !strings.Contains(err.Error(), want) {
+		t.Errorf("write() error = %v, want it to contain %q", err, want)
+	}
+
+	if _, err := db.Exec("CREATE TABLE missing (id INT);"); err != nil {
+		t.Fatalf("Test infra failure: Failed to create table with error %v", err)
+	}
+	if err := w.write(context.Background(), db, "ramsql"); err != nil {
+		t.Fatalf("write() after creating the table failed: %v", err)
+	}
+	if w.rowCount != 0 {
+		t.Errorf("rowCount after write = %v, want 0", w.rowCount)
+	}
+}
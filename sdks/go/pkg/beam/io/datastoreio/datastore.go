@@ -37,6 +37,8 @@ import (
 
 type clientType interface {
 	Run(context.Context, *datastore.Query) *datastore.Iterator
+	PutMulti(context.Context, []*datastore.Key, any) ([]*datastore.Key, error)
+	DeleteMulti(context.Context, []*datastore.Key) error
 	Close() error
 }
 
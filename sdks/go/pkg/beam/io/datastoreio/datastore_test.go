@@ -44,6 +44,12 @@ func init() {
 type fakeClient struct {
 	runCounter   int
 	closeCounter int
+
+	// puts and deletes record the keys of each PutMulti and DeleteMulti call.
+	puts    [][]*datastore.Key
+	deletes [][]*datastore.Key
+	// commitErrs are returned by successive PutMulti and DeleteMulti calls.
+	commitErrs []error
 }
 
 func (client *fakeClient) Run(context.Context, *datastore.Query) *datastore.Iterator {
@@ -52,6 +58,31 @@ func (client *fakeClient) Run(context.Context, *datastore.Query) *datastore.Iter
 	return new(datastore.Iterator)
 }
 
+func (client *fakeClient) PutMulti(_ context.Context, keys []*datastore.Key, _ any) ([]*datastore.Key, error) {
+	if err := client.commitErr(); err != nil {
+		return nil, err
+	}
+	client.puts = append(client.puts, keys)
+	return keys, nil
+}
+
+func (client *fakeClient) DeleteMulti(_ context.Context, keys []*datastore.Key) error {
+	if err := client.commitErr(); err != nil {
+		return err
+	}
+	client.deletes = append(client.deletes, keys)
+	return nil
+}
+
+func (client *fakeClient) commitErr() error {
+	if len(client.commitErrs) == 0 {
+		return nil
+	}
+	err := client.commitErrs[0]
+	client.commitErrs = client.commitErrs[1:]
+	return err
+}
+
 func (client *fakeClient) Close() error {
 	client.closeCounter += 1
 	return nil
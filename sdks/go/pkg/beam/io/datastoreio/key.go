@@ -0,0 +1,80 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastoreio
+
+import (
+	"io"
+	"reflect"
+
+	"cloud.google.com/go/datastore"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/coder"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/runtime/graphx/schema"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+)
+
+var (
+	typeOfKey      = reflect.TypeOf((*datastore.Key)(nil))
+	keyStorageType = reflect.TypeOf((*struct{ EncodedKey []byte })(nil)).Elem()
+)
+
+func init() {
+	// Keys are recursive through their parents, so they are encoded in their URL safe
+	// form both as elements and as the __key__ field of entities.
+	beam.RegisterCoder(typeOfKey, encodeKey, decodeKey)
+	schema.RegisterLogicalType(schema.ToLogicalType("datastore.Key", typeOfKey, keyStorageType))
+	coder.RegisterSchemaProviders(typeOfKey, keyEnc, keyDec)
+}
+
+func encodeKey(k *datastore.Key) ([]byte, error) {
+	if k == nil {
+		return nil, nil
+	}
+	return []byte(k.Encode()), nil
+}
+
+func decodeKey(data []byte) (*datastore.Key, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return datastore.DecodeKey(string(data))
+}
+
+func keyEnc(reflect.Type) (func(any, io.Writer) error, error) {
+	return func(iface any, w io.Writer) error {
+		if err := coder.WriteSimpleRowHeader(1, w); err != nil {
+			return errors.Wrap(err, "encoding *datastore.Key schema override")
+		}
+		data, err := encodeKey(iface.(*datastore.Key))
+		if err != nil {
+			return err
+		}
+		return coder.EncodeBytes(data, w)
+	}, nil
+}
+
+func keyDec(reflect.Type) (func(io.Reader) (any, error), error) {
+	return func(r io.Reader) (any, error) {
+		if err := coder.ReadSimpleRowHeader(1, r); err != nil {
+			return nil, errors.Wrap(err, "decoding *datastore.Key schema override")
+		}
+		data, err := coder.DecodeBytes(r)
+		if err != nil {
+			return nil, errors.Wrap(err, "retrieving key data")
+		}
+		return decodeKey(data)
+	}, nil
+}
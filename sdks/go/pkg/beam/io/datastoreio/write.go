@@ -0,0 +1,328 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastoreio
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/throttling"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxBatchMutations is the maximum number of mutations in a Datastore commit.
+	maxBatchMutations = 500
+	// maxBatchBytes bounds the estimated size of a commit, leaving headroom under the
+	// 10MiB request limit for the overhead the estimate doesn't account for.
+	maxBatchBytes = 9 << 20
+	// maxCommitAttempts is the number of times a commit is tried before failing the bundle.
+	maxCommitAttempts = 5
+	// initialCommitBackoff is the delay before the first retry of a failed commit.
+	initialCommitBackoff = 500 * time.Millisecond
+
+	keyFieldName = "__key__"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*mutateFn)(nil)).Elem())
+}
+
+// mutationOp is the operation a mutateFn applies to each element.
+type mutationOp string
+
+const (
+	upsertOp mutationOp = "upsert"
+	deleteOp mutationOp = "delete"
+)
+
+// Write upserts the entities of the given PCollection<T>. T must be a struct with a
+// *datastore.Key field tagged `datastore:"__key__"`, as populated by Read, holding the
+// complete key of the entity. The other fields are saved as for datastore.Client.Put.
+//
+// Entities are committed in batches of at most 500 mutations and 10MiB. Commits that fail
+// with contention or overload errors are retried with backoff, and an adaptive throttler
+// delays commits while Datastore rejects a large fraction of them. The time spent
+// throttled is reported in the "throttling-msecs" counter.
+//
+// Example:
+//
+//	type Item struct {
+//		Key  *datastore.Key `datastore:"__key__"`
+//		Name string
+//	}
+//
+//	datastoreio.Write(s, "project", items)
+func Write(s beam.Scope, project string, col beam.PCollection) {
+	s = s.Scope("datastore.Write")
+	mustHaveKeyField(col.Type().Type())
+	mutate(s, project, col, upsertOp, nil)
+}
+
+// DeleteEntities deletes the entities of the given PCollection<T>, identified by their
+// *datastore.Key field tagged `datastore:"__key__"`. Deletes are batched and throttled
+// as for Write.
+func DeleteEntities(s beam.Scope, project string, col beam.PCollection) {
+	s = s.Scope("datastore.DeleteEntities")
+	mustHaveKeyField(col.Type().Type())
+	mutate(s, project, col, deleteOp, nil)
+}
+
+// DeleteKeys deletes the entities with the keys of the given PCollection<*datastore.Key>.
+// Deletes are batched and throttled as for Write.
+func DeleteKeys(s beam.Scope, project string, col beam.PCollection) {
+	s = s.Scope("datastore.DeleteKeys")
+	if t := col.Type().Type(); t != typeOfKey {
+		panic(fmt.Sprintf("datastoreio.DeleteKeys: PCollection type must be %v, got %v", typeOfKey, t))
+	}
+	mutate(s, project, col, deleteOp, nil)
+}
+
+func mutate(s beam.Scope, project string, col beam.PCollection, op mutationOp, newClient newClientFuncType) {
+	beam.ParDo0(s, &mutateFn{Project: project, Op: op, Type: beam.EncodedType{T: col.Type().Type()}, newClientFunc: newClient}, col)
+}
+
+func mustHaveKeyField(t reflect.Type) {
+	if t.Kind() != reflect.Struct || keyFieldIndex(t) < 0 {
+		panic(fmt.Sprintf("datastoreio: type %v must be a struct with a *datastore.Key field tagged `datastore:\"%v\"`", t, keyFieldName))
+	}
+}
+
+// keyFieldIndex returns the index of the key field of the struct type t, or -1 if it has none.
+func keyFieldIndex(t reflect.Type) int {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if strings.Split(field.Tag.Get("datastore"), ",")[0] == keyFieldName && field.Type == typeOfKey {
+			return i
+		}
+	}
+	return -1
+}
+
+type mutateFn struct {
+	// Project is the project
+	Project string `json:"project"`
+	// Op is the operation applied to each element.
+	Op mutationOp `json:"op"`
+	// Type is the element type, either *datastore.Key or an entity struct.
+	Type          beam.EncodedType `json:"type"`
+	newClientFunc newClientFuncType
+
+	client    clientType
+	throttler *throttling.AdaptiveThrottler
+	keyField  int
+	sleep     func(time.Duration)
+
+	keys     []*datastore.Key
+	entities []any
+	seen     map[string]bool
+	size     int
+
+	rpcSuccesses beam.Counter
+	rpcErrors    beam.Counter
+}
+
+func (f *mutateFn) Setup(ctx context.Context) error {
+	if nil == f.newClientFunc {
+		// setup default newClientFunc for DoFns
+		f.newClientFunc = datastoreNewClient
+	}
+	if f.sleep == nil {
+		f.sleep = time.Sleep
+	}
+
+	client, err := f.newClientFunc(ctx, f.Project)
+	if err != nil {
+		return err
+	}
+	f.client = client
+	f.throttler = throttling.NewAdaptiveThrottler("datastoreio")
+	f.keyField = -1
+	if f.Type.T != typeOfKey {
+		f.keyField = keyFieldIndex(f.Type.T)
+	}
+	f.seen = make(map[string]bool)
+
+	f.rpcSuccesses = beam.NewCounter("datastoreio", "datastoreRpcSuccesses")
+	f.rpcErrors = beam.NewCounter("datastoreio", "datastoreRpcErrors")
+	return nil
+}
+
+func (f *mutateFn) ProcessElement(ctx context.Context, elem beam.X) error {
+	key, entity, size, err := f.mutation(elem)
+	if err != nil {
+		return err
+	}
+	if key == nil || key.Incomplete() {
+		return errors.Errorf("datastoreio: key must be complete, got %v", key)
+	}
+
+	// A commit can't mutate the same entity twice, so start a new batch for repeated keys.
+	if len(f.keys) == maxBatchMutations || f.size+size > maxBatchBytes || f.seen[key.String()] {
+		if err := f.flush(ctx); err != nil {
+			return err
+		}
+	}
+	f.keys = append(f.keys, key)
+	f.entities = append(f.entities, entity)
+	f.seen[key.String()] = true
+	f.size += size
+	return nil
+}
+
+// mutation returns the key of the element, the entity to upsert and the estimated size of
+// the mutation.
+func (f *mutateFn) mutation(elem any) (*datastore.Key, any, int, error) {
+	if f.keyField < 0 {
+		key := elem.(*datastore.Key)
+		return key, nil, keySize(key), nil
+	}
+
+	v := reflect.ValueOf(elem)
+	key := v.Field(f.keyField).Interface().(*datastore.Key)
+	if f.Op == deleteOp {
+		return key, nil, keySize(key), nil
+	}
+
+	entity := reflect.New(v.Type()) // entity : *T
+	entity.Elem().Set(v)
+	props, err := saveEntity(entity.Interface())
+	if err != nil {
+		return nil, nil, 0, errors.Wrapf(err, "datastoreio: invalid entity %v", key)
+	}
+	return key, entity.Interface(), keySize(key) + propertiesSize(props), nil
+}
+
+func saveEntity(entity any) ([]datastore.Property, error) {
+	if pls, ok := entity.(datastore.PropertyLoadSaver); ok {
+		return pls.Save()
+	}
+	return datastore.SaveStruct(entity)
+}
+
+func (f *mutateFn) FinishBundle(ctx context.Context) error {
+	return f.flush(ctx)
+}
+
+func (f *mutateFn) Teardown() error {
+	if f.client == nil {
+		return nil
+	}
+	return f.client.Close()
+}
+
+// flush commits the batch, retrying contention and overload errors with exponential backoff.
+func (f *mutateFn) flush(ctx context.Context) error {
+	if len(f.keys) == 0 {
+		return nil
+	}
+
+	backoff := initialCommitBackoff
+	for attempt := 1; ; attempt++ {
+		if err := f.throttler.Wait(ctx); err != nil {
+			return err
+		}
+
+		err := f.commit(ctx)
+		if err == nil {
+			f.throttler.SuccessfulRequest(time.Now())
+			f.rpcSuccesses.Inc(ctx, 1)
+			break
+		}
+		f.rpcErrors.Inc(ctx, 1)
+		if !isRetryable(err) || attempt == maxCommitAttempts {
+			return errors.Wrapf(err, "datastoreio: %v of %d entities failed", f.Op, len(f.keys))
+		}
+		log.Warnf(ctx, "Datastore: %v of %d entities failed, retrying in %v: %v", f.Op, len(f.keys), backoff, err)
+		f.sleep(backoff)
+		backoff *= 2
+	}
+
+	f.keys = nil
+	f.entities = nil
+	f.seen = make(map[string]bool)
+	f.size = 0
+	return nil
+}
+
+func (f *mutateFn) commit(ctx context.Context) error {
+	if f.Op == deleteOp {
+		return f.client.DeleteMulti(ctx, f.keys)
+	}
+	_, err := f.client.PutMulti(ctx, f.keys, f.entities)
+	return err
+}
+
+// isRetryable returns whether a failed commit may succeed if tried again.
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Aborted, codes.DeadlineExceeded, codes.Unavailable, codes.ResourceExhausted:
+		return true
+	}
+	return false
+}
+
+func keySize(k *datastore.Key) int {
+	if k == nil {
+		return 0
+	}
+	return len(k.Kind) + len(k.Name) + len(k.Namespace) + 8 + keySize(k.Parent)
+}
+
+// propertiesSize estimates the encoded size of the properties of an entity.
+func propertiesSize(props []datastore.Property) int {
+	size := 0
+	for _, p := range props {
+		if p.Name == keyFieldName {
+			continue
+		}
+		size += len(p.Name) + valueSize(p.Value)
+	}
+	return size
+}
+
+func valueSize(v any) int {
+	switch v := v.(type) {
+	case string:
+		return len(v)
+	case []byte:
+		return len(v)
+	case *datastore.Key:
+		return keySize(v)
+	case *datastore.Entity:
+		if v == nil {
+			return 0
+		}
+		return keySize(v.Key) + propertiesSize(v.Properties)
+	case []any:
+		size := 0
+		for _, e := range v {
+			size += valueSize(e)
+		}
+		return size
+	default:
+		// Numbers, booleans, times and geo points.
+		return 16
+	}
+}
@@ -0,0 +1,227 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datastoreio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/datastore"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/throttling"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Entity struct {
+	Key   *datastore.Key `datastore:"__key__"`
+	Name  string
+	Count int64
+}
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*Entity)(nil)).Elem())
+}
+
+func newFakeClientFunc(client *fakeClient) newClientFuncType {
+	return func(ctx context.Context, projectID string, opts ...option.ClientOption) (clientType, error) {
+		return client, nil
+	}
+}
+
+func keyNames(batches [][]*datastore.Key) []string {
+	var names []string
+	for _, batch := range batches {
+		for _, k := range batch {
+			names = append(names, k.Name)
+		}
+	}
+	return names
+}
+
+func Test_mutate(t *testing.T) {
+	entities := []Entity{
+		{Key: datastore.NameKey("Entity", "a", nil), Name: "a", Count: 1},
+		{Key: datastore.NameKey("Entity", "b", datastore.NameKey("Parent", "p", nil)), Name: "b", Count: 2},
+	}
+	keys := []*datastore.Key{datastore.NameKey("Entity", "c", nil)}
+
+	client := fakeClient{}
+	p, s := beam.NewPipelineWithRoot()
+	mutate(s, "project", beam.CreateList(s, entities), upsertOp, newFakeClientFunc(&client))
+	mutate(s, "project", beam.CreateList(s, keys), deleteOp, newFakeClientFunc(&client))
+	ptest.RunAndValidate(t, p)
+
+	if diff := cmp.Diff([]string{"a", "b"}, keyNames(client.puts)); diff != "" {
+		t.Errorf("upserted keys mismatch (-want +got):\n%s", diff)
+	}
+	if got := client.puts[0][1].Parent; got == nil || got.Name != "p" {
+		t.Errorf("upserted key lost its parent: got %v", got)
+	}
+	if diff := cmp.Diff([]string{"c"}, keyNames(client.deletes)); diff != "" {
+		t.Errorf("deleted keys mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWrite_badType(t *testing.T) {
+	tests := []struct {
+		name string
+		v    any
+		fn   func(beam.Scope, string, beam.PCollection)
+	}{
+		{"Write without key field", Foo{}, Write},
+		{"DeleteEntities without key field", Foo{}, DeleteEntities},
+		{"DeleteKeys of entities", Entity{}, DeleteKeys},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("%v does not panic", test.name)
+				}
+			}()
+			_, s := beam.NewPipelineWithRoot()
+			test.fn(s, "project", beam.Create(s, test.v))
+		})
+	}
+}
+
+func newTestMutateFn(t *testing.T, client *fakeClient, op mutationOp, typ reflect.Type) (*mutateFn, *[]time.Duration) {
+	t.Helper()
+	var sleeps []time.Duration
+	fn := &mutateFn{
+		Project:       "project",
+		Op:            op,
+		Type:          beam.EncodedType{T: typ},
+		newClientFunc: newFakeClientFunc(client),
+		sleep:         func(d time.Duration) { sleeps = append(sleeps, d) },
+	}
+	if err := fn.Setup(context.Background()); err != nil {
+		t.Fatalf("Setup() failed: %v", err)
+	}
+	return fn, &sleeps
+}
+
+func Test_mutateFn_batches(t *testing.T) {
+	ctx := context.Background()
+	client := fakeClient{}
+	fn, _ := newTestMutateFn(t, &client, upsertOp, reflect.TypeOf(Entity{}))
+
+	for i := 0; i < 1200; i++ {
+		e := Entity{Key: datastore.NameKey("Entity", fmt.Sprintf("e%d", i), nil)}
+		if err := fn.ProcessElement(ctx, e); err != nil {
+			t.Fatalf("ProcessElement() failed: %v", err)
+		}
+	}
+	// A repeated key must go to a new commit.
+	if err := fn.ProcessElement(ctx, Entity{Key: datastore.NameKey("Entity", "e1100", nil)}); err != nil {
+		t.Fatalf("ProcessElement() failed: %v", err)
+	}
+	// Large entities are bounded by the byte limit.
+	for i := 0; i < 3; i++ {
+		e := Entity{Key: datastore.NameKey("Entity", fmt.Sprintf("large%d", i), nil), Name: strings.Repeat("x", 4<<20)}
+		if err := fn.ProcessElement(ctx, e); err != nil {
+			t.Fatalf("ProcessElement() failed: %v", err)
+		}
+	}
+	if err := fn.FinishBundle(ctx); err != nil {
+		t.Fatalf("FinishBundle() failed: %v", err)
+	}
+
+	var got []int
+	for _, batch := range client.puts {
+		got = append(got, len(batch))
+	}
+	if diff := cmp.Diff([]int{500, 500, 200, 3, 1}, got); diff != "" {
+		t.Errorf("commit sizes mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_mutateFn_incompleteKey(t *testing.T) {
+	fn, _ := newTestMutateFn(t, &fakeClient{}, deleteOp, typeOfKey)
+	if err := fn.ProcessElement(context.Background(), datastore.IncompleteKey("Entity", nil)); err == nil {
+		t.Errorf("ProcessElement() of an incomplete key succeeded, want error")
+	}
+}
+
+func Test_mutateFn_retries(t *testing.T) {
+	ctx := context.Background()
+	client := fakeClient{commitErrs: []error{
+		status.Error(codes.Aborted, "too much contention"),
+		status.Error(codes.Unavailable, "unavailable"),
+	}}
+	fn, sleeps := newTestMutateFn(t, &client, deleteOp, typeOfKey)
+	fn.throttler = throttling.NewAdaptiveThrottler("datastoreio", throttling.WithRandom(func() float64 { return 1 })) // never throttle
+
+	if err := fn.ProcessElement(ctx, datastore.IDKey("Entity", 1, nil)); err != nil {
+		t.Fatalf("ProcessElement() failed: %v", err)
+	}
+	if err := fn.FinishBundle(ctx); err != nil {
+		t.Fatalf("FinishBundle() failed: %v", err)
+	}
+	if got, want := len(client.deletes), 1; got != want {
+		t.Errorf("got %v commits, want %v", got, want)
+	}
+	if diff := cmp.Diff([]time.Duration{initialCommitBackoff, 2 * initialCommitBackoff}, *sleeps); diff != "" {
+		t.Errorf("retry backoffs mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_mutateFn_permanentError(t *testing.T) {
+	ctx := context.Background()
+	client := fakeClient{commitErrs: []error{status.Error(codes.InvalidArgument, "bad entity")}}
+	fn, sleeps := newTestMutateFn(t, &client, deleteOp, typeOfKey)
+
+	if err := fn.ProcessElement(ctx, datastore.IDKey("Entity", 1, nil)); err != nil {
+		t.Fatalf("ProcessElement() failed: %v", err)
+	}
+	if err := fn.FinishBundle(ctx); err == nil || !strings.Contains(err.Error(), "bad entity") {
+		t.Errorf("FinishBundle() = %v, want bad entity error", err)
+	}
+	if len(*sleeps) != 0 {
+		t.Errorf("permanent error was retried after %v", *sleeps)
+	}
+}
+
+func Test_mutateFn_throttledCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := fakeClient{}
+	fn, _ := newTestMutateFn(t, &client, deleteOp, typeOfKey)
+	fn.throttler = throttling.NewAdaptiveThrottler("datastoreio", throttling.WithRandom(func() float64 { return 0 }))
+	// Requests that were never accepted make the throttler reject all requests.
+	for i := 0; i < 10; i++ {
+		fn.throttler.ThrottleRequest(time.Now())
+	}
+
+	if err := fn.ProcessElement(ctx, datastore.IDKey("Entity", 1, nil)); err != nil {
+		t.Fatalf("ProcessElement() failed: %v", err)
+	}
+	cancel()
+	if err := fn.FinishBundle(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("FinishBundle() = %v, want %v", err, context.Canceled)
+	}
+	if got := len(client.deletes); got != 0 {
+		t.Errorf("got %v commits while throttled, want 0", got)
+	}
+}
@@ -0,0 +1,320 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchio
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeCluster is an in-process stand-in for the REST API of a cluster. It implements the
+// subset of the bulk, search, scroll and point in time APIs used by elasticsearchio.
+type fakeCluster struct {
+	mu       sync.Mutex
+	srv      *httptest.Server
+	docs     map[string]map[string]string // index -> id -> source
+	failures map[string][]int             // id -> statuses of the next attempts
+	bulks    int
+	queries  []json.RawMessage
+	scrolls  map[string][]searchHit
+	cleared  int
+	pits     map[string][]searchHit
+	closed   int
+	users    []string
+	nextID   int
+}
+
+func newFakeCluster(t *testing.T) *fakeCluster {
+	t.Helper()
+
+	c := &fakeCluster{
+		docs:     make(map[string]map[string]string),
+		failures: make(map[string][]int),
+		scrolls:  make(map[string][]searchHit),
+		pits:     make(map[string][]searchHit),
+	}
+	c.srv = httptest.NewServer(http.HandlerFunc(c.handle))
+	t.Cleanup(c.srv.Close)
+	return c
+}
+
+func (c *fakeCluster) url() string {
+	return c.srv.URL
+}
+
+func (c *fakeCluster) add(index, id, source string) {
+	if c.docs[index] == nil {
+		c.docs[index] = make(map[string]string)
+	}
+	c.docs[index][id] = source
+}
+
+func (c *fakeCluster) handle(w http.ResponseWriter, r *http.Request) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if user, _, ok := r.BasicAuth(); ok {
+		c.users = append(c.users, user)
+	}
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r.Body)
+	body := buf.Bytes()
+
+	var status int
+	var resp any
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/_bulk":
+		status, resp = c.bulk(body)
+	case r.Method == http.MethodPost && r.URL.Path == "/_search/scroll":
+		status, resp = c.scroll(body)
+	case r.Method == http.MethodDelete && r.URL.Path == "/_search/scroll":
+		status, resp = c.clearScroll(body)
+	case r.Method == http.MethodPost && r.URL.Path == "/_search":
+		status, resp = c.searchPointInTime(body)
+	case r.Method == http.MethodDelete && r.URL.Path == "/_pit":
+		status, resp = c.closePointInTime(body)
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/_search"):
+		status, resp = c.search(strings.TrimSuffix(r.URL.Path[1:], "/_search"), body)
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/_pit"):
+		status, resp = c.openPointInTime(strings.TrimSuffix(r.URL.Path[1:], "/_pit"))
+	default:
+		status, resp = http.StatusNotFound, map[string]string{"error": "unknown path " + r.URL.Path}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resp)
+}
+
+func (c *fakeCluster) bulk(body []byte) (int, any) {
+	c.bulks++
+
+	var items []map[string]any
+	errs := false
+	sc := bufio.NewScanner(bytes.NewReader(body))
+	for sc.Scan() {
+		var action map[string]bulkAction
+		if err := json.Unmarshal(sc.Bytes(), &action); err != nil {
+			return http.StatusBadRequest, map[string]string{"error": err.Error()}
+		}
+		if !sc.Scan() {
+			return http.StatusBadRequest, map[string]string{"error": "missing source"}
+		}
+		source := sc.Text()
+		a := action["index"]
+
+		if statuses := c.failures[a.ID]; len(statuses) > 0 {
+			c.failures[a.ID] = statuses[1:]
+			errs = true
+			items = append(items, map[string]any{"index": map[string]any{
+				"_index": a.Index,
+				"_id":    a.ID,
+				"status": statuses[0],
+				"error":  map[string]string{"type": "fake_exception", "reason": "status " + strconv.Itoa(statuses[0])},
+			}})
+			continue
+		}
+
+		if a.ID == "" {
+			c.nextID++
+			a.ID = fmt.Sprintf("generated-%d", c.nextID)
+		}
+		c.add(a.Index, a.ID, source)
+		items = append(items, map[string]any{"index": map[string]any{"_index": a.Index, "_id": a.ID, "status": 201}})
+	}
+	return http.StatusOK, map[string]any{"errors": errs, "items": items}
+}
+
+type fakeSearchRequest struct {
+	Size  int             `json:"size"`
+	Query json.RawMessage `json:"query"`
+	Slice *struct {
+		ID  int `json:"id"`
+		Max int `json:"max"`
+	} `json:"slice"`
+	PointInTime struct {
+		ID string `json:"id"`
+	} `json:"pit"`
+	SearchAfter []float64 `json:"search_after"`
+}
+
+// snapshot returns the documents of the indices matching pattern, sorted by index and ID,
+// with their position as sort value.
+func (c *fakeCluster) snapshot(pattern string) []searchHit {
+	var indices []string
+	for index := range c.docs {
+		for _, p := range strings.Split(pattern, ",") {
+			if ok, _ := path.Match(p, index); ok {
+				indices = append(indices, index)
+				break
+			}
+		}
+	}
+	sort.Strings(indices)
+
+	var hits []searchHit
+	for _, index := range indices {
+		var ids []string
+		for id := range c.docs[index] {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			hits = append(hits, searchHit{
+				Index:  index,
+				ID:     id,
+				Source: json.RawMessage(c.docs[index][id]),
+				Sort:   []any{len(hits)},
+			})
+		}
+	}
+	return hits
+}
+
+func sliceHits(hits []searchHit, req fakeSearchRequest) []searchHit {
+	if req.Slice == nil {
+		return hits
+	}
+	var out []searchHit
+	for _, hit := range hits {
+		h := fnv.New32a()
+		h.Write([]byte(hit.ID))
+		if int(h.Sum32()%uint32(req.Slice.Max)) == req.Slice.ID {
+			out = append(out, hit)
+		}
+	}
+	return out
+}
+
+func searchResult(id string, hits []searchHit) map[string]any {
+	if hits == nil {
+		hits = []searchHit{}
+	}
+	return map[string]any{"_scroll_id": id, "pit_id": id, "hits": map[string]any{"hits": hits}}
+}
+
+func page(hits []searchHit, size int) ([]searchHit, []searchHit) {
+	if len(hits) < size {
+		return hits, nil
+	}
+	return hits[:size], hits[size:]
+}
+
+func (c *fakeCluster) search(pattern string, body []byte) (int, any) {
+	var req fakeSearchRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return http.StatusBadRequest, map[string]string{"error": err.Error()}
+	}
+	c.queries = append(c.queries, req.Query)
+
+	hits, rest := page(sliceHits(c.snapshot(pattern), req), req.Size)
+	c.nextID++
+	id := fmt.Sprintf("scroll-%d", c.nextID)
+	c.scrolls[id] = append(rest, searchHit{Sort: []any{req.Size}})
+	return http.StatusOK, searchResult(id, hits)
+}
+
+func (c *fakeCluster) scroll(body []byte) (int, any) {
+	var req struct {
+		ScrollID string `json:"scroll_id"`
+	}
+	json.Unmarshal(body, &req)
+	rest, ok := c.scrolls[req.ScrollID]
+	if !ok {
+		return http.StatusNotFound, map[string]string{"error": "no scroll " + req.ScrollID}
+	}
+	// The page size is stored as the last element of the scroll.
+	size := rest[len(rest)-1].Sort[0].(int)
+	hits, rest := page(rest[:len(rest)-1], size)
+	c.scrolls[req.ScrollID] = append(rest, searchHit{Sort: []any{size}})
+	return http.StatusOK, searchResult(req.ScrollID, hits)
+}
+
+func (c *fakeCluster) clearScroll(body []byte) (int, any) {
+	var req struct {
+		ScrollID []string `json:"scroll_id"`
+	}
+	json.Unmarshal(body, &req)
+	for _, id := range req.ScrollID {
+		delete(c.scrolls, id)
+		c.cleared++
+	}
+	return http.StatusOK, map[string]bool{"succeeded": true}
+}
+
+func (c *fakeCluster) openPointInTime(pattern string) (int, any) {
+	c.nextID++
+	id := fmt.Sprintf("pit-%d", c.nextID)
+	c.pits[id] = c.snapshot(pattern)
+	return http.StatusOK, map[string]string{"id": id}
+}
+
+func (c *fakeCluster) searchPointInTime(body []byte) (int, any) {
+	var req fakeSearchRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return http.StatusBadRequest, map[string]string{"error": err.Error()}
+	}
+	snapshot, ok := c.pits[req.PointInTime.ID]
+	if !ok {
+		return http.StatusNotFound, map[string]string{"error": "no point in time " + req.PointInTime.ID}
+	}
+	c.queries = append(c.queries, req.Query)
+
+	var hits []searchHit
+	for _, hit := range sliceHits(snapshot, req) {
+		if len(req.SearchAfter) == 1 && hit.Sort[0].(int) <= int(req.SearchAfter[0]) {
+			continue
+		}
+		hits = append(hits, hit)
+	}
+	hits, _ = page(hits, req.Size)
+	return http.StatusOK, searchResult(req.PointInTime.ID, hits)
+}
+
+func (c *fakeCluster) closePointInTime(body []byte) (int, any) {
+	var req struct {
+		ID string `json:"id"`
+	}
+	json.Unmarshal(body, &req)
+	if _, ok := c.pits[req.ID]; !ok {
+		return http.StatusNotFound, map[string]bool{"succeeded": false}
+	}
+	delete(c.pits, req.ID)
+	c.closed++
+	return http.StatusOK, map[string]bool{"succeeded": true}
+}
+
+// noSleep replaces the sleep of a writeFn, recording the backoffs.
+type noSleep struct {
+	backoffs []time.Duration
+}
+
+func (s *noSleep) sleep(d time.Duration) {
+	s.backoffs = append(s.backoffs, d)
+}
@@ -0,0 +1,274 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/log"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/transforms/stats"
+)
+
+const (
+	defaultReadSlices    = 1
+	defaultReadBatchSize = 1000
+	defaultReadKeepAlive = 5 * time.Minute
+)
+
+func init() {
+	register.DoFn3x1[context.Context, []byte, func(readSlice), error](&createSlicesFn{})
+	register.Emitter1[readSlice]()
+	register.DoFn4x1[context.Context, readSlice, func(Hit), func(string), error](&readSliceFn{})
+	register.Emitter1[Hit]()
+	register.Emitter1[string]()
+	register.DoFn3x1[context.Context, string, int, error](&closePointInTimeFn{})
+
+	beam.RegisterType(reflect.TypeOf((*Hit)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*readSlice)(nil)).Elem())
+}
+
+// Hit represents a document read from Elasticsearch.
+type Hit struct {
+	Index string
+	ID    string
+	// Source is the JSON-encoded document.
+	Source string
+}
+
+// Read reads the documents of an index, or of the indices matching a pattern such as
+// "logs-*", and returns a PCollection<Hit>. The search is split into slices that are read
+// in parallel, each with the scroll API or, with ReadPointInTime, with search_after in a
+// point in time.
+//
+// Read takes a variable number of ReadOptionFn to configure the read operation:
+//   - ReadQuery: query that the documents must match. Defaults to all documents.
+//   - ReadSlices: number of slices. Defaults to 1.
+//   - ReadBatchSize: number of documents per request. Defaults to 1000.
+//   - ReadKeepAlive: time the search context is kept alive between requests. Defaults to 5m.
+//   - ReadPointInTime: read a point in time instead of using the scroll API.
+func Read(s beam.Scope, url, index string, opts ...ReadOptionFn) beam.PCollection {
+	s = s.Scope("elasticsearchio.Read")
+
+	if index == "" {
+		panic("elasticsearchio.Read: index must not be empty")
+	}
+
+	option := &readOption{
+		Slices:    defaultReadSlices,
+		BatchSize: defaultReadBatchSize,
+		KeepAlive: defaultReadKeepAlive,
+	}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("elasticsearchio.Read: invalid option: %v", err))
+		}
+	}
+
+	keepAlive := fmt.Sprintf("%ds", int64(option.KeepAlive/time.Second))
+
+	imp := beam.Impulse(s)
+	slices := beam.ParDo(s, &createSlicesFn{
+		elasticsearchFn: elasticsearchFn{URL: url},
+		Index:           index,
+		Slices:          option.Slices,
+		KeepAlive:       keepAlive,
+		PointInTime:     option.PointInTime,
+	}, imp)
+	slices = beam.Reshuffle(s, slices)
+
+	hits, done := beam.ParDo2(s, &readSliceFn{
+		elasticsearchFn: elasticsearchFn{URL: url},
+		Index:           index,
+		Query:           option.Query,
+		BatchSize:       option.BatchSize,
+		KeepAlive:       keepAlive,
+	}, slices)
+
+	if option.PointInTime {
+		beam.ParDo0(s, &closePointInTimeFn{elasticsearchFn: elasticsearchFn{URL: url}}, stats.Count(s, done))
+	}
+	return hits
+}
+
+// readSlice is a slice of a search, read in a point in time if PointInTime is set.
+type readSlice struct {
+	PointInTime string
+	ID          int
+	Max         int
+}
+
+type createSlicesFn struct {
+	elasticsearchFn
+	Index       string
+	Slices      int
+	KeepAlive   string
+	PointInTime bool
+}
+
+func (fn *createSlicesFn) ProcessElement(ctx context.Context, _ []byte, emit func(readSlice)) error {
+	var pit string
+	if fn.PointInTime {
+		var resp struct {
+			ID string `json:"id"`
+		}
+		query := url.Values{"keep_alive": {fn.KeepAlive}}
+		if err := fn.client.do(ctx, http.MethodPost, "/"+fn.Index+"/_pit", query, nil, &resp); err != nil {
+			return fmt.Errorf("error opening point in time of %v: %v", fn.Index, err)
+		}
+		pit = resp.ID
+	}
+
+	for i := 0; i < fn.Slices; i++ {
+		emit(readSlice{PointInTime: pit, ID: i, Max: fn.Slices})
+	}
+	return nil
+}
+
+type readSliceFn struct {
+	elasticsearchFn
+	Index     string
+	Query     string
+	BatchSize int
+	KeepAlive string
+}
+
+type searchResponse struct {
+	ScrollID    string `json:"_scroll_id"`
+	PointInTime string `json:"pit_id"`
+	Hits        struct {
+		Hits []searchHit `json:"hits"`
+	} `json:"hits"`
+}
+
+type searchHit struct {
+	Index  string          `json:"_index"`
+	ID     string          `json:"_id"`
+	Source json.RawMessage `json:"_source"`
+	Sort   []any           `json:"sort"`
+}
+
+// ProcessElement reads the documents of the slice, and outputs the point in time of the
+// slice, if any, once they are read.
+func (fn *readSliceFn) ProcessElement(ctx context.Context, slice readSlice, emit func(Hit), done func(string)) error {
+	body := map[string]any{"size": fn.BatchSize}
+	if fn.Query != "" {
+		body["query"] = json.RawMessage(fn.Query)
+	}
+	// Slicing a search into a single slice is rejected.
+	if slice.Max > 1 {
+		body["slice"] = map[string]int{"id": slice.ID, "max": slice.Max}
+	}
+
+	if slice.PointInTime == "" {
+		return fn.readScroll(ctx, body, emit)
+	}
+	if err := fn.readPointInTime(ctx, slice.PointInTime, body, emit); err != nil {
+		return err
+	}
+	done(slice.PointInTime)
+	return nil
+}
+
+func (fn *readSliceFn) readScroll(ctx context.Context, body map[string]any, emit func(Hit)) error {
+	body["sort"] = []string{"_doc"}
+
+	var resp searchResponse
+	query := url.Values{"scroll": {fn.KeepAlive}}
+	if err := fn.client.do(ctx, http.MethodPost, "/"+fn.Index+"/_search", query, body, &resp); err != nil {
+		return fmt.Errorf("error searching %v: %v", fn.Index, err)
+	}
+	defer func() {
+		if resp.ScrollID == "" {
+			return
+		}
+		clear := map[string]any{"scroll_id": []string{resp.ScrollID}}
+		if err := fn.client.do(ctx, http.MethodDelete, "/_search/scroll", nil, clear, nil); err != nil {
+			log.Warnf(ctx, "Failed to clear scroll of %v: %v", fn.Index, err)
+		}
+	}()
+
+	for len(resp.Hits.Hits) > 0 {
+		emitHits(resp.Hits.Hits, emit)
+
+		scroll := map[string]any{"scroll": fn.KeepAlive, "scroll_id": resp.ScrollID}
+		next := searchResponse{ScrollID: resp.ScrollID}
+		if err := fn.client.do(ctx, http.MethodPost, "/_search/scroll", nil, scroll, &next); err != nil {
+			return fmt.Errorf("error scrolling %v: %v", fn.Index, err)
+		}
+		if next.ScrollID == "" {
+			next.ScrollID = resp.ScrollID
+		}
+		resp = next
+	}
+	return nil
+}
+
+func (fn *readSliceFn) readPointInTime(ctx context.Context, pit string, body map[string]any, emit func(Hit)) error {
+	body["sort"] = []string{"_shard_doc"}
+
+	for {
+		body["pit"] = map[string]string{"id": pit, "keep_alive": fn.KeepAlive}
+
+		var resp searchResponse
+		if err := fn.client.do(ctx, http.MethodPost, "/_search", nil, body, &resp); err != nil {
+			return fmt.Errorf("error searching point in time of %v: %v", fn.Index, err)
+		}
+		hits := resp.Hits.Hits
+		if len(hits) == 0 {
+			return nil
+		}
+		emitHits(hits, emit)
+
+		// The point in time ID may change between searches, and the latest one must be used.
+		if resp.PointInTime != "" {
+			pit = resp.PointInTime
+		}
+		body["search_after"] = hits[len(hits)-1].Sort
+	}
+}
+
+func emitHits(hits []searchHit, emit func(Hit)) {
+	for _, hit := range hits {
+		emit(Hit{Index: hit.Index, ID: hit.ID, Source: string(hit.Source)})
+	}
+}
+
+// closePointInTimeFn closes a point in time once all of its slices are read.
+type closePointInTimeFn struct {
+	elasticsearchFn
+}
+
+func (fn *closePointInTimeFn) ProcessElement(ctx context.Context, pit string, _ int) error {
+	err := fn.client.do(ctx, http.MethodDelete, "/_pit", nil, map[string]string{"id": pit}, nil)
+	var serr *statusError
+	if errors.As(err, &serr) && serr.Status == http.StatusNotFound {
+		// The point in time has already expired.
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error closing point in time: %v", err)
+	}
+	return nil
+}
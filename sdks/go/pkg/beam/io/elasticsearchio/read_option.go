@@ -0,0 +1,100 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchio
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidQuery     = errors.New("query must be a JSON object")
+	errInvalidSlices    = errors.New("slices must be greater than 0")
+	errInvalidKeepAlive = errors.New("keep alive must be at least 1s")
+)
+
+type readOption struct {
+	Query       string
+	Slices      int
+	BatchSize   int
+	KeepAlive   time.Duration
+	PointInTime bool
+}
+
+// ReadOptionFn is a function that can be passed to Read to configure options for reading
+// from Elasticsearch.
+type ReadOptionFn func(option *readOption) error
+
+// ReadQuery sets the JSON-encoded query that the read documents must match, such as
+// {"term": {"user.id": "kimchy"}}. All documents are read by default.
+func ReadQuery(query string) ReadOptionFn {
+	return func(o *readOption) error {
+		var q map[string]any
+		if err := json.Unmarshal([]byte(query), &q); err != nil {
+			return errInvalidQuery
+		}
+
+		o.Query = query
+		return nil
+	}
+}
+
+// ReadSlices sets the number of slices that the search is split into. Slices are read in
+// parallel.
+func ReadSlices(slices int) ReadOptionFn {
+	return func(o *readOption) error {
+		if slices <= 0 {
+			return errInvalidSlices
+		}
+
+		o.Slices = slices
+		return nil
+	}
+}
+
+// ReadBatchSize sets the number of documents to read per request.
+func ReadBatchSize(size int) ReadOptionFn {
+	return func(o *readOption) error {
+		if size <= 0 {
+			return errInvalidBatchSize
+		}
+
+		o.BatchSize = size
+		return nil
+	}
+}
+
+// ReadKeepAlive sets the time that the search context is kept alive between requests.
+func ReadKeepAlive(keepAlive time.Duration) ReadOptionFn {
+	return func(o *readOption) error {
+		if keepAlive < time.Second {
+			return errInvalidKeepAlive
+		}
+
+		o.KeepAlive = keepAlive
+		return nil
+	}
+}
+
+// ReadPointInTime configures Read to search a point in time with search_after, instead of
+// using the scroll API. Point in time searches require Elasticsearch 7.10 or later.
+func ReadPointInTime() ReadOptionFn {
+	return func(o *readOption) error {
+		o.PointInTime = true
+		return nil
+	}
+}
@@ -0,0 +1,137 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchio
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func addDocuments(cluster *fakeCluster, index string, n int) []any {
+	var hits []any
+	for i := 0; i < n; i++ {
+		id := fmt.Sprintf("doc-%02d", i)
+		source := fmt.Sprintf(`{"n":%d}`, i)
+		cluster.add(index, id, source)
+		hits = append(hits, Hit{Index: index, ID: id, Source: source})
+	}
+	return hits
+}
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ReadOptionFn
+	}{
+		{
+			name: "Scroll",
+		},
+		{
+			name: "Scroll with slices",
+			opts: []ReadOptionFn{ReadSlices(3), ReadBatchSize(2)},
+		},
+		{
+			name: "Point in time",
+			opts: []ReadOptionFn{ReadPointInTime(), ReadBatchSize(2)},
+		},
+		{
+			name: "Point in time with slices",
+			opts: []ReadOptionFn{ReadPointInTime(), ReadSlices(3), ReadBatchSize(2)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := newFakeCluster(t)
+			want := addDocuments(cluster, "logs-1", 6)
+			want = append(want, addDocuments(cluster, "logs-2", 5)...)
+			addDocuments(cluster, "other", 3)
+
+			p, s := beam.NewPipelineWithRoot()
+
+			got := Read(s, cluster.url(), "logs-*", tt.opts...)
+			passert.Equals(s, got, want...)
+
+			ptest.RunAndValidate(t, p)
+
+			if got := len(cluster.scrolls); got != 0 {
+				t.Errorf("Open scrolls = %v, want 0", got)
+			}
+			if got := len(cluster.pits); got != 0 {
+				t.Errorf("Open points in time = %v, want 0", got)
+			}
+		})
+	}
+}
+
+func TestRead_query(t *testing.T) {
+	cluster := newFakeCluster(t)
+	want := addDocuments(cluster, "docs", 1)
+
+	p, s := beam.NewPipelineWithRoot()
+
+	query := `{"term":{"n":0}}`
+	got := Read(s, cluster.url(), "docs", ReadQuery(query), ReadKeepAlive(time.Minute))
+	passert.Equals(s, got, want...)
+
+	ptest.RunAndValidate(t, p)
+
+	if diff := cmp.Diff([]json.RawMessage{json.RawMessage(query)}, cluster.queries); diff != "" {
+		t.Errorf("Queries mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRead_invalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  ReadOptionFn
+	}{
+		{
+			name: "Invalid query",
+			opt:  ReadQuery("match_all"),
+		},
+		{
+			name: "Slices of 0",
+			opt:  ReadSlices(0),
+		},
+		{
+			name: "Batch size of 0",
+			opt:  ReadBatchSize(0),
+		},
+		{
+			name: "Keep alive below 1s",
+			opt:  ReadKeepAlive(time.Millisecond),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Read() did not panic, want panic")
+				}
+			}()
+
+			_, s := beam.NewPipelineWithRoot()
+			Read(s, "http://localhost:9200", "docs", tt.opt)
+		})
+	}
+}
@@ -0,0 +1,308 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/internal/batch"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+const (
+	defaultWriteBatchSize            = 1000
+	defaultWriteBatchBytes           = 5 << 20
+	defaultWriteMaxBufferingDuration = batch.DefaultMaxBufferingDuration
+	defaultWriteMaxRetries           = 3
+	defaultWriteRetryBackoff         = time.Second
+)
+
+func init() {
+	register.DoFn3x1[context.Context, batch.Batch, func(beam.EventTime, FailedDocument), error](&writeFn{})
+	register.Emitter2[beam.EventTime, FailedDocument]()
+
+	beam.RegisterType(reflect.TypeOf((*Document)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*FailedDocument)(nil)).Elem())
+}
+
+// Document represents a document to index.
+type Document struct {
+	// Index is the index to write the document to. It overrides the index passed to Write.
+	Index string
+	// ID is the ID of the document. It is generated by the cluster if empty.
+	ID string
+	// Routing is the routing value of the document. It defaults to the ID if empty.
+	Routing string
+	// Source is the JSON-encoded document.
+	Source string
+}
+
+// FailedDocument represents a document that couldn't be indexed.
+type FailedDocument struct {
+	Document Document
+	// Status is the status code of the last attempt to index the document, or 0 if the
+	// document wasn't sent.
+	Status int
+	Error  string
+}
+
+// Write indexes documents with the bulk API. It takes a PCollection<Document> and returns
+// a PCollection<FailedDocument> of the documents that couldn't be indexed, to be used as a
+// dead letter queue. Documents are written to their Index, or to index if they have none.
+//
+// Documents are batched per window, and a batch is written when it is full or when the
+// watermark passes the end of its window. Since the end of the global window is never reached
+// for an unbounded PCollection, a batch of an unbounded PCollection is also written once it
+// has been buffered for the maximum buffering duration. Failed documents are output in the
+// window and at the timestamp of their document.
+//
+// Documents that fail with a retryable status, such as 429 Too Many Requests, are retried
+// with exponential backoff. Documents that fail with another status, or once retries are
+// exhausted, are output as failed documents. Bulk requests that fail as a whole are retried
+// as well, and fail the bundle once retries are exhausted.
+//
+// Write takes a variable number of WriteOptionFn to configure the write operation:
+//   - WriteBatchSize: maximum number of documents per bulk request. Defaults to 1000.
+//   - WriteBatchBytes: maximum size of a bulk request. Defaults to 5MiB.
+//   - WriteMaxBufferingDuration: maximum time documents of an unbounded PCollection are
+//     buffered for. Defaults to 10s.
+//   - WriteMaxRetries: maximum number of retries. Defaults to 3.
+//   - WriteRetryBackoff: time to wait before the first retry. Defaults to 1s.
+func Write(s beam.Scope, url, index string, col beam.PCollection, opts ...WriteOptionFn) beam.PCollection {
+	s = s.Scope("elasticsearchio.Write")
+
+	option := &writeOption{
+		BatchSize:            defaultWriteBatchSize,
+		BatchBytes:           defaultWriteBatchBytes,
+		MaxBufferingDuration: defaultWriteMaxBufferingDuration,
+		MaxRetries:           defaultWriteMaxRetries,
+		RetryBackoff:         defaultWriteRetryBackoff,
+	}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("elasticsearchio.Write: invalid option: %v", err))
+		}
+	}
+
+	batches := batch.Group(s, col, batch.Options{
+		Size:                 option.BatchSize,
+		Bytes:                option.BatchBytes,
+		MaxBufferingDuration: option.MaxBufferingDuration,
+	})
+	return beam.ParDo(s, newWriteFn(url, index, option), batches)
+}
+
+type writeFn struct {
+	elasticsearchFn
+	Index        string
+	BatchSize    int
+	BatchBytes   int
+	MaxRetries   int
+	RetryBackoff time.Duration
+	sleep        func(time.Duration)
+	dec          *batch.Decoder
+	batch        []bulkItem
+	bytes        int
+}
+
+func newWriteFn(url, index string, option *writeOption) *writeFn {
+	return &writeFn{
+		elasticsearchFn: elasticsearchFn{URL: url},
+		Index:           index,
+		BatchSize:       option.BatchSize,
+		BatchBytes:      option.BatchBytes,
+		MaxRetries:      option.MaxRetries,
+		RetryBackoff:    option.RetryBackoff,
+	}
+}
+
+// bulkItem is a document, its timestamp and its lines in a bulk request.
+type bulkItem struct {
+	doc   Document
+	ts    beam.EventTime
+	lines []byte
+}
+
+type bulkAction struct {
+	Index   string `json:"_index"`
+	ID      string `json:"_id,omitempty"`
+	Routing string `json:"routing,omitempty"`
+}
+
+var errNoIndex = errors.New("document has no index")
+
+// newBulkItem returns the index action and source lines of a document.
+func newBulkItem(doc Document, defaultIndex string) (bulkItem, error) {
+	action := bulkAction{Index: doc.Index, ID: doc.ID, Routing: doc.Routing}
+	if action.Index == "" {
+		action.Index = defaultIndex
+	}
+	if action.Index == "" {
+		return bulkItem{}, errNoIndex
+	}
+
+	var buf bytes.Buffer
+	line, err := json.Marshal(map[string]bulkAction{"index": action})
+	if err != nil {
+		return bulkItem{}, err
+	}
+	buf.Write(line)
+	buf.WriteByte('\n')
+	// The source must be on a single line, so it is compacted.
+	if err := json.Compact(&buf, []byte(doc.Source)); err != nil {
+		return bulkItem{}, fmt.Errorf("invalid JSON source: %v", err)
+	}
+	buf.WriteByte('\n')
+	return bulkItem{doc: doc, lines: buf.Bytes()}, nil
+}
+
+func (fn *writeFn) Setup() error {
+	if fn.sleep == nil {
+		fn.sleep = time.Sleep
+	}
+	fn.dec = batch.NewDecoder(reflect.TypeOf((*Document)(nil)).Elem())
+	return fn.elasticsearchFn.Setup()
+}
+
+// ProcessElement writes a batch of documents of the same window with bulk requests.
+func (fn *writeFn) ProcessElement(ctx context.Context, b batch.Batch, emit func(beam.EventTime, FailedDocument)) error {
+	fn.batch = nil
+	fn.bytes = 0
+	for _, e := range b.Elements {
+		v, err := fn.dec.Decode(e)
+		if err != nil {
+			return fmt.Errorf("error decoding document: %v", err)
+		}
+		doc := v.(Document)
+		item, err := newBulkItem(doc, fn.Index)
+		if err != nil {
+			emit(e.EventTime(), FailedDocument{Document: doc, Error: err.Error()})
+			continue
+		}
+		item.ts = e.EventTime()
+
+		if len(fn.batch) > 0 && fn.bytes+len(item.lines) > fn.BatchBytes {
+			if err := fn.flush(ctx, emit); err != nil {
+				return err
+			}
+		}
+		fn.batch = append(fn.batch, item)
+		fn.bytes += len(item.lines)
+		if len(fn.batch) >= fn.BatchSize || fn.bytes >= fn.BatchBytes {
+			if err := fn.flush(ctx, emit); err != nil {
+				return err
+			}
+		}
+	}
+	return fn.flush(ctx, emit)
+}
+
+// flush sends the batched documents, retrying the documents that failed with a retryable
+// status, and outputs the documents that failed otherwise.
+func (fn *writeFn) flush(ctx context.Context, emit func(beam.EventTime, FailedDocument)) error {
+	items := fn.batch
+	fn.batch = nil
+	fn.bytes = 0
+
+	backoff := fn.RetryBackoff
+	for attempt := 0; len(items) > 0; attempt++ {
+		retry := attempt < fn.MaxRetries
+		results, err := fn.bulk(ctx, items)
+		if err != nil {
+			var serr *statusError
+			if errors.As(err, &serr) && !retryableStatus(serr.Status) || !retry {
+				return fmt.Errorf("error writing %d documents to Elasticsearch: %v", len(items), err)
+			}
+			fn.sleep(backoff)
+			backoff *= 2
+			continue
+		}
+
+		var failed []bulkItem
+		for i, result := range results {
+			if result.Status >= 200 && result.Status <= 299 {
+				continue
+			}
+			if retry && retryableStatus(result.Status) {
+				failed = append(failed, items[i])
+				continue
+			}
+			emit(items[i].ts, FailedDocument{Document: items[i].doc, Status: result.Status, Error: result.errorMessage()})
+		}
+		if len(failed) > 0 {
+			fn.sleep(backoff)
+			backoff *= 2
+		}
+		items = failed
+	}
+	return nil
+}
+
+type bulkResponse struct {
+	Errors bool                        `json:"errors"`
+	Items  []map[string]bulkItemResult `json:"items"`
+}
+
+type bulkItemResult struct {
+	Status int             `json:"status"`
+	Error  json.RawMessage `json:"error"`
+}
+
+func (r bulkItemResult) errorMessage() string {
+	var reason struct {
+		Type   string `json:"type"`
+		Reason string `json:"reason"`
+	}
+	if err := json.Unmarshal(r.Error, &reason); err != nil || reason.Type == "" {
+		if len(r.Error) > 0 {
+			return string(r.Error)
+		}
+		return http.StatusText(r.Status)
+	}
+	return reason.Type + ": " + reason.Reason
+}
+
+// bulk sends a bulk request and returns the result of each item.
+func (fn *writeFn) bulk(ctx context.Context, items []bulkItem) ([]bulkItemResult, error) {
+	var body []byte
+	for _, item := range items {
+		body = append(body, item.lines...)
+	}
+
+	var resp bulkResponse
+	if err := fn.client.do(ctx, http.MethodPost, "/_bulk", nil, body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Items) != len(items) {
+		return nil, fmt.Errorf("bulk response has %d items, want %d", len(resp.Items), len(items))
+	}
+
+	results := make([]bulkItemResult, len(items))
+	for i, item := range resp.Items {
+		for _, result := range item {
+			results[i] = result
+		}
+	}
+	return results, nil
+}
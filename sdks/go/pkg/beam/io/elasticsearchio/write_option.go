@@ -0,0 +1,105 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchio
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidBatchSize    = errors.New("batch size must be greater than 0")
+	errInvalidBatchBytes   = errors.New("batch bytes must be greater than 0")
+	errInvalidBuffering    = errors.New("max buffering duration must be greater than 0")
+	errInvalidMaxRetries   = errors.New("max retries must not be negative")
+	errInvalidRetryBackoff = errors.New("retry backoff must be greater than 0")
+)
+
+type writeOption struct {
+	BatchSize            int
+	BatchBytes           int
+	MaxBufferingDuration time.Duration
+	MaxRetries           int
+	RetryBackoff         time.Duration
+}
+
+// WriteOptionFn is a function that can be passed to Write to configure options for writing
+// to Elasticsearch.
+type WriteOptionFn func(option *writeOption) error
+
+// WriteBatchSize sets the maximum number of documents sent in a single bulk request.
+func WriteBatchSize(size int) WriteOptionFn {
+	return func(o *writeOption) error {
+		if size <= 0 {
+			return errInvalidBatchSize
+		}
+
+		o.BatchSize = size
+		return nil
+	}
+}
+
+// WriteBatchBytes sets the maximum size in bytes of a bulk request. A document larger than
+// the maximum is sent in a bulk request of its own.
+func WriteBatchBytes(bytes int) WriteOptionFn {
+	return func(o *writeOption) error {
+		if bytes <= 0 {
+			return errInvalidBatchBytes
+		}
+
+		o.BatchBytes = bytes
+		return nil
+	}
+}
+
+// WriteMaxBufferingDuration sets the maximum processing time that documents of an unbounded
+// PCollection are buffered for before they are sent, even if their bulk request isn't full.
+func WriteMaxBufferingDuration(d time.Duration) WriteOptionFn {
+	return func(o *writeOption) error {
+		if d <= 0 {
+			return errInvalidBuffering
+		}
+
+		o.MaxBufferingDuration = d
+		return nil
+	}
+}
+
+// WriteMaxRetries sets the maximum number of times that a bulk request, or the documents of
+// a bulk request that failed with a retryable status, are retried.
+func WriteMaxRetries(retries int) WriteOptionFn {
+	return func(o *writeOption) error {
+		if retries < 0 {
+			return errInvalidMaxRetries
+		}
+
+		o.MaxRetries = retries
+		return nil
+	}
+}
+
+// WriteRetryBackoff sets the time to wait before the first retry. The time is doubled for
+// each subsequent retry.
+func WriteRetryBackoff(backoff time.Duration) WriteOptionFn {
+	return func(o *writeOption) error {
+		if backoff <= 0 {
+			return errInvalidRetryBackoff
+		}
+
+		o.RetryBackoff = backoff
+		return nil
+	}
+}
@@ -0,0 +1,296 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package elasticsearchio
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/internal/batch"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func init() {
+	register.Function2x0(timestampDocumentFn)
+	register.Emitter2[beam.EventTime, Document]()
+}
+
+// timestampDocumentFn outputs documents at the timestamp in seconds of their ID.
+func timestampDocumentFn(doc Document, emit func(beam.EventTime, Document)) {
+	secs := map[string]int{"a": 10, "b": 20, "c": 70, "d": 80}[doc.ID]
+	emit(mtime.FromDuration(time.Duration(secs)*time.Second), doc)
+}
+
+func TestMain(m *testing.M) {
+	ptest.Main(m)
+}
+
+func TestWrite(t *testing.T) {
+	cluster := newFakeCluster(t)
+	cluster.failures["rejected"] = []int{400}
+
+	p, s := beam.NewPipelineWithRoot()
+
+	invalid := Document{ID: "invalid", Source: `{"n":`}
+	rejected := Document{ID: "rejected", Source: `{"n": 4}`}
+	col := beam.Create(s,
+		Document{ID: "a", Source: `{"n": 1}`},
+		Document{ID: "b", Index: "other", Routing: "r", Source: "{\n  \"n\": 2\n}"},
+		Document{Source: `{"n": 3}`},
+		invalid,
+		rejected,
+	)
+	failed := Write(s, cluster.url(), "docs", col)
+
+	passert.Equals(s, failed,
+		FailedDocument{Document: invalid, Error: "invalid JSON source: unexpected end of JSON input"},
+		FailedDocument{Document: rejected, Status: 400, Error: "fake_exception: status 400"},
+	)
+
+	ptest.RunAndValidate(t, p)
+
+	want := map[string]map[string]string{
+		"docs":  {"a": `{"n":1}`, "generated-1": `{"n":3}`},
+		"other": {"b": `{"n":2}`},
+	}
+	if diff := cmp.Diff(want, cluster.docs); diff != "" {
+		t.Errorf("Documents mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWrite_windowed(t *testing.T) {
+	cluster := newFakeCluster(t)
+	cluster.failures["b"] = []int{400}
+	cluster.failures["d"] = []int{400}
+
+	p, s := beam.NewPipelineWithRoot()
+	docs := beam.ParDo(s, timestampDocumentFn, beam.Create(s,
+		Document{ID: "a", Source: `{}`},
+		Document{ID: "b", Source: `{}`},
+		Document{ID: "c", Source: `{}`},
+		Document{ID: "d", Source: `{}`},
+	))
+	failed := Write(s, cluster.url(), "docs", beam.WindowInto(s, window.NewFixedWindows(time.Minute), docs))
+
+	first := window.IntervalWindow{Start: 0, End: mtime.FromDuration(time.Minute)}
+	second := window.IntervalWindow{Start: mtime.FromDuration(time.Minute), End: mtime.FromDuration(2 * time.Minute)}
+	passert.Equals(s, passert.InWindow(s, failed, first),
+		FailedDocument{Document: Document{ID: "b", Source: `{}`}, Status: 400, Error: "fake_exception: status 400"})
+	passert.Equals(s, passert.InWindow(s, failed, second),
+		FailedDocument{Document: Document{ID: "d", Source: `{}`}, Status: 400, Error: "fake_exception: status 400"})
+
+	ptest.RunAndValidate(t, p)
+}
+
+func newTestWriteFn(t *testing.T, url string, opts ...WriteOptionFn) (*writeFn, *noSleep) {
+	t.Helper()
+
+	option := &writeOption{
+		BatchSize:    defaultWriteBatchSize,
+		BatchBytes:   defaultWriteBatchBytes,
+		MaxRetries:   defaultWriteMaxRetries,
+		RetryBackoff: defaultWriteRetryBackoff,
+	}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			t.Fatalf("Invalid option: %v", err)
+		}
+	}
+
+	fn := newWriteFn(url, "docs", option)
+	if err := fn.Setup(); err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+	t.Cleanup(fn.Teardown)
+
+	ns := &noSleep{}
+	fn.sleep = ns.sleep
+	return fn, ns
+}
+
+// writeDocuments writes a batch of the documents, with the timestamp of each
+// document its index in milliseconds, and checks the failed documents are output
+// at the timestamp of their document.
+func writeDocuments(t *testing.T, fn *writeFn, docs ...Document) ([]FailedDocument, error) {
+	t.Helper()
+
+	enc := beam.NewElementEncoder(reflect.TypeOf(Document{}))
+	var b batch.Batch
+	timestamps := map[string]beam.EventTime{}
+	for i, doc := range docs {
+		var buf bytes.Buffer
+		if err := enc.Encode(doc, &buf); err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+		b.Elements = append(b.Elements, batch.Element{Data: buf.Bytes(), Timestamp: int64(i)})
+		timestamps[doc.ID] = mtime.Time(i)
+	}
+
+	var failed []FailedDocument
+	emit := func(ts beam.EventTime, doc FailedDocument) {
+		if want := timestamps[doc.Document.ID]; ts != want {
+			t.Errorf("Failed document %v at %v, want %v", doc.Document.ID, ts, want)
+		}
+		failed = append(failed, doc)
+	}
+	return failed, fn.ProcessElement(context.Background(), b, emit)
+}
+
+func TestWriteFn_retries(t *testing.T) {
+	cluster := newFakeCluster(t)
+	cluster.failures["a"] = []int{429, 429}
+	cluster.failures["b"] = []int{400}
+	cluster.failures["c"] = []int{503, 503, 503, 503}
+
+	fn, ns := newTestWriteFn(t, cluster.url())
+	a := Document{ID: "a", Source: `{}`}
+	b := Document{ID: "b", Source: `{}`}
+	c := Document{ID: "c", Source: `{}`}
+	failed, err := writeDocuments(t, fn, a, b, c)
+	if err != nil {
+		t.Fatalf("Write error = %v", err)
+	}
+
+	wantFailed := []FailedDocument{
+		{Document: b, Status: 400, Error: "fake_exception: status 400"},
+		{Document: c, Status: 503, Error: "fake_exception: status 503"},
+	}
+	if diff := cmp.Diff(wantFailed, failed); diff != "" {
+		t.Errorf("Failed documents mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]string{"a": "{}"}, cluster.docs["docs"]); diff != "" {
+		t.Errorf("Documents mismatch (-want +got):\n%s", diff)
+	}
+	wantBackoffs := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second}
+	if diff := cmp.Diff(wantBackoffs, ns.backoffs); diff != "" {
+		t.Errorf("Backoffs mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteFn_batches(t *testing.T) {
+	docs := []Document{
+		{ID: "a", Source: `{"n": 1}`},
+		{ID: "b", Source: `{"n": 2}`},
+		{ID: "c", Source: `{"n": 3}`},
+		{ID: "d", Source: `{"n": 4}`},
+		{ID: "e", Source: `{"n": 5}`},
+	}
+	// Each document is 44 bytes in a bulk request.
+	tests := []struct {
+		name      string
+		opts      []WriteOptionFn
+		wantBulks int
+	}{
+		{
+			name:      "Single batch",
+			wantBulks: 1,
+		},
+		{
+			name:      "Batches by count",
+			opts:      []WriteOptionFn{WriteBatchSize(2)},
+			wantBulks: 3,
+		},
+		{
+			name:      "Batches by bytes",
+			opts:      []WriteOptionFn{WriteBatchBytes(100)},
+			wantBulks: 3,
+		},
+		{
+			name:      "Documents larger than the batch bytes",
+			opts:      []WriteOptionFn{WriteBatchBytes(10)},
+			wantBulks: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := newFakeCluster(t)
+			fn, _ := newTestWriteFn(t, cluster.url(), tt.opts...)
+			if _, err := writeDocuments(t, fn, docs...); err != nil {
+				t.Fatalf("Write error = %v", err)
+			}
+
+			if got := cluster.bulks; got != tt.wantBulks {
+				t.Errorf("Bulk requests = %v, want %v", got, tt.wantBulks)
+			}
+			if got, want := len(cluster.docs["docs"]), len(docs); got != want {
+				t.Errorf("Documents = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestWriteFn_requestFailure(t *testing.T) {
+	cluster := newFakeCluster(t)
+	fn, ns := newTestWriteFn(t, cluster.url(), WriteMaxRetries(2), WriteRetryBackoff(time.Millisecond))
+	cluster.srv.Close()
+
+	if _, err := writeDocuments(t, fn, Document{ID: "a", Source: `{}`}); err == nil {
+		t.Fatal("Write error = nil, want error")
+	}
+	wantBackoffs := []time.Duration{time.Millisecond, 2 * time.Millisecond}
+	if diff := cmp.Diff(wantBackoffs, ns.backoffs); diff != "" {
+		t.Errorf("Backoffs mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWrite_invalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  WriteOptionFn
+	}{
+		{
+			name: "Batch size of 0",
+			opt:  WriteBatchSize(0),
+		},
+		{
+			name: "Batch bytes of 0",
+			opt:  WriteBatchBytes(0),
+		},
+		{
+			name: "Max buffering duration of 0",
+			opt:  WriteMaxBufferingDuration(0),
+		},
+		{
+			name: "Negative max retries",
+			opt:  WriteMaxRetries(-1),
+		},
+		{
+			name: "Retry backoff of 0",
+			opt:  WriteRetryBackoff(0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Write() did not panic, want panic")
+				}
+			}()
+
+			_, s := beam.NewPipelineWithRoot()
+			Write(s, "http://localhost:9200", "docs", beam.Create(s, Document{Source: "{}"}), tt.opt)
+		})
+	}
+}
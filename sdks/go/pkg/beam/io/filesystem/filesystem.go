@@ -115,6 +115,14 @@ type Renamer interface {
 	Rename(ctx context.Context, oldpath, newpath string) error
 }
 
+// RangeReader is an interface for reading part of a file without reading
+// the bytes before it.
+type RangeReader interface {
+	// OpenReadRange opens a file for reading length bytes starting at offset.
+	// The reader returns fewer bytes if the file ends before offset+length.
+	OpenReadRange(ctx context.Context, filename string, offset, length int64) (io.ReadCloser, error)
+}
+
 func getScheme(path string) string {
 	if index := strings.Index(path, "://"); index > 0 {
 		return path[:index]
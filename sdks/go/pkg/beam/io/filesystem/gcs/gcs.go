@@ -110,6 +110,16 @@ func (f *fs) OpenRead(ctx context.Context, filename string) (io.ReadCloser, erro
 	return f.client.Bucket(bucket).Object(object).NewReader(ctx)
 }
 
+// OpenReadRange opens a file for reading length bytes starting at offset.
+func (f *fs) OpenReadRange(ctx context.Context, filename string, offset, length int64) (io.ReadCloser, error) {
+	bucket, object, err := gcsx.ParseObject(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.client.Bucket(bucket).Object(object).NewRangeReader(ctx, offset, length)
+}
+
 // TODO(herohde) 7/12/2017: should we create the bucket in OpenWrite? For now, "no".
 
 func (f *fs) OpenWrite(ctx context.Context, filename string) (io.WriteCloser, error) {
@@ -210,4 +220,5 @@ var (
 	_ filesystem.Remover            = ((*fs)(nil))
 	_ filesystem.Copier             = ((*fs)(nil))
 	_ filesystem.Renamer            = ((*fs)(nil))
+	_ filesystem.RangeReader        = ((*fs)(nil))
 )
@@ -263,3 +263,22 @@ func createFakeGCSServer(tb testing.TB) *fakestorage.Server {
 	tb.Cleanup(server.Stop)
 	return server
 }
+
+func TestGCS_readRange(t *testing.T) {
+	ctx := context.Background()
+	server := createFakeGCSServer(t)
+	gcsFS := &fs{client: server.Client()}
+	filePath := "gs://beamgogcsfilesystemtest/file.txt"
+
+	if err := filesystem.Write(ctx, gcsFS, filePath, []byte("0123456789")); err != nil {
+		t.Fatalf("filesystem.Write(ctx, %q) error = %v, want nil", filePath, err)
+	}
+	r, err := gcsFS.OpenReadRange(ctx, filePath, 2, 3)
+	if err != nil {
+		t.Fatalf("OpenReadRange(%q, 2, 3) error = %v, want nil", filePath, err)
+	}
+	defer r.Close()
+	if got, err := io.ReadAll(r); err != nil || string(got) != "234" {
+		t.Errorf("OpenReadRange(%q, 2, 3) read %q, %v, want %q", filePath, got, err, "234")
+	}
+}
@@ -49,6 +49,18 @@ func (f *fs) OpenRead(_ context.Context, filename string) (io.ReadCloser, error)
 	return os.Open(filename)
 }
 
+// OpenReadRange opens a file for reading length bytes starting at offset.
+func (f *fs) OpenReadRange(_ context.Context, filename string, offset, length int64) (io.ReadCloser, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{io.NewSectionReader(file, offset, length), file}, nil
+}
+
 func (f *fs) OpenWrite(_ context.Context, filename string) (io.WriteCloser, error) {
 	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
 		return nil, err
@@ -110,4 +122,5 @@ var (
 	_ filesystem.Copier             = ((*fs)(nil))
 	_ filesystem.Remover            = ((*fs)(nil))
 	_ filesystem.Renamer            = ((*fs)(nil))
+	_ filesystem.RangeReader        = ((*fs)(nil))
 )
@@ -283,3 +283,27 @@ func TestLocal_copy(t *testing.T) {
 		t.Errorf("List(%s) = %v, want []string{%s, %s}", listGlob, files, filePath1, filePath2)
 	}
 }
+
+func TestLocal_readRange(t *testing.T) {
+	ctx := context.Background()
+	localFS := &fs{}
+	filePath := filepath.Join(t.TempDir(), "file.txt")
+
+	if err := filesystem.Write(ctx, localFS, filePath, []byte("0123456789")); err != nil {
+		t.Fatalf("filesystem.Write(ctx, %q) error = %v, want nil", filePath, err)
+	}
+	for _, tt := range []struct {
+		offset, length int64
+		want           string
+	}{{2, 3, "234"}, {8, 5, "89"}, {10, 1, ""}} {
+		r, err := localFS.OpenReadRange(ctx, filePath, tt.offset, tt.length)
+		if err != nil {
+			t.Fatalf("OpenReadRange(%q, %v, %v) error = %v, want nil", filePath, tt.offset, tt.length, err)
+		}
+		got, err := io.ReadAll(r)
+		r.Close()
+		if err != nil || string(got) != tt.want {
+			t.Errorf("OpenReadRange(%q, %v, %v) read %q, %v, want %q", filePath, tt.offset, tt.length, got, err, tt.want)
+		}
+	}
+}
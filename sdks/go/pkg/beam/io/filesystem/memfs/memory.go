@@ -97,6 +97,17 @@ func (f *fs) OpenRead(_ context.Context, filename string) (io.ReadCloser, error)
 	return nil, os.ErrNotExist
 }
 
+// OpenReadRange opens a file for reading length bytes starting at offset.
+func (f *fs) OpenReadRange(_ context.Context, filename string, offset, length int64) (io.ReadCloser, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if v, ok := f.m[normalize(filename)]; ok {
+		return io.NopCloser(io.NewSectionReader(bytes.NewReader(v.Data), offset, length)), nil
+	}
+	return nil, os.ErrNotExist
+}
+
 func (f *fs) OpenWrite(_ context.Context, filename string) (io.WriteCloser, error) {
 	return &commitWriter{key: filename, instance: f}, nil
 }
@@ -161,6 +172,7 @@ var (
 	_ filesystem.Remover            = ((*fs)(nil))
 	_ filesystem.Renamer            = ((*fs)(nil))
 	_ filesystem.Copier             = ((*fs)(nil))
+	_ filesystem.RangeReader        = ((*fs)(nil))
 )
 
 // write is a helper function for writing to the global store.
@@ -17,6 +17,7 @@ package memfs
 
 import (
 	"context"
+	"io"
 	"os"
 	"testing"
 	"time"
@@ -346,3 +347,30 @@ func TestRename(t *testing.T) {
 		t.Errorf("Rename() error got %q, want %q", got, want)
 	}
 }
+
+func TestReadRange(t *testing.T) {
+	ctx := context.Background()
+	memFS := &fs{m: make(map[string]file)}
+	filePath := "fizzbuzz"
+
+	if err := filesystem.Write(ctx, memFS, filePath, []byte("0123456789")); err != nil {
+		t.Fatalf("filesystem.Write(%q) error = %v, want nil", filePath, err)
+	}
+	for _, tt := range []struct {
+		offset, length int64
+		want           string
+	}{{2, 3, "234"}, {8, 5, "89"}, {10, 1, ""}} {
+		r, err := memFS.OpenReadRange(ctx, filePath, tt.offset, tt.length)
+		if err != nil {
+			t.Fatalf("OpenReadRange(%q, %v, %v) error = %v, want nil", filePath, tt.offset, tt.length, err)
+		}
+		got, err := io.ReadAll(r)
+		r.Close()
+		if err != nil || string(got) != tt.want {
+			t.Errorf("OpenReadRange(%q, %v, %v) read %q, %v, want %q", filePath, tt.offset, tt.length, got, err, tt.want)
+		}
+	}
+	if _, err := memFS.OpenReadRange(ctx, "non-existent", 0, 1); err == nil {
+		t.Error("OpenReadRange(non-existent) error = nil, want error")
+	}
+}
@@ -17,6 +17,7 @@
 package s3
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -134,6 +135,30 @@ func (f *fs) OpenRead(ctx context.Context, filename string) (io.ReadCloser, erro
 	return output.Body, nil
 }
 
+// OpenReadRange returns a new io.ReadCloser to read length bytes of the file starting at offset.
+// The caller must call Close on the returned io.ReadCloser when done reading.
+func (f *fs) OpenReadRange(ctx context.Context, filename string, offset, length int64) (io.ReadCloser, error) {
+	bucket, key, err := parseURI(filename)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing S3 uri %s: %v", filename, err)
+	}
+	if length <= 0 {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+
+	params := &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)),
+	}
+	output, err := f.client.GetObject(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("error getting object %s: %v", filename, err)
+	}
+
+	return output.Body, nil
+}
+
 // OpenWrite returns a new io.WriteCloser to write contents to the file. The caller must call Close
 // on the returned io.WriteCloser when done writing.
 func (f *fs) OpenWrite(ctx context.Context, filename string) (io.WriteCloser, error) {
@@ -231,4 +256,5 @@ var (
 	_ filesystem.LastModifiedGetter = (*fs)(nil)
 	_ filesystem.Remover            = (*fs)(nil)
 	_ filesystem.Copier             = (*fs)(nil)
+	_ filesystem.RangeReader        = (*fs)(nil)
 )
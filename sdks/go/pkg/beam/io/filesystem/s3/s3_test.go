@@ -444,3 +444,24 @@ func Test_fs_Copy(t *testing.T) {
 		})
 	}
 }
+
+func Test_fs_OpenReadRange(t *testing.T) {
+	ctx := context.Background()
+	server := newServer(t)
+	client := newClient(ctx, t, server.URL)
+
+	bucket := "bucket"
+	key := "file.txt"
+	createBucket(ctx, t, client, bucket)
+	createObject(ctx, t, client, bucket, key, []byte("0123456789"))
+
+	fileSystem := &fs{client: client}
+	reader, err := fileSystem.OpenReadRange(ctx, "s3://bucket/file.txt", 2, 3)
+	if err != nil {
+		t.Fatalf("OpenReadRange() error = %v, want nil", err)
+	}
+	defer reader.Close()
+	if err := iotest.TestReader(reader, []byte("234")); err != nil {
+		t.Errorf("TestReader() error = %v, want %v", err, nil)
+	}
+}
@@ -0,0 +1,78 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpio
+
+import (
+	"container/list"
+	"time"
+)
+
+// responseCache is a least recently used cache of responses that expire after a TTL.
+type responseCache struct {
+	ttl   time.Duration
+	size  int
+	now   func() time.Time
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key     string
+	resp    Response
+	expires time.Time
+}
+
+func newResponseCache(ttl time.Duration, size int) *responseCache {
+	return &responseCache{
+		ttl:   ttl,
+		size:  size,
+		now:   time.Now,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+func (c *responseCache) get(key string) (Response, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return Response{}, false
+	}
+	entry := e.Value.(*cacheEntry)
+	if !c.now().Before(entry.expires) {
+		c.ll.Remove(e)
+		delete(c.items, key)
+		return Response{}, false
+	}
+	c.ll.MoveToFront(e)
+	return entry.resp, true
+}
+
+func (c *responseCache) put(key string, resp Response) {
+	expires := c.now().Add(c.ttl)
+	if e, ok := c.items[key]; ok {
+		entry := e.Value.(*cacheEntry)
+		entry.resp, entry.expires = resp, expires
+		c.ll.MoveToFront(e)
+		return
+	}
+
+	c.items[key] = c.ll.PushFront(&cacheEntry{key: key, resp: resp, expires: expires})
+	if c.ll.Len() > c.size {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+}
@@ -0,0 +1,49 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpio
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResponseCache(t *testing.T) {
+	now := time.Unix(0, 0)
+	c := newResponseCache(time.Minute, 2)
+	c.now = func() time.Time { return now }
+
+	c.put("a", Response{Body: []byte("a")})
+	c.put("b", Response{Body: []byte("b")})
+	if _, ok := c.get("a"); !ok {
+		t.Error("get(a) = false, want true")
+	}
+
+	// b is the least recently used entry, and is evicted.
+	c.put("c", Response{Body: []byte("c")})
+	if _, ok := c.get("b"); ok {
+		t.Error("get(b) after eviction = true, want false")
+	}
+
+	now = now.Add(30 * time.Second)
+	c.put("a", Response{Body: []byte("a2")})
+	now = now.Add(40 * time.Second)
+	if _, ok := c.get("c"); ok {
+		t.Error("get(c) after expiry = true, want false")
+	}
+	if resp, ok := c.get("a"); !ok || string(resp.Body) != "a2" {
+		t.Errorf("get(a) = %s, %v, want a2, true", resp.Body, ok)
+	}
+}
@@ -0,0 +1,284 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package httpio contains transforms for enriching elements with the responses of HTTP
+// requests, such as calls to a REST API.
+//
+// Requests are built by user functions, and sent with client-side rate limiting, retries
+// with exponential backoff and optional response caching. Requests that fail are output
+// with their elements, so they can be handled as dead letters.
+package httpio
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/util/reflectx"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/internal/batch"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+const (
+	defaultTimeout              = 30 * time.Second
+	defaultMaxRetries           = 3
+	defaultRetryBackoff         = time.Second
+	defaultBatchSize            = 100
+	defaultMaxBufferingDuration = batch.DefaultMaxBufferingDuration
+)
+
+var (
+	typeOfRequest  = reflect.TypeOf((*Request)(nil)).Elem()
+	typeOfResponse = reflect.TypeOf((*Response)(nil)).Elem()
+	typeOfError    = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+func init() {
+	register.DoFn4x0[context.Context, beam.T, func(beam.T, Response), func(beam.T, Failure)](&enrichFn{})
+	register.DoFn4x1[context.Context, batch.Batch, func(beam.EventTime, beam.T, Response), func(beam.EventTime, beam.T, Failure), error](&enrichBatchFn{})
+	register.Emitter2[beam.T, Response]()
+	register.Emitter2[beam.T, Failure]()
+	register.Emitter3[beam.EventTime, beam.T, Response]()
+	register.Emitter3[beam.EventTime, beam.T, Failure]()
+
+	beam.RegisterType(typeOfRequest)
+	beam.RegisterType(typeOfResponse)
+	beam.RegisterType(reflect.TypeOf((*Failure)(nil)).Elem())
+}
+
+// Request represents an HTTP request.
+type Request struct {
+	// Method is the HTTP method. It defaults to GET if empty.
+	Method string
+	URL    string
+	Header map[string][]string
+	Body   []byte
+}
+
+// Response represents a successful HTTP response.
+type Response struct {
+	StatusCode int
+	Header     map[string][]string
+	Body       []byte
+}
+
+// Failure represents a request that failed.
+type Failure struct {
+	// StatusCode is the status code of the last attempt, or 0 if the request couldn't be
+	// built or sent.
+	StatusCode int
+	Error      string
+	Body       []byte
+}
+
+// Enrich sends a request for each element of a PCollection<T>. The request is built by the
+// function build, which must be of the form func(T) (Request, error), and must be
+// registered. Enrich returns a PCollection<KV<T, Response>> of the elements and their
+// responses, and a PCollection<KV<T, Failure>> of the elements whose request couldn't be
+// built, or failed with a non-2xx status code once retries were exhausted. For example:
+//
+//	func lookupRequest(id string) (httpio.Request, error) {
+//		return httpio.Request{URL: "https://api.example.com/users/" + url.PathEscape(id)}, nil
+//	}
+//
+//	func init() { register.Function1x2(lookupRequest) }
+//
+//	users, failed := httpio.Enrich(s, ids, lookupRequest, httpio.EnrichRateLimit(100, 10))
+//
+// Enrich takes a variable number of EnrichOptionFn to configure the requests:
+//   - EnrichTimeout: timeout of each request attempt. Defaults to 30s.
+//   - EnrichRateLimit: requests per second and burst per worker. Defaults to no limit.
+//   - EnrichMaxRetries: maximum number of retries. Defaults to 3.
+//   - EnrichRetryBackoff: time to wait before the first retry. Defaults to 1s.
+//   - EnrichCache: TTL and size of the response cache. Defaults to no cache.
+func Enrich(s beam.Scope, col beam.PCollection, build any, opts ...EnrichOptionFn) (beam.PCollection, beam.PCollection) {
+	s = s.Scope("httpio.Enrich")
+
+	beam.ValidateNonCompositeType(col)
+	t := col.Type().Type()
+	mustHaveSignature("Enrich", "build", build, []reflect.Type{t}, []reflect.Type{typeOfRequest, typeOfError})
+
+	option := newEnrichOption("Enrich", opts)
+	fn := &enrichFn{
+		requester: newRequester(option),
+		Build:     beam.EncodedFunc{Fn: reflectx.MakeFunc(build)},
+	}
+	return beam.ParDo2(s, fn, col)
+}
+
+// EnrichBatch sends a request for each batch of elements of a PCollection<T>, for APIs that
+// take several inputs per request. The request is built by the function build, which must
+// be of the form func([]T) (Request, error), and its response is split into a response per
+// element by the function split, which must be of the form
+// func([]T, Response) ([]Response, error). Both functions must be registered.
+//
+// Batches are formed per window, and a batch is sent when it is full or when the watermark
+// passes the end of its window. Since the end of the global window is never reached for an
+// unbounded PCollection, a batch of an unbounded PCollection is also sent once it has been
+// buffered for the maximum buffering duration. The outputs are in the window and at the
+// timestamp of their element.
+//
+// EnrichBatch returns a PCollection<KV<T, Response>> of the elements and their responses,
+// and a PCollection<KV<T, Failure>> of the elements whose batch failed.
+//
+// EnrichBatch takes the EnrichOptionFn of Enrich, and additionally:
+//   - EnrichBatchSize: maximum number of elements per request. Defaults to 100.
+//   - EnrichMaxBufferingDuration: maximum time elements of an unbounded PCollection are
+//     buffered for. Defaults to 10s.
+func EnrichBatch(s beam.Scope, col beam.PCollection, build, split any, opts ...EnrichOptionFn) (beam.PCollection, beam.PCollection) {
+	s = s.Scope("httpio.EnrichBatch")
+
+	beam.ValidateNonCompositeType(col)
+	t := col.Type().Type()
+	slice := reflect.SliceOf(t)
+	mustHaveSignature("EnrichBatch", "build", build, []reflect.Type{slice}, []reflect.Type{typeOfRequest, typeOfError})
+	mustHaveSignature("EnrichBatch", "split", split,
+		[]reflect.Type{slice, typeOfResponse}, []reflect.Type{reflect.SliceOf(typeOfResponse), typeOfError})
+
+	option := newEnrichOption("EnrichBatch", opts)
+	fn := &enrichBatchFn{
+		requester: newRequester(option),
+		Build:     beam.EncodedFunc{Fn: reflectx.MakeFunc(build)},
+		Split:     beam.EncodedFunc{Fn: reflectx.MakeFunc(split)},
+		Type:      beam.EncodedType{T: t},
+	}
+	batches := batch.Group(s, col, batch.Options{Size: option.BatchSize, MaxBufferingDuration: option.MaxBufferingDuration})
+	return beam.ParDo2(s, fn, batches, beam.TypeDefinition{Var: beam.TType, T: t})
+}
+
+func newEnrichOption(name string, opts []EnrichOptionFn) *enrichOption {
+	option := &enrichOption{
+		Timeout:              defaultTimeout,
+		MaxRetries:           defaultMaxRetries,
+		RetryBackoff:         defaultRetryBackoff,
+		BatchSize:            defaultBatchSize,
+		MaxBufferingDuration: defaultMaxBufferingDuration,
+	}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("httpio.%v: invalid option: %v", name, err))
+		}
+	}
+	return option
+}
+
+func mustHaveSignature(name, param string, fn any, in, out []reflect.Type) {
+	want := reflect.FuncOf(in, out, false)
+	if got := reflect.TypeOf(fn); got != want {
+		panic(fmt.Sprintf("httpio.%v: %v must be a %v, got %v", name, param, want, got))
+	}
+}
+
+// call converts the results of a user function returning a value and an error.
+func call(v, err any) (any, error) {
+	if err != nil {
+		return v, err.(error)
+	}
+	return v, nil
+}
+
+type enrichFn struct {
+	requester
+	Build beam.EncodedFunc
+	build reflectx.Func1x2
+}
+
+func (fn *enrichFn) Setup() {
+	fn.requester.Setup()
+	fn.build = reflectx.ToFunc1x2(fn.Build.Fn)
+}
+
+func (fn *enrichFn) ProcessElement(ctx context.Context, elem beam.T, emit func(beam.T, Response), fail func(beam.T, Failure)) {
+	req, err := call(fn.build.Call1x2(elem))
+	if err != nil {
+		fail(elem, Failure{Error: fmt.Sprintf("error building request: %v", err)})
+		return
+	}
+
+	resp, err := fn.send(ctx, req.(Request))
+	if err != nil {
+		fail(elem, newFailure(err))
+		return
+	}
+	emit(elem, resp)
+}
+
+type enrichBatchFn struct {
+	requester
+	Build beam.EncodedFunc
+	Split beam.EncodedFunc
+	Type  beam.EncodedType
+	build reflectx.Func1x2
+	split reflectx.Func2x2
+	dec   *batch.Decoder
+}
+
+func (fn *enrichBatchFn) Setup() {
+	fn.requester.Setup()
+	fn.build = reflectx.ToFunc1x2(fn.Build.Fn)
+	fn.split = reflectx.ToFunc2x2(fn.Split.Fn)
+	fn.dec = batch.NewDecoder(fn.Type.T)
+}
+
+// ProcessElement sends a request for a batch of elements of the same window, and outputs
+// each element at its timestamp.
+func (fn *enrichBatchFn) ProcessElement(ctx context.Context, b batch.Batch, emit func(beam.EventTime, beam.T, Response), fail func(beam.EventTime, beam.T, Failure)) error {
+	elems := reflect.MakeSlice(fn.Build.Fn.Type().In(0), 0, len(b.Elements))
+	for _, e := range b.Elements {
+		elem, err := fn.dec.Decode(e)
+		if err != nil {
+			return fmt.Errorf("error decoding element: %v", err)
+		}
+		elems = reflect.Append(elems, reflect.ValueOf(elem))
+	}
+
+	failAll := func(failure Failure) {
+		for i := 0; i < elems.Len(); i++ {
+			fail(b.Elements[i].EventTime(), elems.Index(i).Interface(), failure)
+		}
+	}
+
+	req, err := call(fn.build.Call1x2(elems.Interface()))
+	if err != nil {
+		failAll(Failure{Error: fmt.Sprintf("error building request: %v", err)})
+		return nil
+	}
+	resp, err := fn.send(ctx, req.(Request))
+	if err != nil {
+		failAll(newFailure(err))
+		return nil
+	}
+
+	resps, err := call(fn.split.Call2x2(elems.Interface(), resp))
+	if err != nil {
+		failAll(Failure{StatusCode: resp.StatusCode, Error: fmt.Sprintf("error splitting response: %v", err), Body: resp.Body})
+		return nil
+	}
+	split := resps.([]Response)
+	if len(split) != elems.Len() {
+		failAll(Failure{
+			StatusCode: resp.StatusCode,
+			Error:      fmt.Sprintf("error splitting response: got %d responses for %d elements", len(split), elems.Len()),
+			Body:       resp.Body,
+		})
+		return nil
+	}
+	for i, r := range split {
+		emit(b.Elements[i].EventTime(), elems.Index(i).Interface(), r)
+	}
+	return nil
+}
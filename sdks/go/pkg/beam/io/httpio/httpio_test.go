@@ -0,0 +1,244 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+)
+
+func init() {
+	register.Function1x2(echoRequest)
+	register.Function1x2(echoBatchRequest)
+	register.Function2x2(splitEchoBatch)
+	register.Function2x1(formatResponse)
+	register.Function2x1(formatFailure)
+	register.Function2x0(timestampIDFn)
+	register.Function3x1(formatTimestampedResponse)
+	register.Emitter2[beam.EventTime, string]()
+}
+
+// echoServer is a stand-in for an API, echoing the path of GET requests and the JSON array
+// bodies of POST requests. Paths starting with /missing return 404.
+type echoServer struct {
+	mu       sync.Mutex
+	srv      *httptest.Server
+	requests int
+}
+
+func newEchoServer(t *testing.T) *echoServer {
+	t.Helper()
+
+	e := &echoServer{}
+	e.srv = httptest.NewServer(http.HandlerFunc(e.handle))
+	t.Cleanup(e.srv.Close)
+	return e
+}
+
+func (e *echoServer) handle(w http.ResponseWriter, r *http.Request) {
+	e.mu.Lock()
+	e.requests++
+	e.mu.Unlock()
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/missing"):
+		http.Error(w, "not found", http.StatusNotFound)
+	case r.Method == http.MethodPost:
+		var ids []string
+		if err := json.NewDecoder(r.Body).Decode(&ids); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for i, id := range ids {
+			ids[i] = "echo " + id
+		}
+		json.NewEncoder(w).Encode(ids)
+	default:
+		io.WriteString(w, "echo "+strings.TrimPrefix(r.URL.Path, "/"))
+	}
+}
+
+// echoURL is the URL of the echo server of a test, read by the request builders.
+var echoURL string
+
+func echoRequest(id string) (Request, error) {
+	if id == "" {
+		return Request{}, errors.New("empty id")
+	}
+	return Request{URL: echoURL + "/" + id}, nil
+}
+
+func echoBatchRequest(ids []string) (Request, error) {
+	body, err := json.Marshal(ids)
+	if err != nil {
+		return Request{}, err
+	}
+	return Request{Method: http.MethodPost, URL: echoURL, Body: body}, nil
+}
+
+func splitEchoBatch(ids []string, resp Response) ([]Response, error) {
+	var bodies []string
+	if err := json.Unmarshal(resp.Body, &bodies); err != nil {
+		return nil, err
+	}
+	resps := make([]Response, len(bodies))
+	for i, body := range bodies {
+		resps[i] = Response{StatusCode: resp.StatusCode, Body: []byte(body)}
+	}
+	return resps, nil
+}
+
+func formatResponse(id string, resp Response) string {
+	return fmt.Sprintf("%v: %d %s", id, resp.StatusCode, resp.Body)
+}
+
+func formatFailure(id string, failure Failure) string {
+	return fmt.Sprintf("%v: %d %v", id, failure.StatusCode, failure.Error)
+}
+
+// timestampIDFn outputs IDs at the timestamp in seconds of their length.
+func timestampIDFn(id string, emit func(beam.EventTime, string)) {
+	emit(mtime.FromDuration(time.Duration(len(id))*10*time.Second), id)
+}
+
+func formatTimestampedResponse(ts beam.EventTime, id string, resp Response) string {
+	return fmt.Sprintf("%v@%v: %s", id, ts.ToTime().Unix(), resp.Body)
+}
+
+func TestMain(m *testing.M) {
+	ptest.Main(m)
+}
+
+func TestEnrich(t *testing.T) {
+	echoURL = newEchoServer(t).srv.URL
+
+	p, s := beam.NewPipelineWithRoot()
+
+	ids := beam.Create(s, "a", "b", "missing", "")
+	enriched, failed := Enrich(s, ids, echoRequest, EnrichMaxRetries(0))
+
+	passert.Equals(s, beam.ParDo(s, formatResponse, enriched), "a: 200 echo a", "b: 200 echo b")
+	passert.Equals(s, beam.ParDo(s, formatFailure, failed),
+		"missing: 404 status 404: not found\n",
+		": 0 error building request: empty id",
+	)
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestEnrichBatch(t *testing.T) {
+	echoURL = newEchoServer(t).srv.URL
+
+	p, s := beam.NewPipelineWithRoot()
+
+	ids := beam.Create(s, "a", "b", "c")
+	enriched, failed := EnrichBatch(s, ids, echoBatchRequest, splitEchoBatch, EnrichBatchSize(2))
+
+	passert.Equals(s, beam.ParDo(s, formatResponse, enriched), "a: 200 echo a", "b: 200 echo b", "c: 200 echo c")
+	passert.Empty(s, failed)
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestEnrichBatch_windowed(t *testing.T) {
+	echoURL = newEchoServer(t).srv.URL
+
+	p, s := beam.NewPipelineWithRoot()
+
+	ids := beam.ParDo(s, timestampIDFn, beam.Create(s, "a", "bb", "ccccccc", "dddddddd"))
+	windowed := beam.WindowInto(s, window.NewFixedWindows(time.Minute), ids)
+	enriched, failed := EnrichBatch(s, windowed, echoBatchRequest, splitEchoBatch, EnrichBatchSize(10))
+	formatted := beam.ParDo(s, formatTimestampedResponse, enriched)
+
+	first := window.IntervalWindow{Start: 0, End: mtime.FromDuration(time.Minute)}
+	second := window.IntervalWindow{Start: mtime.FromDuration(time.Minute), End: mtime.FromDuration(2 * time.Minute)}
+	passert.Equals(s, passert.InWindow(s, formatted, first), "a@10: echo a", "bb@20: echo bb")
+	passert.Equals(s, passert.InWindow(s, formatted, second), "ccccccc@70: echo ccccccc", "dddddddd@80: echo dddddddd")
+	passert.Empty(s, failed)
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestEnrich_invalidFunctions(t *testing.T) {
+	tests := []struct {
+		name   string
+		enrich func(s beam.Scope, col beam.PCollection)
+	}{
+		{
+			name: "Build of another element type",
+			enrich: func(s beam.Scope, col beam.PCollection) {
+				Enrich(s, col, func(int) (Request, error) { return Request{}, nil })
+			},
+		},
+		{
+			name: "Build without error",
+			enrich: func(s beam.Scope, col beam.PCollection) {
+				Enrich(s, col, func(string) Request { return Request{} })
+			},
+		},
+		{
+			name: "Batch build of single elements",
+			enrich: func(s beam.Scope, col beam.PCollection) {
+				EnrichBatch(s, col, echoRequest, splitEchoBatch)
+			},
+		},
+		{
+			name: "Batch split of a single response",
+			enrich: func(s beam.Scope, col beam.PCollection) {
+				EnrichBatch(s, col, echoBatchRequest, func([]string, Response) (Response, error) { return Response{}, nil })
+			},
+		},
+		{
+			name: "Invalid option",
+			enrich: func(s beam.Scope, col beam.PCollection) {
+				Enrich(s, col, echoRequest, EnrichRateLimit(0, 1))
+			},
+		},
+		{
+			name: "Invalid max buffering duration",
+			enrich: func(s beam.Scope, col beam.PCollection) {
+				EnrichBatch(s, col, echoBatchRequest, splitEchoBatch, EnrichMaxBufferingDuration(0))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Enrich did not panic, want panic")
+				}
+			}()
+
+			_, s := beam.NewPipelineWithRoot()
+			tt.enrich(s, beam.Create(s, "a"))
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpio
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidTimeout      = errors.New("timeout must be greater than 0")
+	errInvalidRateLimit    = errors.New("rate limit and burst must be greater than 0")
+	errInvalidMaxRetries   = errors.New("max retries must not be negative")
+	errInvalidRetryBackoff = errors.New("retry backoff must be greater than 0")
+	errInvalidCache        = errors.New("cache TTL and size must be greater than 0")
+	errInvalidBatchSize    = errors.New("batch size must be greater than 0")
+	errInvalidBuffering    = errors.New("max buffering duration must be greater than 0")
+)
+
+type enrichOption struct {
+	Timeout              time.Duration
+	RateLimit            float64
+	Burst                int
+	MaxRetries           int
+	RetryBackoff         time.Duration
+	CacheTTL             time.Duration
+	CacheSize            int
+	BatchSize            int
+	MaxBufferingDuration time.Duration
+}
+
+// EnrichOptionFn is a function that can be passed to Enrich and EnrichBatch to configure
+// options for sending requests.
+type EnrichOptionFn func(option *enrichOption) error
+
+// EnrichTimeout sets the timeout of each request attempt.
+func EnrichTimeout(timeout time.Duration) EnrichOptionFn {
+	return func(o *enrichOption) error {
+		if timeout <= 0 {
+			return errInvalidTimeout
+		}
+
+		o.Timeout = timeout
+		return nil
+	}
+}
+
+// EnrichRateLimit limits the rate of requests sent by each worker to requestsPerSecond,
+// allowing bursts of up to burst requests. Time spent waiting is reported to the
+// "throttling-msecs" counter. Requests aren't rate limited by default.
+func EnrichRateLimit(requestsPerSecond float64, burst int) EnrichOptionFn {
+	return func(o *enrichOption) error {
+		if requestsPerSecond <= 0 || burst <= 0 {
+			return errInvalidRateLimit
+		}
+
+		o.RateLimit = requestsPerSecond
+		o.Burst = burst
+		return nil
+	}
+}
+
+// EnrichMaxRetries sets the maximum number of times that a request failing with a
+// retryable error, such as a 429 or 5xx status, is retried.
+func EnrichMaxRetries(retries int) EnrichOptionFn {
+	return func(o *enrichOption) error {
+		if retries < 0 {
+			return errInvalidMaxRetries
+		}
+
+		o.MaxRetries = retries
+		return nil
+	}
+}
+
+// EnrichRetryBackoff sets the time to wait before the first retry. The time is doubled for
+// each subsequent retry, unless the server asks to wait longer with a Retry-After header.
+func EnrichRetryBackoff(backoff time.Duration) EnrichOptionFn {
+	return func(o *enrichOption) error {
+		if backoff <= 0 {
+			return errInvalidRetryBackoff
+		}
+
+		o.RetryBackoff = backoff
+		return nil
+	}
+}
+
+// EnrichCache caches successful responses for ttl, keeping up to size responses per
+// worker. Requests with the same method, URL, headers and body share a cached response.
+// Responses aren't cached by default.
+func EnrichCache(ttl time.Duration, size int) EnrichOptionFn {
+	return func(o *enrichOption) error {
+		if ttl <= 0 || size <= 0 {
+			return errInvalidCache
+		}
+
+		o.CacheTTL = ttl
+		o.CacheSize = size
+		return nil
+	}
+}
+
+// EnrichBatchSize sets the maximum number of elements per request of EnrichBatch. It is
+// ignored by Enrich.
+func EnrichBatchSize(size int) EnrichOptionFn {
+	return func(o *enrichOption) error {
+		if size <= 0 {
+			return errInvalidBatchSize
+		}
+
+		o.BatchSize = size
+		return nil
+	}
+}
+
+// EnrichMaxBufferingDuration sets the maximum processing time that elements of an unbounded
+// PCollection are buffered for by EnrichBatch before they are sent, even if their batch isn't
+// full. It is ignored by Enrich.
+func EnrichMaxBufferingDuration(d time.Duration) EnrichOptionFn {
+	return func(o *enrichOption) error {
+		if d <= 0 {
+			return errInvalidBuffering
+		}
+
+		o.MaxBufferingDuration = d
+		return nil
+	}
+}
@@ -0,0 +1,238 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpio
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/throttling"
+	"github.com/google/uuid"
+)
+
+// statusError is returned for responses with a non-2xx status code.
+type statusError struct {
+	StatusCode int
+	Body       []byte
+	RetryAfter time.Duration
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("status %d: %s", e.StatusCode, e.Body)
+}
+
+// retryable returns true for errors of requests that may succeed when retried.
+func retryable(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var serr *statusError
+	if !errors.As(err, &serr) {
+		return true
+	}
+	switch {
+	case serr.StatusCode == http.StatusRequestTimeout, serr.StatusCode == http.StatusTooManyRequests:
+		return true
+	default:
+		return serr.StatusCode >= http.StatusInternalServerError
+	}
+}
+
+// retryAfter parses a Retry-After header given in seconds.
+func retryAfter(header string) time.Duration {
+	secs, err := strconv.Atoi(header)
+	if err != nil || secs < 0 {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
+
+// newFailure returns the failure of a request that failed with err.
+func newFailure(err error) Failure {
+	var serr *statusError
+	if errors.As(err, &serr) {
+		return Failure{StatusCode: serr.StatusCode, Error: err.Error(), Body: serr.Body}
+	}
+	return Failure{Error: err.Error()}
+}
+
+// requester sends requests with rate limiting, retries and caching.
+type requester struct {
+	// LimiterKey identifies the token bucket shared by the instances of a transform on a
+	// worker.
+	LimiterKey   string
+	Timeout      time.Duration
+	RateLimit    float64
+	Burst        int
+	MaxRetries   int
+	RetryBackoff time.Duration
+	CacheTTL     time.Duration
+	CacheSize    int
+
+	client    *http.Client
+	limiter   *throttling.TokenBucket
+	cache     *responseCache
+	sleep     func(time.Duration)
+	requests  beam.Counter
+	failures  beam.Counter
+	cacheHits beam.Counter
+}
+
+func newRequester(option *enrichOption) requester {
+	return requester{
+		LimiterKey:   uuid.NewString(),
+		Timeout:      option.Timeout,
+		RateLimit:    option.RateLimit,
+		Burst:        option.Burst,
+		MaxRetries:   option.MaxRetries,
+		RetryBackoff: option.RetryBackoff,
+		CacheTTL:     option.CacheTTL,
+		CacheSize:    option.CacheSize,
+	}
+}
+
+func (r *requester) Setup() {
+	r.client = &http.Client{Timeout: r.Timeout}
+	if r.RateLimit > 0 {
+		r.limiter = throttling.SharedTokenBucket(r.LimiterKey, "httpio", r.RateLimit, r.Burst)
+	}
+	if r.CacheTTL > 0 {
+		r.cache = newResponseCache(r.CacheTTL, r.CacheSize)
+	}
+	if r.sleep == nil {
+		r.sleep = time.Sleep
+	}
+	r.requests = beam.NewCounter("httpio", "requests")
+	r.failures = beam.NewCounter("httpio", "requestFailures")
+	r.cacheHits = beam.NewCounter("httpio", "cacheHits")
+}
+
+func (r *requester) Teardown() {
+	if r.client != nil {
+		r.client.CloseIdleConnections()
+	}
+}
+
+// send sends the request, or returns its cached response. Requests failing with a
+// retryable error are retried with exponential backoff.
+func (r *requester) send(ctx context.Context, req Request) (Response, error) {
+	var key string
+	if r.cache != nil {
+		key = req.cacheKey()
+		if resp, ok := r.cache.get(key); ok {
+			r.cacheHits.Inc(ctx, 1)
+			return resp, nil
+		}
+	}
+
+	backoff := r.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		if r.limiter != nil {
+			if err := r.limiter.Wait(ctx); err != nil {
+				return Response{}, err
+			}
+		}
+
+		r.requests.Inc(ctx, 1)
+		resp, err := r.do(ctx, req)
+		if err == nil {
+			if r.cache != nil {
+				r.cache.put(key, resp)
+			}
+			return resp, nil
+		}
+		r.failures.Inc(ctx, 1)
+		if attempt >= r.MaxRetries || !retryable(err) {
+			return Response{}, err
+		}
+
+		delay := backoff
+		var serr *statusError
+		if errors.As(err, &serr) && serr.RetryAfter > delay {
+			delay = serr.RetryAfter
+		}
+		r.sleep(delay)
+		backoff *= 2
+	}
+}
+
+func (r *requester) do(ctx context.Context, req Request) (Response, error) {
+	method := req.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	var body io.Reader
+	if req.Body != nil {
+		body = bytes.NewReader(req.Body)
+	}
+
+	hr, err := http.NewRequestWithContext(ctx, method, req.URL, body)
+	if err != nil {
+		return Response{}, err
+	}
+	for name, values := range req.Header {
+		for _, v := range values {
+			hr.Header.Add(name, v)
+		}
+	}
+
+	resp, err := r.client.Do(hr)
+	if err != nil {
+		return Response{}, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Response{}, fmt.Errorf("error reading response: %v", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return Response{}, &statusError{
+			StatusCode: resp.StatusCode,
+			Body:       data,
+			RetryAfter: retryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+	return Response{StatusCode: resp.StatusCode, Header: resp.Header, Body: data}, nil
+}
+
+// cacheKey returns a key identifying the method, URL, headers and body of the request.
+func (req Request) cacheKey() string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s\n", req.Method, req.URL)
+
+	names := make([]string, 0, len(req.Header))
+	for name := range req.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(h, "%s: %q\n", http.CanonicalHeaderKey(name), req.Header[name])
+	}
+	h.Write([]byte{'\n'})
+	h.Write(req.Body)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,167 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// statusServer returns the statuses in order, and 200 once they are exhausted.
+func statusServer(t *testing.T, header http.Header, statuses ...int) (*httptest.Server, *int) {
+	t.Helper()
+
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		for name, values := range header {
+			w.Header()[name] = values
+		}
+		if len(statuses) > 0 {
+			w.WriteHeader(statuses[0])
+			statuses = statuses[1:]
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func newTestRequester(opts ...EnrichOptionFn) (*requester, *[]time.Duration) {
+	var sleeps []time.Duration
+	r := newRequester(newEnrichOption("test", opts))
+	r.sleep = func(d time.Duration) { sleeps = append(sleeps, d) }
+	r.Setup()
+	return &r, &sleeps
+}
+
+func TestRequester_send_retries(t *testing.T) {
+	tests := []struct {
+		name         string
+		header       http.Header
+		statuses     []int
+		opts         []EnrichOptionFn
+		wantStatus   int
+		wantRequests int
+		wantSleeps   []time.Duration
+	}{
+		{
+			name:         "Success after retryable statuses",
+			statuses:     []int{503, 429},
+			wantRequests: 3,
+			wantSleeps:   []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name:         "Retry-After longer than the backoff",
+			header:       http.Header{"Retry-After": {"5"}},
+			statuses:     []int{429},
+			wantRequests: 2,
+			wantSleeps:   []time.Duration{5 * time.Second},
+		},
+		{
+			name:         "Non-retryable status",
+			statuses:     []int{400},
+			wantStatus:   400,
+			wantRequests: 1,
+		},
+		{
+			name:         "Retries exhausted",
+			statuses:     []int{500, 500, 500},
+			opts:         []EnrichOptionFn{EnrichMaxRetries(2), EnrichRetryBackoff(time.Millisecond)},
+			wantStatus:   500,
+			wantRequests: 3,
+			wantSleeps:   []time.Duration{time.Millisecond, 2 * time.Millisecond},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, requests := statusServer(t, tt.header, tt.statuses...)
+			r, sleeps := newTestRequester(tt.opts...)
+
+			resp, err := r.send(context.Background(), Request{URL: srv.URL})
+			if tt.wantStatus == 0 {
+				if err != nil {
+					t.Fatalf("send() error = %v", err)
+				}
+				if got, want := string(resp.Body), "ok"; got != want {
+					t.Errorf("Body = %v, want %v", got, want)
+				}
+			} else if got := newFailure(err).StatusCode; got != tt.wantStatus {
+				t.Errorf("send() status = %v, want %v", got, tt.wantStatus)
+			}
+
+			if *requests != tt.wantRequests {
+				t.Errorf("Requests = %v, want %v", *requests, tt.wantRequests)
+			}
+			if diff := cmp.Diff(tt.wantSleeps, *sleeps); diff != "" {
+				t.Errorf("Sleeps mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRequester_send_cache(t *testing.T) {
+	srv, requests := statusServer(t, nil, 200, 500)
+	r, _ := newTestRequester(EnrichCache(time.Minute, 10), EnrichMaxRetries(0))
+	ctx := context.Background()
+
+	reqs := []Request{
+		{URL: srv.URL + "/a"},
+		{URL: srv.URL + "/a"},
+		{URL: srv.URL + "/a", Header: map[string][]string{"X-Version": {"2"}}},
+		{URL: srv.URL + "/a", Header: map[string][]string{"X-Version": {"2"}}},
+	}
+	var errs int
+	for _, req := range reqs {
+		if _, err := r.send(ctx, req); err != nil {
+			errs++
+		}
+	}
+
+	// The failed response of the third request isn't cached, so the fourth one is sent.
+	if got, want := *requests, 3; got != want {
+		t.Errorf("Requests = %v, want %v", got, want)
+	}
+	if got, want := errs, 1; got != want {
+		t.Errorf("Errors = %v, want %v", got, want)
+	}
+}
+
+func TestRequester_send_rateLimit(t *testing.T) {
+	srv, requests := statusServer(t, nil)
+	r, _ := newTestRequester(EnrichRateLimit(20, 1))
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := r.send(ctx, Request{URL: srv.URL}); err != nil {
+			t.Fatalf("send() error = %v", err)
+		}
+	}
+
+	if got, want := time.Since(start), 90*time.Millisecond; got < want {
+		t.Errorf("Elapsed time = %v, want at least %v", got, want)
+	}
+	if got, want := *requests, 3; got != want {
+		t.Errorf("Requests = %v, want %v", got, want)
+	}
+}
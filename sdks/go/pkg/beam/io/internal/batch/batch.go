@@ -0,0 +1,301 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package batch groups the elements of a PCollection into batches with state
+// and timers, so that the batches are output in the windows of their elements.
+// It is used by the io packages that send elements in batches and output
+// per-element results.
+package batch
+
+import (
+	"bytes"
+	"math/rand"
+	"reflect"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/state"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/timers"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+// DefaultMaxBufferingDuration is the maximum buffering duration of batches
+// when Options doesn't set one.
+const DefaultMaxBufferingDuration = 10 * time.Second
+
+// shards is the number of keys that elements are spread over, which bounds the
+// number of batches that are filled in parallel per window.
+const shards = 32
+
+func init() {
+	register.DoFn3x1[beam.EventTime, beam.T, func(int, Element), error](&keyFn{})
+	register.DoFn6x1[beam.Window, state.Provider, timers.Provider, int, Element, func(beam.EventTime, Batch), error](&groupFn{})
+	register.DoFn6x1[beam.Window, state.Provider, timers.Provider, int, Element, func(beam.EventTime, Batch), error](&bufferedGroupFn{})
+	register.Emitter2[int, Element]()
+	register.Emitter2[beam.EventTime, Batch]()
+
+	beam.RegisterType(reflect.TypeOf((*Element)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*Batch)(nil)).Elem())
+}
+
+// Element is an encoded element and its timestamp.
+type Element struct {
+	Data      []byte
+	Timestamp int64
+}
+
+// EventTime returns the timestamp of the element.
+func (e Element) EventTime() beam.EventTime {
+	return mtime.Time(e.Timestamp)
+}
+
+// Batch is a batch of elements of the same window.
+type Batch struct {
+	Elements []Element
+}
+
+// Decoder decodes the elements of batches.
+type Decoder struct {
+	dec beam.ElementDecoder
+}
+
+// NewDecoder returns a decoder of elements of type t.
+func NewDecoder(t reflect.Type) *Decoder {
+	return &Decoder{dec: beam.NewElementDecoder(t)}
+}
+
+// Decode decodes an element.
+func (d *Decoder) Decode(e Element) (any, error) {
+	return d.dec.Decode(bytes.NewReader(e.Data))
+}
+
+// Options configures when batches are output.
+type Options struct {
+	// Size is the maximum number of elements of a batch.
+	Size int
+	// Bytes is the maximum total size of the encoded elements of a batch, or 0
+	// for no limit.
+	Bytes int
+	// MaxBufferingDuration is the maximum processing time that a batch of an
+	// unbounded PCollection is buffered for before it is output, or 0 for
+	// DefaultMaxBufferingDuration.
+	MaxBufferingDuration time.Duration
+}
+
+// Group groups the elements of a PCollection<T> into batches, and returns a
+// PCollection<Batch>. A batch is output when it is full, or when the watermark
+// passes the end of its window. A batch of an unbounded PCollection is also
+// output once it has been buffered for the maximum buffering duration. Batches are output in the window of their elements, with the
+// timestamp of their earliest element, which the watermark is held at until the
+// batch is output.
+func Group(s beam.Scope, col beam.PCollection, opts Options) beam.PCollection {
+	s = s.Scope("batch.Group")
+
+	keyed := beam.ParDo(s, &keyFn{Type: beam.EncodedType{T: col.Type().Type()}}, col)
+	if !col.IsBounded() {
+		return beam.ParDo(s, newBufferedGroupFn(opts), keyed)
+	}
+	return beam.ParDo(s, newGroupFn(opts), keyed)
+}
+
+// keyFn encodes elements and spreads them over the shard keys.
+type keyFn struct {
+	Type beam.EncodedType
+	enc  beam.ElementEncoder
+	next int
+}
+
+func (fn *keyFn) Setup() {
+	fn.enc = beam.NewElementEncoder(fn.Type.T)
+	fn.next = rand.Intn(shards)
+}
+
+func (fn *keyFn) ProcessElement(et beam.EventTime, elem beam.T, emit func(int, Element)) error {
+	var buf bytes.Buffer
+	if err := fn.enc.Encode(elem, &buf); err != nil {
+		return err
+	}
+	emit(fn.next, Element{Data: buf.Bytes(), Timestamp: int64(et)})
+	fn.next = (fn.next + 1) % shards
+	return nil
+}
+
+// groupFn buffers the elements of a key and window until their batch is output.
+type groupFn struct {
+	Elements state.Bag[Element]
+	Count    state.Value[int]
+	Size     state.Value[int]
+	Hold     state.Value[int64]
+	Flush    timers.EventTime
+
+	Options Options
+}
+
+func newGroupFn(opts Options) *groupFn {
+	return &groupFn{
+		Elements: state.MakeBagState[Element]("elements"),
+		Count:    state.MakeValueState[int]("count"),
+		Size:     state.MakeValueState[int]("size"),
+		Hold:     state.MakeValueState[int64]("hold"),
+		Flush:    timers.InEventTime("flush"),
+		Options:  opts,
+	}
+}
+
+func (fn *groupFn) ProcessElement(w beam.Window, sp state.Provider, tp timers.Provider, _ int, e Element, emit func(beam.EventTime, Batch)) error {
+	_, err := fn.add(w, sp, tp, e, emit)
+	return err
+}
+
+// add buffers the element, and outputs the batch if it is full. It returns the
+// number of buffered elements, which is 0 if the batch was output.
+func (fn *groupFn) add(w beam.Window, sp state.Provider, tp timers.Provider, e Element, emit func(beam.EventTime, Batch)) (int, error) {
+	count, _, err := fn.Count.Read(sp)
+	if err != nil {
+		return 0, err
+	}
+	size, _, err := fn.Size.Read(sp)
+	if err != nil {
+		return 0, err
+	}
+	hold, ok, err := fn.Hold.Read(sp)
+	if err != nil {
+		return 0, err
+	}
+	if !ok || e.Timestamp < hold {
+		hold = e.Timestamp
+	}
+	count++
+	size += len(e.Data)
+
+	if err := fn.Elements.Add(sp, e); err != nil {
+		return 0, err
+	}
+	if count >= fn.Options.Size || fn.Options.Bytes > 0 && size >= fn.Options.Bytes {
+		fn.Flush.Clear(tp)
+		return 0, fn.flush(sp, emit)
+	}
+
+	// The watermark is held at the earliest buffered element, so that the batch
+	// isn't late once it is output with its timestamp.
+	fn.Flush.Set(tp, w.MaxTimestamp().ToTime(), timers.WithOutputTimestamp(mtime.Time(hold).ToTime()))
+	if err := fn.Count.Write(sp, count); err != nil {
+		return 0, err
+	}
+	if err := fn.Size.Write(sp, size); err != nil {
+		return 0, err
+	}
+	return count, fn.Hold.Write(sp, hold)
+}
+
+func (fn *groupFn) OnTimer(sp state.Provider, tp timers.Provider, _ int, _ timers.Context, emit func(beam.EventTime, Batch)) error {
+	return fn.flush(sp, emit)
+}
+
+// flush outputs the buffered elements as a batch and clears the state.
+func (fn *groupFn) flush(sp state.Provider, emit func(beam.EventTime, Batch)) error {
+	elems, ok, err := fn.Elements.Read(sp)
+	if err != nil || !ok || len(elems) == 0 {
+		return err
+	}
+	hold := elems[0].Timestamp
+	for _, e := range elems[1:] {
+		if e.Timestamp < hold {
+			hold = e.Timestamp
+		}
+	}
+	emit(mtime.Time(hold), Batch{Elements: elems})
+
+	if err := fn.Elements.Clear(sp); err != nil {
+		return err
+	}
+	if err := fn.Count.Clear(sp); err != nil {
+		return err
+	}
+	if err := fn.Size.Clear(sp); err != nil {
+		return err
+	}
+	return fn.Hold.Clear(sp)
+}
+
+// bufferedGroupFn is a groupFn that also outputs a batch once it has been
+// buffered for the maximum buffering duration, since the end of the window of an
+// unbounded PCollection, such as the global window, may never be reached. It is
+// a separate DoFn so that bounded PCollections can be grouped by runners without
+// processing time timers.
+type bufferedGroupFn struct {
+	Elements state.Bag[Element]
+	Count    state.Value[int]
+	Size     state.Value[int]
+	Hold     state.Value[int64]
+	Flush    timers.EventTime
+	Buffered timers.ProcessingTime
+
+	Options Options
+	group   *groupFn
+}
+
+func newBufferedGroupFn(opts Options) *bufferedGroupFn {
+	if opts.MaxBufferingDuration <= 0 {
+		opts.MaxBufferingDuration = DefaultMaxBufferingDuration
+	}
+	fn := newGroupFn(opts)
+	return &bufferedGroupFn{
+		Elements: fn.Elements,
+		Count:    fn.Count,
+		Size:     fn.Size,
+		Hold:     fn.Hold,
+		Flush:    fn.Flush,
+		Buffered: timers.InProcessingTime("buffered"),
+		Options:  opts,
+	}
+}
+
+func (fn *bufferedGroupFn) Setup() {
+	fn.group = &groupFn{
+		Elements: fn.Elements,
+		Count:    fn.Count,
+		Size:     fn.Size,
+		Hold:     fn.Hold,
+		Flush:    fn.Flush,
+		Options:  fn.Options,
+	}
+}
+
+func (fn *bufferedGroupFn) ProcessElement(w beam.Window, sp state.Provider, tp timers.Provider, _ int, e Element, emit func(beam.EventTime, Batch)) error {
+	count, err := fn.group.add(w, sp, tp, e, emit)
+	if err != nil {
+		return err
+	}
+	switch count {
+	case 0:
+		fn.Buffered.Clear(tp)
+	case 1:
+		// The watermark is held at the element, which is the earliest of the batch
+		// when the timer is set, like for the flush timer.
+		fn.Buffered.Set(tp, time.Now().Add(fn.Options.MaxBufferingDuration), timers.WithOutputTimestamp(e.EventTime().ToTime()))
+	}
+	return nil
+}
+
+func (fn *bufferedGroupFn) OnTimer(sp state.Provider, tp timers.Provider, _ int, tc timers.Context, emit func(beam.EventTime, Batch)) error {
+	if tc.Family == fn.Buffered.Family {
+		fn.Flush.Clear(tp)
+	} else {
+		fn.Buffered.Clear(tp)
+	}
+	return fn.group.flush(sp, emit)
+}
@@ -0,0 +1,180 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package batch
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/dofntest"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func init() {
+	register.Function2x0(timestampFn)
+	register.DoFn2x1[Batch, func(beam.EventTime, int64), error](&unbatchFn{})
+	register.Emitter2[beam.EventTime, int64]()
+}
+
+func TestMain(m *testing.M) {
+	ptest.Main(m)
+}
+
+// timestampFn outputs the elements, which are milliseconds since the epoch,
+// at their timestamp.
+func timestampFn(ms int64, emit func(beam.EventTime, int64)) {
+	emit(mtime.Time(ms), ms)
+}
+
+// unbatchFn outputs the elements of batches of int64 at their timestamp,
+// checking that it is the element.
+type unbatchFn struct {
+	dec *Decoder
+}
+
+func (fn *unbatchFn) ProcessElement(b Batch, emit func(beam.EventTime, int64)) error {
+	if fn.dec == nil {
+		fn.dec = NewDecoder(reflect.TypeOf(int64(0)))
+	}
+	for _, e := range b.Elements {
+		v, err := fn.dec.Decode(e)
+		if err != nil {
+			return err
+		}
+		if v.(int64) != e.Timestamp {
+			return nil
+		}
+		emit(e.EventTime(), v.(int64))
+	}
+	return nil
+}
+
+func TestGroup(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.ParDo(s, timestampFn, beam.Create(s, int64(10000), int64(20000), int64(70000), int64(80000), int64(90000)))
+	windowed := beam.WindowInto(s, window.NewFixedWindows(time.Minute), col)
+	out := beam.ParDo(s, &unbatchFn{}, Group(s, windowed, Options{Size: 2}))
+
+	first := window.IntervalWindow{Start: 0, End: mtime.FromDuration(time.Minute)}
+	second := window.IntervalWindow{Start: mtime.FromDuration(time.Minute), End: mtime.FromDuration(2 * time.Minute)}
+	passert.Equals(s, passert.InWindow(s, out, first), int64(10000), int64(20000))
+	passert.Equals(s, passert.InWindow(s, out, second), int64(70000), int64(80000), int64(90000))
+	ptest.RunAndValidate(t, p)
+}
+
+func TestGroupFn(t *testing.T) {
+	w := window.IntervalWindow{Start: 0, End: mtime.FromDuration(time.Minute)}
+	elem := func(ms int64) Element {
+		return Element{Data: []byte{byte(ms)}, Timestamp: ms}
+	}
+
+	tests := []struct {
+		name string
+		opts Options
+		want []int
+	}{
+		{
+			name: "size",
+			opts: Options{Size: 2},
+			want: []int{2, 1},
+		},
+		{
+			name: "bytes",
+			opts: Options{Size: 10, Bytes: 3},
+			want: []int{3},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			h, err := dofntest.New(newGroupFn(test.opts))
+			if err != nil {
+				t.Fatalf("dofntest.New() failed: %v", err)
+			}
+			for _, ms := range []int64{3, 1, 2} {
+				if err := h.ProcessInWindow(w, mtime.Time(ms).ToTime(), 0, elem(ms)); err != nil {
+					t.Fatalf("ProcessInWindow(%v) failed: %v", ms, err)
+				}
+			}
+			if err := h.AdvanceWatermarkToInfinity(); err != nil {
+				t.Fatalf("AdvanceWatermarkToInfinity() failed: %v", err)
+			}
+
+			var got []int
+			for _, out := range h.Outputs(0) {
+				b := out.Value.(Batch)
+				got = append(got, len(b.Elements))
+				if out.Window != w {
+					t.Errorf("batch %v in window %v, want %v", b, out.Window, w)
+				}
+				want := b.Elements[0].Timestamp
+				for _, e := range b.Elements {
+					if e.Timestamp < want {
+						want = e.Timestamp
+					}
+				}
+				if got := mtime.FromTime(out.Timestamp); got != mtime.Time(want) {
+					t.Errorf("batch %v at %v, want its earliest timestamp %v", b, got, want)
+				}
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("batch sizes mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBufferedGroupFn(t *testing.T) {
+	h, err := dofntest.New(newBufferedGroupFn(Options{Size: 10, MaxBufferingDuration: time.Minute}), dofntest.WithProcessingTime(time.Now()))
+	if err != nil {
+		t.Fatalf("dofntest.New() failed: %v", err)
+	}
+	for _, ms := range []int64{2, 1} {
+		e := Element{Data: []byte{byte(ms)}, Timestamp: ms}
+		if err := h.ProcessInWindow(window.GlobalWindow{}, mtime.Time(ms).ToTime(), 0, e); err != nil {
+			t.Fatalf("ProcessInWindow(%v) failed: %v", ms, err)
+		}
+	}
+
+	if err := h.AdvanceProcessingTime(30 * time.Second); err != nil {
+		t.Fatalf("AdvanceProcessingTime(30s) failed: %v", err)
+	}
+	if got := len(h.Outputs(0)); got != 0 {
+		t.Fatalf("got %v batches before the maximum buffering duration, want 0", got)
+	}
+	if err := h.AdvanceProcessingTime(time.Minute); err != nil {
+		t.Fatalf("AdvanceProcessingTime(1m) failed: %v", err)
+	}
+	outs := h.Outputs(0)
+	if len(outs) != 1 {
+		t.Fatalf("got %v batches after the maximum buffering duration, want 1", len(outs))
+	}
+	if got, want := len(outs[0].Value.(Batch).Elements), 2; got != want {
+		t.Errorf("got a batch of %v elements, want %v", got, want)
+	}
+	if got, want := mtime.FromTime(outs[0].Timestamp), mtime.Time(1); got != want {
+		t.Errorf("batch at %v, want its earliest timestamp %v", got, want)
+	}
+	if got := h.Timers(); len(got) != 0 {
+		t.Errorf("got pending timers %v after the batch was output, want none", got)
+	}
+}
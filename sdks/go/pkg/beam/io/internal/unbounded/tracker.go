@@ -0,0 +1,82 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package unbounded contains restriction trackers and watermark estimators
+// shared by the unbounded sources of the io packages.
+package unbounded
+
+// Tracker tracks reading from a source whose elements have no positions to
+// split at, such as a subscription or a consumer group. The restriction names
+// the source and can only be checkpointed, which moves the whole source to the
+// residual.
+type Tracker struct {
+	rest string
+	done bool
+}
+
+// NewTracker returns a tracker of the named source.
+func NewTracker(rest string) *Tracker {
+	return &Tracker{rest: rest}
+}
+
+// TryClaim returns true iff the tracker hasn't been checkpointed and the position
+// is the source of the restriction.
+func (rt *Tracker) TryClaim(pos any) bool {
+	name, ok := pos.(string)
+	return ok && !rt.IsDone() && name == rt.rest
+}
+
+// GetError always returns nil, as claims can't fail with an error.
+func (rt *Tracker) GetError() error {
+	return nil
+}
+
+// TrySplit only splits at a fraction of 0, checkpointing the tracker by moving
+// the source to the residual.
+func (rt *Tracker) TrySplit(fraction float64) (primary, residual any, err error) {
+	if fraction != 0 || rt.IsDone() {
+		return rt.rest, nil, nil
+	}
+
+	residual = rt.rest
+	rt.rest = ""
+	rt.done = true
+	return rt.rest, residual, nil
+}
+
+// GetProgress reports all work as remaining until the tracker is done, as the
+// amount of unread elements is unknown.
+func (rt *Tracker) GetProgress() (done, remaining float64) {
+	if rt.IsDone() {
+		return 1, 0
+	}
+	return 0, 1
+}
+
+// IsDone returns true iff the tracker has been checkpointed or its restriction
+// has been truncated.
+func (rt *Tracker) IsDone() bool {
+	return rt.done || rt.rest == ""
+}
+
+// GetRestriction returns the source of the restriction.
+func (rt *Tracker) GetRestriction() any {
+	return rt.rest
+}
+
+// IsBounded returns false, as the source has an unbounded number of elements.
+func (rt *Tracker) IsBounded() bool {
+	return false
+}
@@ -0,0 +1,58 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unbounded
+
+import "testing"
+
+func TestTracker_TryClaim(t *testing.T) {
+	rt := NewTracker("sub")
+	if rt.TryClaim("other") {
+		t.Errorf("TryClaim(other) = true, want false")
+	}
+	if !rt.TryClaim("sub") {
+		t.Errorf("TryClaim(sub) = false, want true")
+	}
+	if rt.IsBounded() {
+		t.Errorf("IsBounded() = true, want false")
+	}
+}
+
+func TestTracker_TrySplit(t *testing.T) {
+	rt := NewTracker("sub")
+	if p, r, err := rt.TrySplit(0.5); err != nil || p != "sub" || r != nil {
+		t.Errorf("TrySplit(0.5) = %v, %v, %v, want sub, nil, nil", p, r, err)
+	}
+	if done, remaining := rt.GetProgress(); done != 0 || remaining != 1 {
+		t.Errorf("GetProgress() = %v, %v, want 0, 1", done, remaining)
+	}
+
+	p, r, err := rt.TrySplit(0)
+	if err != nil || p != "" || r != "sub" {
+		t.Errorf("TrySplit(0) = %v, %v, %v, want \"\", sub, nil", p, r, err)
+	}
+	if !rt.IsDone() {
+		t.Errorf("IsDone() = false after checkpoint, want true")
+	}
+	if rt.TryClaim("sub") {
+		t.Errorf("TryClaim(sub) = true after checkpoint, want false")
+	}
+	if done, remaining := rt.GetProgress(); done != 1 || remaining != 0 {
+		t.Errorf("GetProgress() = %v, %v after checkpoint, want 1, 0", done, remaining)
+	}
+	if p, r, _ := rt.TrySplit(0); p != "" || r != nil {
+		t.Errorf("TrySplit(0) = %v, %v after checkpoint, want no residual", p, r)
+	}
+}
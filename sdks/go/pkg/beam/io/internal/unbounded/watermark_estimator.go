@@ -0,0 +1,49 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unbounded
+
+import "time"
+
+// WatermarkEstimator is a watermark estimator that is advanced manually by the
+// DoFn, typically to the earliest timestamp of its outstanding elements, or the
+// current time once none are outstanding. It never moves backwards. Its state
+// is the watermark in milliseconds since the epoch.
+type WatermarkEstimator struct {
+	State int64
+}
+
+// CurrentWatermark returns the current watermark.
+func (e *WatermarkEstimator) CurrentWatermark() time.Time {
+	return time.UnixMilli(e.State)
+}
+
+// Advance moves the watermark to t, unless the watermark is already later.
+func (e *WatermarkEstimator) Advance(t time.Time) {
+	if ms := t.UnixMilli(); ms > e.State {
+		e.State = ms
+	}
+}
+
+// ObservingWatermarkEstimator is a WatermarkEstimator that the SDK also
+// advances to the timestamp of each emitted element.
+type ObservingWatermarkEstimator struct {
+	WatermarkEstimator
+}
+
+// ObserveTimestamp advances the watermark to the timestamp of an emitted element.
+func (e *ObservingWatermarkEstimator) ObserveTimestamp(t time.Time) {
+	e.Advance(t)
+}
@@ -0,0 +1,213 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kafkaio contains transforms for reading from and writing to Kafka
+// natively, without an expansion service.
+package kafkaio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/twmb/franz-go/pkg/kerr"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+const (
+	earliestOffset int64 = -2
+	latestOffset   int64 = -1
+)
+
+type kafkaFn struct {
+	Brokers []string
+	client  *kgo.Client
+}
+
+func (fn *kafkaFn) Setup() error {
+	if fn.client != nil {
+		return nil
+	}
+
+	client, err := kgo.NewClient(kgo.SeedBrokers(fn.Brokers...))
+	if err != nil {
+		return fmt.Errorf("error creating Kafka client: %v", err)
+	}
+	fn.client = client
+
+	return nil
+}
+
+func (fn *kafkaFn) Teardown() {
+	if fn.client != nil {
+		fn.client.Close()
+	}
+}
+
+// listPartitions returns the partitions of a topic.
+func listPartitions(ctx context.Context, client *kgo.Client, topic string) ([]int32, error) {
+	req := kmsg.NewPtrMetadataRequest()
+	reqTopic := kmsg.NewMetadataRequestTopic()
+	reqTopic.Topic = kmsg.StringPtr(topic)
+	req.Topics = append(req.Topics, reqTopic)
+
+	resp, err := req.RequestWith(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting metadata: %v", err)
+	}
+
+	var partitions []int32
+	for _, t := range resp.Topics {
+		if err := kerr.ErrorForCode(t.ErrorCode); err != nil {
+			return nil, fmt.Errorf("error getting metadata of topic %s: %v", topic, err)
+		}
+		for _, p := range t.Partitions {
+			partitions = append(partitions, p.Partition)
+		}
+	}
+
+	return partitions, nil
+}
+
+// listOffsets returns the offsets of the first records of the partitions of a
+// topic with a timestamp at or after the given timestamp in milliseconds. The
+// timestamp can also be earliestOffset or latestOffset. The offset is -1 for
+// partitions without such a record.
+func listOffsets(
+	ctx context.Context,
+	client *kgo.Client,
+	topic string,
+	partitions []int32,
+	timestamp int64,
+	isolationLevel int8,
+) (map[int32]int64, error) {
+	req := kmsg.NewPtrListOffsetsRequest()
+	req.ReplicaID = -1
+	req.IsolationLevel = isolationLevel
+	reqTopic := kmsg.NewListOffsetsRequestTopic()
+	reqTopic.Topic = topic
+	for _, p := range partitions {
+		reqPartition := kmsg.NewListOffsetsRequestTopicPartition()
+		reqPartition.Partition = p
+		reqPartition.Timestamp = timestamp
+		reqTopic.Partitions = append(reqTopic.Partitions, reqPartition)
+	}
+	req.Topics = append(req.Topics, reqTopic)
+
+	resp, err := req.RequestWith(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("error listing offsets: %v", err)
+	}
+
+	offsets := make(map[int32]int64, len(partitions))
+	for _, t := range resp.Topics {
+		for _, p := range t.Partitions {
+			if err := kerr.ErrorForCode(p.ErrorCode); err != nil {
+				return nil, fmt.Errorf("error listing offset of partition %d of topic %s: %v", p.Partition, topic, err)
+			}
+			offsets[p.Partition] = p.Offset
+		}
+	}
+
+	return offsets, nil
+}
+
+// fetchCommittedOffsets returns the offsets committed by a consumer group for
+// the partitions of a topic. Partitions without a committed offset are
+// omitted.
+func fetchCommittedOffsets(
+	ctx context.Context,
+	client *kgo.Client,
+	group string,
+	topic string,
+	partitions []int32,
+) (map[int32]int64, error) {
+	req := kmsg.NewPtrOffsetFetchRequest()
+	req.Group = group
+	reqTopic := kmsg.NewOffsetFetchRequestTopic()
+	reqTopic.Topic = topic
+	reqTopic.Partitions = partitions
+	req.Topics = append(req.Topics, reqTopic)
+
+	offsets := make(map[int32]int64)
+
+	resp, err := req.RequestWith(ctx, client)
+	if errors.Is(err, kerr.GroupIDNotFound) {
+		return offsets, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error fetching committed offsets: %v", err)
+	}
+	if err := kerr.ErrorForCode(resp.ErrorCode); err != nil {
+		if errors.Is(err, kerr.GroupIDNotFound) {
+			return offsets, nil
+		}
+		return nil, fmt.Errorf("error fetching committed offsets of group %s: %v", group, err)
+	}
+
+	for _, t := range resp.Topics {
+		for _, p := range t.Partitions {
+			if err := kerr.ErrorForCode(p.ErrorCode); err != nil {
+				return nil, fmt.Errorf("error fetching committed offset of partition %d: %v", p.Partition, err)
+			}
+			if p.Offset >= 0 {
+				offsets[p.Partition] = p.Offset
+			}
+		}
+	}
+
+	return offsets, nil
+}
+
+// commitOffset commits the offset of the next record to read from a partition
+// for a consumer group. The offset is committed outside of any group
+// generation, as partitions are assigned by the runner rather than by the
+// group.
+func commitOffset(
+	ctx context.Context,
+	client *kgo.Client,
+	group string,
+	topic string,
+	partition int32,
+	offset int64,
+) error {
+	req := kmsg.NewPtrOffsetCommitRequest()
+	req.Group = group
+	req.Generation = -1
+	reqTopic := kmsg.NewOffsetCommitRequestTopic()
+	reqTopic.Topic = topic
+	reqPartition := kmsg.NewOffsetCommitRequestTopicPartition()
+	reqPartition.Partition = partition
+	reqPartition.Offset = offset
+	reqPartition.LeaderEpoch = -1
+	reqTopic.Partitions = append(reqTopic.Partitions, reqPartition)
+	req.Topics = append(req.Topics, reqTopic)
+
+	resp, err := req.RequestWith(ctx, client)
+	if err != nil {
+		return fmt.Errorf("error committing offset: %v", err)
+	}
+
+	for _, t := range resp.Topics {
+		for _, p := range t.Partitions {
+			if err := kerr.ErrorForCode(p.ErrorCode); err != nil {
+				return fmt.Errorf("error committing offset of partition %d of topic %s: %v", p.Partition, topic, err)
+			}
+		}
+	}
+
+	return nil
+}
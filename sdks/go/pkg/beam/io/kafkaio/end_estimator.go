@@ -0,0 +1,49 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaio
+
+import (
+	"context"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// endEstimator estimates the end of the offset range of a partition as its
+// latest offset.
+type endEstimator struct {
+	client         *kgo.Client
+	topic          string
+	partition      int32
+	isolationLevel int8
+}
+
+func newEndEstimator(client *kgo.Client, topic string, partition int32, isolationLevel int8) *endEstimator {
+	return &endEstimator{
+		client:         client,
+		topic:          topic,
+		partition:      partition,
+		isolationLevel: isolationLevel,
+	}
+}
+
+func (e *endEstimator) Estimate() int64 {
+	ctx := context.Background()
+	offsets, err := listOffsets(ctx, e.client, e.topic, []int32{e.partition}, latestOffset, e.isolationLevel)
+	if err != nil {
+		panic(err)
+	}
+	return offsets[e.partition]
+}
@@ -0,0 +1,59 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaio
+
+import (
+	"context"
+	"testing"
+)
+
+func Test_endEstimator_Estimate(t *testing.T) {
+	tests := []struct {
+		name      string
+		produce   bool
+		partition int32
+		want      int64
+	}{
+		{
+			name:      "Estimate end for produced records",
+			produce:   true,
+			partition: 0,
+			want:      3,
+		},
+		{
+			name:      "Estimate end for no produced records",
+			partition: 1,
+			want:      0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			cluster := newCluster(t, 2, topic)
+			brokers := cluster.ListenAddrs()
+			client := newClient(t, brokers)
+
+			if tt.produce {
+				produceRecords(ctx, t, brokers, testRecords()[:3])
+			}
+
+			estimator := newEndEstimator(client, topic, tt.partition, 0)
+			if got := estimator.Estimate(); got != tt.want {
+				t.Fatalf("Estimate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
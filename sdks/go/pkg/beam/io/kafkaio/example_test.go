@@ -0,0 +1,76 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaio_test
+
+import (
+	"context"
+	"log"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/kafkaio"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/x/beamx"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/x/debug"
+)
+
+func ExampleRead() {
+	beam.Init()
+
+	p, s := beam.NewPipelineWithRoot()
+
+	brokers := []string{"localhost:9092"}
+	topics := []string{"events"}
+
+	col := kafkaio.Read(
+		s,
+		brokers,
+		topics,
+		kafkaio.ReadConsumerGroup("beam"),
+		kafkaio.ReadCommitOffsetsInFinalize(),
+		kafkaio.ReadFromEarliest(),
+	)
+	debug.Print(s, col)
+
+	if err := beamx.Run(context.Background(), p); err != nil {
+		log.Fatalf("Failed to execute job: %v", err)
+	}
+}
+
+func ExampleWrite() {
+	beam.Init()
+
+	p, s := beam.NewPipelineWithRoot()
+
+	brokers := []string{"localhost:9092"}
+	records := []kafkaio.ProducerRecord{
+		{
+			Key:     []byte("123"),
+			Value:   []byte("hello"),
+			Headers: []kafkaio.Header{{Key: "key", Value: []byte("val1")}},
+		},
+		{
+			Key:     []byte("124"),
+			Value:   []byte("world"),
+			Headers: []kafkaio.Header{{Key: "key", Value: []byte("val2")}},
+		},
+	}
+
+	input := beam.CreateList(s, records)
+	kafkaio.Write(s, brokers, "events", input, kafkaio.WriteTransactional("beam"))
+
+	if err := beamx.Run(context.Background(), p); err != nil {
+		log.Fatalf("Failed to execute job: %v", err)
+	}
+}
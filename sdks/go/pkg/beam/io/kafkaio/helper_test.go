@@ -0,0 +1,192 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaio
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"github.com/twmb/franz-go/pkg/kmsg"
+)
+
+func newCluster(t *testing.T, partitions int32, topics ...string) *kfake.Cluster {
+	t.Helper()
+
+	cluster, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(partitions, topics...))
+	if err != nil {
+		t.Fatalf("Failed to create Kafka cluster: %v", err)
+	}
+	t.Cleanup(cluster.Close)
+
+	return cluster
+}
+
+func newClient(t *testing.T, brokers []string, opts ...kgo.Opt) *kgo.Client {
+	t.Helper()
+
+	opts = append(opts, kgo.SeedBrokers(brokers...))
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		t.Fatalf("Failed to create Kafka client: %v", err)
+	}
+	t.Cleanup(client.Close)
+
+	return client
+}
+
+func produceRecords(ctx context.Context, t *testing.T, brokers []string, records []*kgo.Record) {
+	t.Helper()
+
+	client := newClient(t, brokers, kgo.RecordPartitioner(kgo.ManualPartitioner()))
+	for _, record := range records {
+		if err := client.ProduceSync(ctx, record).FirstErr(); err != nil {
+			t.Fatalf("Failed to produce record: %v", err)
+		}
+	}
+}
+
+func consumeRecords(ctx context.Context, t *testing.T, brokers []string, topic string, n int) []*kgo.Record {
+	t.Helper()
+
+	client := newClient(t, brokers, kgo.ConsumeTopics(topic), kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()))
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	var records []*kgo.Record
+	for len(records) < n {
+		fetches := client.PollFetches(ctx)
+		if err := ctx.Err(); err != nil {
+			t.Fatalf("Timed out consuming records, got %d, want %d", len(records), n)
+		}
+		if err := fetches.Err(); err != nil {
+			t.Fatalf("Failed to consume records: %v", err)
+		}
+		records = append(records, fetches.Records()...)
+	}
+
+	return records
+}
+
+// offsetStore stores the offsets committed to a cluster outside of group
+// generations, which the in-process cluster does not support.
+type offsetStore struct {
+	mu      sync.Mutex
+	offsets map[string]map[int32]int64
+}
+
+func newOffsetStore(cluster *kfake.Cluster) *offsetStore {
+	store := &offsetStore{offsets: make(map[string]map[int32]int64)}
+
+	cluster.ControlKey(int16(kmsg.OffsetCommit), func(kreq kmsg.Request) (kmsg.Response, error, bool) {
+		cluster.KeepControl()
+		req := kreq.(*kmsg.OffsetCommitRequest)
+		resp := req.ResponseKind().(*kmsg.OffsetCommitResponse)
+		for _, t := range req.Topics {
+			rt := kmsg.NewOffsetCommitResponseTopic()
+			rt.Topic = t.Topic
+			for _, p := range t.Partitions {
+				store.set(t.Topic, p.Partition, p.Offset)
+				rp := kmsg.NewOffsetCommitResponseTopicPartition()
+				rp.Partition = p.Partition
+				rt.Partitions = append(rt.Partitions, rp)
+			}
+			resp.Topics = append(resp.Topics, rt)
+		}
+		return resp, nil, true
+	})
+
+	cluster.ControlKey(int16(kmsg.OffsetFetch), func(kreq kmsg.Request) (kmsg.Response, error, bool) {
+		cluster.KeepControl()
+		req := kreq.(*kmsg.OffsetFetchRequest)
+		resp := req.ResponseKind().(*kmsg.OffsetFetchResponse)
+		for _, g := range req.Groups {
+			rg := kmsg.NewOffsetFetchResponseGroup()
+			rg.Group = g.Group
+			for _, t := range g.Topics {
+				rt := kmsg.NewOffsetFetchResponseGroupTopic()
+				rt.Topic = t.Topic
+				for _, p := range t.Partitions {
+					rp := kmsg.NewOffsetFetchResponseGroupTopicPartition()
+					rp.Partition = p
+					rp.Offset = store.get(t.Topic, p)
+					rt.Partitions = append(rt.Partitions, rp)
+				}
+				rg.Topics = append(rg.Topics, rt)
+			}
+			resp.Groups = append(resp.Groups, rg)
+		}
+		for _, t := range req.Topics {
+			rt := kmsg.NewOffsetFetchResponseTopic()
+			rt.Topic = t.Topic
+			for _, p := range t.Partitions {
+				rp := kmsg.NewOffsetFetchResponseTopicPartition()
+				rp.Partition = p
+				rp.Offset = store.get(t.Topic, p)
+				rt.Partitions = append(rt.Partitions, rp)
+			}
+			resp.Topics = append(resp.Topics, rt)
+		}
+		return resp, nil, true
+	})
+
+	return store
+}
+
+func (s *offsetStore) set(topic string, partition int32, offset int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.offsets[topic] == nil {
+		s.offsets[topic] = make(map[int32]int64)
+	}
+	s.offsets[topic][partition] = offset
+}
+
+func (s *offsetStore) get(topic string, partition int32) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	offset, ok := s.offsets[topic][partition]
+	if !ok {
+		return -1
+	}
+	return offset
+}
+
+// bundleFinalization collects the callbacks registered for a bundle.
+type bundleFinalization struct {
+	callbacks []func() error
+}
+
+func (bf *bundleFinalization) RegisterCallback(_ time.Duration, callback func() error) {
+	bf.callbacks = append(bf.callbacks, callback)
+}
+
+func (bf *bundleFinalization) finalize(t *testing.T) {
+	t.Helper()
+
+	for _, callback := range bf.callbacks {
+		if err := callback(); err != nil {
+			t.Fatalf("Failed to finalize bundle: %v", err)
+		}
+	}
+	bf.callbacks = nil
+}
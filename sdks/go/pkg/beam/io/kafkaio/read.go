@@ -0,0 +1,461 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/sdf"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/internal/unbounded"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/rtrackers/offsetrange"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func init() {
+	register.DoFn3x1[context.Context, []byte, func(topicPartition), error](&listPartitionsFn{})
+	register.Emitter1[topicPartition]()
+	register.DoFn5x2[
+		context.Context, *unbounded.ObservingWatermarkEstimator, *sdf.LockRTracker, topicPartition,
+		func(beam.EventTime, ConsumerRecord), sdf.ProcessContinuation, error,
+	](
+		&readFn{},
+	)
+	register.DoFn6x2[
+		context.Context, *unbounded.ObservingWatermarkEstimator, beam.BundleFinalization, *sdf.LockRTracker, topicPartition,
+		func(beam.EventTime, ConsumerRecord), sdf.ProcessContinuation, error,
+	](
+		&commitReadFn{},
+	)
+	register.Emitter2[beam.EventTime, ConsumerRecord]()
+	beam.RegisterType(reflect.TypeOf((*ConsumerRecord)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*Header)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*topicPartition)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*partitionRestriction)(nil)).Elem())
+}
+
+const (
+	defaultMaxPollRecords = 500
+	pollTimeout           = 3 * time.Second
+	assumedLag            = 1 * time.Second
+	resumeDelay           = 5 * time.Second
+	finalizationTimeout   = 5 * time.Minute
+)
+
+// Header is a header of a Kafka record.
+type Header struct {
+	Key   string
+	Value []byte
+}
+
+// ConsumerRecord is a record read from Kafka.
+type ConsumerRecord struct {
+	Topic     string
+	Partition int32
+	Offset    int64
+	Timestamp time.Time
+	Key       []byte
+	Value     []byte
+	Headers   []Header
+}
+
+// topicPartition is a partition of a topic with the range of offsets to read
+// from it.
+type topicPartition struct {
+	Topic       string
+	Partition   int32
+	StartOffset int64
+	EndOffset   int64
+}
+
+// Read reads records from the partitions of Kafka topics and returns a
+// PCollection<ConsumerRecord>. The partitions of the topics are listed when the
+// pipeline starts, and each is read as a separate restriction that the runner
+// can split. The event time of each record is its timestamp, and the watermark
+// of each partition is estimated from the timestamps of its records.
+//
+// Read is unbounded unless ReadUntilLatest is set. Read takes a variable number of ReadOptionFn
+// to configure the read operation:
+//   - ConsumerGroup: the consumer group to start reading from the committed offsets of. Defaults
+//     to none.
+//   - CommitOffsetsInFinalize: whether to commit the offsets of read records to the consumer group
+//     once their bundle is finalized. Requires a consumer group. Defaults to false.
+//   - FromEarliest / FromLatest / FromTimestamp: where to start reading partitions without a
+//     committed offset. Defaults to the latest offsets.
+//   - UntilLatest: whether to stop reading at the latest offsets when the pipeline starts.
+//     Defaults to false.
+//   - MaxPollRecords: the maximum number of records to retrieve at a time. Defaults to 500.
+//   - Committed: whether to only read records of committed transactions. Defaults to false.
+func Read(s beam.Scope, brokers []string, topics []string, opts ...ReadOptionFn) beam.PCollection {
+	s = s.Scope("kafkaio.Read")
+
+	option := &readOption{
+		StartOffset:    latestOffset,
+		MaxPollRecords: defaultMaxPollRecords,
+	}
+
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("kafkaio.Read: invalid option: %v", err))
+		}
+	}
+
+	if option.CommitOffsets && option.ConsumerGroup == "" {
+		panic(fmt.Sprintf("kafkaio.Read: invalid option: %v", errCommitWithoutGroup))
+	}
+
+	imp := beam.Impulse(s)
+	partitions := beam.ParDo(s, newListPartitionsFn(brokers, topics, option), imp)
+
+	fn := newReadFn(brokers, option)
+	if option.CommitOffsets {
+		return beam.ParDo(s, &commitReadFn{readFn: *fn}, partitions)
+	}
+	return beam.ParDo(s, fn, partitions)
+}
+
+type listPartitionsFn struct {
+	kafkaFn
+	Topics        []string
+	ConsumerGroup string
+	StartOffset   int64
+	UntilLatest   bool
+	ReadCommitted bool
+}
+
+func newListPartitionsFn(brokers []string, topics []string, option *readOption) *listPartitionsFn {
+	return &listPartitionsFn{
+		kafkaFn: kafkaFn{
+			Brokers: brokers,
+		},
+		Topics:        topics,
+		ConsumerGroup: option.ConsumerGroup,
+		StartOffset:   option.StartOffset,
+		UntilLatest:   option.UntilLatest,
+		ReadCommitted: option.ReadCommitted,
+	}
+}
+
+func (fn *listPartitionsFn) ProcessElement(ctx context.Context, _ []byte, emit func(topicPartition)) error {
+	level := isolationLevel(fn.ReadCommitted)
+
+	for _, topic := range fn.Topics {
+		partitions, err := listPartitions(ctx, fn.client, topic)
+		if err != nil {
+			return err
+		}
+
+		committed := make(map[int32]int64)
+		if fn.ConsumerGroup != "" {
+			committed, err = fetchCommittedOffsets(ctx, fn.client, fn.ConsumerGroup, topic, partitions)
+			if err != nil {
+				return err
+			}
+		}
+
+		starts, err := listOffsets(ctx, fn.client, topic, partitions, fn.StartOffset, level)
+		if err != nil {
+			return err
+		}
+
+		latest, err := listOffsets(ctx, fn.client, topic, partitions, latestOffset, level)
+		if err != nil {
+			return err
+		}
+
+		for _, partition := range partitions {
+			start, ok := committed[partition]
+			if !ok {
+				start = starts[partition]
+			}
+			if start < 0 {
+				// There are no records at or after the start timestamp.
+				start = latest[partition]
+			}
+
+			end := int64(math.MaxInt64)
+			if fn.UntilLatest {
+				end = latest[partition]
+			}
+			if start > end {
+				start = end
+			}
+
+			emit(topicPartition{
+				Topic:       topic,
+				Partition:   partition,
+				StartOffset: start,
+				EndOffset:   end,
+			})
+		}
+	}
+
+	return nil
+}
+
+type readFn struct {
+	kafkaFn
+	ConsumerGroup  string
+	MaxPollRecords int
+	ReadCommitted  bool
+}
+
+func newReadFn(brokers []string, option *readOption) *readFn {
+	return &readFn{
+		kafkaFn: kafkaFn{
+			Brokers: brokers,
+		},
+		ConsumerGroup:  option.ConsumerGroup,
+		MaxPollRecords: option.MaxPollRecords,
+		ReadCommitted:  option.ReadCommitted,
+	}
+}
+
+func (fn *readFn) CreateInitialRestriction(tp topicPartition) partitionRestriction {
+	return partitionRestriction{
+		Topic:     tp.Topic,
+		Partition: tp.Partition,
+		Offsets: offsetrange.Restriction{
+			Start: tp.StartOffset,
+			End:   tp.EndOffset,
+		},
+	}
+}
+
+func (fn *readFn) SplitRestriction(_ topicPartition, rest partitionRestriction) []partitionRestriction {
+	return []partitionRestriction{rest}
+}
+
+func (fn *readFn) RestrictionSize(_ topicPartition, rest partitionRestriction) (float64, error) {
+	if err := fn.kafkaFn.Setup(); err != nil {
+		return -1, err
+	}
+
+	rt, err := fn.createRTracker(rest)
+	if err != nil {
+		return -1, err
+	}
+
+	_, remaining := rt.GetProgress()
+	return remaining, nil
+}
+
+func (fn *readFn) CreateTracker(rest partitionRestriction) (*sdf.LockRTracker, error) {
+	rt, err := fn.createRTracker(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdf.NewLockRTracker(rt), nil
+}
+
+func (fn *readFn) TruncateRestriction(rt *sdf.LockRTracker, _ topicPartition) partitionRestriction {
+	rest := rt.GetRestriction().(partitionRestriction)
+	rest.Offsets.End = rest.Offsets.Start
+	return rest
+}
+
+func (fn *readFn) InitialWatermarkEstimatorState(
+	et beam.EventTime,
+	_ partitionRestriction,
+	_ topicPartition,
+) int64 {
+	return et.Milliseconds()
+}
+
+func (fn *readFn) CreateWatermarkEstimator(ms int64) *unbounded.ObservingWatermarkEstimator {
+	return &unbounded.ObservingWatermarkEstimator{WatermarkEstimator: unbounded.WatermarkEstimator{State: ms}}
+}
+
+func (fn *readFn) WatermarkEstimatorState(we *unbounded.ObservingWatermarkEstimator) int64 {
+	return we.State
+}
+
+func (fn *readFn) ProcessElement(
+	ctx context.Context,
+	we *unbounded.ObservingWatermarkEstimator,
+	rt *sdf.LockRTracker,
+	_ topicPartition,
+	emit func(beam.EventTime, ConsumerRecord),
+) (sdf.ProcessContinuation, error) {
+	_, pc, err := fn.read(ctx, we, rt, emit)
+	return pc, err
+}
+
+// read reads the records of the restriction, returning the offset of the next
+// record to read.
+func (fn *readFn) read(
+	ctx context.Context,
+	we *unbounded.ObservingWatermarkEstimator,
+	rt *sdf.LockRTracker,
+	emit func(beam.EventTime, ConsumerRecord),
+) (int64, sdf.ProcessContinuation, error) {
+	rest := rt.GetRestriction().(partitionRestriction)
+	next := rest.Offsets.Start
+	if rest.Offsets.Start >= rest.Offsets.End {
+		rt.TryClaim(rest.Offsets.End)
+		return next, sdf.StopProcessing(), nil
+	}
+
+	consumer, err := fn.createConsumer(rest.Topic, rest.Partition, rest.Offsets.Start)
+	if err != nil {
+		return next, sdf.StopProcessing(), err
+	}
+	defer consumer.Close()
+
+	for {
+		records, err := fn.poll(ctx, consumer)
+		if err != nil {
+			return next, sdf.StopProcessing(), err
+		}
+
+		if len(records) == 0 {
+			if rest.Offsets.End < math.MaxInt64 {
+				// The records up to the latest offset when the pipeline
+				// started have been read, so finish claiming before
+				// returning to avoid errors.
+				rt.TryClaim(rest.Offsets.End)
+				return next, sdf.StopProcessing(), nil
+			}
+
+			updateWatermarkManually(we)
+			return next, sdf.ResumeProcessingIn(resumeDelay), nil
+		}
+
+		for _, record := range records {
+			if !rt.TryClaim(record.Offset) {
+				return next, sdf.StopProcessing(), nil
+			}
+
+			emit(mtime.FromTime(record.Timestamp), createConsumerRecord(record))
+			next = record.Offset + 1
+		}
+	}
+}
+
+func (fn *readFn) createRTracker(rest partitionRestriction) (sdf.BoundableRTracker, error) {
+	if rest.Offsets.End < math.MaxInt64 {
+		return newPartitionTracker(rest, offsetrange.NewTracker(rest.Offsets)), nil
+	}
+
+	estimator := newEndEstimator(fn.client, rest.Topic, rest.Partition, isolationLevel(fn.ReadCommitted))
+	rt, err := offsetrange.NewGrowableTracker(rest.Offsets, estimator)
+	if err != nil {
+		return nil, fmt.Errorf("error creating growable tracker: %v", err)
+	}
+
+	return newPartitionTracker(rest, rt), nil
+}
+
+func (fn *readFn) createConsumer(topic string, partition int32, offset int64) (*kgo.Client, error) {
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(fn.Brokers...),
+		kgo.ConsumePartitions(map[string]map[int32]kgo.Offset{
+			topic: {partition: kgo.NewOffset().At(offset)},
+		}),
+	}
+	if fn.ReadCommitted {
+		opts = append(opts, kgo.FetchIsolationLevel(kgo.ReadCommitted()))
+	}
+
+	consumer, err := kgo.NewClient(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating consumer: %v", err)
+	}
+
+	return consumer, nil
+}
+
+// poll returns the next records of the consumer, or no records if there are
+// none within the poll timeout.
+func (fn *readFn) poll(ctx context.Context, consumer *kgo.Client) ([]*kgo.Record, error) {
+	pollCtx, cancel := context.WithTimeout(ctx, pollTimeout)
+	defer cancel()
+
+	fetches := consumer.PollRecords(pollCtx, fn.MaxPollRecords)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, fetchErr := range fetches.Errors() {
+		if errors.Is(fetchErr.Err, context.DeadlineExceeded) || errors.Is(fetchErr.Err, context.Canceled) {
+			continue
+		}
+		return nil, fmt.Errorf("error fetching records: %v", fetchErr.Err)
+	}
+
+	return fetches.Records(), nil
+}
+
+// commitReadFn is a readFn that commits the offset of the next record to read
+// to the consumer group once the bundle that read the records is finalized.
+type commitReadFn struct {
+	readFn
+}
+
+func (fn *commitReadFn) ProcessElement(
+	ctx context.Context,
+	we *unbounded.ObservingWatermarkEstimator,
+	bf beam.BundleFinalization,
+	rt *sdf.LockRTracker,
+	_ topicPartition,
+	emit func(beam.EventTime, ConsumerRecord),
+) (sdf.ProcessContinuation, error) {
+	rest := rt.GetRestriction().(partitionRestriction)
+	next, pc, err := fn.read(ctx, we, rt, emit)
+	if next > rest.Offsets.Start {
+		bf.RegisterCallback(finalizationTimeout, func() error {
+			return commitOffset(context.Background(), fn.client, fn.ConsumerGroup, rest.Topic, rest.Partition, next)
+		})
+	}
+	return pc, err
+}
+
+func createConsumerRecord(record *kgo.Record) ConsumerRecord {
+	var headers []Header
+	for _, h := range record.Headers {
+		headers = append(headers, Header{Key: h.Key, Value: h.Value})
+	}
+
+	return ConsumerRecord{
+		Topic:     record.Topic,
+		Partition: record.Partition,
+		Offset:    record.Offset,
+		Timestamp: record.Timestamp,
+		Key:       record.Key,
+		Value:     record.Value,
+		Headers:   headers,
+	}
+}
+
+func updateWatermarkManually(we *unbounded.ObservingWatermarkEstimator) {
+	t := time.Now().Add(-1 * assumedLag)
+	we.ObserveTimestamp(t)
+}
+
+func isolationLevel(readCommitted bool) int8 {
+	if readCommitted {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,122 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaio
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidConsumerGroup  = errors.New("consumer group must not be empty")
+	errInvalidMaxPollRecords = errors.New("max poll records must be greater than 0")
+	errCommitWithoutGroup    = errors.New("committing offsets requires a consumer group")
+)
+
+type readOption struct {
+	ConsumerGroup  string
+	CommitOffsets  bool
+	StartOffset    int64
+	UntilLatest    bool
+	MaxPollRecords int
+	ReadCommitted  bool
+}
+
+// ReadOptionFn is a function that can be passed to Read to configure options for reading
+// from Kafka.
+type ReadOptionFn func(option *readOption) error
+
+// ReadConsumerGroup sets the consumer group whose committed offsets partitions are read from, for
+// partitions that have them. The group is only used to store offsets: partitions are assigned by
+// the runner, not by the group.
+func ReadConsumerGroup(group string) ReadOptionFn {
+	return func(o *readOption) error {
+		if group == "" {
+			return errInvalidConsumerGroup
+		}
+
+		o.ConsumerGroup = group
+		return nil
+	}
+}
+
+// ReadCommitOffsetsInFinalize specifies that the offsets of read records are committed to the
+// consumer group once the runner has durably processed the bundle that read them, so that later
+// pipelines reading with the group resume where this one left off. It requires a consumer group
+// and a runner that supports bundle finalization.
+func ReadCommitOffsetsInFinalize() ReadOptionFn {
+	return func(o *readOption) error {
+		o.CommitOffsets = true
+		return nil
+	}
+}
+
+// ReadFromEarliest specifies that partitions without a committed offset are read from their
+// earliest available record.
+func ReadFromEarliest() ReadOptionFn {
+	return func(o *readOption) error {
+		o.StartOffset = earliestOffset
+		return nil
+	}
+}
+
+// ReadFromLatest specifies that partitions without a committed offset are read from the records
+// produced after the pipeline starts. This is the default.
+func ReadFromLatest() ReadOptionFn {
+	return func(o *readOption) error {
+		o.StartOffset = latestOffset
+		return nil
+	}
+}
+
+// ReadFromTimestamp specifies that partitions without a committed offset are read from their
+// first record with a timestamp at or after the given time.
+func ReadFromTimestamp(t time.Time) ReadOptionFn {
+	return func(o *readOption) error {
+		o.StartOffset = t.UnixMilli()
+		return nil
+	}
+}
+
+// ReadUntilLatest specifies that partitions are read until the latest offsets at the time the
+// pipeline starts, making the read bounded.
+func ReadUntilLatest() ReadOptionFn {
+	return func(o *readOption) error {
+		o.UntilLatest = true
+		return nil
+	}
+}
+
+// ReadMaxPollRecords sets the maximum number of records to retrieve at a time.
+func ReadMaxPollRecords(n int) ReadOptionFn {
+	return func(o *readOption) error {
+		if n <= 0 {
+			return errInvalidMaxPollRecords
+		}
+
+		o.MaxPollRecords = n
+		return nil
+	}
+}
+
+// ReadCommitted specifies that only records of committed transactions are read. By default,
+// records of aborted and ongoing transactions are read as well.
+func ReadCommitted() ReadOptionFn {
+	return func(o *readOption) error {
+		o.ReadCommitted = true
+		return nil
+	}
+}
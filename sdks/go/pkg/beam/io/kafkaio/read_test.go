@@ -0,0 +1,323 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaio
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+const topic = "topic"
+
+var baseTime = time.UnixMilli(1577934245000)
+
+func testRecords() []*kgo.Record {
+	return []*kgo.Record{
+		{
+			Topic:     topic,
+			Partition: 0,
+			Key:       []byte("key1"),
+			Value:     []byte("value1"),
+			Timestamp: baseTime,
+		},
+		{
+			Topic:     topic,
+			Partition: 0,
+			Key:       []byte("key2"),
+			Value:     []byte("value2"),
+			Timestamp: baseTime.Add(1 * time.Second),
+		},
+		{
+			Topic:     topic,
+			Partition: 0,
+			Value:     []byte("value3"),
+			Headers:   []kgo.RecordHeader{{Key: "header", Value: []byte("value")}},
+			Timestamp: baseTime.Add(2 * time.Second),
+		},
+		{
+			Topic:     topic,
+			Partition: 1,
+			Key:       []byte("key4"),
+			Value:     []byte("value4"),
+			Timestamp: baseTime.Add(1 * time.Second),
+		},
+	}
+}
+
+func wantRecords(indices ...int) []any {
+	all := []ConsumerRecord{
+		{
+			Topic:     topic,
+			Partition: 0,
+			Offset:    0,
+			Timestamp: baseTime,
+			Key:       []byte("key1"),
+			Value:     []byte("value1"),
+		},
+		{
+			Topic:     topic,
+			Partition: 0,
+			Offset:    1,
+			Timestamp: baseTime.Add(1 * time.Second),
+			Key:       []byte("key2"),
+			Value:     []byte("value2"),
+		},
+		{
+			Topic:     topic,
+			Partition: 0,
+			Offset:    2,
+			Timestamp: baseTime.Add(2 * time.Second),
+			Value:     []byte("value3"),
+			Headers:   []Header{{Key: "header", Value: []byte("value")}},
+		},
+		{
+			Topic:     topic,
+			Partition: 1,
+			Offset:    0,
+			Timestamp: baseTime.Add(1 * time.Second),
+			Key:       []byte("key4"),
+			Value:     []byte("value4"),
+		},
+	}
+
+	var want []any
+	for _, i := range indices {
+		want = append(want, all[i])
+	}
+	return want
+}
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name      string
+		committed map[int32]int64
+		opts      []ReadOptionFn
+		want      []any
+	}{
+		{
+			name: "Read records of all partitions from earliest offsets",
+			opts: []ReadOptionFn{
+				ReadFromEarliest(),
+				ReadUntilLatest(),
+			},
+			want: wantRecords(0, 1, 2, 3),
+		},
+		{
+			name: "Read records from timestamp",
+			opts: []ReadOptionFn{
+				ReadFromTimestamp(baseTime.Add(1 * time.Second)),
+				ReadUntilLatest(),
+			},
+			want: wantRecords(1, 2, 3),
+		},
+		{
+			name: "Read records from latest offsets",
+			opts: []ReadOptionFn{
+				ReadFromLatest(),
+				ReadUntilLatest(),
+			},
+		},
+		{
+			name:      "Read records from committed offsets of consumer group",
+			committed: map[int32]int64{0: 2},
+			opts: []ReadOptionFn{
+				ReadConsumerGroup("group"),
+				ReadFromEarliest(),
+				ReadUntilLatest(),
+			},
+			want: wantRecords(2, 3),
+		},
+		{
+			name: "Read records with custom max poll records",
+			opts: []ReadOptionFn{
+				ReadFromEarliest(),
+				ReadUntilLatest(),
+				ReadMaxPollRecords(1),
+			},
+			want: wantRecords(0, 1, 2, 3),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			cluster := newCluster(t, 2, topic)
+			brokers := cluster.ListenAddrs()
+			store := newOffsetStore(cluster)
+			for partition, offset := range tt.committed {
+				store.set(topic, partition, offset)
+			}
+
+			produceRecords(ctx, t, brokers, testRecords())
+
+			p, s := beam.NewPipelineWithRoot()
+			got := Read(s, brokers, []string{topic}, tt.opts...)
+
+			passert.Equals(s, got, tt.want...)
+			ptest.RunAndValidate(t, p)
+		})
+	}
+}
+
+func TestRead_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  ReadOptionFn
+	}{
+		{
+			name: "Empty consumer group",
+			opt:  ReadConsumerGroup(""),
+		},
+		{
+			name: "Zero max poll records",
+			opt:  ReadMaxPollRecords(0),
+		},
+		{
+			name: "Commit offsets without consumer group",
+			opt:  ReadCommitOffsetsInFinalize(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Read() did not panic")
+				}
+			}()
+
+			_, s := beam.NewPipelineWithRoot()
+			Read(s, []string{"localhost:9092"}, []string{topic}, tt.opt)
+		})
+	}
+}
+
+func TestReadFn_ProcessElement(t *testing.T) {
+	tests := []struct {
+		name       string
+		end        int64
+		wantResume bool
+	}{
+		{
+			name:       "Stop processing at the end of a bounded restriction",
+			end:        3,
+			wantResume: false,
+		},
+		{
+			name:       "Resume processing of an unbounded restriction without new records",
+			end:        math.MaxInt64,
+			wantResume: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			cluster := newCluster(t, 2, topic)
+			brokers := cluster.ListenAddrs()
+
+			produceRecords(ctx, t, brokers, testRecords())
+
+			fn := newReadFn(brokers, &readOption{MaxPollRecords: defaultMaxPollRecords})
+			if err := fn.Setup(); err != nil {
+				t.Fatalf("Setup() failed: %v", err)
+			}
+			defer fn.Teardown()
+
+			tp := topicPartition{Topic: topic, Partition: 0, StartOffset: 0, EndOffset: tt.end}
+			rt, err := fn.CreateTracker(fn.CreateInitialRestriction(tp))
+			if err != nil {
+				t.Fatalf("CreateTracker() failed: %v", err)
+			}
+			we := fn.CreateWatermarkEstimator(0)
+
+			var got []any
+			pc, err := fn.ProcessElement(ctx, we, rt, tp, func(et beam.EventTime, record ConsumerRecord) {
+				if got, want := et.ToTime(), record.Timestamp; !got.Equal(want) {
+					t.Errorf("event time = %v, want %v", got, want)
+				}
+				got = append(got, record)
+			})
+			if err != nil {
+				t.Fatalf("ProcessElement() failed: %v", err)
+			}
+
+			if got, want := pc.ShouldResume(), tt.wantResume; got != want {
+				t.Errorf("ShouldResume() = %v, want %v", got, want)
+			}
+			if diff := cmp.Diff(wantRecords(0, 1, 2), got); diff != "" {
+				t.Errorf("ProcessElement() records mismatch (-want +got):\n%s", diff)
+			}
+			if !tt.wantResume && !rt.IsDone() {
+				t.Errorf("IsDone() = false, want true")
+			}
+			if tt.wantResume && !we.CurrentWatermark().After(baseTime) {
+				t.Errorf("CurrentWatermark() = %v, want it to advance past %v", we.CurrentWatermark(), baseTime)
+			}
+		})
+	}
+}
+
+func TestCommitReadFn_ProcessElement(t *testing.T) {
+	ctx := context.Background()
+	cluster := newCluster(t, 2, topic)
+	brokers := cluster.ListenAddrs()
+	store := newOffsetStore(cluster)
+
+	produceRecords(ctx, t, brokers, testRecords())
+
+	option := &readOption{
+		ConsumerGroup:  "group",
+		CommitOffsets:  true,
+		MaxPollRecords: defaultMaxPollRecords,
+	}
+	fn := &commitReadFn{readFn: *newReadFn(brokers, option)}
+	if err := fn.Setup(); err != nil {
+		t.Fatalf("Setup() failed: %v", err)
+	}
+	defer fn.Teardown()
+
+	tp := topicPartition{Topic: topic, Partition: 0, StartOffset: 1, EndOffset: 3}
+	rt, err := fn.CreateTracker(fn.CreateInitialRestriction(tp))
+	if err != nil {
+		t.Fatalf("CreateTracker() failed: %v", err)
+	}
+	we := fn.CreateWatermarkEstimator(0)
+	bf := &bundleFinalization{}
+
+	var got []any
+	if _, err := fn.ProcessElement(ctx, we, bf, rt, tp, func(_ beam.EventTime, record ConsumerRecord) {
+		got = append(got, record)
+	}); err != nil {
+		t.Fatalf("ProcessElement() failed: %v", err)
+	}
+	if diff := cmp.Diff(wantRecords(1, 2), got); diff != "" {
+		t.Errorf("ProcessElement() records mismatch (-want +got):\n%s", diff)
+	}
+
+	if got, want := store.get(topic, 0), int64(-1); got != want {
+		t.Errorf("committed offset before finalization = %v, want %v", got, want)
+	}
+	bf.finalize(t)
+	if got, want := store.get(topic, 0), int64(3); got != want {
+		t.Errorf("committed offset after finalization = %v, want %v", got, want)
+	}
+}
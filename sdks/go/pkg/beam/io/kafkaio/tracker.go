@@ -0,0 +1,89 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaio
+
+import (
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/sdf"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/rtrackers/offsetrange"
+)
+
+// partitionRestriction is a range of offsets of a partition of a topic.
+type partitionRestriction struct {
+	Topic     string
+	Partition int32
+	Offsets   offsetrange.Restriction
+}
+
+// partitionTracker tracks a partitionRestriction by delegating to a tracker of
+// its offset range.
+type partitionTracker struct {
+	topic     string
+	partition int32
+	rt        sdf.BoundableRTracker
+}
+
+func newPartitionTracker(rest partitionRestriction, rt sdf.BoundableRTracker) *partitionTracker {
+	return &partitionTracker{
+		topic:     rest.Topic,
+		partition: rest.Partition,
+		rt:        rt,
+	}
+}
+
+func (t *partitionTracker) TryClaim(pos any) bool {
+	return t.rt.TryClaim(pos)
+}
+
+func (t *partitionTracker) GetError() error {
+	return t.rt.GetError()
+}
+
+func (t *partitionTracker) TrySplit(fraction float64) (primary, residual any, err error) {
+	p, r, err := t.rt.TrySplit(fraction)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	primary = t.wrap(p)
+	if r != nil {
+		residual = t.wrap(r)
+	}
+	return primary, residual, nil
+}
+
+func (t *partitionTracker) GetProgress() (done, remaining float64) {
+	return t.rt.GetProgress()
+}
+
+func (t *partitionTracker) IsDone() bool {
+	return t.rt.IsDone()
+}
+
+func (t *partitionTracker) GetRestriction() any {
+	return t.wrap(t.rt.GetRestriction())
+}
+
+func (t *partitionTracker) IsBounded() bool {
+	return t.rt.IsBounded()
+}
+
+func (t *partitionTracker) wrap(rest any) partitionRestriction {
+	return partitionRestriction{
+		Topic:     t.topic,
+		Partition: t.partition,
+		Offsets:   rest.(offsetrange.Restriction),
+	}
+}
@@ -0,0 +1,216 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaio
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/google/uuid"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func init() {
+	register.DoFn2x1[context.Context, ProducerRecord, error](&writeFn{})
+	beam.RegisterType(reflect.TypeOf((*ProducerRecord)(nil)).Elem())
+}
+
+// ProducerRecord is a record to be written to Kafka. If the timestamp is not set, the time the
+// record is produced is used.
+type ProducerRecord struct {
+	Key       []byte
+	Value     []byte
+	Headers   []Header
+	Timestamp time.Time
+}
+
+// Write writes a PCollection<ProducerRecord> to a Kafka topic. Records are partitioned by the
+// hash of their key, or spread across partitions if they have no key. Write takes a variable
+// number of WriteOptionFn to configure the write operation:
+//   - Idempotent: whether to use the idempotent producer. Defaults to false.
+//   - Transactional: the prefix of transactional IDs to write each bundle in a transaction with.
+//     Defaults to non-transactional writes.
+func Write(s beam.Scope, brokers []string, topic string, col beam.PCollection, opts ...WriteOptionFn) {
+	s = s.Scope("kafkaio.Write")
+
+	option := &writeOption{}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("kafkaio.Write: invalid option: %v", err))
+		}
+	}
+
+	beam.ParDo0(s, newWriteFn(brokers, topic, option), col)
+}
+
+type writeFn struct {
+	kafkaFn
+	Topic                 string
+	Idempotent            bool
+	TransactionalIDPrefix string
+
+	mu    sync.Mutex
+	err   error
+	inTxn bool
+}
+
+func newWriteFn(brokers []string, topic string, option *writeOption) *writeFn {
+	return &writeFn{
+		kafkaFn: kafkaFn{
+			Brokers: brokers,
+		},
+		Topic:                 topic,
+		Idempotent:            option.Idempotent,
+		TransactionalIDPrefix: option.TransactionalIDPrefix,
+	}
+}
+
+func (fn *writeFn) Setup() error {
+	if fn.client != nil {
+		return nil
+	}
+
+	opts := []kgo.Opt{
+		kgo.SeedBrokers(fn.Brokers...),
+		kgo.DefaultProduceTopic(fn.Topic),
+	}
+	switch {
+	case fn.TransactionalIDPrefix != "":
+		opts = append(opts, kgo.TransactionalID(fmt.Sprintf("%s-%s", fn.TransactionalIDPrefix, uuid.NewString())))
+	case !fn.Idempotent:
+		opts = append(opts, kgo.DisableIdempotentWrite())
+	}
+
+	client, err := kgo.NewClient(opts...)
+	if err != nil {
+		return fmt.Errorf("error creating Kafka producer: %v", err)
+	}
+	fn.client = client
+
+	return nil
+}
+
+func (fn *writeFn) StartBundle(ctx context.Context) error {
+	fn.resetError()
+
+	if fn.TransactionalIDPrefix == "" {
+		return nil
+	}
+
+	if fn.inTxn {
+		// The previous bundle failed before it finished.
+		if err := fn.abortTransaction(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err := fn.client.BeginTransaction(); err != nil {
+		return fmt.Errorf("error beginning transaction: %v", err)
+	}
+	fn.inTxn = true
+
+	return nil
+}
+
+func (fn *writeFn) ProcessElement(ctx context.Context, elem ProducerRecord) error {
+	if err := fn.getError(); err != nil {
+		return err
+	}
+
+	record := &kgo.Record{
+		Key:       elem.Key,
+		Value:     elem.Value,
+		Timestamp: elem.Timestamp,
+	}
+	for _, h := range elem.Headers {
+		record.Headers = append(record.Headers, kgo.RecordHeader{Key: h.Key, Value: h.Value})
+	}
+
+	fn.client.Produce(ctx, record, func(_ *kgo.Record, err error) {
+		if err != nil {
+			fn.recordError(fmt.Errorf("error producing record: %v", err))
+		}
+	})
+
+	return nil
+}
+
+func (fn *writeFn) FinishBundle(ctx context.Context) error {
+	if err := fn.client.Flush(ctx); err != nil {
+		fn.recordError(fmt.Errorf("error flushing records: %v", err))
+	}
+	err := fn.getError()
+
+	if fn.TransactionalIDPrefix == "" {
+		return err
+	}
+
+	if err != nil {
+		if abortErr := fn.abortTransaction(ctx); abortErr != nil {
+			return fmt.Errorf("%v; %v", err, abortErr)
+		}
+		return err
+	}
+
+	fn.inTxn = false
+	if err := fn.client.EndTransaction(ctx, kgo.TryCommit); err != nil {
+		return fmt.Errorf("error committing transaction: %v", err)
+	}
+
+	return nil
+}
+
+func (fn *writeFn) abortTransaction(ctx context.Context) error {
+	fn.inTxn = false
+
+	if err := fn.client.AbortBufferedRecords(ctx); err != nil {
+		return fmt.Errorf("error aborting buffered records: %v", err)
+	}
+	if err := fn.client.EndTransaction(ctx, kgo.TryAbort); err != nil {
+		return fmt.Errorf("error aborting transaction: %v", err)
+	}
+
+	return nil
+}
+
+func (fn *writeFn) getError() error {
+	fn.mu.Lock()
+	defer fn.mu.Unlock()
+
+	return fn.err
+}
+
+// recordError records the first error of the bundle.
+func (fn *writeFn) recordError(err error) {
+	fn.mu.Lock()
+	defer fn.mu.Unlock()
+
+	if fn.err == nil {
+		fn.err = err
+	}
+}
+
+func (fn *writeFn) resetError() {
+	fn.mu.Lock()
+	defer fn.mu.Unlock()
+
+	fn.err = nil
+}
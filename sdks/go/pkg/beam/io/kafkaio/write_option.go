@@ -0,0 +1,54 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaio
+
+import "errors"
+
+var errInvalidTransactionalID = errors.New("transactional ID prefix must not be empty")
+
+type writeOption struct {
+	Idempotent            bool
+	TransactionalIDPrefix string
+}
+
+// WriteOptionFn is a function that can be passed to Write to configure options for
+// writing records.
+type WriteOptionFn func(option *writeOption) error
+
+// WriteIdempotent enables the idempotent producer, which prevents retries of produce requests
+// from writing duplicate records within a partition.
+func WriteIdempotent() WriteOptionFn {
+	return func(o *writeOption) error {
+		o.Idempotent = true
+		return nil
+	}
+}
+
+// WriteTransactional writes the records of each bundle in a Kafka transaction, which is
+// committed when the bundle finishes and aborted when it fails. Consumers that read only
+// committed records do not see the records of failed bundles. Each worker uses a transactional
+// ID that starts with the given prefix. Transactional writes are also idempotent.
+func WriteTransactional(prefix string) WriteOptionFn {
+	return func(o *writeOption) error {
+		if prefix == "" {
+			return errInvalidTransactionalID
+		}
+
+		o.Idempotent = true
+		o.TransactionalIDPrefix = prefix
+		return nil
+	}
+}
@@ -0,0 +1,114 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafkaio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMain(m *testing.M) {
+	ptest.Main(m)
+}
+
+func TestWrite(t *testing.T) {
+	input := []any{
+		ProducerRecord{
+			Key:       []byte("key1"),
+			Value:     []byte("value1"),
+			Timestamp: baseTime,
+		},
+		ProducerRecord{
+			Key:       []byte("key1"),
+			Value:     []byte("value2"),
+			Headers:   []Header{{Key: "header", Value: []byte("value")}},
+			Timestamp: baseTime.Add(1 * time.Second),
+		},
+	}
+	want := []ConsumerRecord{
+		{
+			Topic:     topic,
+			Offset:    0,
+			Timestamp: baseTime,
+			Key:       []byte("key1"),
+			Value:     []byte("value1"),
+		},
+		{
+			Topic:     topic,
+			Offset:    1,
+			Timestamp: baseTime.Add(1 * time.Second),
+			Key:       []byte("key1"),
+			Value:     []byte("value2"),
+			Headers:   []Header{{Key: "header", Value: []byte("value")}},
+		},
+	}
+
+	tests := []struct {
+		name string
+		opts []WriteOptionFn
+	}{
+		{
+			name: "Write records",
+		},
+		{
+			name: "Write records with idempotent producer",
+			opts: []WriteOptionFn{
+				WriteIdempotent(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			cluster := newCluster(t, 1, topic)
+			brokers := cluster.ListenAddrs()
+
+			p, s := beam.NewPipelineWithRoot()
+
+			col := beam.Create(s, input...)
+			Write(s, brokers, topic, col, tt.opts...)
+
+			ptest.RunAndValidate(t, p)
+
+			records := consumeRecords(ctx, t, brokers, topic, len(want))
+			var got []ConsumerRecord
+			for _, r := range records {
+				got = append(got, createConsumerRecord(r))
+			}
+
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("records mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWrite_InvalidOptions(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Write() did not panic")
+		}
+	}()
+
+	_, s := beam.NewPipelineWithRoot()
+	col := beam.Create(s, ProducerRecord{Value: []byte("value")})
+	Write(s, []string{"localhost:9092"}, topic, col, WriteTransactional(""))
+}
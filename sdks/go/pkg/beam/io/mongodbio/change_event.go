@@ -0,0 +1,206 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbio
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*ChangeEvent)(nil)).Elem())
+}
+
+// OperationType is the type of operation that caused a change event.
+type OperationType string
+
+// The operation types of the change events emitted by ReadChangeStream.
+const (
+	OperationInsert       OperationType = "insert"
+	OperationUpdate       OperationType = "update"
+	OperationReplace      OperationType = "replace"
+	OperationDelete       OperationType = "delete"
+	OperationDrop         OperationType = "drop"
+	OperationRename       OperationType = "rename"
+	OperationDropDatabase OperationType = "dropDatabase"
+	OperationInvalidate   OperationType = "invalidate"
+)
+
+// ErrNoFullDocument is returned when decoding the full document of a change event that doesn't
+// have one.
+var ErrNoFullDocument = errors.New("change event has no full document")
+
+// ChangeEvent is a change event of a MongoDB change stream. The documents of the event are held as
+// raw BSON, and can be decoded into a struct with DecodeDocumentKey and DecodeFullDocument.
+//
+// DocumentKey holds the _id of the changed document, and the shard key for sharded collections.
+// FullDocument holds the inserted or replacing document of insert and replace events. Update
+// events only hold it when reading with a full document mode, see WithChangeStreamFullDocument.
+// UpdatedFields and RemovedFields describe the changes of update events.
+//
+// ClusterTime is the time of the oplog entry of the operation, and WallTime is the server time of
+// the operation, which is only reported by MongoDB 6.0 and later. ResumeToken can be passed to
+// WithChangeStreamResumeAfter to start a new read after the event.
+type ChangeEvent struct {
+	OperationType OperationType       `bson:"operationType"`
+	Database      string              `bson:"database"`
+	Collection    string              `bson:"collection,omitempty"`
+	DocumentKey   bson.Raw            `bson:"documentKey,omitempty"`
+	FullDocument  bson.Raw            `bson:"fullDocument,omitempty"`
+	UpdatedFields bson.Raw            `bson:"updatedFields,omitempty"`
+	RemovedFields []string            `bson:"removedFields,omitempty"`
+	ClusterTime   primitive.Timestamp `bson:"clusterTime"`
+	WallTime      time.Time           `bson:"wallTime,omitempty"`
+	ResumeToken   bson.Raw            `bson:"resumeToken"`
+}
+
+// DecodeDocumentKey decodes the document key of the event into v.
+func (e ChangeEvent) DecodeDocumentKey(v any) error {
+	if len(e.DocumentKey) == 0 {
+		return errors.New("change event has no document key")
+	}
+
+	if err := bson.Unmarshal(e.DocumentKey, v); err != nil {
+		return fmt.Errorf("error decoding document key: %w", err)
+	}
+
+	return nil
+}
+
+// DecodeFullDocument decodes the full document of the event into v. Returns ErrNoFullDocument if
+// the event has no full document.
+func (e ChangeEvent) DecodeFullDocument(v any) error {
+	if len(e.FullDocument) == 0 {
+		return ErrNoFullDocument
+	}
+
+	if err := bson.Unmarshal(e.FullDocument, v); err != nil {
+		return fmt.Errorf("error decoding full document: %w", err)
+	}
+
+	return nil
+}
+
+// eventTime returns the time of the event, which is the time of its cluster time. The wall time is
+// not used, since it is the server time of the operation and is not ordered with the cluster times
+// that the watermark follows.
+func (e ChangeEvent) eventTime() time.Time {
+	return clusterTime(e.ClusterTime)
+}
+
+// clusterTime returns the time of a cluster time, with second precision.
+func clusterTime(ts primitive.Timestamp) time.Time {
+	return time.Unix(int64(ts.T), 0)
+}
+
+type rawChangeEvent struct {
+	ID                bson.Raw            `bson:"_id"`
+	OperationType     string              `bson:"operationType"`
+	NS                rawNamespace        `bson:"ns"`
+	DocumentKey       bson.RawValue       `bson:"documentKey"`
+	FullDocument      bson.RawValue       `bson:"fullDocument"`
+	UpdateDescription *rawUpdate          `bson:"updateDescription"`
+	ClusterTime       primitive.Timestamp `bson:"clusterTime"`
+	WallTime          *primitive.DateTime `bson:"wallTime"`
+}
+
+type rawNamespace struct {
+	DB   string `bson:"db"`
+	Coll string `bson:"coll"`
+}
+
+type rawUpdate struct {
+	UpdatedFields bson.Raw `bson:"updatedFields"`
+	RemovedFields []string `bson:"removedFields"`
+}
+
+// parseChangeEvent parses a change event from the raw document returned by a change stream.
+func parseChangeEvent(doc bson.Raw) (ChangeEvent, error) {
+	var raw rawChangeEvent
+	if err := bson.Unmarshal(doc, &raw); err != nil {
+		return ChangeEvent{}, fmt.Errorf("error decoding change event: %w", err)
+	}
+
+	if len(raw.ID) == 0 {
+		return ChangeEvent{}, errors.New("change event has no resume token")
+	}
+
+	event := ChangeEvent{
+		OperationType: OperationType(raw.OperationType),
+		Database:      raw.NS.DB,
+		Collection:    raw.NS.Coll,
+		DocumentKey:   documentValue(raw.DocumentKey),
+		FullDocument:  documentValue(raw.FullDocument),
+		ClusterTime:   raw.ClusterTime,
+		ResumeToken:   raw.ID,
+	}
+
+	if raw.UpdateDescription != nil {
+		event.UpdatedFields = raw.UpdateDescription.UpdatedFields
+		event.RemovedFields = raw.UpdateDescription.RemovedFields
+	}
+
+	if raw.WallTime != nil {
+		event.WallTime = raw.WallTime.Time().UTC()
+	}
+
+	return event, nil
+}
+
+// documentValue returns the document held by the value, or nil if the value is missing, null or
+// not a document.
+func documentValue(v bson.RawValue) bson.Raw {
+	if v.Type != bsontype.EmbeddedDocument {
+		return nil
+	}
+
+	return v.Document()
+}
+
+// resumeTokenTime returns the cluster time encoded in a resume token. The _data field of a resume
+// token is a hex encoded KeyString starting with the cluster time of the event, or of the latest
+// oplog entry scanned by the server for a post batch resume token. Reports false if the token is not
+// in this format.
+func resumeTokenTime(token bson.Raw) (primitive.Timestamp, bool) {
+	data, ok := token.Lookup("_data").StringValueOK()
+	if !ok || len(data) < 18 {
+		return primitive.Timestamp{}, false
+	}
+
+	b, err := hex.DecodeString(data[:18])
+	if err != nil || b[0] != keyStringTimestampType {
+		return primitive.Timestamp{}, false
+	}
+
+	ts := primitive.Timestamp{
+		T: binary.BigEndian.Uint32(b[1:5]),
+		I: binary.BigEndian.Uint32(b[5:9]),
+	}
+
+	return ts, true
+}
+
+// keyStringTimestampType is the KeyString type byte of a BSON timestamp.
+const keyStringTimestampType = 130
@@ -0,0 +1,250 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbio
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func mustMarshal(t *testing.T, v any) bson.Raw {
+	t.Helper()
+
+	raw, err := bson.Marshal(v)
+	if err != nil {
+		t.Fatalf("error marshaling BSON: %v", err)
+	}
+
+	return raw
+}
+
+func Test_parseChangeEvent(t *testing.T) {
+	token := mustMarshal(t, bson.M{"_data": "8263"})
+	key := mustMarshal(t, bson.M{"_id": 1})
+	doc := mustMarshal(t, bson.M{"_id": 1, "field1": "value"})
+	updated := mustMarshal(t, bson.M{"field1": "value"})
+	wallTime := time.Date(2023, 4, 1, 12, 0, 0, int(500*time.Millisecond), time.UTC)
+
+	tests := []struct {
+		name string
+		doc  bson.D
+		want ChangeEvent
+	}{
+		{
+			name: "Parse insert event",
+			doc: bson.D{
+				{Key: "_id", Value: token},
+				{Key: "operationType", Value: "insert"},
+				{Key: "ns", Value: bson.M{"db": "db", "coll": "coll"}},
+				{Key: "documentKey", Value: key},
+				{Key: "fullDocument", Value: doc},
+				{Key: "clusterTime", Value: primitive.Timestamp{T: 1680350400, I: 1}},
+				{Key: "wallTime", Value: primitive.NewDateTimeFromTime(wallTime)},
+			},
+			want: ChangeEvent{
+				OperationType: OperationInsert,
+				Database:      "db",
+				Collection:    "coll",
+				DocumentKey:   key,
+				FullDocument:  doc,
+				ClusterTime:   primitive.Timestamp{T: 1680350400, I: 1},
+				WallTime:      wallTime,
+				ResumeToken:   token,
+			},
+		},
+		{
+			name: "Parse update event without full document",
+			doc: bson.D{
+				{Key: "_id", Value: token},
+				{Key: "operationType", Value: "update"},
+				{Key: "ns", Value: bson.M{"db": "db", "coll": "coll"}},
+				{Key: "documentKey", Value: key},
+				{Key: "fullDocument", Value: nil},
+				{Key: "updateDescription", Value: bson.M{
+					"updatedFields": updated,
+					"removedFields": bson.A{"field2"},
+				}},
+				{Key: "clusterTime", Value: primitive.Timestamp{T: 1680350400, I: 2}},
+			},
+			want: ChangeEvent{
+				OperationType: OperationUpdate,
+				Database:      "db",
+				Collection:    "coll",
+				DocumentKey:   key,
+				UpdatedFields: updated,
+				RemovedFields: []string{"field2"},
+				ClusterTime:   primitive.Timestamp{T: 1680350400, I: 2},
+				ResumeToken:   token,
+			},
+		},
+		{
+			name: "Parse drop database event",
+			doc: bson.D{
+				{Key: "_id", Value: token},
+				{Key: "operationType", Value: "dropDatabase"},
+				{Key: "ns", Value: bson.M{"db": "db"}},
+				{Key: "clusterTime", Value: primitive.Timestamp{T: 1680350400, I: 3}},
+			},
+			want: ChangeEvent{
+				OperationType: OperationDropDatabase,
+				Database:      "db",
+				ClusterTime:   primitive.Timestamp{T: 1680350400, I: 3},
+				ResumeToken:   token,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseChangeEvent(mustMarshal(t, tt.doc))
+			if err != nil {
+				t.Fatalf("parseChangeEvent() error = %v", err)
+			}
+
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("parseChangeEvent() mismatch (-want +got):\n%s", cmp.Diff(tt.want, got))
+			}
+		})
+	}
+}
+
+func Test_parseChangeEventError(t *testing.T) {
+	doc := mustMarshal(t, bson.M{"operationType": "insert"})
+
+	if _, err := parseChangeEvent(doc); err == nil {
+		t.Errorf("parseChangeEvent() error = nil, want error for event without resume token")
+	}
+}
+
+func TestChangeEvent_DecodeFullDocument(t *testing.T) {
+	type doc struct {
+		ID     int    `bson:"_id"`
+		Field1 string `bson:"field1"`
+	}
+
+	t.Run("Decode full document", func(t *testing.T) {
+		event := ChangeEvent{FullDocument: mustMarshal(t, doc{ID: 1, Field1: "value"})}
+
+		var got doc
+		if err := event.DecodeFullDocument(&got); err != nil {
+			t.Fatalf("DecodeFullDocument() error = %v", err)
+		}
+
+		if want := (doc{ID: 1, Field1: "value"}); got != want {
+			t.Errorf("DecodeFullDocument() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("Error when event has no full document", func(t *testing.T) {
+		var got doc
+		if err := (ChangeEvent{}).DecodeFullDocument(&got); !errors.Is(err, ErrNoFullDocument) {
+			t.Errorf("DecodeFullDocument() error = %v, want %v", err, ErrNoFullDocument)
+		}
+	})
+}
+
+func TestChangeEvent_DecodeDocumentKey(t *testing.T) {
+	event := ChangeEvent{DocumentKey: mustMarshal(t, bson.M{"_id": "id"})}
+
+	var got documentID
+	if err := event.DecodeDocumentKey(&got); err != nil {
+		t.Fatalf("DecodeDocumentKey() error = %v", err)
+	}
+
+	if got.ID != "id" {
+		t.Errorf("DecodeDocumentKey() = %v, want %v", got.ID, "id")
+	}
+}
+
+func TestChangeEvent_eventTime(t *testing.T) {
+	wallTime := time.Date(2023, 4, 1, 12, 0, 0, int(500*time.Millisecond), time.UTC)
+
+	tests := []struct {
+		name  string
+		event ChangeEvent
+		want  time.Time
+	}{
+		{
+			name: "Use cluster time when wall time is reported",
+			event: ChangeEvent{
+				ClusterTime: primitive.Timestamp{T: 1680350400, I: 1},
+				WallTime:    wallTime,
+			},
+			want: time.Unix(1680350400, 0),
+		},
+		{
+			name: "Use cluster time when wall time is not reported",
+			event: ChangeEvent{
+				ClusterTime: primitive.Timestamp{T: 1680350400, I: 1},
+			},
+			want: time.Unix(1680350400, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.event.eventTime(); !got.Equal(tt.want) {
+				t.Errorf("eventTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_resumeTokenTime(t *testing.T) {
+	tests := []struct {
+		name   string
+		token  bson.Raw
+		want   primitive.Timestamp
+		wantOK bool
+	}{
+		{
+			name:   "Parse cluster time of resume token",
+			token:  mustMarshal(t, bson.M{"_data": "826427F1C0000000022B022C0100296E5A1004"}),
+			want:   primitive.Timestamp{T: 0x6427F1C0, I: 2},
+			wantOK: true,
+		},
+		{
+			name:   "Not ok when data is too short",
+			token:  mustMarshal(t, bson.M{"_data": "8264"}),
+			wantOK: false,
+		},
+		{
+			name:   "Not ok when data does not start with a timestamp",
+			token:  mustMarshal(t, bson.M{"_data": "3C6427F1C0000000022B"}),
+			wantOK: false,
+		},
+		{
+			name:   "Not ok when token has no data",
+			token:  mustMarshal(t, bson.M{"_id": 1}),
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := resumeTokenTime(tt.token)
+			if ok != tt.wantOK {
+				t.Fatalf("resumeTokenTime() ok = %v, want %v", ok, tt.wantOK)
+			}
+
+			if got != tt.want {
+				t.Errorf("resumeTokenTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
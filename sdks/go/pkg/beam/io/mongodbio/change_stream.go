@@ -0,0 +1,366 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/sdf"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/internal/unbounded"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/log"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	defaultMaxAwaitTime = 1 * time.Second
+	maxReadTime         = 10 * time.Second
+	resumeDelay         = 1 * time.Second
+)
+
+func init() {
+	register.DoFn5x2[
+		context.Context, *unbounded.WatermarkEstimator, *sdf.LockRTracker, []byte,
+		func(beam.EventTime, ChangeEvent), sdf.ProcessContinuation, error,
+	](
+		&changeStreamFn{},
+	)
+	register.Emitter2[beam.EventTime, ChangeEvent]()
+}
+
+// ReadChangeStream reads the change stream of a MongoDB collection, database or deployment and
+// returns an unbounded PCollection<ChangeEvent>. The change stream of a database is read if
+// collection is empty, and the change stream of the whole deployment if database is also empty.
+// Change streams require a replica set or sharded cluster.
+//
+// The position in the change stream is tracked by its resume token, so reading continues after
+// the last read change event when the transform is checkpointed. By default, reading starts at
+// the time the pipeline starts reading. The elements are timestamped with the cluster time of
+// their operation, which the watermark follows, so no element is behind the watermark. Reading stops when the change stream is
+// invalidated, such as by dropping the watched collection.
+//
+// The ReadChangeStream transform has the required parameters:
+//   - s: the scope of the pipeline
+//   - uri: the MongoDB connection string
+//   - database: the MongoDB database to read the change stream of, or empty for the deployment
+//   - collection: the MongoDB collection to read the change stream of, or empty for the database
+//
+// The ReadChangeStream transform takes a variadic number of ChangeStreamOptionFn which can set the
+// ChangeStreamOption fields:
+//   - FullDocument: the full document mode of update events. Defaults to options.Default, which
+//     emits update events without the full document
+//   - Filter: a bson.M map that is used to filter the change events. Defaults to nil, which means
+//     no filter is applied
+//   - StartAtOperationTime: the time to start reading change events at
+//   - ResumeAfter: the resume token of the change event to start reading after. Takes precedence
+//     over StartAtOperationTime
+//   - MaxAwaitTime: the maximum time the server waits for new change events. Defaults to 1 second
+func ReadChangeStream(
+	s beam.Scope,
+	uri string,
+	database string,
+	collection string,
+	opts ...ChangeStreamOptionFn,
+) beam.PCollection {
+	s = s.Scope("mongodbio.ReadChangeStream")
+
+	if database == "" && collection != "" {
+		panic("mongodbio.ReadChangeStream: database must be set when collection is set")
+	}
+
+	option := &ChangeStreamOption{
+		MaxAwaitTime: defaultMaxAwaitTime,
+	}
+
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("mongodbio.ReadChangeStream: invalid option: %v", err))
+		}
+	}
+
+	imp := beam.Impulse(s)
+
+	return beam.ParDo(s, newChangeStreamFn(uri, database, collection, option), imp)
+}
+
+type changeStreamFn struct {
+	mongoDBFn
+	FullDocument         string
+	Filter               []byte
+	StartAtOperationTime primitive.Timestamp
+	ResumeAfter          []byte
+	MaxAwaitTime         time.Duration
+	filter               bson.M
+}
+
+func newChangeStreamFn(
+	uri string,
+	database string,
+	collection string,
+	option *ChangeStreamOption,
+) *changeStreamFn {
+	filter, err := encodeBSON[bson.M](option.Filter)
+	if err != nil {
+		panic(fmt.Sprintf("mongodbio.newChangeStreamFn: %v", err))
+	}
+
+	var startAt primitive.Timestamp
+	if !option.StartAtOperationTime.IsZero() {
+		startAt.T = uint32(option.StartAtOperationTime.Unix())
+	}
+
+	return &changeStreamFn{
+		mongoDBFn: mongoDBFn{
+			URI:        uri,
+			Database:   database,
+			Collection: collection,
+		},
+		FullDocument:         string(option.FullDocument),
+		Filter:               filter,
+		StartAtOperationTime: startAt,
+		ResumeAfter:          option.ResumeAfter,
+		MaxAwaitTime:         option.MaxAwaitTime,
+	}
+}
+
+func (fn *changeStreamFn) Setup(ctx context.Context) error {
+	var err error
+	if err = fn.mongoDBFn.Setup(ctx); err != nil {
+		return err
+	}
+
+	fn.filter, err = decodeBSON[bson.M](fn.Filter)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateInitialRestriction creates a restriction starting at the configured resume token or
+// operation time. Without either, the change stream is opened to obtain a resume token of the
+// current position, so that all work instances of the restriction read from the same position.
+func (fn *changeStreamFn) CreateInitialRestriction(
+	ctx context.Context,
+	_ []byte,
+) (changeStreamRestriction, error) {
+	if len(fn.ResumeAfter) != 0 {
+		return changeStreamRestriction{ResumeToken: fn.ResumeAfter}, nil
+	}
+
+	if !fn.StartAtOperationTime.IsZero() {
+		return changeStreamRestriction{StartAtOperationTime: fn.StartAtOperationTime}, nil
+	}
+
+	if err := fn.Setup(ctx); err != nil {
+		return changeStreamRestriction{}, err
+	}
+
+	cs, err := fn.watch(ctx, options.ChangeStream())
+	if err != nil {
+		return changeStreamRestriction{}, err
+	}
+	defer closeChangeStream(ctx, cs)
+
+	if token := cs.ResumeToken(); len(token) != 0 {
+		return changeStreamRestriction{ResumeToken: token}, nil
+	}
+
+	// Servers before MongoDB 4.0.7 don't report a resume token until the first change event.
+	now := primitive.Timestamp{T: uint32(time.Now().Unix())}
+
+	return changeStreamRestriction{StartAtOperationTime: now}, nil
+}
+
+// SplitRestriction returns the restriction unsplit, as a change stream can only be read in order.
+func (fn *changeStreamFn) SplitRestriction(
+	_ []byte,
+	rest changeStreamRestriction,
+) []changeStreamRestriction {
+	return []changeStreamRestriction{rest}
+}
+
+func (fn *changeStreamFn) CreateTracker(rest changeStreamRestriction) *sdf.LockRTracker {
+	return sdf.NewLockRTracker(newChangeStreamTracker(rest))
+}
+
+func (fn *changeStreamFn) RestrictionSize(_ []byte, _ changeStreamRestriction) float64 {
+	return 1
+}
+
+// TruncateRestriction stops the restriction, as change streams are unbounded.
+func (fn *changeStreamFn) TruncateRestriction(
+	rt *sdf.LockRTracker,
+	_ []byte,
+) changeStreamRestriction {
+	rest := rt.GetRestriction().(changeStreamRestriction)
+	rest.Stopped = true
+
+	return rest
+}
+
+func (fn *changeStreamFn) InitialWatermarkEstimatorState(
+	et beam.EventTime,
+	_ changeStreamRestriction,
+	_ []byte,
+) int64 {
+	return et.Milliseconds()
+}
+
+func (fn *changeStreamFn) CreateWatermarkEstimator(ms int64) *unbounded.WatermarkEstimator {
+	return &unbounded.WatermarkEstimator{State: ms}
+}
+
+func (fn *changeStreamFn) WatermarkEstimatorState(we *unbounded.WatermarkEstimator) int64 {
+	return we.State
+}
+
+// ProcessElement reads change events for up to maxReadTime, and then checkpoints so that reading
+// resumes after the last read change event. If no change events are pending, it checkpoints after
+// resumeDelay.
+func (fn *changeStreamFn) ProcessElement(
+	ctx context.Context,
+	we *unbounded.WatermarkEstimator,
+	rt *sdf.LockRTracker,
+	_ []byte,
+	emit func(beam.EventTime, ChangeEvent),
+) (sdf.ProcessContinuation, error) {
+	rest := rt.GetRestriction().(changeStreamRestriction)
+	if rest.Stopped {
+		return sdf.StopProcessing(), nil
+	}
+
+	cs, err := fn.watch(ctx, fn.changeStreamOptions(rest))
+	if err != nil {
+		return sdf.StopProcessing(), err
+	}
+	defer closeChangeStream(ctx, cs)
+
+	deadline := time.Now().Add(maxReadTime)
+	for time.Now().Before(deadline) {
+		if !cs.TryNext(ctx) {
+			if err := cs.Err(); err != nil {
+				return sdf.StopProcessing(), fmt.Errorf("error reading change stream: %w", err)
+			}
+
+			token := cs.ResumeToken()
+			if !rt.TryClaim(streamResult{resumeToken: token}) {
+				return sdf.StopProcessing(), rt.GetError()
+			}
+
+			// Without pending change events, later change events are expected to have a later
+			// cluster time than the latest oplog entry scanned by the server.
+			if ts, ok := resumeTokenTime(token); ok {
+				we.Advance(clusterTime(ts))
+			}
+
+			return sdf.ResumeProcessingIn(resumeDelay), nil
+		}
+
+		event, err := parseChangeEvent(cs.Current)
+		if err != nil {
+			return sdf.StopProcessing(), err
+		}
+
+		if event.OperationType == OperationInvalidate {
+			log.Infof(ctx, "Change stream of %s was invalidated", fn.namespace())
+			rt.TryClaim(streamResult{isInvalidated: true})
+
+			return sdf.StopProcessing(), rt.GetError()
+		}
+
+		if !rt.TryClaim(streamResult{resumeToken: event.ResumeToken}) {
+			return sdf.StopProcessing(), rt.GetError()
+		}
+
+		emit(mtime.FromTime(event.eventTime()), event)
+		we.Advance(event.eventTime())
+	}
+
+	return sdf.ResumeProcessingIn(0), nil
+}
+
+func (fn *changeStreamFn) changeStreamOptions(
+	rest changeStreamRestriction,
+) *options.ChangeStreamOptions {
+	opts := options.ChangeStream().SetMaxAwaitTime(fn.MaxAwaitTime)
+
+	if fn.FullDocument != "" {
+		opts.SetFullDocument(options.FullDocument(fn.FullDocument))
+	}
+
+	if len(rest.ResumeToken) != 0 {
+		opts.SetResumeAfter(rest.ResumeToken)
+	} else if !rest.StartAtOperationTime.IsZero() {
+		startAt := rest.StartAtOperationTime
+		opts.SetStartAtOperationTime(&startAt)
+	}
+
+	return opts
+}
+
+// watch opens the change stream of the collection, database or deployment of the fn.
+func (fn *changeStreamFn) watch(
+	ctx context.Context,
+	opts *options.ChangeStreamOptions,
+) (*mongo.ChangeStream, error) {
+	pipeline := mongo.Pipeline{}
+	if len(fn.filter) != 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: fn.filter}})
+	}
+
+	var cs *mongo.ChangeStream
+	var err error
+
+	switch {
+	case fn.Collection != "":
+		cs, err = fn.collection.Watch(ctx, pipeline, opts)
+	case fn.Database != "":
+		cs, err = fn.client.Database(fn.Database).Watch(ctx, pipeline, opts)
+	default:
+		cs, err = fn.client.Watch(ctx, pipeline, opts)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening change stream of %s: %w", fn.namespace(), err)
+	}
+
+	return cs, nil
+}
+
+func (fn *changeStreamFn) namespace() string {
+	switch {
+	case fn.Collection != "":
+		return fn.Database + "." + fn.Collection
+	case fn.Database != "":
+		return fn.Database
+	default:
+		return "deployment"
+	}
+}
+
+func closeChangeStream(ctx context.Context, cs *mongo.ChangeStream) {
+	if err := cs.Close(ctx); err != nil {
+		log.Errorf(ctx, "error closing change stream: %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbio
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ChangeStreamOption represents options for reading a change stream from MongoDB.
+type ChangeStreamOption struct {
+	FullDocument         options.FullDocument
+	Filter               bson.M
+	StartAtOperationTime time.Time
+	ResumeAfter          bson.Raw
+	MaxAwaitTime         time.Duration
+}
+
+// ChangeStreamOptionFn is a function that configures a ChangeStreamOption.
+type ChangeStreamOptionFn func(option *ChangeStreamOption) error
+
+// WithChangeStreamFullDocument configures the ChangeStreamOption to use the provided full document
+// mode. With options.UpdateLookup, update events contain the current version of the updated
+// document. With options.WhenAvailable or options.Required, they contain the post-image of the
+// update, which requires the collection to have changeStreamPreAndPostImages enabled.
+func WithChangeStreamFullDocument(fullDocument options.FullDocument) ChangeStreamOptionFn {
+	return func(o *ChangeStreamOption) error {
+		switch fullDocument {
+		case options.Default, options.Off, options.UpdateLookup, options.WhenAvailable,
+			options.Required:
+		default:
+			return fmt.Errorf("invalid full document mode: %q", fullDocument)
+		}
+
+		o.FullDocument = fullDocument
+		return nil
+	}
+}
+
+// WithChangeStreamFilter configures the ChangeStreamOption to use the provided filter. The filter
+// is applied to the change events with a $match stage, so it refers to their fields, such as
+// "operationType" or "fullDocument.<field>".
+func WithChangeStreamFilter(filter bson.M) ChangeStreamOptionFn {
+	return func(o *ChangeStreamOption) error {
+		o.Filter = filter
+		return nil
+	}
+}
+
+// WithChangeStreamStartAtOperationTime configures the ChangeStreamOption to start reading the
+// change events at the provided time, which must be within the oplog window of the deployment.
+func WithChangeStreamStartAtOperationTime(t time.Time) ChangeStreamOptionFn {
+	return func(o *ChangeStreamOption) error {
+		if t.IsZero() {
+			return errors.New("start at operation time must not be zero")
+		}
+
+		o.StartAtOperationTime = t
+		return nil
+	}
+}
+
+// WithChangeStreamResumeAfter configures the ChangeStreamOption to start reading the change events
+// after the event with the provided resume token, as found in ChangeEvent.ResumeToken.
+func WithChangeStreamResumeAfter(resumeToken bson.Raw) ChangeStreamOptionFn {
+	return func(o *ChangeStreamOption) error {
+		if err := resumeToken.Validate(); err != nil {
+			return fmt.Errorf("invalid resume token: %w", err)
+		}
+
+		o.ResumeAfter = resumeToken
+		return nil
+	}
+}
+
+// WithChangeStreamMaxAwaitTime configures the ChangeStreamOption to use the provided maximum time
+// that the server waits for new change events before returning an empty batch.
+func WithChangeStreamMaxAwaitTime(maxAwaitTime time.Duration) ChangeStreamOptionFn {
+	return func(o *ChangeStreamOption) error {
+		if maxAwaitTime <= 0 {
+			return errors.New("max await time must be greater than 0")
+		}
+
+		o.MaxAwaitTime = maxAwaitTime
+		return nil
+	}
+}
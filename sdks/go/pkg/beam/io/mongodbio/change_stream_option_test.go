@@ -0,0 +1,164 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbio
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestWithChangeStreamFullDocument(t *testing.T) {
+	tests := []struct {
+		name         string
+		fullDocument options.FullDocument
+		want         options.FullDocument
+		wantErr      bool
+	}{
+		{
+			name:         "Set full document to updateLookup",
+			fullDocument: options.UpdateLookup,
+			want:         options.UpdateLookup,
+			wantErr:      false,
+		},
+		{
+			name:         "Error when full document mode is invalid",
+			fullDocument: "invalid",
+			want:         "",
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var option ChangeStreamOption
+
+			if err := WithChangeStreamFullDocument(tt.fullDocument)(&option); (err != nil) != tt.wantErr {
+				t.Fatalf("WithChangeStreamFullDocument() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if option.FullDocument != tt.want {
+				t.Errorf("option.FullDocument = %v, want %v", option.FullDocument, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithChangeStreamStartAtOperationTime(t *testing.T) {
+	startAt := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		t       time.Time
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:    "Set start at operation time",
+			t:       startAt,
+			want:    startAt,
+			wantErr: false,
+		},
+		{
+			name:    "Error when start at operation time is zero",
+			t:       time.Time{},
+			want:    time.Time{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var option ChangeStreamOption
+
+			if err := WithChangeStreamStartAtOperationTime(tt.t)(&option); (err != nil) != tt.wantErr {
+				t.Fatalf("WithChangeStreamStartAtOperationTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !option.StartAtOperationTime.Equal(tt.want) {
+				t.Errorf("option.StartAtOperationTime = %v, want %v", option.StartAtOperationTime, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithChangeStreamResumeAfter(t *testing.T) {
+	token, err := bson.Marshal(bson.M{"_data": "token"})
+	if err != nil {
+		t.Fatalf("error marshaling BSON: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		token   bson.Raw
+		wantErr bool
+	}{
+		{
+			name:    "Set resume token",
+			token:   token,
+			wantErr: false,
+		},
+		{
+			name:    "Error when resume token is not a document",
+			token:   bson.Raw{0x01},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var option ChangeStreamOption
+
+			if err := WithChangeStreamResumeAfter(tt.token)(&option); (err != nil) != tt.wantErr {
+				t.Fatalf("WithChangeStreamResumeAfter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWithChangeStreamMaxAwaitTime(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxAwaitTime time.Duration
+		want         time.Duration
+		wantErr      bool
+	}{
+		{
+			name:         "Set max await time to 5s",
+			maxAwaitTime: 5 * time.Second,
+			want:         5 * time.Second,
+			wantErr:      false,
+		},
+		{
+			name:         "Error when max await time is 0",
+			maxAwaitTime: 0,
+			want:         0,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var option ChangeStreamOption
+
+			if err := WithChangeStreamMaxAwaitTime(tt.maxAwaitTime)(&option); (err != nil) != tt.wantErr {
+				t.Fatalf("WithChangeStreamMaxAwaitTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if option.MaxAwaitTime != tt.want {
+				t.Errorf("option.MaxAwaitTime = %v, want %v", option.MaxAwaitTime, tt.want)
+			}
+		})
+	}
+}
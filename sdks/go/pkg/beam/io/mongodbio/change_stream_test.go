@@ -0,0 +1,166 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbio
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/google/go-cmp/cmp"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func TestReadChangeStream(t *testing.T) {
+	_, s := beam.NewPipelineWithRoot()
+
+	col := ReadChangeStream(s, "mongodb://localhost:27017", "db", "coll")
+
+	if got, want := col.Type().Type(), reflect.TypeOf(ChangeEvent{}); got != want {
+		t.Errorf("ReadChangeStream() element type = %v, want %v", got, want)
+	}
+}
+
+func TestReadChangeStreamPanic(t *testing.T) {
+	tests := []struct {
+		name       string
+		database   string
+		collection string
+		opts       []ChangeStreamOptionFn
+	}{
+		{
+			name:       "Panic when collection is set without database",
+			database:   "",
+			collection: "coll",
+		},
+		{
+			name:       "Panic when option is invalid",
+			database:   "db",
+			collection: "coll",
+			opts:       []ChangeStreamOptionFn{WithChangeStreamMaxAwaitTime(0)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("ReadChangeStream() does not panic")
+				}
+			}()
+
+			_, s := beam.NewPipelineWithRoot()
+			ReadChangeStream(s, "mongodb://localhost:27017", tt.database, tt.collection, tt.opts...)
+		})
+	}
+}
+
+func Test_changeStreamFn_CreateInitialRestriction(t *testing.T) {
+	token := mustMarshal(t, bson.M{"_data": "token"})
+	startAt := time.Unix(1680350400, 0)
+
+	tests := []struct {
+		name   string
+		option *ChangeStreamOption
+		want   changeStreamRestriction
+	}{
+		{
+			name:   "Start after configured resume token",
+			option: &ChangeStreamOption{ResumeAfter: token, StartAtOperationTime: startAt},
+			want:   changeStreamRestriction{ResumeToken: token},
+		},
+		{
+			name:   "Start at configured operation time",
+			option: &ChangeStreamOption{StartAtOperationTime: startAt},
+			want: changeStreamRestriction{
+				StartAtOperationTime: primitive.Timestamp{T: 1680350400},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn := newChangeStreamFn("mongodb://localhost:27017", "db", "coll", tt.option)
+
+			got, err := fn.CreateInitialRestriction(context.Background(), nil)
+			if err != nil {
+				t.Fatalf("CreateInitialRestriction() error = %v", err)
+			}
+
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("CreateInitialRestriction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_changeStreamFn_changeStreamOptions(t *testing.T) {
+	token := mustMarshal(t, bson.M{"_data": "token"})
+	startAt := primitive.Timestamp{T: 1680350400}
+	fn := &changeStreamFn{
+		FullDocument: string(options.UpdateLookup),
+		MaxAwaitTime: time.Second,
+	}
+
+	t.Run("Resume after resume token", func(t *testing.T) {
+		opts := fn.changeStreamOptions(changeStreamRestriction{
+			ResumeToken:          token,
+			StartAtOperationTime: startAt,
+		})
+
+		if !cmp.Equal(opts.ResumeAfter, token) {
+			t.Errorf("ResumeAfter = %v, want %v", opts.ResumeAfter, token)
+		}
+
+		if opts.StartAtOperationTime != nil {
+			t.Errorf("StartAtOperationTime = %v, want nil", opts.StartAtOperationTime)
+		}
+
+		if *opts.FullDocument != options.UpdateLookup {
+			t.Errorf("FullDocument = %v, want %v", *opts.FullDocument, options.UpdateLookup)
+		}
+
+		if *opts.MaxAwaitTime != time.Second {
+			t.Errorf("MaxAwaitTime = %v, want %v", *opts.MaxAwaitTime, time.Second)
+		}
+	})
+
+	t.Run("Start at operation time without resume token", func(t *testing.T) {
+		opts := fn.changeStreamOptions(changeStreamRestriction{StartAtOperationTime: startAt})
+
+		if opts.ResumeAfter != nil {
+			t.Errorf("ResumeAfter = %v, want nil", opts.ResumeAfter)
+		}
+
+		if opts.StartAtOperationTime == nil || *opts.StartAtOperationTime != startAt {
+			t.Errorf("StartAtOperationTime = %v, want %v", opts.StartAtOperationTime, startAt)
+		}
+	})
+}
+
+func Test_changeStreamFn_TruncateRestriction(t *testing.T) {
+	token := mustMarshal(t, bson.M{"_data": "token"})
+	fn := &changeStreamFn{}
+	rt := fn.CreateTracker(changeStreamRestriction{ResumeToken: token})
+
+	got := fn.TruncateRestriction(rt, nil)
+
+	if want := (changeStreamRestriction{ResumeToken: token, Stopped: true}); !cmp.Equal(got, want) {
+		t.Errorf("TruncateRestriction() = %v, want %v", got, want)
+	}
+}
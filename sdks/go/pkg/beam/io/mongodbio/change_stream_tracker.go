@@ -0,0 +1,139 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbio
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*changeStreamRestriction)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*changeStreamTracker)(nil)))
+}
+
+// changeStreamRestriction represents the position to read a change stream from. ResumeToken is the
+// resume token to resume the change stream after. If it is empty, the change stream is started at
+// StartAtOperationTime. Stopped is whether no more change events are to be read, which is the case
+// for the primary restriction of a checkpoint, a truncated restriction and an invalidated change
+// stream.
+type changeStreamRestriction struct {
+	ResumeToken          bson.Raw            `bson:"resumeToken,omitempty"`
+	StartAtOperationTime primitive.Timestamp `bson:"startAtOperationTime"`
+	Stopped              bool                `bson:"stopped"`
+}
+
+// changeStreamTracker is a tracker of a changeStreamRestriction. Change events have no positions
+// to split at, so the restriction can only be checkpointed, which moves the change stream after the
+// last claimed resume token to the residual.
+type changeStreamTracker struct {
+	rest changeStreamRestriction
+	err  error
+}
+
+// newChangeStreamTracker creates a new changeStreamTracker tracking the provided
+// changeStreamRestriction.
+func newChangeStreamTracker(rest changeStreamRestriction) *changeStreamTracker {
+	return &changeStreamTracker{rest: rest}
+}
+
+// streamResult holds information about the position reached in a change stream. resumeToken is
+// the resume token of the last read change event, or the post batch resume token of the change
+// stream. isInvalidated is whether the change stream has been invalidated.
+type streamResult struct {
+	resumeToken   bson.Raw
+	isInvalidated bool
+}
+
+// TryClaim accepts a position representing a streamResult. The position is successfully claimed if
+// the tracker has not been stopped and the change stream has not been invalidated, in which case
+// the restriction moves to the resume token of the result.
+func (rt *changeStreamTracker) TryClaim(pos any) bool {
+	result, ok := pos.(streamResult)
+	if !ok {
+		rt.err = fmt.Errorf("invalid pos type: %T", pos)
+		return false
+	}
+
+	if rt.IsDone() {
+		return false
+	}
+
+	if result.isInvalidated {
+		rt.rest.Stopped = true
+		return false
+	}
+
+	if len(result.resumeToken) != 0 {
+		rt.rest.ResumeToken = result.resumeToken
+		rt.rest.StartAtOperationTime = primitive.Timestamp{}
+	}
+
+	return true
+}
+
+// GetError returns the error associated with the tracker, if any.
+func (rt *changeStreamTracker) GetError() error {
+	return rt.err
+}
+
+// TrySplit only splits at a fraction of 0, stopping the tracker and returning a residual that
+// resumes the change stream after the last claimed resume token. Other fractions return the full
+// restriction as the primary and nil as the residual.
+func (rt *changeStreamTracker) TrySplit(fraction float64) (primary, residual any, err error) {
+	if fraction < 0 || fraction > 1 {
+		return nil, nil, errors.New("fraction must be between 0 and 1")
+	}
+
+	if fraction != 0 || rt.IsDone() {
+		return rt.rest, nil, nil
+	}
+
+	residual = rt.rest
+	rt.rest.Stopped = true
+
+	return rt.rest, residual, nil
+}
+
+// GetProgress reports all work as remaining until the tracker is stopped, as the amount of change
+// events yet to be read is unknown.
+func (rt *changeStreamTracker) GetProgress() (done float64, remaining float64) {
+	if rt.IsDone() {
+		return 1, 0
+	}
+
+	return 0, 1
+}
+
+// IsDone returns true if the tracker has been stopped.
+func (rt *changeStreamTracker) IsDone() bool {
+	return rt.rest.Stopped
+}
+
+// GetRestriction returns a copy of the restriction the tracker is tracking.
+func (rt *changeStreamTracker) GetRestriction() any {
+	return rt.rest
+}
+
+// IsBounded returns false, as a change stream has an unbounded number of change events.
+func (*changeStreamTracker) IsBounded() bool {
+	return false
+}
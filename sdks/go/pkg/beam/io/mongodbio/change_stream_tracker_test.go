@@ -0,0 +1,151 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mongodbio
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func Test_changeStreamTracker_TryClaim(t *testing.T) {
+	token := mustMarshal(t, bson.M{"_data": "token"})
+	startAt := primitive.Timestamp{T: 1680350400}
+
+	tests := []struct {
+		name     string
+		rest     changeStreamRestriction
+		pos      any
+		wantOK   bool
+		wantRest changeStreamRestriction
+		wantErr  bool
+	}{
+		{
+			name:     "Claim resume token",
+			rest:     changeStreamRestriction{StartAtOperationTime: startAt},
+			pos:      streamResult{resumeToken: token},
+			wantOK:   true,
+			wantRest: changeStreamRestriction{ResumeToken: token},
+		},
+		{
+			name:     "Claim without resume token keeps restriction",
+			rest:     changeStreamRestriction{StartAtOperationTime: startAt},
+			pos:      streamResult{},
+			wantOK:   true,
+			wantRest: changeStreamRestriction{StartAtOperationTime: startAt},
+		},
+		{
+			name:     "Stop when change stream is invalidated",
+			rest:     changeStreamRestriction{ResumeToken: token},
+			pos:      streamResult{isInvalidated: true},
+			wantOK:   false,
+			wantRest: changeStreamRestriction{ResumeToken: token, Stopped: true},
+		},
+		{
+			name:     "Fail when tracker is stopped",
+			rest:     changeStreamRestriction{Stopped: true},
+			pos:      streamResult{resumeToken: token},
+			wantOK:   false,
+			wantRest: changeStreamRestriction{Stopped: true},
+		},
+		{
+			name:     "Error when position has invalid type",
+			rest:     changeStreamRestriction{},
+			pos:      token,
+			wantOK:   false,
+			wantRest: changeStreamRestriction{},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := newChangeStreamTracker(tt.rest)
+
+			if ok := rt.TryClaim(tt.pos); ok != tt.wantOK {
+				t.Errorf("TryClaim() = %v, want %v", ok, tt.wantOK)
+			}
+
+			if err := rt.GetError(); (err != nil) != tt.wantErr {
+				t.Errorf("GetError() = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got := rt.GetRestriction(); !cmp.Equal(got, tt.wantRest) {
+				t.Errorf("GetRestriction() = %v, want %v", got, tt.wantRest)
+			}
+		})
+	}
+}
+
+func Test_changeStreamTracker_TrySplit(t *testing.T) {
+	token := mustMarshal(t, bson.M{"_data": "token"})
+	claimed := mustMarshal(t, bson.M{"_data": "claimed"})
+
+	tests := []struct {
+		name         string
+		fraction     float64
+		wantPrimary  any
+		wantResidual any
+		wantDone     bool
+	}{
+		{
+			name:         "Checkpoint after the claimed resume token",
+			fraction:     0,
+			wantPrimary:  changeStreamRestriction{ResumeToken: claimed, Stopped: true},
+			wantResidual: changeStreamRestriction{ResumeToken: claimed},
+			wantDone:     true,
+		},
+		{
+			name:         "No split for fractions greater than 0",
+			fraction:     0.5,
+			wantPrimary:  changeStreamRestriction{ResumeToken: claimed},
+			wantResidual: nil,
+			wantDone:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := newChangeStreamTracker(changeStreamRestriction{ResumeToken: token})
+			rt.TryClaim(streamResult{resumeToken: claimed})
+
+			primary, residual, err := rt.TrySplit(tt.fraction)
+			if err != nil {
+				t.Fatalf("TrySplit() error = %v", err)
+			}
+
+			if !cmp.Equal(primary, tt.wantPrimary) {
+				t.Errorf("TrySplit() primary = %v, want %v", primary, tt.wantPrimary)
+			}
+
+			if !cmp.Equal(residual, tt.wantResidual) {
+				t.Errorf("TrySplit() residual = %v, want %v", residual, tt.wantResidual)
+			}
+
+			if done := rt.IsDone(); done != tt.wantDone {
+				t.Errorf("IsDone() = %v, want %v", done, tt.wantDone)
+			}
+		})
+	}
+}
+
+func Test_changeStreamTracker_TrySplitInvalidFraction(t *testing.T) {
+	rt := newChangeStreamTracker(changeStreamRestriction{})
+
+	if _, _, err := rt.TrySplit(1.5); err == nil {
+		t.Errorf("TrySplit() error = nil, want error for fraction 1.5")
+	}
+}
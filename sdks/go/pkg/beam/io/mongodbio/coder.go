@@ -35,6 +35,16 @@ func init() {
 		encodeRange,
 		decodeRange,
 	)
+	beam.RegisterCoder(
+		reflect.TypeOf((*changeStreamRestriction)(nil)).Elem(),
+		encodeChangeStreamRestriction,
+		decodeChangeStreamRestriction,
+	)
+	beam.RegisterCoder(
+		reflect.TypeOf((*ChangeEvent)(nil)).Elem(),
+		encodeChangeEvent,
+		decodeChangeEvent,
+	)
 	beam.RegisterCoder(
 		reflect.TypeOf((*primitive.ObjectID)(nil)).Elem(),
 		encodeObjectID,
@@ -59,6 +69,20 @@ func decodeRange(in []byte) (idRange, error) {
 	return decodeBSON[idRange](in)
 }
 
+func encodeChangeStreamRestriction(in changeStreamRestriction) ([]byte, error) {
+	return encodeBSON(in)
+}
+func decodeChangeStreamRestriction(in []byte) (changeStreamRestriction, error) {
+	return decodeBSON[changeStreamRestriction](in)
+}
+
+func encodeChangeEvent(in ChangeEvent) ([]byte, error) {
+	return encodeBSON(in)
+}
+func decodeChangeEvent(in []byte) (ChangeEvent, error) {
+	return decodeBSON[ChangeEvent](in)
+}
+
 func encodeBSON[T any](in T) ([]byte, error) {
 	out, err := bson.Marshal(in)
 	if err != nil {
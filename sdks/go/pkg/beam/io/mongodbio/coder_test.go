@@ -17,6 +17,7 @@ package mongodbio
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"go.mongodb.org/mongo-driver/bson"
@@ -123,3 +124,90 @@ func Test_encodeDecodeObjectID(t *testing.T) {
 		})
 	}
 }
+
+func Test_encodeDecodeChangeStreamRestriction(t *testing.T) {
+	tests := []struct {
+		name string
+		rest changeStreamRestriction
+	}{
+		{
+			name: "Encode/decode changeStreamRestriction with resume token",
+			rest: changeStreamRestriction{
+				ResumeToken: mustMarshal(t, bson.M{"_data": "token"}),
+			},
+		},
+		{
+			name: "Encode/decode stopped changeStreamRestriction with start at operation time",
+			rest: changeStreamRestriction{
+				StartAtOperationTime: primitive.Timestamp{T: 1680350400, I: 1},
+				Stopped:              true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := encodeChangeStreamRestriction(tt.rest)
+			if err != nil {
+				t.Fatalf("encodeChangeStreamRestriction() error = %v", err)
+			}
+
+			decoded, err := decodeChangeStreamRestriction(encoded)
+			if err != nil {
+				t.Fatalf("decodeChangeStreamRestriction() error = %v", err)
+			}
+
+			if diff := cmp.Diff(tt.rest, decoded); diff != "" {
+				t.Errorf("encode/decode mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_encodeDecodeChangeEvent(t *testing.T) {
+	tests := []struct {
+		name  string
+		event ChangeEvent
+	}{
+		{
+			name: "Encode/decode ChangeEvent",
+			event: ChangeEvent{
+				OperationType: OperationUpdate,
+				Database:      "db",
+				Collection:    "coll",
+				DocumentKey:   mustMarshal(t, bson.M{"_id": 1}),
+				FullDocument:  mustMarshal(t, bson.M{"_id": 1, "key": "val"}),
+				UpdatedFields: mustMarshal(t, bson.M{"key": "val"}),
+				RemovedFields: []string{"other"},
+				ClusterTime:   primitive.Timestamp{T: 1680350400, I: 1},
+				WallTime:      time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC),
+				ResumeToken:   mustMarshal(t, bson.M{"_data": "token"}),
+			},
+		},
+		{
+			name: "Encode/decode ChangeEvent without documents",
+			event: ChangeEvent{
+				OperationType: OperationDropDatabase,
+				Database:      "db",
+				ClusterTime:   primitive.Timestamp{T: 1680350400, I: 1},
+				ResumeToken:   mustMarshal(t, bson.M{"_data": "token"}),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := encodeChangeEvent(tt.event)
+			if err != nil {
+				t.Fatalf("encodeChangeEvent() error = %v", err)
+			}
+
+			decoded, err := decodeChangeEvent(encoded)
+			if err != nil {
+				t.Fatalf("decodeChangeEvent() error = %v", err)
+			}
+
+			if diff := cmp.Diff(tt.event, decoded); diff != "" {
+				t.Errorf("encode/decode mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/x/debug"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func ExampleRead_default() {
@@ -80,6 +81,25 @@ func ExampleRead_options() {
 	}
 }
 
+func ExampleReadChangeStream() {
+	beam.Init()
+	p, s := beam.NewPipelineWithRoot()
+
+	col := mongodbio.ReadChangeStream(
+		s,
+		"mongodb://localhost:27017/?replicaSet=rs0",
+		"demo",
+		"events",
+		mongodbio.WithChangeStreamFullDocument(options.UpdateLookup),
+		mongodbio.WithChangeStreamFilter(bson.M{"operationType": bson.M{"$in": bson.A{"insert", "update"}}}),
+	)
+	debug.Print(s, col)
+
+	if err := beamx.Run(context.Background(), p); err != nil {
+		log.Fatalf("Failed to execute job: %v", err)
+	}
+}
+
 func ExampleWrite_default() {
 	type Event struct {
 		ID        primitive.ObjectID `bson:"_id"`
@@ -0,0 +1,481 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquetio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+
+	"github.com/xitongsys/parquet-go/common"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/schema"
+)
+
+type filterOp int
+
+const (
+	opEq filterOp = iota
+	opNotEq
+	opLt
+	opLtEq
+	opGt
+	opGtEq
+)
+
+func (op filterOp) String() string {
+	switch op {
+	case opEq:
+		return "="
+	case opNotEq:
+		return "!="
+	case opLt:
+		return "<"
+	case opLtEq:
+		return "<="
+	case opGt:
+		return ">"
+	case opGtEq:
+		return ">="
+	default:
+		return fmt.Sprintf("filterOp(%d)", int(op))
+	}
+}
+
+// matches reports whether a comparison result between a column value and the
+// filter value satisfies the operator.
+func (op filterOp) matches(cmp int) bool {
+	switch op {
+	case opEq:
+		return cmp == 0
+	case opNotEq:
+		return cmp != 0
+	case opLt:
+		return cmp < 0
+	case opLtEq:
+		return cmp <= 0
+	case opGt:
+		return cmp > 0
+	case opGtEq:
+		return cmp >= 0
+	default:
+		return false
+	}
+}
+
+type valueKind int
+
+const (
+	kindInt valueKind = iota
+	kindFloat
+	kindBytes
+	kindBool
+)
+
+// filterValue is a serializable representation of a comparable column value.
+type filterValue struct {
+	Kind  valueKind
+	Int   int64
+	Float float64
+	Bytes []byte
+	Bool  bool
+}
+
+func newFilterValue(v any) (filterValue, error) {
+	switch x := v.(type) {
+	case int:
+		return filterValue{Kind: kindInt, Int: int64(x)}, nil
+	case int8:
+		return filterValue{Kind: kindInt, Int: int64(x)}, nil
+	case int16:
+		return filterValue{Kind: kindInt, Int: int64(x)}, nil
+	case int32:
+		return filterValue{Kind: kindInt, Int: int64(x)}, nil
+	case int64:
+		return filterValue{Kind: kindInt, Int: x}, nil
+	case uint8:
+		return filterValue{Kind: kindInt, Int: int64(x)}, nil
+	case uint16:
+		return filterValue{Kind: kindInt, Int: int64(x)}, nil
+	case uint32:
+		return filterValue{Kind: kindInt, Int: int64(x)}, nil
+	case uint:
+		return newUintFilterValue(uint64(x))
+	case uint64:
+		return newUintFilterValue(x)
+	case float32:
+		return filterValue{Kind: kindFloat, Float: float64(x)}, nil
+	case float64:
+		return filterValue{Kind: kindFloat, Float: x}, nil
+	case string:
+		return filterValue{Kind: kindBytes, Bytes: []byte(x)}, nil
+	case []byte:
+		return filterValue{Kind: kindBytes, Bytes: x}, nil
+	case bool:
+		return filterValue{Kind: kindBool, Bool: x}, nil
+	default:
+		return filterValue{}, fmt.Errorf("unsupported filter value type %T", v)
+	}
+}
+
+// newUintFilterValue converts an unsigned integer into a filterValue, which
+// holds integers as int64.
+func newUintFilterValue(x uint64) (filterValue, error) {
+	if x > math.MaxInt64 {
+		return filterValue{}, fmt.Errorf("unsigned filter value %v overflows int64", x)
+	}
+	return filterValue{Kind: kindInt, Int: int64(x)}, nil
+}
+
+// reflectFilterValue converts a struct field value into a filterValue. It
+// returns false for nil pointers and unsupported kinds.
+func reflectFilterValue(v reflect.Value) (filterValue, bool) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return filterValue{}, false
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return filterValue{Kind: kindInt, Int: v.Int()}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := v.Uint()
+		if u > math.MaxInt64 {
+			// Larger than any filter value, which keeps its order as a float.
+			return filterValue{Kind: kindFloat, Float: float64(u)}, true
+		}
+		return filterValue{Kind: kindInt, Int: int64(u)}, true
+	case reflect.Float32, reflect.Float64:
+		return filterValue{Kind: kindFloat, Float: v.Float()}, true
+	case reflect.String:
+		return filterValue{Kind: kindBytes, Bytes: []byte(v.String())}, true
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.Uint8 {
+			return filterValue{}, false
+		}
+		return filterValue{Kind: kindBytes, Bytes: v.Bytes()}, true
+	case reflect.Bool:
+		return filterValue{Kind: kindBool, Bool: v.Bool()}, true
+	default:
+		return filterValue{}, false
+	}
+}
+
+// compareValues compares a to b, returning false if the values are not
+// comparable with each other.
+func compareValues(a, b filterValue) (int, bool) {
+	switch {
+	case a.Kind == kindInt && b.Kind == kindInt:
+		switch {
+		case a.Int < b.Int:
+			return -1, true
+		case a.Int > b.Int:
+			return 1, true
+		default:
+			return 0, true
+		}
+	case (a.Kind == kindInt || a.Kind == kindFloat) && (b.Kind == kindInt || b.Kind == kindFloat):
+		af, bf := a.asFloat(), b.asFloat()
+		if math.IsNaN(af) || math.IsNaN(bf) {
+			return 0, false
+		}
+		switch {
+		case af < bf:
+			return -1, true
+		case af > bf:
+			return 1, true
+		default:
+			return 0, true
+		}
+	case a.Kind == kindBytes && b.Kind == kindBytes:
+		return bytes.Compare(a.Bytes, b.Bytes), true
+	case a.Kind == kindBool && b.Kind == kindBool:
+		switch {
+		case a.Bool == b.Bool:
+			return 0, true
+		case !a.Bool:
+			return -1, true
+		default:
+			return 1, true
+		}
+	default:
+		return 0, false
+	}
+}
+
+func (v filterValue) asFloat() float64 {
+	if v.Kind == kindInt {
+		return float64(v.Int)
+	}
+	return v.Float
+}
+
+// Filter is a comparison between a column and a constant value. Filters are
+// created with Eq, NotEq, Lt, LtEq, Gt and GtEq and passed to Read with
+// ReadFilter.
+//
+// Columns are referred to by their parquet name, as given in the "name" part of
+// the parquet struct tag. Nested columns are referred to by joining the names
+// along the path with dots, e.g. "address.city". Values may be of any integer,
+// floating point, string, []byte or bool type. Null values never match a filter.
+type Filter struct {
+	Column string
+	Op     filterOp
+	Value  filterValue
+
+	err error
+}
+
+func newFilter(column string, op filterOp, value any) Filter {
+	v, err := newFilterValue(value)
+	if err != nil {
+		err = fmt.Errorf("invalid filter on column %q: %v", column, err)
+	}
+	return Filter{Column: column, Op: op, Value: v, err: err}
+}
+
+// Eq returns a Filter matching rows where column is equal to value.
+func Eq(column string, value any) Filter {
+	return newFilter(column, opEq, value)
+}
+
+// NotEq returns a Filter matching rows where column is not equal to value.
+func NotEq(column string, value any) Filter {
+	return newFilter(column, opNotEq, value)
+}
+
+// Lt returns a Filter matching rows where column is less than value.
+func Lt(column string, value any) Filter {
+	return newFilter(column, opLt, value)
+}
+
+// LtEq returns a Filter matching rows where column is less than or equal to value.
+func LtEq(column string, value any) Filter {
+	return newFilter(column, opLtEq, value)
+}
+
+// Gt returns a Filter matching rows where column is greater than value.
+func Gt(column string, value any) Filter {
+	return newFilter(column, opGt, value)
+}
+
+// GtEq returns a Filter matching rows where column is greater than or equal to value.
+func GtEq(column string, value any) Filter {
+	return newFilter(column, opGtEq, value)
+}
+
+func (f Filter) String() string {
+	return fmt.Sprintf("%s %v %v", f.Column, f.Op, f.Value)
+}
+
+func (v filterValue) String() string {
+	switch v.Kind {
+	case kindInt:
+		return fmt.Sprint(v.Int)
+	case kindFloat:
+		return fmt.Sprint(v.Float)
+	case kindBytes:
+		return fmt.Sprintf("%q", v.Bytes)
+	default:
+		return fmt.Sprint(v.Bool)
+	}
+}
+
+// boundFilter is a Filter resolved against the schema of a struct type.
+type boundFilter struct {
+	Filter
+	// inPath is the internal parquet-go path of the column.
+	inPath string
+	// fieldIndex locates the column value in the struct.
+	fieldIndex []int
+	// unsigned reports whether the column holds unsigned integers, whose
+	// statistics can't be compared as signed values.
+	unsigned bool
+}
+
+// bindFilters resolves the columns of the given filters against the schema of t.
+func bindFilters(sh *schema.SchemaHandler, t reflect.Type, filters []Filter) ([]boundFilter, error) {
+	var bound []boundFilter
+	for _, f := range filters {
+		exPath := append([]string{sh.GetRootExName()}, strings.Split(f.Column, ".")...)
+		inPath, ok := sh.ExPathToInPath[common.PathToStr(exPath)]
+		if !ok {
+			return nil, fmt.Errorf("filter column %q is not present in type %v", f.Column, t)
+		}
+		idx, ok := sh.MapIndex[inPath]
+		if !ok || sh.SchemaElements[idx].GetNumChildren() > 0 {
+			return nil, fmt.Errorf("filter column %q in type %v is not a primitive column", f.Column, t)
+		}
+		fieldIndex, err := structFieldIndex(t, common.StrToPath(inPath)[1:])
+		if err != nil {
+			return nil, fmt.Errorf("filter column %q: %v", f.Column, err)
+		}
+		bound = append(bound, boundFilter{
+			Filter:     f,
+			inPath:     inPath,
+			fieldIndex: fieldIndex,
+			unsigned:   isUnsigned(sh.SchemaElements[idx]),
+		})
+	}
+	return bound, nil
+}
+
+// structFieldIndex returns the index sequence of the nested struct field with
+// the given Go field names.
+func structFieldIndex(t reflect.Type, names []string) ([]int, error) {
+	var index []int
+	for _, name := range names {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("field %v is not reachable through struct fields", strings.Join(names, "."))
+		}
+		sf, ok := t.FieldByName(name)
+		if !ok {
+			return nil, fmt.Errorf("no field %v in %v", name, t)
+		}
+		index = append(index, sf.Index...)
+		t = sf.Type
+	}
+	return index, nil
+}
+
+func isUnsigned(se *parquet.SchemaElement) bool {
+	if !se.IsSetConvertedType() {
+		return false
+	}
+	switch se.GetConvertedType() {
+	case parquet.ConvertedType_UINT_8, parquet.ConvertedType_UINT_16,
+		parquet.ConvertedType_UINT_32, parquet.ConvertedType_UINT_64:
+		return true
+	default:
+		return false
+	}
+}
+
+// matchesRow reports whether the given struct value satisfies the filter.
+func (f *boundFilter) matchesRow(v reflect.Value) bool {
+	for _, i := range f.fieldIndex {
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	fv, ok := reflectFilterValue(v)
+	if !ok {
+		return false
+	}
+	cmp, ok := compareValues(fv, f.Value)
+	return ok && f.Op.matches(cmp)
+}
+
+// mayMatchChunk reports whether a column chunk may contain values satisfying
+// the filter, based on its statistics. It returns true whenever the
+// statistics are missing or inconclusive.
+func (f *boundFilter) mayMatchChunk(md *parquet.ColumnMetaData) bool {
+	if f.unsigned || md == nil || md.Statistics == nil {
+		return true
+	}
+	stats := md.Statistics
+	if stats.NullCount != nil && *stats.NullCount == md.NumValues && md.NumValues > 0 {
+		// Only nulls, which never match.
+		return false
+	}
+	minB, maxB := stats.MinValue, stats.MaxValue
+	if minB == nil || maxB == nil {
+		if md.Type == parquet.Type_BYTE_ARRAY || md.Type == parquet.Type_FIXED_LEN_BYTE_ARRAY {
+			// The deprecated min and max fields use signed byte ordering.
+			return true
+		}
+		minB, maxB = stats.Min, stats.Max
+	}
+	min, ok := decodeStat(minB, md.Type)
+	if !ok {
+		return true
+	}
+	max, ok := decodeStat(maxB, md.Type)
+	if !ok {
+		return true
+	}
+	cmpMin, ok := compareValues(min, f.Value)
+	if !ok {
+		return true
+	}
+	cmpMax, ok := compareValues(max, f.Value)
+	if !ok {
+		return true
+	}
+
+	switch f.Op {
+	case opEq:
+		return cmpMin <= 0 && cmpMax >= 0
+	case opNotEq:
+		return cmpMin != 0 || cmpMax != 0
+	case opLt:
+		return cmpMin < 0
+	case opLtEq:
+		return cmpMin <= 0
+	case opGt:
+		return cmpMax > 0
+	case opGtEq:
+		return cmpMax >= 0
+	default:
+		return true
+	}
+}
+
+// decodeStat decodes a plain encoded statistics value of the given physical type.
+func decodeStat(b []byte, t parquet.Type) (filterValue, bool) {
+	switch t {
+	case parquet.Type_BOOLEAN:
+		if len(b) < 1 {
+			return filterValue{}, false
+		}
+		return filterValue{Kind: kindBool, Bool: b[0]&1 == 1}, true
+	case parquet.Type_INT32:
+		if len(b) != 4 {
+			return filterValue{}, false
+		}
+		return filterValue{Kind: kindInt, Int: int64(int32(binary.LittleEndian.Uint32(b)))}, true
+	case parquet.Type_INT64:
+		if len(b) != 8 {
+			return filterValue{}, false
+		}
+		return filterValue{Kind: kindInt, Int: int64(binary.LittleEndian.Uint64(b))}, true
+	case parquet.Type_FLOAT:
+		if len(b) != 4 {
+			return filterValue{}, false
+		}
+		return filterValue{Kind: kindFloat, Float: float64(math.Float32frombits(binary.LittleEndian.Uint32(b)))}, true
+	case parquet.Type_DOUBLE:
+		if len(b) != 8 {
+			return filterValue{}, false
+		}
+		return filterValue{Kind: kindFloat, Float: math.Float64frombits(binary.LittleEndian.Uint64(b))}, true
+	case parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY:
+		return filterValue{Kind: kindBytes, Bytes: b}, true
+	default:
+		return filterValue{}, false
+	}
+}
@@ -0,0 +1,182 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquetio
+
+import (
+	"encoding/binary"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/schema"
+)
+
+func int32Stat(v int32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, uint32(v))
+	return b
+}
+
+func bindStudentFilter(t *testing.T, f Filter) boundFilter {
+	t.Helper()
+	typ := reflect.TypeOf(Student{})
+	sh, err := schema.NewSchemaHandlerFromStruct(reflect.New(typ).Interface())
+	if err != nil {
+		t.Fatalf("NewSchemaHandlerFromStruct() failed: %v", err)
+	}
+	bound, err := bindFilters(sh, typ, []Filter{f})
+	if err != nil {
+		t.Fatalf("bindFilters(%v) failed: %v", f, err)
+	}
+	return bound[0]
+}
+
+func TestBoundFilter_mayMatchChunk(t *testing.T) {
+	// Row group with ages in [20, 30].
+	md := &parquet.ColumnMetaData{
+		Type:      parquet.Type_INT32,
+		NumValues: 10,
+		Statistics: &parquet.Statistics{
+			MinValue: int32Stat(20),
+			MaxValue: int32Stat(30),
+		},
+	}
+
+	tests := []struct {
+		filter Filter
+		want   bool
+	}{
+		{filter: Eq("age", 19), want: false},
+		{filter: Eq("age", 20), want: true},
+		{filter: Eq("age", 31), want: false},
+		{filter: NotEq("age", 25), want: true},
+		{filter: Lt("age", 20), want: false},
+		{filter: Lt("age", 21), want: true},
+		{filter: LtEq("age", 20), want: true},
+		{filter: Gt("age", 30), want: false},
+		{filter: Gt("age", 29.5), want: true},
+		{filter: GtEq("age", 30), want: true},
+		{filter: GtEq("age", int64(31)), want: false},
+		{filter: Eq("age", uint(20)), want: true},
+		{filter: GtEq("age", uint64(31)), want: false},
+		{filter: Eq("age", "20"), want: true}, // Not comparable, so inconclusive.
+	}
+	for _, test := range tests {
+		f := bindStudentFilter(t, test.filter)
+		if got := f.mayMatchChunk(md); got != test.want {
+			t.Errorf("%v: mayMatchChunk() = %v, want %v", test.filter, got, test.want)
+		}
+	}
+}
+
+func TestBoundFilter_mayMatchChunk_special(t *testing.T) {
+	nulls := int64(10)
+	tests := []struct {
+		name   string
+		filter Filter
+		md     *parquet.ColumnMetaData
+		want   bool
+	}{
+		{
+			name:   "no statistics",
+			filter: Eq("age", 1),
+			md:     &parquet.ColumnMetaData{Type: parquet.Type_INT32, NumValues: 10},
+			want:   true,
+		},
+		{
+			name:   "only nulls",
+			filter: NotEq("age", 1),
+			md: &parquet.ColumnMetaData{
+				Type:       parquet.Type_INT32,
+				NumValues:  10,
+				Statistics: &parquet.Statistics{NullCount: &nulls},
+			},
+			want: false,
+		},
+		{
+			name:   "single value not equal",
+			filter: NotEq("age", 5),
+			md: &parquet.ColumnMetaData{
+				Type:       parquet.Type_INT32,
+				NumValues:  10,
+				Statistics: &parquet.Statistics{MinValue: int32Stat(5), MaxValue: int32Stat(5)},
+			},
+			want: false,
+		},
+		{
+			name:   "byte array",
+			filter: Lt("name", "Alice"),
+			md: &parquet.ColumnMetaData{
+				Type:       parquet.Type_BYTE_ARRAY,
+				NumValues:  10,
+				Statistics: &parquet.Statistics{MinValue: []byte("Bob"), MaxValue: []byte("Zed")},
+			},
+			want: false,
+		},
+		{
+			name:   "deprecated byte array statistics",
+			filter: Lt("name", "Alice"),
+			md: &parquet.ColumnMetaData{
+				Type:       parquet.Type_BYTE_ARRAY,
+				NumValues:  10,
+				Statistics: &parquet.Statistics{Min: []byte("Bob"), Max: []byte("Zed")},
+			},
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f := bindStudentFilter(t, test.filter)
+			if got := f.mayMatchChunk(test.md); got != test.want {
+				t.Errorf("%v: mayMatchChunk() = %v, want %v", test.filter, got, test.want)
+			}
+		})
+	}
+}
+
+func TestBoundFilter_matchesRow(t *testing.T) {
+	student := Student{Name: "Bob", Age: 20, Id: 7, Weight: 50.5, Sex: true}
+
+	tests := []struct {
+		filter Filter
+		want   bool
+	}{
+		{filter: Eq("name", "Bob"), want: true},
+		{filter: Eq("name", []byte("Bob")), want: true},
+		{filter: Gt("name", "Carl"), want: false},
+		{filter: Eq("age", 20), want: true},
+		{filter: LtEq("id", int64(6)), want: false},
+		{filter: Gt("weight", 50), want: true},
+		{filter: Eq("sex", false), want: false},
+		{filter: Eq("sex", "true"), want: false},
+	}
+	for _, test := range tests {
+		f := bindStudentFilter(t, test.filter)
+		if got := f.matchesRow(reflect.ValueOf(student)); got != test.want {
+			t.Errorf("%v: matchesRow(%+v) = %v, want %v", test.filter, student, got, test.want)
+		}
+	}
+}
+
+func TestNewFilter_unsigned(t *testing.T) {
+	if f := Eq("id", uint64(math.MaxInt64)); f.err != nil {
+		t.Errorf("Eq(id, MaxInt64) failed: %v", f.err)
+	}
+	if f := Eq("id", uint64(math.MaxUint64)); f.err == nil {
+		t.Error("Eq(id, MaxUint64) succeeded, want an overflow error")
+	}
+}
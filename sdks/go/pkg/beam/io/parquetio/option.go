@@ -0,0 +1,139 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquetio
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/xitongsys/parquet-go/parquet"
+)
+
+var (
+	errInvalidRowGroupSize = errors.New("row group size must be greater than 0")
+	errInvalidPageSize     = errors.New("page size must be greater than 0")
+)
+
+type readOption struct {
+	Filters []Filter
+}
+
+// ReadOptionFn is a function that can be passed to Read or ReadAll to configure options for
+// reading parquet files.
+type ReadOptionFn func(*readOption) error
+
+// ReadFilter specifies filters that elements must satisfy to be output. The filters are also
+// evaluated against the statistics of each row group, so that row groups that cannot contain
+// matching rows are skipped without being decoded. Multiple filters are combined with AND.
+func ReadFilter(filters ...Filter) ReadOptionFn {
+	return func(o *readOption) error {
+		for _, f := range filters {
+			if f.err != nil {
+				return f.err
+			}
+		}
+		o.Filters = append(o.Filters, filters...)
+		return nil
+	}
+}
+
+// Compression is a compression codec used for the pages of a parquet file.
+type Compression int
+
+const (
+	// CompressionSnappy compresses pages with snappy. This is the default.
+	CompressionSnappy Compression = iota
+	// CompressionUncompressed leaves pages uncompressed.
+	CompressionUncompressed
+	// CompressionGzip compresses pages with gzip.
+	CompressionGzip
+	// CompressionZstd compresses pages with zstd.
+	CompressionZstd
+	// CompressionLZ4 compresses pages with lz4.
+	CompressionLZ4
+)
+
+func (c Compression) codec() (parquet.CompressionCodec, error) {
+	switch c {
+	case CompressionSnappy:
+		return parquet.CompressionCodec_SNAPPY, nil
+	case CompressionUncompressed:
+		return parquet.CompressionCodec_UNCOMPRESSED, nil
+	case CompressionGzip:
+		return parquet.CompressionCodec_GZIP, nil
+	case CompressionZstd:
+		return parquet.CompressionCodec_ZSTD, nil
+	case CompressionLZ4:
+		return parquet.CompressionCodec_LZ4, nil
+	default:
+		return 0, fmt.Errorf("unknown compression %d", c)
+	}
+}
+
+const (
+	defaultRowGroupSize = 128 * 1024 * 1024 // 128 MB
+	defaultPageSize     = 8 * 1024          // 8 KB
+)
+
+type writeOption struct {
+	RowGroupSize int64
+	PageSize     int64
+	Codec        parquet.CompressionCodec
+}
+
+// WriteOptionFn is a function that can be passed to Write to configure options for writing
+// parquet files.
+type WriteOptionFn func(*writeOption) error
+
+// WriteRowGroupSize sets the approximate size of each row group in bytes. Defaults to 128 MB.
+// Smaller row groups allow reads to be split more finely at the cost of a larger footer and
+// less effective compression.
+func WriteRowGroupSize(size int64) WriteOptionFn {
+	return func(o *writeOption) error {
+		if size <= 0 {
+			return errInvalidRowGroupSize
+		}
+
+		o.RowGroupSize = size
+		return nil
+	}
+}
+
+// WritePageSize sets the approximate size of each data page in bytes. Defaults to 8 KB.
+func WritePageSize(size int64) WriteOptionFn {
+	return func(o *writeOption) error {
+		if size <= 0 {
+			return errInvalidPageSize
+		}
+
+		o.PageSize = size
+		return nil
+	}
+}
+
+// WriteCompression sets the compression codec used for data pages. Defaults to
+// CompressionSnappy.
+func WriteCompression(c Compression) WriteOptionFn {
+	return func(o *writeOption) error {
+		codec, err := c.codec()
+		if err != nil {
+			return err
+		}
+
+		o.Codec = codec
+		return nil
+	}
+}
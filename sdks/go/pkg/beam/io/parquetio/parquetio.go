@@ -18,21 +18,26 @@ package parquetio
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/sdf"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/fileio"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/filesystem"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/rtrackers/offsetrange"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/log"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
-	"github.com/xitongsys/parquet-go-source/buffer"
-	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/schema"
 	"github.com/xitongsys/parquet-go/writer"
 )
 
 func init() {
 	register.Emitter1[string]()
 
-	register.DoFn3x1[context.Context, fileio.ReadableFile, func(beam.X), error](&parquetReadFn{})
+	register.DoFn4x1[context.Context, *sdf.LockRTracker, fileio.ReadableFile, func(beam.X), error](&parquetReadFn{})
 	register.Emitter1[beam.X]()
 
 	register.DoFn3x1[context.Context, int, func(*beam.X) bool, error](&parquetWriteFn{})
@@ -52,49 +57,180 @@ func init() {
 //	  Day     int32   `parquet:"name=day, type=INT32, convertedtype=DATE"`
 //	  Ignored int32   //without parquet tag and won't write
 //	}
-func Read(s beam.Scope, glob string, t reflect.Type) beam.PCollection {
+//
+// Only the columns present in the struct type are decoded, so a struct with a
+// subset of the columns of the files can be used to read just those columns.
+// Files on filesystems with ranged reads (filesystem.RangeReader) are split
+// by row group, allowing runners that support splitting to read the row
+// groups of a file in parallel. Each row group restriction reads only the
+// footer and its own column chunks. Files on other filesystems are read whole.
+//
+// Read accepts a variadic number of ReadOptionFn to configure the read:
+//   - ReadFilter: filters that elements must satisfy, which are also used to
+//     skip row groups based on their column statistics.
+func Read(s beam.Scope, glob string, t reflect.Type, opts ...ReadOptionFn) beam.PCollection {
 	s = s.Scope("parquetio.Read")
 	filesystem.ValidateScheme(glob)
-	return read(s, t, beam.Create(s, glob))
+	return read(s, t, beam.Create(s, glob), opts...)
 }
 
-func read(s beam.Scope, t reflect.Type, col beam.PCollection) beam.PCollection {
+func read(s beam.Scope, t reflect.Type, col beam.PCollection, opts ...ReadOptionFn) beam.PCollection {
+	option := &readOption{}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("parquetio.Read: invalid option: %v", err))
+		}
+	}
+	fn := &parquetReadFn{Type: beam.EncodedType{T: t}, Filters: option.Filters}
+	if err := fn.Setup(); err != nil {
+		panic(fmt.Sprintf("parquetio.Read: %v", err))
+	}
+
 	matches := fileio.MatchAll(s, col, fileio.MatchEmptyAllow())
 	files := fileio.ReadMatches(s, matches, fileio.ReadUncompressed())
 	return beam.ParDo(s,
-		&parquetReadFn{Type: beam.EncodedType{T: t}},
+		fn,
 		files,
 		beam.TypeDefinition{Var: beam.XType, T: t},
 	)
 }
 
+// parquetReadFn is an SDF that reads the row groups of a parquet file. Its
+// restriction is the range of row group indices of the file.
 type parquetReadFn struct {
-	Type beam.EncodedType
+	Type    beam.EncodedType
+	Filters []Filter
+
+	sh      *schema.SchemaHandler
+	filters []boundFilter
 }
 
-func (a *parquetReadFn) ProcessElement(ctx context.Context, file fileio.ReadableFile, emit func(beam.X)) error {
-	data, err := file.Read(ctx)
+func (a *parquetReadFn) Setup() error {
+	sh, err := schema.NewSchemaHandlerFromStruct(reflect.New(a.Type.T).Interface())
+	if err != nil {
+		return errors.Wrapf(err, "invalid parquet type %v", a.Type.T)
+	}
+	filters, err := bindFilters(sh, a.Type.T, a.Filters)
 	if err != nil {
 		return err
 	}
+	a.sh = sh
+	a.filters = filters
+	return nil
+}
 
-	bufferReader := buffer.NewBufferFileFromBytes(data)
-	parquetReader, err := reader.NewParquetReader(bufferReader, reflect.New(a.Type.T).Interface(), 4)
+// CreateInitialRestriction creates an offset range restriction over the
+// indices of the file's row groups.
+func (a *parquetReadFn) CreateInitialRestriction(ctx context.Context, file fileio.ReadableFile) (offsetrange.Restriction, error) {
+	f, err := openParquetFile(ctx, file)
 	if err != nil {
-		return err
+		return offsetrange.Restriction{}, err
+	}
+	defer f.Close()
+	footer, err := readFooter(ctx, f)
+	if err != nil {
+		return offsetrange.Restriction{}, err
+	}
+	return offsetrange.Restriction{
+		Start: 0,
+		End:   int64(len(footer.RowGroups)),
+	}, nil
+}
+
+// SplitRestriction splits each file restriction into one restriction per row
+// group, if the row groups can be read individually.
+func (a *parquetReadFn) SplitRestriction(file fileio.ReadableFile, rest offsetrange.Restriction) []offsetrange.Restriction {
+	if !splittable(file.Metadata.Path) {
+		return []offsetrange.Restriction{rest}
 	}
+	return rest.SizedSplits(1)
+}
+
+// RestrictionSize returns the size of each restriction as its number of row
+// groups.
+func (a *parquetReadFn) RestrictionSize(_ fileio.ReadableFile, rest offsetrange.Restriction) float64 {
+	return rest.Size()
+}
 
-	vals, err := parquetReader.ReadByNumber(int(parquetReader.GetNumRows()))
+// CreateTracker creates sdf.LockRTrackers wrapping offsetRange.Trackers for
+// each restriction.
+func (a *parquetReadFn) CreateTracker(rest offsetrange.Restriction) *sdf.LockRTracker {
+	return sdf.NewLockRTracker(offsetrange.NewTracker(rest))
+}
+
+func (a *parquetReadFn) ProcessElement(ctx context.Context, rt *sdf.LockRTracker, file fileio.ReadableFile, emit func(beam.X)) error {
+	rest := rt.GetRestriction().(offsetrange.Restriction)
+	if rest.Start >= rest.End {
+		return nil
+	}
+	log.Infof(ctx, "Reading row groups [%d, %d) from %v", rest.Start, rest.End, file.Metadata.Path)
+
+	f, err := openParquetFile(ctx, file)
 	if err != nil {
 		return err
 	}
-	for _, v := range vals {
-		emit(v)
+	defer f.Close()
+	footer, err := readFooter(ctx, f)
+	if err != nil {
+		return err
+	}
+	r, err := newRowGroupReader(footer, a.sh, a.Type.T)
+	if err != nil {
+		return errors.WithContextf(err, "reading %v", file.Metadata.Path)
 	}
 
+	for i := rest.Start; rt.TryClaim(i); i++ {
+		if i >= r.numRowGroups() {
+			return errors.Errorf("row group %d of %v does not exist, the file has %d row groups",
+				i, file.Metadata.Path, r.numRowGroups())
+		}
+		if !a.mayMatchRowGroup(r, i) {
+			continue
+		}
+		chunks, err := f.readChunks(ctx, r.chunks[i])
+		if err != nil {
+			return errors.WithContextf(err, "reading %v", file.Metadata.Path)
+		}
+		vals, err := r.read(chunks, i)
+		if err != nil {
+			return errors.WithContextf(err, "reading %v", file.Metadata.Path)
+		}
+		for _, v := range vals {
+			if a.matchesRow(v) {
+				emit(v)
+			}
+		}
+	}
 	return nil
 }
 
+// mayMatchRowGroup reports whether the statistics of the i-th row group allow
+// it to contain rows that satisfy all filters.
+func (a *parquetReadFn) mayMatchRowGroup(r *rowGroupReader, i int64) bool {
+	for j := range a.filters {
+		f := &a.filters[j]
+		c := r.column(i, f.inPath)
+		if c != nil && !f.mayMatchChunk(c.MetaData) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesRow reports whether the value satisfies all filters.
+func (a *parquetReadFn) matchesRow(v any) bool {
+	if len(a.filters) == 0 {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	for j := range a.filters {
+		if !a.filters[j].matchesRow(rv) {
+			return false
+		}
+	}
+	return true
+}
+
 // Write writes a PCollection<parquetStruct> to .parquet file.
 // Write expects elements of a struct type with parquet tags
 // For example:
@@ -108,18 +244,44 @@ func (a *parquetReadFn) ProcessElement(ctx context.Context, file fileio.Readable
 //	  Day     int32   `parquet:"name=day, type=INT32, convertedtype=DATE"`
 //	  Ignored int32   //without parquet tag and won't write
 //	}
-func Write(s beam.Scope, filename string, col beam.PCollection) {
+//
+// Write accepts a variadic number of WriteOptionFn to configure the write:
+//   - WriteRowGroupSize: the approximate size of each row group in bytes. Defaults to 128 MB.
+//   - WritePageSize: the approximate size of each data page in bytes. Defaults to 8 KB.
+//   - WriteCompression: the compression codec of data pages. Defaults to CompressionSnappy.
+func Write(s beam.Scope, filename string, col beam.PCollection, opts ...WriteOptionFn) {
 	t := col.Type().Type()
 	s = s.Scope("parquetio.Write")
 	filesystem.ValidateScheme(filename)
+
+	option := &writeOption{
+		RowGroupSize: defaultRowGroupSize,
+		PageSize:     defaultPageSize,
+		Codec:        parquet.CompressionCodec_SNAPPY,
+	}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("parquetio.Write: invalid option: %v", err))
+		}
+	}
+
 	pre := beam.AddFixedKey(s, col)
 	post := beam.GroupByKey(s, pre)
-	beam.ParDo0(s, &parquetWriteFn{Filename: filename, Type: beam.EncodedType{T: t}}, post)
+	beam.ParDo0(s, &parquetWriteFn{
+		Filename:     filename,
+		Type:         beam.EncodedType{T: t},
+		RowGroupSize: option.RowGroupSize,
+		PageSize:     option.PageSize,
+		Codec:        option.Codec,
+	}, post)
 }
 
 type parquetWriteFn struct {
-	Type     beam.EncodedType
-	Filename string `json:"filename"`
+	Type         beam.EncodedType
+	Filename     string `json:"filename"`
+	RowGroupSize int64
+	PageSize     int64
+	Codec        parquet.CompressionCodec
 }
 
 func (a *parquetWriteFn) ProcessElement(ctx context.Context, _ int, iter func(*beam.X) bool) error {
@@ -135,10 +297,17 @@ func (a *parquetWriteFn) ProcessElement(ctx context.Context, _ int, iter func(*b
 	}
 
 	defer fd.Close()
-	pw, err := writer.NewParquetWriterFromWriter(fd, reflect.New(a.Type.T).Interface(), 4)
+	pw, err := writer.NewParquetWriterFromWriter(fd, reflect.New(a.Type.T).Interface(), parallelism)
 	if err != nil {
 		return err
 	}
+	if a.RowGroupSize > 0 {
+		pw.RowGroupSize = a.RowGroupSize
+	}
+	if a.PageSize > 0 {
+		pw.PageSize = a.PageSize
+	}
+	pw.CompressionType = a.Codec
 
 	var val beam.X
 	for iter(&val) {
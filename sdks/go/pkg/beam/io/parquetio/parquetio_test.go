@@ -17,7 +17,9 @@ package parquetio
 
 import (
 	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -26,6 +28,7 @@ import (
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
 	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/parquet"
 	"github.com/xitongsys/parquet-go/reader"
 )
 
@@ -124,3 +127,169 @@ func TestWrite(t *testing.T) {
 		t.Fatalf("students differs from studentList. got %+v, expected %+v", students, studentList)
 	}
 }
+
+type StudentSummary struct {
+	Age  int32  `parquet:"name=age, type=INT32, encoding=PLAIN"`
+	Name string `parquet:"name=name, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+}
+
+func TestRead_projection(t *testing.T) {
+	parquetFile := "../../../../data/student.parquet"
+	p := beam.NewPipeline()
+	s := p.Root()
+	students := Read(s, parquetFile, reflect.TypeOf(StudentSummary{}))
+	passert.Equals(s, students,
+		StudentSummary{Age: 20, Name: "StudentName"},
+		StudentSummary{Age: 21, Name: "StudentName"},
+	)
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestRead_filter(t *testing.T) {
+	parquetFile := "../../../../data/student.parquet"
+	p := beam.NewPipeline()
+	s := p.Root()
+	students := Read(s, parquetFile, reflect.TypeOf(StudentSummary{}), ReadFilter(Gt("age", 20)))
+	passert.Equals(s, students, StudentSummary{Age: 21, Name: "StudentName"})
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestRead_invalidFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter Filter
+	}{
+		{name: "unknown column", filter: Eq("unknown", 1)},
+		{name: "column not in type", filter: Eq("id", 1)},
+		{name: "unsupported value", filter: Eq("age", struct{}{})},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Read() with filter %v did not panic", test.filter)
+				}
+			}()
+			p := beam.NewPipeline()
+			Read(p.Root(), "../../../../data/student.parquet", reflect.TypeOf(StudentSummary{}), ReadFilter(test.filter))
+		})
+	}
+}
+
+func makeStudents(n int) []any {
+	students := make([]any, n)
+	for i := range students {
+		students[i] = Student{
+			Name:   fmt.Sprintf("Student%04d", i),
+			Age:    int32(18 + i%10),
+			Id:     int64(i),
+			Weight: 50,
+			Sex:    i%2 == 0,
+			Day:    19089,
+		}
+	}
+	return students
+}
+
+func writeStudents(t *testing.T, students []any, opts ...WriteOptionFn) string {
+	t.Helper()
+	parquetFile := filepath.Join(t.TempDir(), "students.parquet")
+	p, s, col := ptest.CreateList(students)
+	Write(s, parquetFile, col, opts...)
+	ptest.RunAndValidate(t, p)
+	return parquetFile
+}
+
+func readFileFooter(t *testing.T, parquetFile string) *parquet.FileMetaData {
+	t.Helper()
+	pf, err := local.NewLocalFileReader(parquetFile)
+	if err != nil {
+		t.Fatalf("Failed to read file %v. err: %v", parquetFile, err)
+	}
+	defer pf.Close()
+	pr, err := reader.NewParquetReader(pf, nil, 1)
+	if err != nil {
+		t.Fatalf("Failed to create parquet reader %v. err: %v", parquetFile, err)
+	}
+	defer pr.ReadStop()
+	return pr.Footer
+}
+
+func TestWrite_options(t *testing.T) {
+	students := makeStudents(500)
+	parquetFile := writeStudents(t, students,
+		WriteRowGroupSize(1024), WritePageSize(64), WriteCompression(CompressionGzip))
+
+	footer := readFileFooter(t, parquetFile)
+	if got := len(footer.RowGroups); got < 2 {
+		t.Fatalf("got %d row groups, want more than 1", got)
+	}
+	for i, rg := range footer.RowGroups {
+		for _, c := range rg.Columns {
+			if got, want := c.MetaData.Codec, parquet.CompressionCodec_GZIP; got != want {
+				t.Errorf("row group %d column %v has codec %v, want %v", i, c.MetaData.PathInSchema, got, want)
+			}
+		}
+	}
+	if got, want := footer.NumRows, int64(len(students)); got != want {
+		t.Errorf("got %d rows, want %d", got, want)
+	}
+}
+
+func TestWrite_invalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  WriteOptionFn
+	}{
+		{name: "row group size", opt: WriteRowGroupSize(0)},
+		{name: "page size", opt: WritePageSize(-1)},
+		{name: "compression", opt: WriteCompression(Compression(-1))},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Write() with invalid %v option did not panic", test.name)
+				}
+			}()
+			_, s, col := ptest.CreateList(makeStudents(1))
+			Write(s, "./invalid.parquet", col, test.opt)
+		})
+	}
+}
+
+func TestRead_rowGroups(t *testing.T) {
+	students := makeStudents(500)
+	parquetFile := writeStudents(t, students, WriteRowGroupSize(1024), WritePageSize(64))
+	if got := len(readFileFooter(t, parquetFile).RowGroups); got < 2 {
+		t.Fatalf("got %d row groups, want more than 1", got)
+	}
+
+	p := beam.NewPipeline()
+	s := p.Root()
+	got := Read(s, parquetFile, reflect.TypeOf(Student{}))
+	passert.Equals(s, got, students...)
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestRead_rowGroupsFiltered(t *testing.T) {
+	students := makeStudents(500)
+	parquetFile := writeStudents(t, students, WriteRowGroupSize(1024), WritePageSize(64))
+
+	var want []any
+	for _, v := range students {
+		if s := v.(Student); s.Id >= 450 && s.Sex {
+			want = append(want, s)
+		}
+	}
+
+	p := beam.NewPipeline()
+	s := p.Root()
+	got := Read(s, parquetFile, reflect.TypeOf(Student{}), ReadFilter(GtEq("id", 450), Eq("sex", true)))
+	passert.Equals(s, got, want...)
+
+	ptest.RunAndValidate(t, p)
+}
@@ -0,0 +1,357 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquetio
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/fileio"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/filesystem"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/common"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/schema"
+	"github.com/xitongsys/parquet-go/source"
+)
+
+const (
+	// parallelism is the number of columns decoded concurrently.
+	parallelism = 4
+	// footerTrailerSize is the size of the footer length and magic number at
+	// the end of a parquet file.
+	footerTrailerSize = 8
+	// maxRangeGap is the largest gap between two column chunks that are read
+	// with a single ranged read. Reading the gap is cheaper than another
+	// request for small gaps.
+	maxRangeGap = 1 << 20
+)
+
+// parquetFile reads parts of a parquet file. If the filesystem supports
+// ranged reads, only the requested byte ranges are read. Otherwise the whole
+// file is read once and kept in memory.
+type parquetFile struct {
+	path   string
+	size   int64
+	fs     filesystem.Interface
+	ranged filesystem.RangeReader
+	// data is the contents of the file, if the filesystem doesn't support
+	// ranged reads and the file has been read.
+	data []byte
+}
+
+// openParquetFile opens the file for reading its parts.
+func openParquetFile(ctx context.Context, file fileio.ReadableFile) (*parquetFile, error) {
+	fs, err := filesystem.New(ctx, file.Metadata.Path)
+	if err != nil {
+		return nil, err
+	}
+	ranged, _ := fs.(filesystem.RangeReader)
+	return &parquetFile{path: file.Metadata.Path, size: file.Metadata.Size, fs: fs, ranged: ranged}, nil
+}
+
+// splittable reports whether the row groups of the file at path can be read
+// individually, which requires ranged reads from its filesystem. Otherwise
+// each restriction would read the whole file.
+func splittable(path string) bool {
+	fs, err := filesystem.New(context.Background(), path)
+	if err != nil {
+		return false
+	}
+	defer fs.Close()
+	_, ok := fs.(filesystem.RangeReader)
+	return ok
+}
+
+func (f *parquetFile) Close() error {
+	return f.fs.Close()
+}
+
+// readAt reads n bytes of the file starting at offset.
+func (f *parquetFile) readAt(ctx context.Context, offset, n int64) ([]byte, error) {
+	if offset < 0 || n < 0 || offset+n > f.size {
+		return nil, errors.Errorf("range [%d, %d) is outside of %v with %d bytes", offset, offset+n, f.path, f.size)
+	}
+	if f.ranged == nil {
+		data, err := f.readAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(data)) < offset+n {
+			return nil, errors.Errorf("%v has %d bytes, want at least %d", f.path, len(data), offset+n)
+		}
+		return data[offset : offset+n], nil
+	}
+
+	rc, err := f.ranged.OpenReadRange(ctx, f.path, offset, n)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(rc, buf); err != nil {
+		return nil, errors.Wrapf(err, "failed to read %d bytes at offset %d of %v", n, offset, f.path)
+	}
+	return buf, nil
+}
+
+// readAll reads the whole file once, for filesystems without ranged reads.
+func (f *parquetFile) readAll(ctx context.Context) ([]byte, error) {
+	if f.data == nil {
+		data, err := filesystem.Read(ctx, f.fs, f.path)
+		if err != nil {
+			return nil, err
+		}
+		f.data = data
+	}
+	return f.data, nil
+}
+
+// readFooter reads the footer of a parquet file.
+func readFooter(ctx context.Context, f *parquetFile) (*parquet.FileMetaData, error) {
+	if f.size < footerTrailerSize {
+		return nil, errors.Errorf("%v is too small to be a parquet file", f.path)
+	}
+	trailer, err := f.readAt(ctx, f.size-footerTrailerSize, footerTrailerSize)
+	if err != nil {
+		return nil, err
+	}
+	if string(trailer[4:]) != "PAR1" {
+		return nil, errors.Errorf("%v is not a parquet file", f.path)
+	}
+	footerSize := int64(binary.LittleEndian.Uint32(trailer))
+	if footerSize+footerTrailerSize > f.size {
+		return nil, errors.Errorf("%v has a corrupt footer length of %d bytes", f.path, footerSize)
+	}
+	tail, err := f.readAt(ctx, f.size-footerTrailerSize-footerSize, footerSize+footerTrailerSize)
+	if err != nil {
+		return nil, err
+	}
+	pr := &reader.ParquetReader{PFile: buffer.NewBufferFileFromBytes(tail)}
+	if err := pr.ReadFooter(); err != nil {
+		return nil, errors.Wrapf(err, "failed to read footer of %v", f.path)
+	}
+	return pr.Footer, nil
+}
+
+// readChunks reads the given column chunks, and returns a source.ParquetFile
+// from which they can be decoded. Chunks that are close together are read
+// with a single ranged read.
+func (f *parquetFile) readChunks(ctx context.Context, chunks []*parquet.ColumnChunk) (source.ParquetFile, error) {
+	if f.ranged == nil {
+		data, err := f.readAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return buffer.NewBufferFileFromBytes(data), nil
+	}
+
+	var spans []byteRange
+	for _, c := range chunks {
+		// The chunk starts at its dictionary page, if any, as in
+		// reader.ColumnBufferType.NextRowGroup.
+		start := c.MetaData.DataPageOffset
+		if c.MetaData.DictionaryPageOffset != nil {
+			start = *c.MetaData.DictionaryPageOffset
+		}
+		spans = append(spans, byteRange{offset: start, end: start + c.MetaData.TotalCompressedSize})
+	}
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].offset < spans[j].offset
+	})
+
+	rf := &rangeFile{path: f.path}
+	for i := 0; i < len(spans); {
+		r := spans[i]
+		for i++; i < len(spans) && spans[i].offset-r.end <= maxRangeGap; i++ {
+			if spans[i].end > r.end {
+				r.end = spans[i].end
+			}
+		}
+		data, err := f.readAt(ctx, r.offset, r.end-r.offset)
+		if err != nil {
+			return nil, err
+		}
+		r.data = data
+		rf.ranges = append(rf.ranges, r)
+	}
+	return rf, nil
+}
+
+// byteRange is the range [offset, end) of a file, with its data once read.
+type byteRange struct {
+	offset, end int64
+	data        []byte
+}
+
+// rangeFile is a read-only source.ParquetFile over some byte ranges of a
+// file. Reading outside of the ranges fails.
+type rangeFile struct {
+	path   string
+	ranges []byteRange
+	pos    int64
+}
+
+func (f *rangeFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += f.pos
+	default:
+		return f.pos, errors.Errorf("unsupported seek whence %d in %v", whence, f.path)
+	}
+	if offset < 0 {
+		return f.pos, errors.Errorf("negative seek offset %d in %v", offset, f.path)
+	}
+	f.pos = offset
+	return f.pos, nil
+}
+
+func (f *rangeFile) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for _, r := range f.ranges {
+		if f.pos >= r.offset && f.pos < r.end {
+			n := copy(p, r.data[f.pos-r.offset:])
+			f.pos += int64(n)
+			return n, nil
+		}
+	}
+	return 0, errors.Errorf("offset %d of %v was not read", f.pos, f.path)
+}
+
+func (f *rangeFile) Write([]byte) (int, error) {
+	return 0, errors.Errorf("%v is read-only", f.path)
+}
+
+func (f *rangeFile) Close() error {
+	return nil
+}
+
+// Open returns an independent reader of the same ranges.
+func (f *rangeFile) Open(string) (source.ParquetFile, error) {
+	return &rangeFile{path: f.path, ranges: f.ranges}, nil
+}
+
+func (f *rangeFile) Create(string) (source.ParquetFile, error) {
+	return nil, errors.Errorf("%v is read-only", f.path)
+}
+
+// rowGroupReader reads individual row groups of a parquet file into values of
+// a struct type. Only the columns that are present in the struct type are
+// decoded, and they may be declared in any order.
+type rowGroupReader struct {
+	footer *parquet.FileMetaData
+	sh     *schema.SchemaHandler
+	t      reflect.Type
+	// chunks holds the projected column chunks of each row group, with paths
+	// renamed to the internal names of the schema handler.
+	chunks [][]*parquet.ColumnChunk
+}
+
+// newRowGroupReader creates a rowGroupReader for the parquet file with the
+// given footer, reading values of type t.
+func newRowGroupReader(footer *parquet.FileMetaData, sh *schema.SchemaHandler, t reflect.Type) (*rowGroupReader, error) {
+	root := sh.GetRootExName()
+	chunks := make([][]*parquet.ColumnChunk, len(footer.RowGroups))
+	for i, rg := range footer.RowGroups {
+		found := make(map[string]bool)
+		for _, c := range rg.Columns {
+			if c.MetaData == nil {
+				continue
+			}
+			exPath := append([]string{root}, c.MetaData.PathInSchema...)
+			inPath, ok := sh.ExPathToInPath[common.PathToStr(exPath)]
+			if !ok {
+				// Not part of the target type, so the column is never read.
+				continue
+			}
+			md := *c.MetaData
+			md.PathInSchema = common.StrToPath(inPath)[1:]
+			cc := *c
+			cc.MetaData = &md
+			chunks[i] = append(chunks[i], &cc)
+			found[inPath] = true
+		}
+		for _, col := range sh.ValueColumns {
+			if !found[col] {
+				return nil, errors.Errorf("column %v of type %v not found in row group %d",
+					sh.InPathToExPath[col], t, i)
+			}
+		}
+	}
+	return &rowGroupReader{
+		footer: footer,
+		sh:     sh,
+		t:      t,
+		chunks: chunks,
+	}, nil
+}
+
+// numRowGroups returns the number of row groups in the file.
+func (r *rowGroupReader) numRowGroups() int64 {
+	return int64(len(r.footer.RowGroups))
+}
+
+// column returns the projected column chunk with the given internal path in
+// the i-th row group, or nil if there is none.
+func (r *rowGroupReader) column(i int64, inPath string) *parquet.ColumnChunk {
+	for _, c := range r.chunks[i] {
+		if common.PathToStr(append([]string{r.sh.GetRootInName()}, c.MetaData.PathInSchema...)) == inPath {
+			return c
+		}
+	}
+	return nil
+}
+
+// read decodes all rows of the i-th row group from file, which must hold the
+// projected column chunks of the row group.
+func (r *rowGroupReader) read(file source.ParquetFile, i int64) ([]any, error) {
+	rg := *r.footer.RowGroups[i]
+	rg.Columns = r.chunks[i]
+	footer := *r.footer
+	footer.RowGroups = []*parquet.RowGroup{&rg}
+	footer.NumRows = rg.NumRows
+
+	pr := &reader.ParquetReader{
+		SchemaHandler: r.sh,
+		NP:            parallelism,
+		Footer:        &footer,
+		PFile:         file,
+		ColumnBuffers: make(map[string]*reader.ColumnBufferType),
+		ObjType:       r.t,
+	}
+	defer pr.ReadStop()
+	for _, col := range r.sh.ValueColumns {
+		cb, err := reader.NewColumnBuffer(file, &footer, r.sh, col)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read column %v of row group %d", r.sh.InPathToExPath[col], i)
+		}
+		pr.ColumnBuffers[col] = cb
+	}
+	vals, err := pr.ReadByNumber(int(rg.NumRows))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read row group %d", i)
+	}
+	return vals, nil
+}
@@ -0,0 +1,217 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquetio
+
+import (
+	"context"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/sdf"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/fileio"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/filesystem"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/filesystem/local"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/rtrackers/offsetrange"
+)
+
+// bytesRead counts the bytes read through the countingfs and streamingfs
+// test filesystems.
+var bytesRead atomic.Int64
+
+func init() {
+	filesystem.Register("countingfs", func(ctx context.Context) filesystem.Interface {
+		return &rangedFS{streamingFS{local.New(ctx)}}
+	})
+	filesystem.Register("streamingfs", func(ctx context.Context) filesystem.Interface {
+		return &streamingFS{local.New(ctx)}
+	})
+}
+
+type countingReader struct {
+	io.ReadCloser
+}
+
+func (r countingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	bytesRead.Add(int64(n))
+	return n, err
+}
+
+// streamingFS is a local filesystem without ranged reads.
+type streamingFS struct {
+	local filesystem.Interface
+}
+
+func localPath(filename string) string {
+	return filename[strings.Index(filename, "://")+3:]
+}
+
+func (f *streamingFS) List(ctx context.Context, glob string) ([]string, error) {
+	return f.local.List(ctx, localPath(glob))
+}
+
+func (f *streamingFS) OpenRead(ctx context.Context, filename string) (io.ReadCloser, error) {
+	rc, err := f.local.OpenRead(ctx, localPath(filename))
+	if err != nil {
+		return nil, err
+	}
+	return countingReader{rc}, nil
+}
+
+func (f *streamingFS) OpenWrite(ctx context.Context, filename string) (io.WriteCloser, error) {
+	return f.local.OpenWrite(ctx, localPath(filename))
+}
+
+func (f *streamingFS) Size(ctx context.Context, filename string) (int64, error) {
+	return f.local.Size(ctx, localPath(filename))
+}
+
+func (f *streamingFS) Close() error {
+	return f.local.Close()
+}
+
+// rangedFS is a local filesystem with ranged reads.
+type rangedFS struct {
+	streamingFS
+}
+
+func (f *rangedFS) OpenReadRange(ctx context.Context, filename string, offset, length int64) (io.ReadCloser, error) {
+	rc, err := f.local.(filesystem.RangeReader).OpenReadRange(ctx, localPath(filename), offset, length)
+	if err != nil {
+		return nil, err
+	}
+	return countingReader{rc}, nil
+}
+
+func readableFile(t *testing.T, path string) fileio.ReadableFile {
+	t.Helper()
+	info, err := os.Stat(localPath(path))
+	if err != nil {
+		t.Fatalf("Stat(%v) failed: %v", path, err)
+	}
+	return fileio.ReadableFile{Metadata: fileio.FileMetadata{Path: path, Size: info.Size()}}
+}
+
+func readRestriction(t *testing.T, fn *parquetReadFn, file fileio.ReadableFile, rest offsetrange.Restriction) []any {
+	t.Helper()
+	var got []any
+	rt := sdf.NewLockRTracker(offsetrange.NewTracker(rest))
+	if err := fn.ProcessElement(context.Background(), rt, file, func(v beam.X) { got = append(got, v) }); err != nil {
+		t.Fatalf("ProcessElement(%v) failed: %v", rest, err)
+	}
+	return got
+}
+
+// TestParquetReadFn_rangedReads verifies that each row group restriction
+// reads just its own column chunks and the footer.
+func TestParquetReadFn_rangedReads(t *testing.T) {
+	students := makeStudents(500)
+	file := readableFile(t, "countingfs://"+writeStudents(t, students, WriteRowGroupSize(1024), WritePageSize(64)))
+
+	fn := &parquetReadFn{Type: beam.EncodedType{T: reflect.TypeOf(Student{})}}
+	if err := fn.Setup(); err != nil {
+		t.Fatalf("Setup() failed: %v", err)
+	}
+	ctx := context.Background()
+	rest, err := fn.CreateInitialRestriction(ctx, file)
+	if err != nil {
+		t.Fatalf("CreateInitialRestriction() failed: %v", err)
+	}
+	splits := fn.SplitRestriction(file, rest)
+	if len(splits) < 2 || int64(len(splits)) != rest.End {
+		t.Fatalf("SplitRestriction(%v) = %v, want one restriction per row group", rest, splits)
+	}
+
+	var got []any
+	var total int64
+	for _, split := range splits {
+		bytesRead.Store(0)
+		got = append(got, readRestriction(t, fn, file, split)...)
+		n := bytesRead.Load()
+		if n >= file.Metadata.Size/2 {
+			t.Errorf("reading %v read %d bytes of %d", split, n, file.Metadata.Size)
+		}
+		total += n
+	}
+	if total > 2*file.Metadata.Size {
+		t.Errorf("reading all row groups read %d bytes, want at most twice the file size of %d", total, file.Metadata.Size)
+	}
+	if !reflect.DeepEqual(got, students) {
+		t.Errorf("read %d students, want %d students in order", len(got), len(students))
+	}
+}
+
+// TestParquetReadFn_streamingReads verifies that files on filesystems without
+// ranged reads are not split, and read once.
+func TestParquetReadFn_streamingReads(t *testing.T) {
+	students := makeStudents(500)
+	file := readableFile(t, "streamingfs://"+writeStudents(t, students, WriteRowGroupSize(1024), WritePageSize(64)))
+
+	fn := &parquetReadFn{Type: beam.EncodedType{T: reflect.TypeOf(Student{})}}
+	if err := fn.Setup(); err != nil {
+		t.Fatalf("Setup() failed: %v", err)
+	}
+	rest, err := fn.CreateInitialRestriction(context.Background(), file)
+	if err != nil {
+		t.Fatalf("CreateInitialRestriction() failed: %v", err)
+	}
+	splits := fn.SplitRestriction(file, rest)
+	if len(splits) != 1 {
+		t.Fatalf("SplitRestriction(%v) = %v, want a single restriction", rest, splits)
+	}
+
+	bytesRead.Store(0)
+	got := readRestriction(t, fn, file, splits[0])
+	if n := bytesRead.Load(); n != file.Metadata.Size {
+		t.Errorf("reading %v read %d bytes, want the file size of %d", splits[0], n, file.Metadata.Size)
+	}
+	if !reflect.DeepEqual(got, students) {
+		t.Errorf("read %d students, want %d students in order", len(got), len(students))
+	}
+}
+
+func TestRangeFile(t *testing.T) {
+	f := &rangeFile{path: "file", ranges: []byteRange{
+		{offset: 2, end: 5, data: []byte("234")},
+		{offset: 7, end: 9, data: []byte("78")},
+	}}
+	if _, err := f.Seek(3, io.SeekStart); err != nil {
+		t.Fatalf("Seek(3) failed: %v", err)
+	}
+	buf := make([]byte, 4)
+	if n, err := f.Read(buf); err != nil || string(buf[:n]) != "34" {
+		t.Errorf("Read() at 3 = %q, %v, want %q", buf[:n], err, "34")
+	}
+	if _, err := f.Read(buf); err == nil {
+		t.Error("Read() at 5 succeeded, want an error for an offset that was not read")
+	}
+
+	g, _ := f.Open("")
+	if _, err := g.Seek(7, io.SeekCurrent); err != nil {
+		t.Fatalf("Seek(7) failed: %v", err)
+	}
+	if n, err := g.Read(buf); err != nil || string(buf[:n]) != "78" {
+		t.Errorf("Read() at 7 = %q, %v, want %q", buf[:n], err, "78")
+	}
+	if _, err := f.Seek(0, io.SeekEnd); err == nil {
+		t.Error("Seek(0, io.SeekEnd) succeeded, want an error")
+	}
+}
@@ -0,0 +1,69 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsubio
+
+import (
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/state"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/timers"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+func init() {
+	register.DoFn6x1[beam.EventTime, state.Provider, timers.Provider, string, []byte, func([]byte), error](&dedupFn{})
+}
+
+// dedupDuration is how long past the timestamp of a message its ID is
+// remembered for to drop redeliveries of it.
+const dedupDuration = 10 * time.Minute
+
+// dedupFn drops messages with an ID that was seen within the dedup duration.
+// IDs are forgotten once the watermark passes the dedup duration after the
+// timestamp of their first message.
+type dedupFn struct {
+	Seen   state.Value[bool]
+	Expiry timers.EventTime
+}
+
+func newDedupFn() *dedupFn {
+	return &dedupFn{
+		Seen:   state.MakeValueState[bool]("seen"),
+		Expiry: timers.InEventTime("expiry"),
+	}
+}
+
+func (fn *dedupFn) ProcessElement(et beam.EventTime, sp state.Provider, tp timers.Provider, id string, payload []byte, emit func([]byte)) error {
+	_, seen, err := fn.Seen.Read(sp)
+	if err != nil {
+		return err
+	}
+	if seen {
+		return nil
+	}
+
+	if err := fn.Seen.Write(sp, true); err != nil {
+		return err
+	}
+	fn.Expiry.Set(tp, et.ToTime().Add(dedupDuration))
+	emit(payload)
+	return nil
+}
+
+func (fn *dedupFn) OnTimer(sp state.Provider, tp timers.Provider, id string, timer timers.Context, emit func([]byte)) error {
+	return fn.Seen.Clear(sp)
+}
@@ -0,0 +1,88 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsubio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/pubsub/pstest"
+)
+
+const (
+	project = "project"
+	topic   = "topic"
+)
+
+// newServer starts a fake Pub/Sub server with a topic, and points the Pub/Sub
+// clients created by the package at it.
+func newServer(t *testing.T) *pstest.Server {
+	t.Helper()
+
+	srv := pstest.NewServer()
+	t.Cleanup(func() { srv.Close() })
+	t.Setenv("PUBSUB_EMULATOR_HOST", srv.Addr)
+
+	client := newClient(t)
+	if _, err := client.CreateTopic(context.Background(), topic); err != nil {
+		t.Fatalf("Failed to create topic: %v", err)
+	}
+
+	return srv
+}
+
+func newClient(t *testing.T) *pubsub.Client {
+	t.Helper()
+
+	client, err := pubsub.NewClient(context.Background(), project)
+	if err != nil {
+		t.Fatalf("Failed to create Pub/Sub client: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	return client
+}
+
+func createSubscription(t *testing.T, sub string) {
+	t.Helper()
+
+	client := newClient(t)
+	cfg := pubsub.SubscriptionConfig{Topic: client.Topic(topic)}
+	if _, err := client.CreateSubscription(context.Background(), sub, cfg); err != nil {
+		t.Fatalf("Failed to create subscription: %v", err)
+	}
+}
+
+type bundleFinalization struct {
+	callbacks []func() error
+}
+
+func (bf *bundleFinalization) RegisterCallback(_ time.Duration, callback func() error) {
+	bf.callbacks = append(bf.callbacks, callback)
+}
+
+func (bf *bundleFinalization) finalize(t *testing.T) {
+	t.Helper()
+
+	for _, callback := range bf.callbacks {
+		if err := callback(); err != nil {
+			t.Fatalf("Failed to finalize bundle: %v", err)
+		}
+	}
+	bf.callbacks = nil
+}
@@ -13,25 +13,34 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package pubsubio provides access to Pub/Sub on Dataflow streaming.
+// Package pubsubio provides access to Pub/Sub.
 //
-// This implementation only functions on the Dataflow runner.
+// On the Dataflow runner, reads and writes are replaced by Dataflow's native
+// Pub/Sub implementation. Other runners, such as Prism, Flink or the direct
+// runner, execute the SDK implementation instead, which pulls messages from a
+// subscription with a splittable DoFn and acknowledges them once their bundle
+// is finalized.
+//
+// The SDK implementation connects to the Pub/Sub emulator when the
+// PUBSUB_EMULATOR_HOST environment variable is set.
 //
 // See https://cloud.google.com/dataflow/docs/concepts/streaming-with-cloud-pubsub
 // for details on using Pub/Sub with Dataflow.
 package pubsubio
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
-	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/runtime/contextreg"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/util/protox"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/util/reflectx"
 	pipepb "github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/model/pipeline_v1"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/util/pubsubx"
+	"github.com/google/uuid"
 	pb "google.golang.org/genproto/googleapis/pubsub/v1"
 	"google.golang.org/protobuf/proto"
 )
@@ -45,9 +54,29 @@ func init() {
 	register.Function2x1(unmarshalMessageFn)
 	register.Function2x1(marshalMessageFn)
 	register.Function2x0(wrapInMessage)
-	register.Function2x0(wrapInMessage)
 	register.Emitter1[[]byte]()
 	register.Emitter1[*pb.PubsubMessage]()
+
+	contextreg.Default().TransformExtractor(extractSpec)
+}
+
+// specKey is the context key of the spec of a Pub/Sub composite transform.
+type specKey struct{}
+
+// withSpec returns a context that sets the spec of the composite transform of
+// the scope it is attached to, so that runners with a native Pub/Sub
+// implementation can substitute it for the SDK implementation.
+func withSpec(urn string, payload []byte) context.Context {
+	spec := &pipepb.FunctionSpec{Urn: urn, Payload: payload}
+	return context.WithValue(context.Background(), specKey{}, spec)
+}
+
+func extractSpec(ctx context.Context) contextreg.TransformMetadata {
+	spec, ok := ctx.Value(specKey{}).(*pipepb.FunctionSpec)
+	if !ok {
+		return contextreg.TransformMetadata{}
+	}
+	return contextreg.TransformMetadata{URN: spec.GetUrn(), Payload: spec.GetPayload()}
 }
 
 // ReadOptions represents options for reading from PubSub.
@@ -61,26 +90,56 @@ type ReadOptions struct {
 // Read reads an unbounded number of PubSubMessages from the given
 // pubsub topic. It produces an unbounded PCollecton<*PubSubMessage>,
 // if WithAttributes is set, or an unbounded PCollection<[]byte>.
+//
+// If Subscription is not set, the SDK implementation reads from a new
+// subscription to the topic, which only receives messages published after
+// the pipeline starts. The subscription is deleted when the pipeline is
+// drained, and otherwise expires after a day without readers.
+//
+// The event time of each message is its publish time, or the value of the
+// TimestampAttribute if set, which must be either milliseconds since the
+// Unix epoch or an RFC 3339 timestamp. Messages are deduplicated by the value
+// of the IDAttribute if set, or else by their message ID, for redeliveries
+// within 10 minutes of each other.
 func Read(s beam.Scope, project, topic string, opts *ReadOptions) beam.PCollection {
 	s = s.Scope("pubsubio.Read")
 
+	if opts == nil {
+		opts = &ReadOptions{}
+	}
+
 	payload := &pipepb.PubSubReadPayload{
-		Topic: pubsubx.MakeQualifiedTopicName(project, topic),
+		Topic:              pubsubx.MakeQualifiedTopicName(project, topic),
+		IdAttribute:        opts.IDAttribute,
+		TimestampAttribute: opts.TimestampAttribute,
+		WithAttributes:     opts.WithAttributes,
 	}
-	if opts != nil {
-		payload.IdAttribute = opts.IDAttribute
-		payload.TimestampAttribute = opts.TimestampAttribute
-		if opts.Subscription != "" {
-			payload.Subscription = pubsubx.MakeQualifiedSubscriptionName(project, opts.Subscription)
-		}
-		payload.WithAttributes = opts.WithAttributes
+	if opts.Subscription != "" {
+		payload.Subscription = pubsubx.MakeQualifiedSubscriptionName(project, opts.Subscription)
+	}
+
+	out := read(s.WithContext(withSpec(readURN, protox.MustEncode(payload)), "PubSubRead"), project, topic, *opts)
+	if opts.WithAttributes {
+		return beam.ParDo(s, unmarshalMessageFn, out)
 	}
+	return out
+}
 
-	out := beam.External(s, readURN, protox.MustEncode(payload), nil, []beam.FullType{typex.New(reflectx.ByteSlice)}, false)
-	if opts != nil && opts.WithAttributes {
-		return beam.ParDo(s, unmarshalMessageFn, out[0])
+// read is the SDK implementation of a Pub/Sub read, producing the same output as
+// the native implementations.
+func read(s beam.Scope, project, topic string, opts ReadOptions) beam.PCollection {
+	temporary := opts.Subscription == ""
+	if temporary {
+		// The name is chosen at construction time, so retries reuse the subscription.
+		opts.Subscription = "beam-" + uuid.NewString()
 	}
-	return out[0]
+
+	imp := beam.Impulse(s)
+	subs := beam.ParDo(s, &subscriptionFn{Project: project, Topic: topic, Subscription: opts.Subscription, Temporary: temporary}, imp)
+	readFn := newReadFn(opts)
+	readFn.DeleteSubscription = temporary
+	msgs := beam.ParDo(s, readFn, subs)
+	return beam.ParDo(s, newDedupFn(), msgs)
 }
 
 func unmarshalMessageFn(raw []byte, emit func(*pb.PubsubMessage)) error {
@@ -113,8 +172,6 @@ var pubSubMessageT = reflect.TypeOf((*pb.PubsubMessage)(nil))
 // Panics if the input pcollection type is not one of those two types.
 //
 // When given []bytes, they are first wrapped in PubSubMessages.
-//
-// Note: Doesn't function in batch pipelines.
 func Write(s beam.Scope, project, topic string, col beam.PCollection) {
 	s = s.Scope("pubsubio.Write")
 
@@ -130,5 +187,11 @@ func Write(s beam.Scope, project, topic string, col beam.PCollection) {
 		panic(fmt.Sprintf("pubsubio.Write only accepts PCollections of %v and %v, received %v", pubSubMessageT, reflectx.ByteSlice, col.Type().Type()))
 	}
 	marshaled := beam.ParDo(s, marshalMessageFn, out)
-	beam.External(s, writeURN, protox.MustEncode(payload), []beam.PCollection{marshaled}, nil, false)
+	write(s.WithContext(withSpec(writeURN, protox.MustEncode(payload)), "PubSubWrite"), project, topic, marshaled)
+}
+
+// write is the SDK implementation of a Pub/Sub write, consuming the same input as
+// the native implementations.
+func write(s beam.Scope, project, topic string, col beam.PCollection) {
+	beam.ParDo0(s, &writeFn{Project: project, Topic: topic}, col)
 }
@@ -0,0 +1,259 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsubio
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/runtime/graphx"
+	pipepb "github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/model/pipeline_v1"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/util/pubsubx"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	pb "google.golang.org/genproto/googleapis/pubsub/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	register.Function2x0(duplicateMessagesFn)
+	register.Emitter2[string, []byte]()
+}
+
+func duplicateMessagesFn(_ []byte, emit func(string, []byte)) {
+	emit("a", []byte("msg1"))
+	emit("b", []byte("msg2"))
+	emit("a", []byte("msg1"))
+}
+
+func TestMain(m *testing.M) {
+	ptest.Main(m)
+}
+
+func TestRead_Spec(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	opts := &ReadOptions{
+		Subscription:       "sub",
+		IDAttribute:        "id",
+		TimestampAttribute: "ts",
+		WithAttributes:     true,
+	}
+	Read(s, project, topic, opts)
+
+	want := &pipepb.PubSubReadPayload{
+		Topic:              pubsubx.MakeQualifiedTopicName(project, topic),
+		Subscription:       pubsubx.MakeQualifiedSubscriptionName(project, "sub"),
+		IdAttribute:        "id",
+		TimestampAttribute: "ts",
+		WithAttributes:     true,
+	}
+	got := &pipepb.PubSubReadPayload{}
+	unmarshalSpec(t, p, readURN, got)
+	if !proto.Equal(got, want) {
+		t.Errorf("read payload = %v, want %v", got, want)
+	}
+}
+
+func TestWrite_Spec(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	Write(s, project, topic, beam.Create(s, []byte("msg")))
+
+	want := &pipepb.PubSubWritePayload{
+		Topic: pubsubx.MakeQualifiedTopicName(project, topic),
+	}
+	got := &pipepb.PubSubWritePayload{}
+	unmarshalSpec(t, p, writeURN, got)
+	if !proto.Equal(got, want) {
+		t.Errorf("write payload = %v, want %v", got, want)
+	}
+}
+
+// unmarshalSpec unmarshals the payload of the single composite transform of the
+// pipeline with the URN.
+func unmarshalSpec(t *testing.T, p *beam.Pipeline, urn string, payload proto.Message) {
+	t.Helper()
+
+	pt := findSpec(t, marshal(t, p), urn)
+	if err := proto.Unmarshal(pt.GetSpec().GetPayload(), payload); err != nil {
+		t.Fatalf("Failed to unmarshal payload: %v", err)
+	}
+}
+
+func marshal(t *testing.T, p *beam.Pipeline) *pipepb.Pipeline {
+	t.Helper()
+
+	edges, _, err := p.Build()
+	if err != nil {
+		t.Fatalf("Failed to build pipeline: %v", err)
+	}
+	pipe, err := graphx.Marshal(edges, &graphx.Options{Environment: &pipepb.Environment{Urn: "beam:env:process:v1"}})
+	if err != nil {
+		t.Fatalf("Failed to marshal pipeline: %v", err)
+	}
+	return pipe
+}
+
+// findSpec returns the single composite transform of the pipeline with the
+// URN, checking it contains the SDK implementation.
+func findSpec(t *testing.T, pipe *pipepb.Pipeline, urn string) *pipepb.PTransform {
+	t.Helper()
+
+	var found []*pipepb.PTransform
+	for _, pt := range pipe.GetComponents().GetTransforms() {
+		if pt.GetSpec().GetUrn() == urn {
+			found = append(found, pt)
+		}
+	}
+	if len(found) != 1 {
+		t.Fatalf("Found %d transforms with URN %v, want 1", len(found), urn)
+	}
+	if len(found[0].GetSubtransforms()) == 0 {
+		t.Errorf("Transform with URN %v has no subtransforms, want the SDK implementation", urn)
+	}
+	return found[0]
+}
+
+// TestSpec_dataflow verifies that the Pub/Sub composites have the shape that
+// runners with a native implementation, such as Dataflow, substitute: the
+// standard composite URNs, with a single unbounded PCollection of encoded
+// messages as the output of reads and the input of writes.
+func TestSpec_dataflow(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	msgs := Read(s, project, topic, &ReadOptions{WithAttributes: true})
+	Write(s, project, topic, msgs)
+	pipe := marshal(t, p)
+
+	for urn, std := range map[string]pipepb.StandardPTransforms_Composites{
+		readURN:  pipepb.StandardPTransforms_PUBSUB_READ,
+		writeURN: pipepb.StandardPTransforms_PUBSUB_WRITE,
+	} {
+		enum := std.Descriptor().Values().ByNumber(std.Number())
+		if want := proto.GetExtension(enum.Options(), pipepb.E_BeamUrn).(string); urn != want {
+			t.Errorf("URN = %v, want the standard composite URN %v", urn, want)
+		}
+	}
+
+	read := findSpec(t, pipe, readURN)
+	if len(read.GetInputs()) != 0 || len(read.GetOutputs()) != 1 {
+		t.Fatalf("read has inputs %v and outputs %v, want a single output", read.GetInputs(), read.GetOutputs())
+	}
+	for _, id := range read.GetOutputs() {
+		checkBytesPCollection(t, pipe, id)
+	}
+	write := findSpec(t, pipe, writeURN)
+	if len(write.GetInputs()) != 1 || len(write.GetOutputs()) != 0 {
+		t.Fatalf("write has inputs %v and outputs %v, want a single input", write.GetInputs(), write.GetOutputs())
+	}
+	for _, id := range write.GetInputs() {
+		checkBytesPCollection(t, pipe, id)
+	}
+}
+
+// checkBytesPCollection checks that the PCollection is unbounded with a bytes
+// coder, as native Pub/Sub implementations produce and consume.
+func checkBytesPCollection(t *testing.T, pipe *pipepb.Pipeline, id string) {
+	t.Helper()
+	col := pipe.GetComponents().GetPcollections()[id]
+	if got, want := col.GetIsBounded(), pipepb.IsBounded_UNBOUNDED; got != want {
+		t.Errorf("PCollection %v is %v, want %v", id, got, want)
+	}
+	c := pipe.GetComponents().GetCoders()[col.GetCoderId()]
+	if got, want := c.GetSpec().GetUrn(), "beam:coder:bytes:v1"; got != want {
+		t.Errorf("PCollection %v has coder %v, want %v", id, got, want)
+	}
+}
+
+func TestWrite(t *testing.T) {
+	srv := newServer(t)
+
+	p, s := beam.NewPipelineWithRoot()
+	data := beam.Create(s, []byte("msg1"), []byte("msg2"))
+	Write(s, project, topic, data)
+	msgs := beam.Create(s, &pb.PubsubMessage{Data: []byte("msg3"), Attributes: map[string]string{"key": "value"}})
+	Write(s, project, topic, msgs)
+	ptest.RunAndValidate(t, p)
+
+	type message struct {
+		Data       string
+		Attributes map[string]string
+	}
+	var got []message
+	for _, msg := range srv.Messages() {
+		got = append(got, message{Data: string(msg.Data), Attributes: msg.Attributes})
+	}
+	sort.Slice(got, func(i, j int) bool { return got[i].Data < got[j].Data })
+
+	want := []message{
+		{Data: "msg1"},
+		{Data: "msg2"},
+		{Data: "msg3", Attributes: map[string]string{"key": "value"}},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("Published messages mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDedupFn(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	keyed := beam.ParDo(s, duplicateMessagesFn, beam.Impulse(s))
+	out := beam.ParDo(s, newDedupFn(), keyed)
+	passert.Equals(s, out, []byte("msg1"), []byte("msg2"))
+	ptest.RunAndValidate(t, p)
+}
+
+func TestSubscriptionFn(t *testing.T) {
+	ctx := context.Background()
+	newServer(t)
+
+	fn := &subscriptionFn{Project: project, Topic: topic, Subscription: "sub"}
+	var got []string
+	for i := 0; i < 2; i++ {
+		if err := fn.ProcessElement(ctx, nil, func(sub string) { got = append(got, sub) }); err != nil {
+			t.Fatalf("ProcessElement() error = %v", err)
+		}
+	}
+
+	sub := pubsubx.MakeQualifiedSubscriptionName(project, "sub")
+	if diff := cmp.Diff([]string{sub, sub}, got); diff != "" {
+		t.Errorf("ProcessElement() mismatch (-want +got):\n%s", diff)
+	}
+	if ok, err := newClient(t).Subscription("sub").Exists(ctx); err != nil || !ok {
+		t.Errorf("Subscription exists = %v, %v, want true", ok, err)
+	}
+}
+
+func TestSubscriptionFn_Temporary(t *testing.T) {
+	ctx := context.Background()
+	newServer(t)
+
+	fn := &subscriptionFn{Project: project, Topic: topic, Subscription: "sub", Temporary: true}
+	if err := fn.ProcessElement(ctx, nil, func(string) {}); err != nil {
+		t.Fatalf("ProcessElement() error = %v", err)
+	}
+
+	cfg, err := newClient(t).Subscription("sub").Config(ctx)
+	if err != nil {
+		t.Fatalf("Subscription config error = %v", err)
+	}
+	if got, want := cfg.ExpirationPolicy, temporarySubscriptionTTL; got != want {
+		t.Errorf("ExpirationPolicy = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,461 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsubio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	vkit "cloud.google.com/go/pubsub/apiv1"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/sdf"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/internal/unbounded"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/util/pubsubx"
+	pb "google.golang.org/genproto/googleapis/pubsub/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	register.DoFn3x1[context.Context, []byte, func(string), error](&subscriptionFn{})
+	register.Emitter1[string]()
+	register.DoFn6x2[
+		context.Context, *unbounded.WatermarkEstimator, beam.BundleFinalization, *sdf.LockRTracker, string,
+		func(beam.EventTime, string, []byte), sdf.ProcessContinuation, error,
+	](
+		&readFn{},
+	)
+	register.Emitter3[beam.EventTime, string, []byte]()
+}
+
+const (
+	maxMessages         = 1000
+	pullTimeout         = 5 * time.Second
+	maxReadTime         = 10 * time.Second
+	resumeDelay         = 5 * time.Second
+	ackDeadline         = 60 * time.Second
+	finalizationTimeout = 5 * time.Minute
+
+	// temporarySubscriptionTTL is how long a subscription created for a read is kept
+	// without readers, if the pipeline ends without being drained.
+	temporarySubscriptionTTL = 24 * time.Hour
+)
+
+// subscriptionFn ensures the subscription to read from exists, and emits its
+// fully-qualified name.
+type subscriptionFn struct {
+	Project      string
+	Topic        string
+	Subscription string
+	// Temporary is whether the subscription is created for the read, in which case
+	// it expires once it has had no readers for temporarySubscriptionTTL.
+	Temporary bool
+}
+
+func (fn *subscriptionFn) ProcessElement(ctx context.Context, _ []byte, emit func(string)) error {
+	client, err := pubsub.NewClient(ctx, fn.Project)
+	if err != nil {
+		return fmt.Errorf("error creating Pub/Sub client: %v", err)
+	}
+	defer client.Close()
+
+	if err := fn.ensureSubscription(ctx, client); err != nil {
+		return fmt.Errorf("error ensuring subscription %v exists: %v", fn.Subscription, err)
+	}
+
+	emit(pubsubx.MakeQualifiedSubscriptionName(fn.Project, fn.Subscription))
+	return nil
+}
+
+func (fn *subscriptionFn) ensureSubscription(ctx context.Context, client *pubsub.Client) error {
+	if !fn.Temporary {
+		_, err := pubsubx.EnsureSubscription(ctx, client, fn.Topic, fn.Subscription)
+		return err
+	}
+
+	exists, err := client.Subscription(fn.Subscription).Exists(ctx)
+	if err != nil || exists {
+		return err
+	}
+	cfg := pubsub.SubscriptionConfig{
+		Topic:            client.Topic(fn.Topic),
+		ExpirationPolicy: temporarySubscriptionTTL,
+	}
+	_, err = client.CreateSubscription(ctx, fn.Subscription, cfg)
+	return err
+}
+
+// readFn pulls messages from a subscription. It emits the ID to deduplicate
+// each message by along with its payload, and acknowledges the messages of a
+// bundle once the bundle is finalized.
+type readFn struct {
+	IDAttribute        string
+	TimestampAttribute string
+	WithAttributes     bool
+	// DeleteSubscription is whether the subscription is deleted once the read is
+	// drained, for subscriptions created for the read.
+	DeleteSubscription bool
+	client             *vkit.SubscriberClient
+	unacked            *unackedMessages
+}
+
+func newReadFn(opts ReadOptions) *readFn {
+	return &readFn{
+		IDAttribute:        opts.IDAttribute,
+		TimestampAttribute: opts.TimestampAttribute,
+		WithAttributes:     opts.WithAttributes,
+	}
+}
+
+func (fn *readFn) Setup(ctx context.Context) error {
+	if fn.unacked == nil {
+		fn.unacked = newUnackedMessages()
+	}
+	if fn.client != nil {
+		return nil
+	}
+
+	client, err := vkit.NewSubscriberClient(ctx, pubsubx.ClientOptions()...)
+	if err != nil {
+		return fmt.Errorf("error creating Pub/Sub subscriber client: %v", err)
+	}
+	fn.client = client
+	return nil
+}
+
+func (fn *readFn) Teardown() error {
+	if fn.client == nil {
+		return nil
+	}
+
+	err := fn.client.Close()
+	fn.client = nil
+	return err
+}
+
+func (fn *readFn) CreateInitialRestriction(sub string) string {
+	return sub
+}
+
+func (fn *readFn) SplitRestriction(_ string, rest string) []string {
+	return []string{rest}
+}
+
+func (fn *readFn) RestrictionSize(_ string, _ string) float64 {
+	return 1
+}
+
+func (fn *readFn) CreateTracker(rest string) *sdf.LockRTracker {
+	return sdf.NewLockRTracker(unbounded.NewTracker(rest))
+}
+
+func (fn *readFn) TruncateRestriction(_ *sdf.LockRTracker, _ string) string {
+	return ""
+}
+
+func (fn *readFn) InitialWatermarkEstimatorState(et beam.EventTime, _ string, _ string) int64 {
+	return et.Milliseconds()
+}
+
+func (fn *readFn) CreateWatermarkEstimator(ms int64) *unbounded.WatermarkEstimator {
+	return &unbounded.WatermarkEstimator{State: ms}
+}
+
+func (fn *readFn) WatermarkEstimatorState(we *unbounded.WatermarkEstimator) int64 {
+	return we.State
+}
+
+// ProcessElement pulls messages from the subscription for up to maxReadTime,
+// and then checkpoints so the messages can be acknowledged once the bundle is
+// finalized. Messages that aren't acknowledged within the ack deadline are
+// redelivered, and deduplicated downstream.
+//
+// Since redelivered messages keep their event time, the watermark is held at
+// the earliest message that isn't acknowledged yet, and only advances to the
+// current time once every pulled message is acknowledged and no messages are
+// left to pull.
+func (fn *readFn) ProcessElement(
+	ctx context.Context,
+	we *unbounded.WatermarkEstimator,
+	bf beam.BundleFinalization,
+	rt *sdf.LockRTracker,
+	sub string,
+	emit func(beam.EventTime, string, []byte),
+) (sdf.ProcessContinuation, error) {
+	if rt.GetRestriction() == "" {
+		// The restriction is only empty once it is truncated to drain the read,
+		// since the primary restriction of a checkpoint isn't processed again.
+		if fn.DeleteSubscription {
+			return sdf.StopProcessing(), fn.deleteSubscription(ctx, sub)
+		}
+		return sdf.StopProcessing(), nil
+	}
+	if !rt.TryClaim(sub) {
+		return sdf.StopProcessing(), nil
+	}
+
+	bundle := fn.unacked.add()
+	var ackIDs []string
+	defer func() {
+		fn.registerAck(bf, sub, bundle, ackIDs)
+	}()
+
+	deadline := time.Now().Add(maxReadTime)
+	for time.Now().Before(deadline) {
+		msgs, err := fn.pull(ctx, sub)
+		if err != nil {
+			return sdf.StopProcessing(), err
+		}
+
+		if len(msgs) == 0 {
+			fn.advanceWatermark(we)
+			return sdf.ResumeProcessingIn(resumeDelay), nil
+		}
+
+		ids := make([]string, len(msgs))
+		for i, msg := range msgs {
+			ids[i] = msg.GetAckId()
+		}
+		if err := fn.extendAckDeadline(ctx, sub, ids); err != nil {
+			return sdf.StopProcessing(), err
+		}
+
+		for _, msg := range msgs {
+			et, id, payload, err := fn.convert(msg.GetMessage())
+			if err != nil {
+				return sdf.StopProcessing(), err
+			}
+
+			emit(et, id, payload)
+			ackIDs = append(ackIDs, msg.GetAckId())
+			fn.unacked.observe(bundle, et.ToTime())
+		}
+		fn.advanceWatermark(we)
+	}
+
+	return sdf.ResumeProcessingIn(0), nil
+}
+
+// advanceWatermark advances the watermark to the earliest message that isn't
+// acknowledged yet. Once all messages are acknowledged, later messages are
+// expected to have been published after now.
+func (fn *readFn) advanceWatermark(we *unbounded.WatermarkEstimator) {
+	now := time.Now()
+	if t, ok := fn.unacked.earliest(now); ok {
+		we.Advance(t)
+		return
+	}
+	we.Advance(now)
+}
+
+// deleteSubscription deletes a subscription created for the read.
+func (fn *readFn) deleteSubscription(ctx context.Context, sub string) error {
+	if err := fn.client.DeleteSubscription(ctx, &pb.DeleteSubscriptionRequest{Subscription: sub}); err != nil {
+		return fmt.Errorf("error deleting subscription %v: %v", sub, err)
+	}
+	return nil
+}
+
+// pull returns the next messages of the subscription, or no messages if there
+// are none within the pull timeout.
+func (fn *readFn) pull(ctx context.Context, sub string) ([]*pb.ReceivedMessage, error) {
+	pullCtx, cancel := context.WithTimeout(ctx, pullTimeout)
+	defer cancel()
+
+	resp, err := fn.client.Pull(pullCtx, &pb.PullRequest{
+		Subscription: sub,
+		MaxMessages:  maxMessages,
+	})
+	if err != nil {
+		if ctx.Err() == nil && errors.Is(pullCtx.Err(), context.DeadlineExceeded) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error pulling messages from %v: %v", sub, err)
+	}
+	return resp.GetReceivedMessages(), nil
+}
+
+// extendAckDeadline extends the ack deadline of the messages to leave time for
+// the bundle to be committed and finalized.
+func (fn *readFn) extendAckDeadline(ctx context.Context, sub string, ackIDs []string) error {
+	err := fn.client.ModifyAckDeadline(ctx, &pb.ModifyAckDeadlineRequest{
+		Subscription:       sub,
+		AckIds:             ackIDs,
+		AckDeadlineSeconds: int32(ackDeadline / time.Second),
+	})
+	if err != nil {
+		return fmt.Errorf("error extending ack deadline of messages from %v: %v", sub, err)
+	}
+	return nil
+}
+
+func (fn *readFn) registerAck(bf beam.BundleFinalization, sub string, bundle int, ackIDs []string) {
+	if len(ackIDs) == 0 {
+		fn.unacked.remove(bundle)
+		return
+	}
+
+	// If the bundle isn't finalized, its messages are redelivered and pulled
+	// again by the time it expires.
+	fn.unacked.expireAt(bundle, time.Now().Add(finalizationTimeout+ackDeadline))
+	bf.RegisterCallback(finalizationTimeout, func() error {
+		ctx := context.Background()
+		for start := 0; start < len(ackIDs); start += maxMessages {
+			end := start + maxMessages
+			if end > len(ackIDs) {
+				end = len(ackIDs)
+			}
+
+			err := fn.client.Acknowledge(ctx, &pb.AcknowledgeRequest{
+				Subscription: sub,
+				AckIds:       ackIDs[start:end],
+			})
+			if err != nil {
+				return fmt.Errorf("error acknowledging messages from %v: %v", sub, err)
+			}
+		}
+		fn.unacked.remove(bundle)
+		return nil
+	})
+}
+
+// unackedMessages tracks the earliest event time of the messages of each bundle
+// that aren't acknowledged yet. It is shared with the finalization callbacks of
+// the bundles.
+type unackedMessages struct {
+	mu      sync.Mutex
+	next    int
+	bundles map[int]*unackedBundle
+}
+
+type unackedBundle struct {
+	earliest time.Time
+	// expiry is when the bundle stops being tracked if it isn't finalized, or the
+	// zero time while the bundle is processed.
+	expiry time.Time
+}
+
+func newUnackedMessages() *unackedMessages {
+	return &unackedMessages{bundles: make(map[int]*unackedBundle)}
+}
+
+// add starts tracking the messages of a bundle, and returns its ID.
+func (u *unackedMessages) add() int {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	id := u.next
+	u.next++
+	u.bundles[id] = &unackedBundle{earliest: mtime.MaxTimestamp.ToTime()}
+	return id
+}
+
+// observe records the event time of a message of a bundle.
+func (u *unackedMessages) observe(bundle int, t time.Time) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if b, ok := u.bundles[bundle]; ok && t.Before(b.earliest) {
+		b.earliest = t
+	}
+}
+
+// expireAt stops tracking the messages of a bundle at t, unless they are
+// acknowledged before.
+func (u *unackedMessages) expireAt(bundle int, t time.Time) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if b, ok := u.bundles[bundle]; ok {
+		b.expiry = t
+	}
+}
+
+// remove stops tracking the messages of a bundle.
+func (u *unackedMessages) remove(bundle int) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	delete(u.bundles, bundle)
+}
+
+// earliest returns the earliest event time of the messages that aren't
+// acknowledged, and false if there are none.
+func (u *unackedMessages) earliest(now time.Time) (time.Time, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	var earliest time.Time
+	found := false
+	for id, b := range u.bundles {
+		if !b.expiry.IsZero() && now.After(b.expiry) {
+			delete(u.bundles, id)
+			continue
+		}
+		if !found || b.earliest.Before(earliest) {
+			earliest = b.earliest
+			found = true
+		}
+	}
+	return earliest, found
+}
+
+// convert returns the event time of the message, the ID to deduplicate it by,
+// and the payload to emit for it.
+func (fn *readFn) convert(msg *pb.PubsubMessage) (beam.EventTime, string, []byte, error) {
+	et := mtime.FromTime(msg.GetPublishTime().AsTime())
+	if fn.TimestampAttribute != "" {
+		if v, ok := msg.GetAttributes()[fn.TimestampAttribute]; ok {
+			t, err := parseTimestamp(v)
+			if err != nil {
+				return 0, "", nil, fmt.Errorf("error parsing timestamp attribute %v of message %v: %v", fn.TimestampAttribute, msg.GetMessageId(), err)
+			}
+			et = mtime.FromTime(t)
+		}
+	}
+
+	id := msg.GetMessageId()
+	if fn.IDAttribute != "" {
+		if v, ok := msg.GetAttributes()[fn.IDAttribute]; ok {
+			id = v
+		}
+	}
+
+	if !fn.WithAttributes {
+		return et, id, msg.GetData(), nil
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return 0, "", nil, fmt.Errorf("error marshaling message %v: %v", msg.GetMessageId(), err)
+	}
+	return et, id, payload, nil
+}
+
+// parseTimestamp parses the value of a timestamp attribute, which is either
+// milliseconds since the Unix epoch or an RFC 3339 timestamp.
+func parseTimestamp(v string) (time.Time, error) {
+	if ms, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return time.UnixMilli(ms), nil
+	}
+	return time.Parse(time.RFC3339Nano, v)
+}
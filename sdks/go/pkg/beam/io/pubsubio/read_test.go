@@ -0,0 +1,352 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsubio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/util/pubsubx"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	pb "google.golang.org/genproto/googleapis/pubsub/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type testMessage struct {
+	Data       string
+	Attributes map[string]string
+}
+
+type emitted struct {
+	Timestamp beam.EventTime
+	ID        string
+	Payload   []byte
+}
+
+func TestReadFn_ProcessElement(t *testing.T) {
+	baseTime := time.UnixMilli(1577934245000)
+
+	tests := []struct {
+		name     string
+		opts     ReadOptions
+		messages []testMessage
+		// want returns the expected output given the IDs and publish times of the messages.
+		want func(ids []string, published []time.Time) []emitted
+	}{
+		{
+			name: "Read data with publish times and message IDs",
+			messages: []testMessage{
+				{Data: "msg1"},
+				{Data: "msg2", Attributes: map[string]string{"key": "value"}},
+			},
+			want: func(ids []string, published []time.Time) []emitted {
+				return []emitted{
+					{Timestamp: mtime.FromTime(published[0]), ID: ids[0], Payload: []byte("msg1")},
+					{Timestamp: mtime.FromTime(published[1]), ID: ids[1], Payload: []byte("msg2")},
+				}
+			},
+		},
+		{
+			name: "Read data with timestamp and ID attributes",
+			opts: ReadOptions{TimestampAttribute: "ts", IDAttribute: "id"},
+			messages: []testMessage{
+				{Data: "msg1", Attributes: map[string]string{"ts": "1577934245000", "id": "a"}},
+				{Data: "msg2", Attributes: map[string]string{"ts": "2020-01-02T03:04:06Z", "id": "b"}},
+				{Data: "msg3"},
+			},
+			want: func(ids []string, published []time.Time) []emitted {
+				return []emitted{
+					{Timestamp: mtime.FromTime(baseTime), ID: "a", Payload: []byte("msg1")},
+					{Timestamp: mtime.FromTime(baseTime.Add(time.Second)), ID: "b", Payload: []byte("msg2")},
+					{Timestamp: mtime.FromTime(published[2]), ID: ids[2], Payload: []byte("msg3")},
+				}
+			},
+		},
+		{
+			name: "Read messages with attributes",
+			opts: ReadOptions{WithAttributes: true},
+			messages: []testMessage{
+				{Data: "msg1", Attributes: map[string]string{"key": "value"}},
+			},
+			want: func(ids []string, published []time.Time) []emitted {
+				return []emitted{
+					{
+						Timestamp: mtime.FromTime(published[0]),
+						ID:        ids[0],
+						Payload:   marshalMessage(t, ids[0], published[0], "msg1", map[string]string{"key": "value"}),
+					},
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			srv := newServer(t)
+			createSubscription(t, "sub")
+
+			var ids []string
+			var published []time.Time
+			for _, msg := range tt.messages {
+				id := srv.Publish(pubsubx.MakeQualifiedTopicName(project, topic), []byte(msg.Data), msg.Attributes)
+				ids = append(ids, id)
+				published = append(published, srv.Message(id).PublishTime)
+			}
+
+			fn := newReadFn(tt.opts)
+			if err := fn.Setup(ctx); err != nil {
+				t.Fatalf("Setup() error = %v", err)
+			}
+			t.Cleanup(func() { fn.Teardown() })
+
+			sub := pubsubx.MakeQualifiedSubscriptionName(project, "sub")
+			rt := fn.CreateTracker(fn.CreateInitialRestriction(sub))
+			we := fn.CreateWatermarkEstimator(0)
+			bf := &bundleFinalization{}
+
+			var got []emitted
+			emit := func(et beam.EventTime, id string, payload []byte) {
+				got = append(got, emitted{Timestamp: et, ID: id, Payload: payload})
+			}
+
+			start := time.Now()
+			pc, err := fn.ProcessElement(ctx, we, bf, rt, sub, emit)
+			if err != nil {
+				t.Fatalf("ProcessElement() error = %v", err)
+			}
+			if !pc.ShouldResume() {
+				t.Errorf("ProcessElement() should resume")
+			}
+			byID := cmpopts.SortSlices(func(a, b emitted) bool { return a.ID < b.ID })
+			if diff := cmp.Diff(tt.want(ids, published), got, byID); diff != "" {
+				t.Errorf("ProcessElement() mismatch (-want +got):\n%s", diff)
+			}
+			earliest := mtime.MaxTimestamp
+			for _, e := range got {
+				earliest = mtime.Min(earliest, e.Timestamp)
+			}
+			if got, want := we.CurrentWatermark(), earliest.ToTime(); !got.Equal(want) {
+				t.Errorf("CurrentWatermark() = %v before finalization, want the earliest message at %v", got, want)
+			}
+
+			for _, id := range ids {
+				if acks := srv.Message(id).Acks; acks != 0 {
+					t.Errorf("message %v acks = %d before finalization, want 0", id, acks)
+				}
+			}
+			bf.finalize(t)
+			for _, id := range ids {
+				if acks := srv.Message(id).Acks; acks != 1 {
+					t.Errorf("message %v acks = %d after finalization, want 1", id, acks)
+				}
+			}
+
+			if _, err := fn.ProcessElement(ctx, we, bf, fn.CreateTracker(sub), sub, emit); err != nil {
+				t.Fatalf("ProcessElement() error = %v", err)
+			}
+			if we.CurrentWatermark().Before(start) {
+				t.Errorf("CurrentWatermark() = %v once acknowledged and idle, want at least %v", we.CurrentWatermark(), start)
+			}
+		})
+	}
+}
+
+func TestReadFn_ProcessElement_Unacknowledged(t *testing.T) {
+	ctx := context.Background()
+	srv := newServer(t)
+	createSubscription(t, "sub")
+	id := srv.Publish(pubsubx.MakeQualifiedTopicName(project, topic), []byte("msg"), nil)
+	published := srv.Message(id).PublishTime
+
+	fn := newReadFn(ReadOptions{})
+	if err := fn.Setup(ctx); err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+	t.Cleanup(func() { fn.Teardown() })
+
+	sub := pubsubx.MakeQualifiedSubscriptionName(project, "sub")
+	we := fn.CreateWatermarkEstimator(0)
+	emit := func(beam.EventTime, string, []byte) {}
+
+	// The bundle that read the message is never finalized, so the message is
+	// redelivered with its publish time, and the watermark must not pass it.
+	for i := 0; i < 2; i++ {
+		if _, err := fn.ProcessElement(ctx, we, &bundleFinalization{}, fn.CreateTracker(sub), sub, emit); err != nil {
+			t.Fatalf("ProcessElement() error = %v", err)
+		}
+		if got, want := we.CurrentWatermark(), mtime.FromTime(published).ToTime(); !got.Equal(want) {
+			t.Errorf("CurrentWatermark() = %v after read %d, want the unacknowledged message at %v", got, i, want)
+		}
+	}
+}
+
+func TestUnackedMessages(t *testing.T) {
+	now := time.UnixMilli(1577934245000)
+	u := newUnackedMessages()
+	if _, ok := u.earliest(now); ok {
+		t.Errorf("earliest() found a message, want none")
+	}
+
+	first := u.add()
+	u.observe(first, now.Add(-time.Minute))
+	second := u.add()
+	u.observe(second, now.Add(-2*time.Minute))
+	u.observe(second, now.Add(-time.Second))
+	if got, ok := u.earliest(now); !ok || !got.Equal(now.Add(-2*time.Minute)) {
+		t.Errorf("earliest() = %v, %v, want %v", got, ok, now.Add(-2*time.Minute))
+	}
+
+	u.expireAt(second, now.Add(time.Minute))
+	if got, ok := u.earliest(now.Add(2 * time.Minute)); !ok || !got.Equal(now.Add(-time.Minute)) {
+		t.Errorf("earliest() = %v, %v once the second bundle expired, want %v", got, ok, now.Add(-time.Minute))
+	}
+	u.remove(first)
+	if got, ok := u.earliest(now); ok {
+		t.Errorf("earliest() = %v once all bundles are acknowledged, want none", got)
+	}
+}
+
+func TestReadFn_ProcessElement_InvalidTimestamp(t *testing.T) {
+	ctx := context.Background()
+	srv := newServer(t)
+	createSubscription(t, "sub")
+	srv.Publish(pubsubx.MakeQualifiedTopicName(project, topic), []byte("msg"), map[string]string{"ts": "yesterday"})
+
+	fn := newReadFn(ReadOptions{TimestampAttribute: "ts"})
+	if err := fn.Setup(ctx); err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+	t.Cleanup(func() { fn.Teardown() })
+
+	sub := pubsubx.MakeQualifiedSubscriptionName(project, "sub")
+	rt := fn.CreateTracker(fn.CreateInitialRestriction(sub))
+	emit := func(beam.EventTime, string, []byte) {}
+	if _, err := fn.ProcessElement(ctx, fn.CreateWatermarkEstimator(0), &bundleFinalization{}, rt, sub, emit); err == nil {
+		t.Errorf("ProcessElement() error = nil, want error")
+	}
+}
+
+func TestReadFn_ProcessElement_Checkpointed(t *testing.T) {
+	ctx := context.Background()
+	newServer(t)
+	createSubscription(t, "sub")
+
+	fn := newReadFn(ReadOptions{})
+	if err := fn.Setup(ctx); err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+	t.Cleanup(func() { fn.Teardown() })
+
+	sub := pubsubx.MakeQualifiedSubscriptionName(project, "sub")
+	rt := fn.CreateTracker(fn.TruncateRestriction(nil, sub))
+	emit := func(beam.EventTime, string, []byte) {
+		t.Errorf("emit called for a truncated restriction")
+	}
+	pc, err := fn.ProcessElement(ctx, fn.CreateWatermarkEstimator(0), &bundleFinalization{}, rt, sub, emit)
+	if err != nil {
+		t.Fatalf("ProcessElement() error = %v", err)
+	}
+	if pc.ShouldResume() {
+		t.Errorf("ProcessElement() should stop for a truncated restriction")
+	}
+	if !rt.IsDone() {
+		t.Errorf("IsDone() = false, want true")
+	}
+}
+
+func TestReadFn_ProcessElement_DeleteSubscription(t *testing.T) {
+	tests := []struct {
+		name   string
+		delete bool
+	}{
+		{name: "Keep a given subscription", delete: false},
+		{name: "Delete a temporary subscription", delete: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			newServer(t)
+			createSubscription(t, "sub")
+
+			fn := newReadFn(ReadOptions{})
+			fn.DeleteSubscription = tt.delete
+			if err := fn.Setup(ctx); err != nil {
+				t.Fatalf("Setup() error = %v", err)
+			}
+			t.Cleanup(func() { fn.Teardown() })
+
+			sub := pubsubx.MakeQualifiedSubscriptionName(project, "sub")
+			emit := func(beam.EventTime, string, []byte) {}
+
+			rt := fn.CreateTracker(fn.TruncateRestriction(nil, sub))
+			if _, err := fn.ProcessElement(ctx, fn.CreateWatermarkEstimator(0), &bundleFinalization{}, rt, sub, emit); err != nil {
+				t.Fatalf("ProcessElement() error = %v", err)
+			}
+			ok, err := newClient(t).Subscription("sub").Exists(ctx)
+			if err != nil {
+				t.Fatalf("Subscription exists error = %v", err)
+			}
+			if ok == tt.delete {
+				t.Errorf("Subscription exists = %v after draining, want %v", ok, !tt.delete)
+			}
+		})
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	want := time.UnixMilli(1577934245123)
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{name: "Milliseconds since epoch", value: "1577934245123"},
+		{name: "RFC 3339", value: "2020-01-02T03:04:05.123Z"},
+		{name: "RFC 3339 with offset", value: "2020-01-02T04:04:05.123+01:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTimestamp(tt.value)
+			if err != nil {
+				t.Fatalf("parseTimestamp(%q) error = %v", tt.value, err)
+			}
+			if !got.Equal(want) {
+				t.Errorf("parseTimestamp(%q) = %v, want %v", tt.value, got, want)
+			}
+		})
+	}
+}
+
+func marshalMessage(t *testing.T, id string, published time.Time, data string, attrs map[string]string) []byte {
+	t.Helper()
+
+	msg := &pb.PubsubMessage{
+		MessageId:   id,
+		PublishTime: timestamppb.New(published),
+		Data:        []byte(data),
+		Attributes:  attrs,
+	}
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Failed to marshal message: %v", err)
+	}
+	return b
+}
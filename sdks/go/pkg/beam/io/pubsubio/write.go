@@ -0,0 +1,98 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsubio
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	pb "google.golang.org/genproto/googleapis/pubsub/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	register.DoFn2x1[context.Context, []byte, error](&writeFn{})
+}
+
+// writeFn publishes serialized PubsubMessages to a topic. Messages are
+// published in batches, and the bundle only completes once all of its
+// messages have been published.
+type writeFn struct {
+	Project string
+	Topic   string
+	client  *pubsub.Client
+	topic   *pubsub.Topic
+	results []*pubsub.PublishResult
+}
+
+func (fn *writeFn) Setup(ctx context.Context) error {
+	if fn.client != nil {
+		return nil
+	}
+
+	client, err := pubsub.NewClient(ctx, fn.Project)
+	if err != nil {
+		return fmt.Errorf("error creating Pub/Sub client: %v", err)
+	}
+	fn.client = client
+	fn.topic = client.Topic(fn.Topic)
+	return nil
+}
+
+func (fn *writeFn) StartBundle() {
+	fn.results = nil
+}
+
+func (fn *writeFn) ProcessElement(ctx context.Context, raw []byte) error {
+	var msg pb.PubsubMessage
+	if err := proto.Unmarshal(raw, &msg); err != nil {
+		return fmt.Errorf("error unmarshaling message: %v", err)
+	}
+
+	result := fn.topic.Publish(ctx, &pubsub.Message{
+		Data:        msg.GetData(),
+		Attributes:  msg.GetAttributes(),
+		OrderingKey: msg.GetOrderingKey(),
+	})
+	fn.results = append(fn.results, result)
+	return nil
+}
+
+func (fn *writeFn) FinishBundle(ctx context.Context) error {
+	defer func() {
+		fn.results = nil
+	}()
+
+	for _, result := range fn.results {
+		if _, err := result.Get(ctx); err != nil {
+			return fmt.Errorf("error publishing message to %v: %v", fn.Topic, err)
+		}
+	}
+	return nil
+}
+
+func (fn *writeFn) Teardown() error {
+	if fn.client == nil {
+		return nil
+	}
+
+	fn.topic.Stop()
+	err := fn.client.Close()
+	fn.client, fn.topic = nil, nil
+	return err
+}
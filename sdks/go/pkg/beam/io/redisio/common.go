@@ -0,0 +1,62 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redisio contains transforms for reading from and writing to Redis.
+//
+// The transforms connect with a redis URL of the form
+// redis://[[user]:password@]host[:port][/db], or a plain host:port.
+package redisio
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*Hash)(nil)).Elem())
+}
+
+// Hash represents a Redis hash and its fields.
+type Hash struct {
+	Key    string
+	Fields map[string]string
+}
+
+type redisFn struct {
+	URL  string
+	conn *conn
+}
+
+func (fn *redisFn) Setup(ctx context.Context) error {
+	if fn.conn != nil {
+		return nil
+	}
+
+	c, err := dial(ctx, fn.URL)
+	if err != nil {
+		return err
+	}
+	fn.conn = c
+	return nil
+}
+
+func (fn *redisFn) Teardown() {
+	if fn.conn != nil {
+		fn.conn.close()
+		fn.conn = nil
+	}
+}
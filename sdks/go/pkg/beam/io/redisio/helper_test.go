@@ -0,0 +1,425 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisio
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeRedis is an in-process stand-in for a Redis server. It speaks RESP2 and implements
+// the subset of commands used by redisio.
+type fakeRedis struct {
+	mu        sync.Mutex
+	ln        net.Listener
+	password  string
+	strings   map[string]string
+	hashes    map[string]map[string]string
+	ttls      map[string]int64
+	streams   map[string]*fakeStream
+	published [][2]string
+	dbs       []int64
+	nextID    int64
+}
+
+type fakeStream struct {
+	entries []fakeEntry
+	groups  map[string]*fakeGroup
+}
+
+type fakeEntry struct {
+	id     string
+	fields []any
+}
+
+type fakeGroup struct {
+	delivered int // number of entries delivered to the group
+	pending   map[string]*fakePending
+}
+
+type fakePending struct {
+	consumer  string
+	delivered time.Time
+}
+
+func newFakeRedis(t *testing.T) *fakeRedis {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	srv := &fakeRedis{
+		ln:      ln,
+		strings: make(map[string]string),
+		hashes:  make(map[string]map[string]string),
+		ttls:    make(map[string]int64),
+		streams: make(map[string]*fakeStream),
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			c, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go srv.serve(c)
+		}
+	}()
+	return srv
+}
+
+func (srv *fakeRedis) url() string {
+	return "redis://" + srv.ln.Addr().String()
+}
+
+func (srv *fakeRedis) serve(c net.Conn) {
+	defer c.Close()
+	r := bufio.NewReader(c)
+	w := bufio.NewWriter(c)
+	for {
+		cmd, err := readReply(r)
+		if err != nil {
+			return
+		}
+		items, ok := cmd.([]any)
+		if !ok || len(items) == 0 {
+			return
+		}
+		args := make([]string, len(items))
+		for i, item := range items {
+			args[i] = string(item.([]byte))
+		}
+		writeFakeReply(w, srv.exec(args))
+		if r.Buffered() == 0 {
+			w.Flush()
+		}
+	}
+}
+
+func writeFakeReply(w *bufio.Writer, reply any) {
+	switch v := reply.(type) {
+	case nil:
+		w.WriteString("$-1\r\n")
+	case redisError:
+		fmt.Fprintf(w, "-%s\r\n", v)
+	case int64:
+		fmt.Fprintf(w, ":%d\r\n", v)
+	case int:
+		fmt.Fprintf(w, ":%d\r\n", v)
+	case fakeStatus:
+		fmt.Fprintf(w, "+%s\r\n", v)
+	case string:
+		fmt.Fprintf(w, "$%d\r\n%s\r\n", len(v), v)
+	case []any:
+		fmt.Fprintf(w, "*%d\r\n", len(v))
+		for _, item := range v {
+			writeFakeReply(w, item)
+		}
+	default:
+		panic(fmt.Sprintf("unsupported fake reply %T", reply))
+	}
+}
+
+// fakeStatus is a simple string reply, such as "OK".
+type fakeStatus string
+
+var errSyntax = redisError("ERR syntax error")
+
+func (srv *fakeRedis) exec(args []string) any {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	switch strings.ToUpper(args[0]) {
+	case "AUTH":
+		if args[len(args)-1] != srv.password {
+			return redisError("WRONGPASS invalid username-password pair")
+		}
+		return fakeStatus("OK")
+	case "SELECT":
+		db, _ := strconv.ParseInt(args[1], 10, 64)
+		srv.dbs = append(srv.dbs, db)
+		return fakeStatus("OK")
+	case "PING":
+		return fakeStatus("PONG")
+	case "SET":
+		srv.strings[args[1]] = args[2]
+		if len(args) == 5 && strings.ToUpper(args[3]) == "PX" {
+			srv.ttls[args[1]], _ = strconv.ParseInt(args[4], 10, 64)
+		}
+		return fakeStatus("OK")
+	case "MGET":
+		var values []any
+		for _, key := range args[1:] {
+			if v, ok := srv.strings[key]; ok {
+				values = append(values, v)
+			} else {
+				values = append(values, nil)
+			}
+		}
+		return values
+	case "HSET":
+		if len(args) < 4 || len(args)%2 != 0 {
+			return redisError("ERR wrong number of arguments for 'hset' command")
+		}
+		if _, ok := srv.strings[args[1]]; ok {
+			return redisError("WRONGTYPE Operation against a key holding the wrong kind of value")
+		}
+		h, ok := srv.hashes[args[1]]
+		if !ok {
+			h = make(map[string]string)
+			srv.hashes[args[1]] = h
+		}
+		for i := 2; i < len(args); i += 2 {
+			h[args[i]] = args[i+1]
+		}
+		return int64((len(args) - 2) / 2)
+	case "HGETALL":
+		if _, ok := srv.strings[args[1]]; ok {
+			return redisError("WRONGTYPE Operation against a key holding the wrong kind of value")
+		}
+		var fields []string
+		for f := range srv.hashes[args[1]] {
+			fields = append(fields, f)
+		}
+		sort.Strings(fields)
+		values := []any{}
+		for _, f := range fields {
+			values = append(values, f, srv.hashes[args[1]][f])
+		}
+		return values
+	case "PEXPIRE":
+		srv.ttls[args[1]], _ = strconv.ParseInt(args[2], 10, 64)
+		return int64(1)
+	case "PUBLISH":
+		srv.published = append(srv.published, [2]string{args[1], args[2]})
+		return int64(0)
+	case "XADD":
+		return srv.xadd(args)
+	case "XGROUP":
+		return srv.xgroupCreate(args)
+	case "XREADGROUP":
+		return srv.xreadgroup(args)
+	case "XAUTOCLAIM":
+		return srv.xautoclaim(args)
+	case "XPENDING":
+		return srv.xpending(args)
+	case "XACK":
+		group := srv.group(args[1], args[2])
+		if group == nil {
+			return int64(0)
+		}
+		var n int64
+		for _, id := range args[3:] {
+			if _, ok := group.pending[id]; ok {
+				delete(group.pending, id)
+				n++
+			}
+		}
+		return n
+	default:
+		return redisError(fmt.Sprintf("ERR unknown command '%v'", args[0]))
+	}
+}
+
+func (srv *fakeRedis) stream(key string) *fakeStream {
+	s, ok := srv.streams[key]
+	if !ok {
+		s = &fakeStream{groups: make(map[string]*fakeGroup)}
+		srv.streams[key] = s
+	}
+	return s
+}
+
+func (srv *fakeRedis) group(key, name string) *fakeGroup {
+	s, ok := srv.streams[key]
+	if !ok {
+		return nil
+	}
+	return s.groups[name]
+}
+
+// xadd supports XADD key [MAXLEN ~ n] * field value [field value ...].
+func (srv *fakeRedis) xadd(args []string) any {
+	key, rest := args[1], args[2:]
+	maxLen := -1
+	if strings.ToUpper(rest[0]) == "MAXLEN" {
+		if rest[1] != "~" {
+			return errSyntax
+		}
+		maxLen, _ = strconv.Atoi(rest[2])
+		rest = rest[3:]
+	}
+	if rest[0] != "*" || len(rest) < 3 || len(rest)%2 != 1 {
+		return errSyntax
+	}
+
+	s := srv.stream(key)
+	srv.nextID++
+	id := fmt.Sprintf("%d-%d", time.Now().UnixMilli(), srv.nextID)
+	var fields []any
+	for _, f := range rest[1:] {
+		fields = append(fields, f)
+	}
+	s.entries = append(s.entries, fakeEntry{id: id, fields: fields})
+	if maxLen >= 0 && len(s.entries) > maxLen {
+		trimmed := len(s.entries) - maxLen
+		s.entries = s.entries[trimmed:]
+		for _, g := range s.groups {
+			g.delivered -= trimmed
+			if g.delivered < 0 {
+				g.delivered = 0
+			}
+		}
+	}
+	return id
+}
+
+// xgroupCreate supports XGROUP CREATE key group <$|0> [MKSTREAM].
+func (srv *fakeRedis) xgroupCreate(args []string) any {
+	if strings.ToUpper(args[1]) != "CREATE" {
+		return errSyntax
+	}
+	key, name, start := args[2], args[3], args[4]
+	if _, ok := srv.streams[key]; !ok && (len(args) < 6 || strings.ToUpper(args[5]) != "MKSTREAM") {
+		return redisError("ERR The XGROUP subcommand requires the key to exist.")
+	}
+	s := srv.stream(key)
+	if _, ok := s.groups[name]; ok {
+		return redisError("BUSYGROUP Consumer Group name already exists")
+	}
+	g := &fakeGroup{pending: make(map[string]*fakePending)}
+	if start == "$" {
+		g.delivered = len(s.entries)
+	}
+	s.groups[name] = g
+	return fakeStatus("OK")
+}
+
+// xreadgroup supports XREADGROUP GROUP group consumer COUNT n BLOCK ms STREAMS key >. It
+// doesn't block.
+func (srv *fakeRedis) xreadgroup(args []string) any {
+	name, consumer := args[2], args[3]
+	count, _ := strconv.Atoi(args[5])
+	key := args[9]
+	g := srv.group(key, name)
+	if g == nil {
+		return redisError("NOGROUP No such key or consumer group")
+	}
+
+	s := srv.streams[key]
+	var entries []any
+	for g.delivered < len(s.entries) && len(entries) < count {
+		e := s.entries[g.delivered]
+		g.delivered++
+		g.pending[e.id] = &fakePending{consumer: consumer, delivered: time.Now()}
+		entries = append(entries, []any{e.id, e.fields})
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+	return []any{[]any{key, entries}}
+}
+
+// xautoclaim supports XAUTOCLAIM key group consumer min-idle-time 0-0 COUNT n.
+func (srv *fakeRedis) xautoclaim(args []string) any {
+	key, name, consumer := args[1], args[2], args[3]
+	minIdle, _ := strconv.ParseInt(args[4], 10, 64)
+	count, _ := strconv.Atoi(args[7])
+	g := srv.group(key, name)
+	if g == nil {
+		return redisError("NOGROUP No such key or consumer group")
+	}
+
+	entries := []any{}
+	for _, e := range srv.streams[key].entries {
+		p, ok := g.pending[e.id]
+		if !ok || time.Since(p.delivered).Milliseconds() < minIdle || len(entries) == count {
+			continue
+		}
+		p.consumer, p.delivered = consumer, time.Now()
+		entries = append(entries, []any{e.id, e.fields})
+	}
+	return []any{"0-0", entries, []any{}}
+}
+
+// xpending supports the summary form XPENDING key group.
+func (srv *fakeRedis) xpending(args []string) any {
+	key, name := args[1], args[2]
+	g := srv.group(key, name)
+	if g == nil {
+		return redisError("NOGROUP No such key or consumer group")
+	}
+
+	var ids []any
+	counts := make(map[string]int)
+	for _, e := range srv.streams[key].entries {
+		if p, ok := g.pending[e.id]; ok {
+			ids = append(ids, e.id)
+			counts[p.consumer]++
+		}
+	}
+	if len(ids) == 0 {
+		return []any{int64(0), nil, nil, nil}
+	}
+	var consumers []any
+	for consumer, n := range counts {
+		consumers = append(consumers, []any{consumer, strconv.Itoa(n)})
+	}
+	return []any{int64(len(ids)), ids[0], ids[len(ids)-1], consumers}
+}
+
+func (srv *fakeRedis) pendingCount(key, group string) int {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	g := srv.group(key, group)
+	if g == nil {
+		return 0
+	}
+	return len(g.pending)
+}
+
+func (srv *fakeRedis) add(key string, fields ...string) {
+	args := append([]string{"XADD", key, "*"}, fields...)
+	srv.exec(args)
+}
+
+type bundleFinalization struct {
+	callbacks []func() error
+}
+
+func (bf *bundleFinalization) RegisterCallback(_ time.Duration, callback func() error) {
+	bf.callbacks = append(bf.callbacks, callback)
+}
+
+func (bf *bundleFinalization) finalize(t *testing.T) {
+	t.Helper()
+
+	for _, callback := range bf.callbacks {
+		if err := callback(); err != nil {
+			t.Fatalf("Failed to finalize bundle: %v", err)
+		}
+	}
+	bf.callbacks = nil
+}
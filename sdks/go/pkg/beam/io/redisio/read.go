@@ -0,0 +1,151 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisio
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+const defaultReadBatchSize = 1000
+
+var typeOfKeys = reflect.TypeOf([]string{})
+
+func init() {
+	register.DoFn3x1[context.Context, []string, func(string, string), error](&readFn{})
+	register.Emitter2[string, string]()
+	register.DoFn3x1[context.Context, []string, func(Hash), error](&readHashesFn{})
+	register.Emitter1[Hash]()
+}
+
+// Read reads the string values of lists of keys with MGET. It takes a PCollection<[]string>
+// of key lists and returns a PCollection<KV<string, string>> of the keys and their values.
+// Keys that don't exist or don't hold a string are omitted.
+// Read takes a variable number of ReadOptionFn to configure the read operation:
+//   - ReadBatchSize: maximum number of keys per MGET. Defaults to 1000.
+func Read(s beam.Scope, url string, keys beam.PCollection, opts ...ReadOptionFn) beam.PCollection {
+	s = s.Scope("redisio.Read")
+
+	option := newReadOption("Read", keys, opts)
+	return beam.ParDo(s, &readFn{redisFn: redisFn{URL: url}, BatchSize: option.BatchSize}, keys)
+}
+
+// ReadHashes reads the hashes of lists of keys with pipelined HGETALL commands. It takes a
+// PCollection<[]string> of key lists and returns a PCollection<Hash> of the keys and their
+// fields. Keys that don't exist are omitted.
+// ReadHashes takes a variable number of ReadOptionFn to configure the read operation:
+//   - ReadBatchSize: maximum number of HGETALL commands per pipeline. Defaults to 1000.
+func ReadHashes(s beam.Scope, url string, keys beam.PCollection, opts ...ReadOptionFn) beam.PCollection {
+	s = s.Scope("redisio.ReadHashes")
+
+	option := newReadOption("ReadHashes", keys, opts)
+	return beam.ParDo(s, &readHashesFn{redisFn: redisFn{URL: url}, BatchSize: option.BatchSize}, keys)
+}
+
+func newReadOption(name string, keys beam.PCollection, opts []ReadOptionFn) *readOption {
+	if t := keys.Type().Type(); t != typeOfKeys {
+		panic(fmt.Sprintf("redisio.%v: keys must be a PCollection<[]string>, got %v", name, t))
+	}
+
+	option := &readOption{BatchSize: defaultReadBatchSize}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("redisio.%v: invalid option: %v", name, err))
+		}
+	}
+	return option
+}
+
+// batches splits keys into consecutive batches of at most size keys.
+func batches(keys []string, size int) [][]string {
+	var out [][]string
+	for len(keys) > size {
+		out = append(out, keys[:size])
+		keys = keys[size:]
+	}
+	if len(keys) > 0 {
+		out = append(out, keys)
+	}
+	return out
+}
+
+type readFn struct {
+	redisFn
+	BatchSize int
+}
+
+func (fn *readFn) ProcessElement(ctx context.Context, keys []string, emit func(string, string)) error {
+	for _, batch := range batches(keys, fn.BatchSize) {
+		args := make([]any, 0, len(batch)+1)
+		args = append(args, "MGET")
+		for _, key := range batch {
+			args = append(args, key)
+		}
+
+		reply, err := fn.conn.do(ctx, args...)
+		if err != nil {
+			return fmt.Errorf("error reading keys: %v", err)
+		}
+		values, ok := reply.([]any)
+		if !ok || len(values) != len(batch) {
+			return fmt.Errorf("error reading keys: %w: %v", errUnexpectedReply, reply)
+		}
+
+		for i, v := range values {
+			if b, ok := v.([]byte); ok {
+				emit(batch[i], string(b))
+			}
+		}
+	}
+	return nil
+}
+
+type readHashesFn struct {
+	redisFn
+	BatchSize int
+}
+
+func (fn *readHashesFn) ProcessElement(ctx context.Context, keys []string, emit func(Hash)) error {
+	for _, batch := range batches(keys, fn.BatchSize) {
+		cmds := make([][]any, len(batch))
+		for i, key := range batch {
+			cmds[i] = []any{"HGETALL", key}
+		}
+
+		replies, err := fn.conn.pipeline(ctx, cmds)
+		if err != nil {
+			return fmt.Errorf("error reading hashes: %v", err)
+		}
+		if err := firstError(replies); err != nil {
+			return fmt.Errorf("error reading hashes: %v", err)
+		}
+
+		for i, reply := range replies {
+			fields, err := stringMap(reply)
+			if err != nil {
+				return fmt.Errorf("error reading hash %v: %v", batch[i], err)
+			}
+			if len(fields) > 0 {
+				emit(Hash{Key: batch[i], Fields: fields})
+			}
+		}
+	}
+	return nil
+}
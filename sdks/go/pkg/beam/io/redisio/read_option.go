@@ -0,0 +1,41 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisio
+
+import "errors"
+
+var errInvalidBatchSize = errors.New("batch size must be greater than 0")
+
+type readOption struct {
+	BatchSize int
+}
+
+// ReadOptionFn is a function that can be passed to Read and ReadHashes to configure options
+// for reading from Redis.
+type ReadOptionFn func(option *readOption) error
+
+// ReadBatchSize sets the maximum number of keys to read with a single MGET, or in a single
+// pipeline of HGETALL commands. Longer key lists are read in several batches.
+func ReadBatchSize(size int) ReadOptionFn {
+	return func(o *readOption) error {
+		if size <= 0 {
+			return errInvalidBatchSize
+		}
+
+		o.BatchSize = size
+		return nil
+	}
+}
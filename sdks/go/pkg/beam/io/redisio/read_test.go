@@ -0,0 +1,100 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisio
+
+import (
+	"testing"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func init() {
+	register.Function2x1(formatKV)
+}
+
+type keyValue struct {
+	Key   string
+	Value string
+}
+
+func formatKV(key, value string) keyValue {
+	return keyValue{Key: key, Value: value}
+}
+
+func TestMain(m *testing.M) {
+	ptest.Main(m)
+}
+
+func TestRead(t *testing.T) {
+	srv := newFakeRedis(t)
+	srv.strings["key1"] = "value1"
+	srv.strings["key2"] = "value2"
+	srv.strings["key3"] = "value3"
+
+	p, s := beam.NewPipelineWithRoot()
+
+	keys := beam.Create(s, []string{"key1", "key2"}, []string{"key3", "missing"})
+	got := beam.ParDo(s, formatKV, Read(s, srv.url(), keys, ReadBatchSize(1)))
+
+	passert.Equals(s, got,
+		keyValue{Key: "key1", Value: "value1"},
+		keyValue{Key: "key2", Value: "value2"},
+		keyValue{Key: "key3", Value: "value3"},
+	)
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestReadHashes(t *testing.T) {
+	srv := newFakeRedis(t)
+	srv.hashes["hash1"] = map[string]string{"a": "1", "b": "2"}
+	srv.hashes["hash2"] = map[string]string{"c": "3"}
+
+	p, s := beam.NewPipelineWithRoot()
+
+	keys := beam.Create(s, []string{"hash1", "hash2", "missing"})
+	got := ReadHashes(s, srv.url(), keys)
+
+	passert.Equals(s, got,
+		Hash{Key: "hash1", Fields: map[string]string{"a": "1", "b": "2"}},
+		Hash{Key: "hash2", Fields: map[string]string{"c": "3"}},
+	)
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestRead_invalidInput(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Read() did not panic, want panic")
+		}
+	}()
+
+	_, s := beam.NewPipelineWithRoot()
+	Read(s, "localhost", beam.Create(s, "key"))
+}
+
+func TestBatches(t *testing.T) {
+	got := batches([]string{"a", "b", "c", "d", "e"}, 2)
+	want := [][]string{{"a", "b"}, {"c", "d"}, {"e"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("batches() mismatch (-want +got):\n%s", diff)
+	}
+}
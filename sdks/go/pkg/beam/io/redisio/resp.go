@@ -0,0 +1,300 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisio
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultPort = "6379"
+	dialTimeout = 10 * time.Second
+	// ioTimeout bounds each exchange with the server, in addition to the blocking time of
+	// blocking commands.
+	ioTimeout = 30 * time.Second
+)
+
+// redisError is an error reply of the server, such as "WRONGTYPE Operation against a key
+// holding the wrong kind of value".
+type redisError string
+
+func (e redisError) Error() string {
+	return string(e)
+}
+
+// prefix returns the error code of the reply, such as "WRONGTYPE".
+func (e redisError) prefix() string {
+	code, _, _ := strings.Cut(string(e), " ")
+	return code
+}
+
+// connConfig is the parsed form of a redis URL: redis://[[user]:password@]host[:port][/db].
+// A plain host:port is accepted as well.
+type connConfig struct {
+	addr     string
+	user     string
+	password string
+	db       int
+}
+
+func parseURL(s string) (connConfig, error) {
+	if !strings.Contains(s, "://") {
+		s = "redis://" + s
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return connConfig{}, fmt.Errorf("invalid redis URL: %v", err)
+	}
+	if u.Scheme != "redis" {
+		return connConfig{}, fmt.Errorf("invalid redis URL scheme %q, want redis", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return connConfig{}, fmt.Errorf("invalid redis URL %q: missing host", s)
+	}
+
+	cfg := connConfig{addr: u.Host}
+	if u.Port() == "" {
+		cfg.addr = net.JoinHostPort(u.Hostname(), defaultPort)
+	}
+	if u.User != nil {
+		cfg.user = u.User.Username()
+		cfg.password, _ = u.User.Password()
+	}
+	if db := strings.TrimPrefix(u.Path, "/"); db != "" {
+		if cfg.db, err = strconv.Atoi(db); err != nil {
+			return connConfig{}, fmt.Errorf("invalid redis URL database %q: %v", db, err)
+		}
+	}
+	return cfg, nil
+}
+
+// conn is a connection speaking the RESP2 protocol. Commands are sent as arrays of bulk
+// strings and may be pipelined. It is safe for concurrent use; exchanges are serialized.
+type conn struct {
+	mu sync.Mutex
+	nc net.Conn
+	r  *bufio.Reader
+	w  *bufio.Writer
+}
+
+func dial(ctx context.Context, rawURL string) (*conn, error) {
+	cfg, err := parseURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	d := net.Dialer{Timeout: dialTimeout}
+	nc, err := d.DialContext(ctx, "tcp", cfg.addr)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to redis at %v: %v", cfg.addr, err)
+	}
+	c := &conn{nc: nc, r: bufio.NewReader(nc), w: bufio.NewWriter(nc)}
+
+	var setup [][]any
+	switch {
+	case cfg.user != "":
+		setup = append(setup, []any{"AUTH", cfg.user, cfg.password})
+	case cfg.password != "":
+		setup = append(setup, []any{"AUTH", cfg.password})
+	}
+	if cfg.db != 0 {
+		setup = append(setup, []any{"SELECT", cfg.db})
+	}
+	if len(setup) > 0 {
+		replies, err := c.pipeline(ctx, setup)
+		if err == nil {
+			err = firstError(replies)
+		}
+		if err != nil {
+			c.close()
+			return nil, fmt.Errorf("error connecting to redis at %v: %v", cfg.addr, err)
+		}
+	}
+	return c, nil
+}
+
+func (c *conn) close() error {
+	return c.nc.Close()
+}
+
+// do sends a single command and returns its reply. Error replies are returned as errors.
+func (c *conn) do(ctx context.Context, args ...any) (any, error) {
+	return c.doBlocking(ctx, 0, args...)
+}
+
+// doBlocking is do for commands that block on the server for up to the given duration.
+func (c *conn) doBlocking(ctx context.Context, block time.Duration, args ...any) (any, error) {
+	replies, err := c.exchange(ctx, block, [][]any{args})
+	if err != nil {
+		return nil, err
+	}
+	if err, ok := replies[0].(redisError); ok {
+		return nil, err
+	}
+	return replies[0], nil
+}
+
+// pipeline sends the commands in one write and reads all their replies. Error replies are
+// returned in place of the reply of the failed command, as the other commands still apply.
+func (c *conn) pipeline(ctx context.Context, cmds [][]any) ([]any, error) {
+	return c.exchange(ctx, 0, cmds)
+}
+
+func (c *conn) exchange(ctx context.Context, block time.Duration, cmds [][]any) ([]any, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	deadline := time.Now().Add(ioTimeout + block)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := c.nc.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	for _, cmd := range cmds {
+		if err := writeCommand(c.w, cmd); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.w.Flush(); err != nil {
+		return nil, fmt.Errorf("error writing to redis: %v", err)
+	}
+
+	replies := make([]any, len(cmds))
+	for i := range cmds {
+		reply, err := readReply(c.r)
+		if err != nil {
+			return nil, fmt.Errorf("error reading from redis: %v", err)
+		}
+		replies[i] = reply
+	}
+	return replies, nil
+}
+
+func writeCommand(w *bufio.Writer, args []any) error {
+	fmt.Fprintf(w, "*%d\r\n", len(args))
+	for _, arg := range args {
+		var s string
+		switch v := arg.(type) {
+		case string:
+			s = v
+		case []byte:
+			s = string(v)
+		case int:
+			s = strconv.Itoa(v)
+		case int64:
+			s = strconv.FormatInt(v, 10)
+		default:
+			return fmt.Errorf("unsupported redis argument type %T", arg)
+		}
+		fmt.Fprintf(w, "$%d\r\n%s\r\n", len(s), s)
+	}
+	return nil
+}
+
+// readReply reads a reply: simple strings as string, errors as redisError, integers as
+// int64, bulk strings as []byte and arrays as []any. Null bulk strings and arrays are nil.
+func readReply(r *bufio.Reader) (any, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("malformed reply %q", line)
+	}
+	kind, body := line[0], line[1:len(line)-2]
+
+	switch kind {
+	case '+':
+		return body, nil
+	case '-':
+		return redisError(body), nil
+	case ':':
+		return strconv.ParseInt(body, 10, 64)
+	case '$':
+		n, err := strconv.Atoi(body)
+		if err != nil {
+			return nil, fmt.Errorf("malformed bulk string length %q", body)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		return buf[:n], nil
+	case '*':
+		n, err := strconv.Atoi(body)
+		if err != nil {
+			return nil, fmt.Errorf("malformed array length %q", body)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		items := make([]any, n)
+		for i := range items {
+			if items[i], err = readReply(r); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("unknown reply type %q", kind)
+	}
+}
+
+// firstError returns the first error reply, if any.
+func firstError(replies []any) error {
+	for _, reply := range replies {
+		if err, ok := reply.(redisError); ok {
+			return err
+		}
+	}
+	return nil
+}
+
+var errUnexpectedReply = errors.New("unexpected reply")
+
+// stringMap converts a flat array reply of field/value pairs, as returned by HGETALL and
+// for the fields of stream entries, to a map.
+func stringMap(reply any) (map[string]string, error) {
+	items, ok := reply.([]any)
+	if !ok || len(items)%2 != 0 {
+		return nil, fmt.Errorf("%w: %v", errUnexpectedReply, reply)
+	}
+	m := make(map[string]string, len(items)/2)
+	for i := 0; i < len(items); i += 2 {
+		k, kok := items[i].([]byte)
+		v, vok := items[i+1].([]byte)
+		if !kok || !vok {
+			return nil, fmt.Errorf("%w: %v", errUnexpectedReply, reply)
+		}
+		m[string(k)] = string(v)
+	}
+	return m, nil
+}
@@ -0,0 +1,358 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisio
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/sdf"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/internal/unbounded"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+func init() {
+	register.DoFn6x2[
+		context.Context, *unbounded.WatermarkEstimator, beam.BundleFinalization, *sdf.LockRTracker, string,
+		func(beam.EventTime, StreamEntry), sdf.ProcessContinuation, error,
+	](
+		&readStreamFn{},
+	)
+	register.Emitter2[beam.EventTime, StreamEntry]()
+
+	beam.RegisterType(reflect.TypeOf((*StreamEntry)(nil)).Elem())
+}
+
+const (
+	defaultConsumer  = "beam"
+	defaultStartID   = "$"
+	defaultCount     = 100
+	defaultClaimIdle = 10 * time.Minute
+
+	blockTime           = time.Second
+	maxReadTime         = 10 * time.Second
+	resumeDelay         = time.Second
+	ackBatchSize        = 1000
+	finalizationTimeout = 5 * time.Minute
+)
+
+// StreamEntry represents an entry read from a Redis stream.
+type StreamEntry struct {
+	Stream string
+	ID     string
+	Fields map[string]string
+}
+
+// ReadStreams reads an unbounded number of entries from the given streams as a consumer
+// group, and returns an unbounded PCollection<StreamEntry>. The group is created if it
+// doesn't exist. Entries are acknowledged once the bundle that read them is finalized.
+// Entries that stay pending, as their bundle failed, are claimed and read again, so
+// entries are read at least once.
+//
+// The event time of each entry is the time of its ID. The watermark is held at the oldest
+// entry pending in the consumer group, since pending entries can be claimed and read again,
+// and advances to the current time once no entries are pending.
+//
+// ReadStreams takes a variable number of ReadStreamOptionFn to configure the read operation:
+//   - ReadStreamConsumer: name of the group consumer. Defaults to "beam".
+//   - ReadStreamStartID: ID after which a new group starts reading. Defaults to "$", the
+//     entries added after the group is created.
+//   - ReadStreamCount: maximum number of entries to read at a time. Defaults to 100.
+//   - ReadStreamClaimIdle: pending time after which entries are read again. Defaults to 10m.
+func ReadStreams(
+	s beam.Scope,
+	url string,
+	group string,
+	streams []string,
+	opts ...ReadStreamOptionFn,
+) beam.PCollection {
+	s = s.Scope("redisio.ReadStreams")
+
+	option := &readStreamOption{
+		Consumer:  defaultConsumer,
+		StartID:   defaultStartID,
+		Count:     defaultCount,
+		ClaimIdle: defaultClaimIdle,
+	}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("redisio.ReadStreams: invalid option: %v", err))
+		}
+	}
+	if group == "" || len(streams) == 0 {
+		panic("redisio.ReadStreams: group and streams must not be empty")
+	}
+
+	col := beam.CreateList(s, streams)
+	return beam.ParDo(s, newReadStreamFn(url, group, option), col)
+}
+
+// readStreamFn reads the entries of a stream as a consumer group.
+type readStreamFn struct {
+	redisFn
+	Group     string
+	Consumer  string
+	StartID   string
+	Count     int64
+	ClaimIdle int64 // milliseconds
+	groups    map[string]bool
+}
+
+func newReadStreamFn(url, group string, option *readStreamOption) *readStreamFn {
+	return &readStreamFn{
+		redisFn:   redisFn{URL: url},
+		Group:     group,
+		Consumer:  option.Consumer,
+		StartID:   option.StartID,
+		Count:     option.Count,
+		ClaimIdle: option.ClaimIdle.Milliseconds(),
+	}
+}
+
+func (fn *readStreamFn) CreateInitialRestriction(stream string) string {
+	return stream
+}
+
+func (fn *readStreamFn) SplitRestriction(_ string, rest string) []string {
+	return []string{rest}
+}
+
+func (fn *readStreamFn) RestrictionSize(_ string, _ string) float64 {
+	return 1
+}
+
+func (fn *readStreamFn) CreateTracker(rest string) *sdf.LockRTracker {
+	return sdf.NewLockRTracker(unbounded.NewTracker(rest))
+}
+
+func (fn *readStreamFn) TruncateRestriction(_ *sdf.LockRTracker, _ string) string {
+	return ""
+}
+
+func (fn *readStreamFn) InitialWatermarkEstimatorState(et beam.EventTime, _ string, _ string) int64 {
+	return et.Milliseconds()
+}
+
+func (fn *readStreamFn) CreateWatermarkEstimator(ms int64) *unbounded.WatermarkEstimator {
+	return &unbounded.WatermarkEstimator{State: ms}
+}
+
+func (fn *readStreamFn) WatermarkEstimatorState(we *unbounded.WatermarkEstimator) int64 {
+	return we.State
+}
+
+// ProcessElement reads entries from the stream for up to maxReadTime, and then checkpoints
+// so the entries can be acknowledged once the bundle is finalized.
+func (fn *readStreamFn) ProcessElement(
+	ctx context.Context,
+	we *unbounded.WatermarkEstimator,
+	bf beam.BundleFinalization,
+	rt *sdf.LockRTracker,
+	stream string,
+	emit func(beam.EventTime, StreamEntry),
+) (sdf.ProcessContinuation, error) {
+	if !rt.TryClaim(stream) {
+		return sdf.StopProcessing(), nil
+	}
+	if err := fn.ensureGroup(ctx, stream); err != nil {
+		return sdf.StopProcessing(), err
+	}
+
+	var ids []string
+	defer func() {
+		fn.registerAck(bf, stream, ids)
+	}()
+
+	deadline := time.Now().Add(maxReadTime)
+	for time.Now().Before(deadline) {
+		entries, err := fn.claim(ctx, stream)
+		if err != nil {
+			return sdf.StopProcessing(), err
+		}
+		if len(entries) == 0 {
+			if entries, err = fn.read(ctx, stream); err != nil {
+				return sdf.StopProcessing(), err
+			}
+		}
+
+		if len(entries) == 0 {
+			if err := fn.advanceWatermark(ctx, we, stream); err != nil {
+				return sdf.StopProcessing(), err
+			}
+			return sdf.ResumeProcessingIn(resumeDelay), nil
+		}
+
+		for _, entry := range entries {
+			emit(entryTime(entry.ID), entry)
+			ids = append(ids, entry.ID)
+		}
+		if err := fn.advanceWatermark(ctx, we, stream); err != nil {
+			return sdf.StopProcessing(), err
+		}
+	}
+
+	return sdf.ResumeProcessingIn(0), nil
+}
+
+// advanceWatermark advances the watermark to the oldest entry pending in the consumer
+// group, which includes the entries read but not yet acknowledged. Entries that are yet to
+// be read have later IDs than the pending ones, so without pending entries, later entries
+// are expected to be added after now.
+func (fn *readStreamFn) advanceWatermark(ctx context.Context, we *unbounded.WatermarkEstimator, stream string) error {
+	reply, err := fn.conn.do(ctx, "XPENDING", stream, fn.Group)
+	if err != nil {
+		return fmt.Errorf("error reading pending entries of stream %v: %v", stream, err)
+	}
+	items, ok := reply.([]any)
+	if !ok || len(items) < 2 {
+		return fmt.Errorf("error reading pending entries of stream %v: %w: %v", stream, errUnexpectedReply, reply)
+	}
+	if count, _ := items[0].(int64); count == 0 {
+		we.Advance(time.Now())
+		return nil
+	}
+	oldest, ok := items[1].([]byte)
+	if !ok {
+		return fmt.Errorf("error reading pending entries of stream %v: %w: %v", stream, errUnexpectedReply, reply)
+	}
+	we.Advance(entryTime(string(oldest)).ToTime())
+	return nil
+}
+
+// ensureGroup creates the consumer group of the stream, and the stream, if they don't exist.
+func (fn *readStreamFn) ensureGroup(ctx context.Context, stream string) error {
+	if fn.groups[stream] {
+		return nil
+	}
+
+	_, err := fn.conn.do(ctx, "XGROUP", "CREATE", stream, fn.Group, fn.StartID, "MKSTREAM")
+	if rerr, ok := err.(redisError); ok && rerr.prefix() == "BUSYGROUP" {
+		err = nil
+	}
+	if err != nil {
+		return fmt.Errorf("error creating consumer group %v of stream %v: %v", fn.Group, stream, err)
+	}
+
+	if fn.groups == nil {
+		fn.groups = make(map[string]bool)
+	}
+	fn.groups[stream] = true
+	return nil
+}
+
+// claim claims entries that have been pending for longer than the claim idle time.
+func (fn *readStreamFn) claim(ctx context.Context, stream string) ([]StreamEntry, error) {
+	reply, err := fn.conn.do(ctx, "XAUTOCLAIM", stream, fn.Group, fn.Consumer, fn.ClaimIdle, "0-0", "COUNT", fn.Count)
+	if err != nil {
+		return nil, fmt.Errorf("error claiming pending entries of stream %v: %v", stream, err)
+	}
+	items, ok := reply.([]any)
+	if !ok || len(items) < 2 {
+		return nil, fmt.Errorf("error claiming pending entries of stream %v: %w: %v", stream, errUnexpectedReply, reply)
+	}
+	return parseEntries(stream, items[1])
+}
+
+// read reads new entries, blocking for up to blockTime if there are none.
+func (fn *readStreamFn) read(ctx context.Context, stream string) ([]StreamEntry, error) {
+	reply, err := fn.conn.doBlocking(ctx, blockTime,
+		"XREADGROUP", "GROUP", fn.Group, fn.Consumer, "COUNT", fn.Count,
+		"BLOCK", blockTime.Milliseconds(), "STREAMS", stream, ">")
+	if err != nil {
+		return nil, fmt.Errorf("error reading stream %v: %v", stream, err)
+	}
+	if reply == nil {
+		return nil, nil
+	}
+
+	streams, ok := reply.([]any)
+	if !ok || len(streams) != 1 {
+		return nil, fmt.Errorf("error reading stream %v: %w: %v", stream, errUnexpectedReply, reply)
+	}
+	kv, ok := streams[0].([]any)
+	if !ok || len(kv) != 2 {
+		return nil, fmt.Errorf("error reading stream %v: %w: %v", stream, errUnexpectedReply, reply)
+	}
+	return parseEntries(stream, kv[1])
+}
+
+// parseEntries parses an array of [id, [field, value, ...]] entries. Entries that were
+// deleted while pending have no fields, and are skipped.
+func parseEntries(stream string, reply any) ([]StreamEntry, error) {
+	items, ok := reply.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", errUnexpectedReply, reply)
+	}
+
+	var entries []StreamEntry
+	for _, item := range items {
+		pair, ok := item.([]any)
+		if !ok || len(pair) != 2 {
+			continue
+		}
+		id, ok := pair[0].([]byte)
+		if !ok || pair[1] == nil {
+			continue
+		}
+		fields, err := stringMap(pair[1])
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, StreamEntry{Stream: stream, ID: string(id), Fields: fields})
+	}
+	return entries, nil
+}
+
+// entryTime returns the time of an entry ID, which is <milliseconds>-<sequence number>.
+func entryTime(id string) beam.EventTime {
+	ms, _, _ := strings.Cut(id, "-")
+	t, err := strconv.ParseInt(ms, 10, 64)
+	if err != nil {
+		return mtime.Now()
+	}
+	return mtime.FromMilliseconds(t)
+}
+
+func (fn *readStreamFn) registerAck(bf beam.BundleFinalization, stream string, ids []string) {
+	if len(ids) == 0 {
+		return
+	}
+
+	bf.RegisterCallback(finalizationTimeout, func() error {
+		ctx := context.Background()
+		for start := 0; start < len(ids); start += ackBatchSize {
+			end := start + ackBatchSize
+			if end > len(ids) {
+				end = len(ids)
+			}
+
+			args := []any{"XACK", stream, fn.Group}
+			for _, id := range ids[start:end] {
+				args = append(args, id)
+			}
+			if _, err := fn.conn.do(ctx, args...); err != nil {
+				return fmt.Errorf("error acknowledging entries of stream %v: %v", stream, err)
+			}
+		}
+		return nil
+	})
+}
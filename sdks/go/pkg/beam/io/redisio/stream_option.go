@@ -0,0 +1,91 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisio
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidConsumer  = errors.New("consumer name must not be empty")
+	errInvalidStartID   = errors.New("start ID must not be empty")
+	errInvalidCount     = errors.New("count must be greater than 0")
+	errInvalidClaimIdle = errors.New("claim idle time must be at least 1ms")
+)
+
+type readStreamOption struct {
+	Consumer  string
+	StartID   string
+	Count     int64
+	ClaimIdle time.Duration
+}
+
+// ReadStreamOptionFn is a function that can be passed to ReadStreams to configure options for
+// reading from Redis Streams.
+type ReadStreamOptionFn func(option *readStreamOption) error
+
+// ReadStreamConsumer sets the name of the consumer of the group that reads the streams.
+func ReadStreamConsumer(name string) ReadStreamOptionFn {
+	return func(o *readStreamOption) error {
+		if name == "" {
+			return errInvalidConsumer
+		}
+
+		o.Consumer = name
+		return nil
+	}
+}
+
+// ReadStreamStartID sets the ID after which a consumer group created by ReadStreams starts
+// reading, such as "0" to read the streams from the beginning. Groups that already exist
+// continue where they left off.
+func ReadStreamStartID(id string) ReadStreamOptionFn {
+	return func(o *readStreamOption) error {
+		if id == "" {
+			return errInvalidStartID
+		}
+
+		o.StartID = id
+		return nil
+	}
+}
+
+// ReadStreamCount sets the maximum number of entries to read from a stream at a time.
+func ReadStreamCount(count int64) ReadStreamOptionFn {
+	return func(o *readStreamOption) error {
+		if count <= 0 {
+			return errInvalidCount
+		}
+
+		o.Count = count
+		return nil
+	}
+}
+
+// ReadStreamClaimIdle sets how long an entry must have been pending, delivered but not
+// acknowledged, before it is claimed and read again. It must be longer than it takes for
+// bundles to be finalized, or entries are read more than once.
+func ReadStreamClaimIdle(idle time.Duration) ReadStreamOptionFn {
+	return func(o *readStreamOption) error {
+		if idle < time.Millisecond {
+			return errInvalidClaimIdle
+		}
+
+		o.ClaimIdle = idle
+		return nil
+	}
+}
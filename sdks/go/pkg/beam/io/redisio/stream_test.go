@@ -0,0 +1,220 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/internal/unbounded"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newTestReadStreamFn(t *testing.T, url string, option *readStreamOption) *readStreamFn {
+	t.Helper()
+
+	fn := newReadStreamFn(url, "group", option)
+	if err := fn.Setup(context.Background()); err != nil {
+		t.Fatalf("Setup() error = %v", err)
+	}
+	t.Cleanup(fn.Teardown)
+	return fn
+}
+
+func defaultReadStreamOption() *readStreamOption {
+	return &readStreamOption{
+		Consumer:  defaultConsumer,
+		StartID:   "0",
+		Count:     defaultCount,
+		ClaimIdle: defaultClaimIdle,
+	}
+}
+
+func processStream(t *testing.T, fn *readStreamFn, we *unbounded.WatermarkEstimator, bf beam.BundleFinalization) []StreamEntry {
+	t.Helper()
+
+	var got []StreamEntry
+	emit := func(et beam.EventTime, entry StreamEntry) {
+		if want := entryTime(entry.ID); et != want {
+			t.Errorf("Event time of %v = %v, want %v", entry.ID, et, want)
+		}
+		got = append(got, entry)
+	}
+
+	rt := fn.CreateTracker(fn.CreateInitialRestriction("stream"))
+	pc, err := fn.ProcessElement(context.Background(), we, bf, rt, "stream", emit)
+	if err != nil {
+		t.Fatalf("ProcessElement() error = %v", err)
+	}
+	if !pc.ShouldResume() {
+		t.Error("ProcessElement() should resume")
+	}
+	return got
+}
+
+func fieldValues(entries []StreamEntry) []string {
+	var values []string
+	for _, e := range entries {
+		values = append(values, e.Fields["n"])
+	}
+	return values
+}
+
+func TestReadStreamFn_ProcessElement(t *testing.T) {
+	srv := newFakeRedis(t)
+	srv.add("stream", "n", "1")
+	srv.add("stream", "n", "2")
+
+	fn := newTestReadStreamFn(t, srv.url(), defaultReadStreamOption())
+	bf := &bundleFinalization{}
+	got := processStream(t, fn, fn.CreateWatermarkEstimator(0), bf)
+
+	if diff := cmp.Diff([]string{"1", "2"}, fieldValues(got)); diff != "" {
+		t.Errorf("Entries mismatch (-want +got):\n%s", diff)
+	}
+	if got, want := srv.pendingCount("stream", "group"), 2; got != want {
+		t.Errorf("Pending entries before finalization = %v, want %v", got, want)
+	}
+
+	bf.finalize(t)
+
+	if got, want := srv.pendingCount("stream", "group"), 0; got != want {
+		t.Errorf("Pending entries after finalization = %v, want %v", got, want)
+	}
+
+	srv.add("stream", "n", "3")
+	got = processStream(t, fn, fn.CreateWatermarkEstimator(0), bf)
+
+	if diff := cmp.Diff([]string{"3"}, fieldValues(got)); diff != "" {
+		t.Errorf("Entries after finalization mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadStreamFn_ProcessElement_claim(t *testing.T) {
+	srv := newFakeRedis(t)
+	srv.add("stream", "n", "1")
+
+	option := defaultReadStreamOption()
+	option.ClaimIdle = time.Millisecond
+	fn := newTestReadStreamFn(t, srv.url(), option)
+
+	// The first bundle is never finalized, so its entry stays pending.
+	processStream(t, fn, fn.CreateWatermarkEstimator(0), &bundleFinalization{})
+	time.Sleep(2 * time.Millisecond)
+
+	bf := &bundleFinalization{}
+	got := processStream(t, fn, fn.CreateWatermarkEstimator(0), bf)
+	if diff := cmp.Diff([]string{"1"}, fieldValues(got)); diff != "" {
+		t.Errorf("Claimed entries mismatch (-want +got):\n%s", diff)
+	}
+
+	bf.finalize(t)
+	if got, want := srv.pendingCount("stream", "group"), 0; got != want {
+		t.Errorf("Pending entries after finalization = %v, want %v", got, want)
+	}
+}
+
+func TestReadStreamFn_ProcessElement_watermark(t *testing.T) {
+	srv := newFakeRedis(t)
+	srv.add("stream", "n", "1")
+
+	fn := newTestReadStreamFn(t, srv.url(), defaultReadStreamOption())
+	we := fn.CreateWatermarkEstimator(0)
+	bf := &bundleFinalization{}
+	got := processStream(t, fn, we, bf)
+	if len(got) != 1 {
+		t.Fatalf("Entries = %v, want 1 entry", got)
+	}
+	pending := entryTime(got[0].ID).ToTime()
+	if !we.CurrentWatermark().Equal(pending) {
+		t.Errorf("CurrentWatermark() = %v, want the pending entry at %v", we.CurrentWatermark(), pending)
+	}
+
+	// The entry stays pending until the bundle is finalized, as it could be claimed and read
+	// again, so the watermark must not pass it while no new entries are read.
+	time.Sleep(2 * time.Millisecond)
+	processStream(t, fn, we, &bundleFinalization{})
+	if !we.CurrentWatermark().Equal(pending) {
+		t.Errorf("CurrentWatermark() = %v while the entry is pending, want %v", we.CurrentWatermark(), pending)
+	}
+
+	bf.finalize(t)
+	start := time.Now()
+	processStream(t, fn, we, &bundleFinalization{})
+	if we.CurrentWatermark().Before(start.Truncate(time.Millisecond)) {
+		t.Errorf("CurrentWatermark() = %v without pending entries, want at least %v", we.CurrentWatermark(), start)
+	}
+}
+
+func TestReadStreamFn_ProcessElement_existingGroup(t *testing.T) {
+	srv := newFakeRedis(t)
+	srv.add("stream", "n", "1")
+	srv.exec([]string{"XGROUP", "CREATE", "stream", "group", "$"})
+	srv.add("stream", "n", "2")
+
+	fn := newTestReadStreamFn(t, srv.url(), defaultReadStreamOption())
+	got := processStream(t, fn, fn.CreateWatermarkEstimator(0), &bundleFinalization{})
+
+	if diff := cmp.Diff([]string{"2"}, fieldValues(got)); diff != "" {
+		t.Errorf("Entries mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadStreamFn_ProcessElement_checkpointed(t *testing.T) {
+	srv := newFakeRedis(t)
+	fn := newTestReadStreamFn(t, srv.url(), defaultReadStreamOption())
+
+	rt := fn.CreateTracker(fn.TruncateRestriction(nil, "stream"))
+	emit := func(beam.EventTime, StreamEntry) {
+		t.Errorf("emit called for a truncated restriction")
+	}
+	pc, err := fn.ProcessElement(context.Background(), fn.CreateWatermarkEstimator(0), &bundleFinalization{}, rt, "stream", emit)
+	if err != nil {
+		t.Fatalf("ProcessElement() error = %v", err)
+	}
+	if pc.ShouldResume() {
+		t.Errorf("ProcessElement() should stop for a truncated restriction")
+	}
+}
+
+func TestParseEntries(t *testing.T) {
+	reply := []any{
+		[]any{[]byte("1-0"), []any{[]byte("a"), []byte("1")}},
+		[]any{[]byte("2-0"), nil},
+		[]any{[]byte("3-0"), []any{[]byte("b"), []byte("2"), []byte("c"), []byte("3")}},
+	}
+	got, err := parseEntries("stream", reply)
+	if err != nil {
+		t.Fatalf("parseEntries() error = %v", err)
+	}
+
+	want := []StreamEntry{
+		{Stream: "stream", ID: "1-0", Fields: map[string]string{"a": "1"}},
+		{Stream: "stream", ID: "3-0", Fields: map[string]string{"b": "2", "c": "3"}},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("parseEntries() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestEntryTime(t *testing.T) {
+	if got, want := entryTime("1577934245123-7"), mtime.FromMilliseconds(1577934245123); got != want {
+		t.Errorf("entryTime() = %v, want %v", got, want)
+	}
+}
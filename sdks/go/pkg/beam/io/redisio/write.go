@@ -0,0 +1,200 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+const defaultWriteBatchSize = 1000
+
+func init() {
+	register.DoFn3x1[context.Context, string, string, error](&writeFn{})
+	register.DoFn2x1[context.Context, Hash, error](&writeHashFn{})
+	register.DoFn2x1[context.Context, StreamEntry, error](&writeStreamFn{})
+}
+
+// Write sets the string values of keys with SET. It takes a PCollection<KV<string, string>>
+// of keys and values.
+// Write takes a variable number of WriteOptionFn to configure the write operation:
+//   - WriteBatchSize: maximum number of commands per pipeline. Defaults to 1000.
+//   - WriteTTL: time to live of the keys. Defaults to none.
+func Write(s beam.Scope, url string, col beam.PCollection, opts ...WriteOptionFn) {
+	s = s.Scope("redisio.Write")
+
+	beam.ParDo0(s, &writeFn{writer: newWriter("Write", "SET", url, opts)}, col)
+}
+
+// Publish publishes messages to channels with PUBLISH. It takes a
+// PCollection<KV<string, string>> of channels and messages.
+// Publish takes a variable number of WriteOptionFn to configure the write operation:
+//   - WriteBatchSize: maximum number of commands per pipeline. Defaults to 1000.
+func Publish(s beam.Scope, url string, col beam.PCollection, opts ...WriteOptionFn) {
+	s = s.Scope("redisio.Publish")
+
+	beam.ParDo0(s, &writeFn{writer: newWriter("Publish", "PUBLISH", url, opts)}, col)
+}
+
+// WriteHashes sets fields of hashes with HSET. It takes a PCollection<Hash> of keys and the
+// fields to set. Fields of the hashes that aren't in the map are left as is.
+// WriteHashes takes a variable number of WriteOptionFn to configure the write operation:
+//   - WriteBatchSize: maximum number of commands per pipeline. Defaults to 1000.
+//   - WriteTTL: time to live of the hashes, renewed on each write. Defaults to none.
+func WriteHashes(s beam.Scope, url string, col beam.PCollection, opts ...WriteOptionFn) {
+	s = s.Scope("redisio.WriteHashes")
+
+	beam.ParDo0(s, &writeHashFn{writer: newWriter("WriteHashes", "HSET", url, opts)}, col)
+}
+
+// WriteStreams appends entries to streams with XADD. It takes a PCollection<StreamEntry> of
+// stream keys and entry fields. Entry IDs are generated by the server, so the IDs of the
+// entries are ignored.
+// WriteStreams takes a variable number of WriteOptionFn to configure the write operation:
+//   - WriteBatchSize: maximum number of commands per pipeline. Defaults to 1000.
+//   - WriteTTL: time to live of the streams, renewed on each write. Defaults to none.
+//   - WriteMaxLen: length to approximately trim the streams to. Defaults to none.
+func WriteStreams(s beam.Scope, url string, col beam.PCollection, opts ...WriteOptionFn) {
+	s = s.Scope("redisio.WriteStreams")
+
+	beam.ParDo0(s, &writeStreamFn{writer: newWriter("WriteStreams", "XADD", url, opts)}, col)
+}
+
+func newWriter(name, command, url string, opts []WriteOptionFn) writer {
+	option := &writeOption{BatchSize: defaultWriteBatchSize}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("redisio.%v: invalid option: %v", name, err))
+		}
+	}
+
+	return writer{
+		redisFn:   redisFn{URL: url},
+		Command:   command,
+		BatchSize: option.BatchSize,
+		TTL:       option.TTL.Milliseconds(),
+		MaxLen:    option.MaxLen,
+	}
+}
+
+// writer pipelines the commands of a bundle, sending them once BatchSize commands are
+// buffered and when the bundle finishes.
+type writer struct {
+	redisFn
+	Command   string
+	BatchSize int
+	TTL       int64 // milliseconds
+	MaxLen    int64
+	cmds      [][]any
+}
+
+func (w *writer) add(ctx context.Context, cmds ...[]any) error {
+	w.cmds = append(w.cmds, cmds...)
+	if len(w.cmds) < w.BatchSize {
+		return nil
+	}
+	return w.flush(ctx)
+}
+
+func (w *writer) flush(ctx context.Context) error {
+	if len(w.cmds) == 0 {
+		return nil
+	}
+
+	replies, err := w.conn.pipeline(ctx, w.cmds)
+	if err != nil {
+		return fmt.Errorf("error writing to redis: %v", err)
+	}
+	if err := firstError(replies); err != nil {
+		return fmt.Errorf("error writing to redis: %v %v", w.Command, err)
+	}
+	w.cmds = w.cmds[:0]
+	return nil
+}
+
+func (w *writer) FinishBundle(ctx context.Context) error {
+	return w.flush(ctx)
+}
+
+// expire returns the command setting the TTL of key, if any.
+func (w *writer) expire(key string) [][]any {
+	if w.TTL == 0 {
+		return nil
+	}
+	return [][]any{{"PEXPIRE", key, w.TTL}}
+}
+
+type writeFn struct {
+	writer
+}
+
+func (fn *writeFn) ProcessElement(ctx context.Context, key string, value string) error {
+	cmd := []any{fn.Command, key, value}
+	if fn.Command == "SET" && fn.TTL != 0 {
+		cmd = append(cmd, "PX", fn.TTL)
+	}
+	return fn.add(ctx, cmd)
+}
+
+type writeHashFn struct {
+	writer
+}
+
+func (fn *writeHashFn) ProcessElement(ctx context.Context, hash Hash) error {
+	return fn.addFields(ctx, hash.Key, hash.Fields)
+}
+
+type writeStreamFn struct {
+	writer
+}
+
+func (fn *writeStreamFn) ProcessElement(ctx context.Context, entry StreamEntry) error {
+	return fn.addFields(ctx, entry.Stream, entry.Fields)
+}
+
+var errNoFields = errors.New("no fields to write")
+
+// addFields adds the command writing the fields of a hash or stream entry.
+func (w *writer) addFields(ctx context.Context, key string, fields map[string]string) error {
+	if len(fields) == 0 {
+		return fmt.Errorf("error writing %v: %w", key, errNoFields)
+	}
+
+	cmd := []any{w.Command, key}
+	if w.Command == "XADD" {
+		if w.MaxLen != 0 {
+			cmd = append(cmd, "MAXLEN", "~", w.MaxLen)
+		}
+		cmd = append(cmd, "*")
+	}
+
+	// Sort the fields so that entries are written deterministically.
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		cmd = append(cmd, name, fields[name])
+	}
+
+	return w.add(ctx, append([][]any{cmd}, w.expire(key)...)...)
+}
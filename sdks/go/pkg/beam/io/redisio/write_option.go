@@ -0,0 +1,73 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisio
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidTTL    = errors.New("TTL must be at least 1ms")
+	errInvalidMaxLen = errors.New("max length must be greater than 0")
+)
+
+type writeOption struct {
+	BatchSize int
+	TTL       time.Duration
+	MaxLen    int64
+}
+
+// WriteOptionFn is a function that can be passed to the write transforms to configure options
+// for writing to Redis.
+type WriteOptionFn func(option *writeOption) error
+
+// WriteBatchSize sets the maximum number of commands sent in a single pipeline.
+func WriteBatchSize(size int) WriteOptionFn {
+	return func(o *writeOption) error {
+		if size <= 0 {
+			return errInvalidBatchSize
+		}
+
+		o.BatchSize = size
+		return nil
+	}
+}
+
+// WriteTTL sets the time to live of the written keys. It is ignored by Publish.
+func WriteTTL(ttl time.Duration) WriteOptionFn {
+	return func(o *writeOption) error {
+		if ttl < time.Millisecond {
+			return errInvalidTTL
+		}
+
+		o.TTL = ttl
+		return nil
+	}
+}
+
+// WriteMaxLen sets the length that WriteStreams approximately trims streams to. Streams are
+// not trimmed by default.
+func WriteMaxLen(maxLen int64) WriteOptionFn {
+	return func(o *writeOption) error {
+		if maxLen <= 0 {
+			return errInvalidMaxLen
+		}
+
+		o.MaxLen = maxLen
+		return nil
+	}
+}
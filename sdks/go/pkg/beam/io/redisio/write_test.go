@@ -0,0 +1,159 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redisio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func init() {
+	register.Function1x2(toKV)
+}
+
+func toKV(kv keyValue) (string, string) {
+	return kv.Key, kv.Value
+}
+
+func TestWrite(t *testing.T) {
+	srv := newFakeRedis(t)
+
+	p, s := beam.NewPipelineWithRoot()
+
+	col := beam.ParDo(s, toKV, beam.Create(s,
+		keyValue{Key: "key1", Value: "value1"},
+		keyValue{Key: "key2", Value: "value2"},
+		keyValue{Key: "key3", Value: "value3"},
+	))
+	Write(s, srv.url(), col, WriteBatchSize(2), WriteTTL(time.Minute))
+
+	ptest.RunAndValidate(t, p)
+
+	wantStrings := map[string]string{"key1": "value1", "key2": "value2", "key3": "value3"}
+	if diff := cmp.Diff(wantStrings, srv.strings); diff != "" {
+		t.Errorf("Strings mismatch (-want +got):\n%s", diff)
+	}
+	wantTTLs := map[string]int64{"key1": 60000, "key2": 60000, "key3": 60000}
+	if diff := cmp.Diff(wantTTLs, srv.ttls); diff != "" {
+		t.Errorf("TTLs mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPublish(t *testing.T) {
+	srv := newFakeRedis(t)
+
+	p, s := beam.NewPipelineWithRoot()
+
+	col := beam.ParDo(s, toKV, beam.Create(s, keyValue{Key: "channel", Value: "message"}))
+	Publish(s, srv.url(), col)
+
+	ptest.RunAndValidate(t, p)
+
+	want := [][2]string{{"channel", "message"}}
+	if diff := cmp.Diff(want, srv.published); diff != "" {
+		t.Errorf("Published messages mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteHashes(t *testing.T) {
+	srv := newFakeRedis(t)
+	srv.hashes["hash1"] = map[string]string{"a": "0", "c": "3"}
+
+	p, s := beam.NewPipelineWithRoot()
+
+	col := beam.Create(s,
+		Hash{Key: "hash1", Fields: map[string]string{"a": "1", "b": "2"}},
+		Hash{Key: "hash2", Fields: map[string]string{"d": "4"}},
+	)
+	WriteHashes(s, srv.url(), col, WriteTTL(time.Second))
+
+	ptest.RunAndValidate(t, p)
+
+	wantHashes := map[string]map[string]string{
+		"hash1": {"a": "1", "b": "2", "c": "3"},
+		"hash2": {"d": "4"},
+	}
+	if diff := cmp.Diff(wantHashes, srv.hashes); diff != "" {
+		t.Errorf("Hashes mismatch (-want +got):\n%s", diff)
+	}
+	wantTTLs := map[string]int64{"hash1": 1000, "hash2": 1000}
+	if diff := cmp.Diff(wantTTLs, srv.ttls); diff != "" {
+		t.Errorf("TTLs mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestWriteStreams(t *testing.T) {
+	srv := newFakeRedis(t)
+
+	p, s := beam.NewPipelineWithRoot()
+
+	col := beam.Create(s,
+		StreamEntry{Stream: "stream", Fields: map[string]string{"n": "1"}},
+		StreamEntry{Stream: "stream", Fields: map[string]string{"n": "2"}},
+		StreamEntry{Stream: "stream", Fields: map[string]string{"n": "3"}},
+	)
+	WriteStreams(s, srv.url(), col, WriteMaxLen(2))
+
+	ptest.RunAndValidate(t, p)
+
+	entries := srv.streams["stream"].entries
+	if got, want := len(entries), 2; got != want {
+		t.Fatalf("Stream length = %v, want %v", got, want)
+	}
+	for _, e := range entries {
+		if len(e.fields) != 2 || e.fields[0] != "n" {
+			t.Errorf("Entry fields = %v, want [n <value>]", e.fields)
+		}
+	}
+}
+
+func TestWrite_invalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  WriteOptionFn
+	}{
+		{
+			name: "Batch size of 0",
+			opt:  WriteBatchSize(0),
+		},
+		{
+			name: "TTL below 1ms",
+			opt:  WriteTTL(time.Microsecond),
+		},
+		{
+			name: "Negative max length",
+			opt:  WriteMaxLen(-1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Write() did not panic, want panic")
+				}
+			}()
+
+			_, s := beam.NewPipelineWithRoot()
+			col := beam.ParDo(s, toKV, beam.Create(s, keyValue{Key: "key", Value: "value"}))
+			Write(s, "localhost", col, tt.opt)
+		})
+	}
+}
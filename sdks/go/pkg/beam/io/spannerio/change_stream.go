@@ -0,0 +1,285 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spannerio
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/sdf"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/io/internal/unbounded"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"google.golang.org/api/iterator"
+)
+
+func init() {
+	register.DoFn5x2[
+		context.Context, *unbounded.WatermarkEstimator, *sdf.LockRTracker, []byte,
+		func(beam.EventTime, DataChangeRecord), sdf.ProcessContinuation, error,
+	]((*changeStreamFn)(nil))
+	register.Emitter2[beam.EventTime, DataChangeRecord]()
+}
+
+const (
+	changeStreamPollInterval = time.Second
+)
+
+var changeStreamNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// ReadChangeStream reads the given change stream of a spanner database. It returns an unbounded
+// PCollection<DataChangeRecord> of the data changes, timestamped with their commit timestamps. The
+// watermark follows the earliest commit timestamp that is yet to be read from the change stream
+// partitions. Only change streams of GoogleSQL databases are supported.
+//
+// Partitions of the change stream are read in parallel, and when a partition ends due to a split or
+// merge, reading continues with its child partitions. A child partition with several parents is read
+// once, after the parent with the smallest token ended. Changes are read at least once: records with the
+// same commit timestamp as the last record read before a checkpoint may be read again.
+func ReadChangeStream(s beam.Scope, db string, changeStream string, options ...ChangeStreamOptionFn) beam.PCollection {
+	if db == "" {
+		panic("no database provided!")
+	}
+
+	if !changeStreamNamePattern.MatchString(changeStream) {
+		panic(fmt.Sprintf("spannerio.ReadChangeStream: invalid change stream name %q", changeStream))
+	}
+
+	s = s.Scope("spannerio.ReadChangeStream")
+
+	imp := beam.Impulse(s)
+
+	return beam.ParDo(s, newChangeStreamFn(db, changeStream, newChangeStreamOptions(options...)), imp)
+}
+
+type changeStreamFn struct {
+	spannerFn
+	ChangeStream string              `json:"changeStream"` // ChangeStream is the name of the change stream.
+	Options      changeStreamOptions `json:"options"`      // Options specifies additional change stream options.
+}
+
+func newChangeStreamFn(db string, changeStream string, options changeStreamOptions) *changeStreamFn {
+	return &changeStreamFn{spannerFn: newSpannerFn(db), ChangeStream: changeStream, Options: options}
+}
+
+func (f *changeStreamFn) Setup(ctx context.Context) error {
+	return f.spannerFn.Setup(ctx)
+}
+
+func (f *changeStreamFn) Teardown() {
+	f.spannerFn.Teardown()
+}
+
+// CreateInitialRestriction creates a restriction holding the query of the root partitions, starting at the
+// configured start timestamp or at the current time.
+func (f *changeStreamFn) CreateInitialRestriction(_ []byte) changeStreamRestriction {
+	start := f.Options.StartTimestamp
+	if start.IsZero() {
+		start = time.Now()
+	}
+
+	return changeStreamRestriction{
+		Partitions: []partitionPosition{{Start: start}},
+		End:        f.Options.EndTimestamp,
+	}
+}
+
+// SplitRestriction returns the restriction unsplit, as the partitions are only known once the root
+// partitions have been queried. The restriction is split into its partitions dynamically.
+func (f *changeStreamFn) SplitRestriction(_ []byte, rest changeStreamRestriction) []changeStreamRestriction {
+	return []changeStreamRestriction{rest}
+}
+
+// RestrictionSize returns the number of partitions of the restriction.
+func (f *changeStreamFn) RestrictionSize(_ []byte, rest changeStreamRestriction) float64 {
+	return float64(len(rest.Partitions))
+}
+
+func (f *changeStreamFn) CreateTracker(rest changeStreamRestriction) *sdf.LockRTracker {
+	return sdf.NewLockRTracker(newChangeStreamTracker(rest))
+}
+
+// TruncateRestriction drops the partitions of unbounded restrictions when draining.
+func (f *changeStreamFn) TruncateRestriction(rt *sdf.LockRTracker, _ []byte) changeStreamRestriction {
+	rest := rt.GetRestriction().(changeStreamRestriction)
+	if rest.End.IsZero() {
+		rest.Partitions = nil
+	}
+
+	return rest
+}
+
+func (f *changeStreamFn) InitialWatermarkEstimatorState(_ beam.EventTime, rest changeStreamRestriction, _ []byte) int64 {
+	return rest.watermark().UnixMilli()
+}
+
+// CreateWatermarkEstimator returns an estimator holding the earliest commit timestamp that is
+// yet to be read from the partitions of the restriction.
+func (f *changeStreamFn) CreateWatermarkEstimator(ms int64) *unbounded.WatermarkEstimator {
+	return &unbounded.WatermarkEstimator{State: ms}
+}
+
+func (f *changeStreamFn) WatermarkEstimatorState(we *unbounded.WatermarkEstimator) int64 {
+	return we.State
+}
+
+// ProcessElement reads the changes of each partition of the restriction up to the current time, and then
+// checkpoints so that reading resumes after changeStreamPollInterval.
+func (f *changeStreamFn) ProcessElement(
+	ctx context.Context,
+	we *unbounded.WatermarkEstimator,
+	rt *sdf.LockRTracker,
+	_ []byte,
+	emit func(beam.EventTime, DataChangeRecord),
+) (sdf.ProcessContinuation, error) {
+	rest := rt.GetRestriction().(changeStreamRestriction)
+	partitions := append([]partitionPosition(nil), rest.Partitions...)
+
+	end := time.Now()
+	if !rest.End.IsZero() && rest.End.Before(end) {
+		end = rest.End
+	}
+
+	for _, p := range partitions {
+		if p.Start.After(end) {
+			continue
+		}
+
+		if err := f.readPartition(ctx, rt, p, end, emit); err != nil {
+			return sdf.StopProcessing(), err
+		}
+
+		if err := rt.GetError(); err != nil {
+			return sdf.StopProcessing(), err
+		}
+	}
+
+	if rt.IsDone() {
+		return sdf.StopProcessing(), nil
+	}
+
+	we.Advance(rt.GetRestriction().(changeStreamRestriction).watermark())
+
+	return sdf.ResumeProcessingIn(changeStreamPollInterval), nil
+}
+
+// readPartition reads the records of a partition from its position up to end, inclusive. It returns early
+// without an error if a claim fails.
+func (f *changeStreamFn) readPartition(
+	ctx context.Context,
+	rt *sdf.LockRTracker,
+	p partitionPosition,
+	end time.Time,
+	emit func(beam.EventTime, DataChangeRecord),
+) error {
+	it := f.client.Single().Query(ctx, changeStreamStatement(f.ChangeStream, p, end, f.Options.HeartbeatInterval))
+	defer it.Stop()
+
+	for {
+		row, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to query change stream %v: %v", f.ChangeStream, err)
+		}
+
+		var records []*changeRecordRow
+		if err := row.Column(0, &records); err != nil {
+			return fmt.Errorf("failed to decode change record: %v", err)
+		}
+
+		for _, r := range records {
+			if r == nil {
+				continue
+			}
+
+			for _, d := range r.DataChangeRecords {
+				if !rt.TryClaim(recordClaim{Token: p.Token, CommitTimestamp: d.CommitTimestamp}) {
+					return nil
+				}
+				emit(mtime.FromTime(d.CommitTimestamp), d.toDataChangeRecord(p.Token))
+			}
+
+			for _, h := range r.HeartbeatRecords {
+				if !rt.TryClaim(progressClaim{Token: p.Token, Timestamp: h.Timestamp}) {
+					return nil
+				}
+			}
+
+			for _, c := range r.ChildPartitionsRecords {
+				if !rt.TryClaim(childPartitionsClaim{Token: p.Token, Children: c.ownedChildren(p.Token)}) {
+					return nil
+				}
+			}
+		}
+	}
+
+	// The partition has been read up to end, unless it ended before.
+	rt.TryClaim(progressClaim{Token: p.Token, Timestamp: end})
+
+	return nil
+}
+
+// ownedChildren returns the child partitions that are read after the partition with the given token ends. A
+// child partition is read after its parent with the smallest token, so that it is read once after a merge.
+func (r *childPartitionsRecordRow) ownedChildren(token string) []partitionPosition {
+	var children []partitionPosition
+	for _, c := range r.ChildPartitions {
+		if c == nil || !ownsChild(token, c.ParentPartitionTokens) {
+			continue
+		}
+		children = append(children, partitionPosition{Token: c.Token, Start: r.StartTimestamp})
+	}
+	return children
+}
+
+func ownsChild(token string, parents []string) bool {
+	for _, parent := range parents {
+		if parent < token {
+			return false
+		}
+	}
+	return true
+}
+
+// changeStreamStatement returns the query of the records of a partition from its position up to end.
+func changeStreamStatement(
+	changeStream string,
+	p partitionPosition,
+	end time.Time,
+	heartbeat time.Duration,
+) spanner.Statement {
+	token := spanner.NullString{StringVal: p.Token, Valid: p.Token != ""}
+
+	return spanner.Statement{
+		SQL: fmt.Sprintf("SELECT ChangeRecord FROM READ_%v("+
+			"start_timestamp => @startTimestamp, "+
+			"end_timestamp => @endTimestamp, "+
+			"partition_token => @partitionToken, "+
+			"heartbeat_milliseconds => @heartbeatMilliseconds)", changeStream),
+		Params: map[string]any{
+			"startTimestamp":        p.Start,
+			"endTimestamp":          end,
+			"partitionToken":        token,
+			"heartbeatMilliseconds": heartbeat.Milliseconds(),
+		},
+	}
+}
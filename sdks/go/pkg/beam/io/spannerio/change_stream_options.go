@@ -0,0 +1,95 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spannerio
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	defaultHeartbeatInterval = 2 * time.Second
+	minHeartbeatInterval     = time.Second
+)
+
+// ChangeStreamOptionFn is a function that can be passed to ReadChangeStream to configure options for
+// reading a change stream.
+type ChangeStreamOptionFn func(*changeStreamOptions) error
+
+// changeStreamOptions represents additional options for reading a change stream.
+type changeStreamOptions struct {
+	StartTimestamp    time.Time     `json:"startTimestamp"`    // Commit timestamp to start reading at, default is the time reading starts.
+	EndTimestamp      time.Time     `json:"endTimestamp"`      // Commit timestamp to stop reading at, default is unbounded.
+	HeartbeatInterval time.Duration `json:"heartbeatInterval"` // Interval of heartbeat records of idle partitions.
+}
+
+func newChangeStreamOptions(options ...ChangeStreamOptionFn) changeStreamOptions {
+	opts := changeStreamOptions{
+		HeartbeatInterval: defaultHeartbeatInterval,
+	}
+
+	for _, opt := range options {
+		if err := opt(&opts); err != nil {
+			panic(fmt.Sprintf("spannerio.ReadChangeStream: invalid option: %v", err))
+		}
+	}
+
+	if !opts.StartTimestamp.IsZero() && !opts.EndTimestamp.IsZero() && opts.EndTimestamp.Before(opts.StartTimestamp) {
+		panic("spannerio.ReadChangeStream: invalid option: end timestamp is before start timestamp")
+	}
+
+	return opts
+}
+
+// WithStartTimestamp sets the commit timestamp to start reading the change stream at. It must be within the
+// retention period of the change stream. By default, reading starts at the time the pipeline starts reading.
+func WithStartTimestamp(start time.Time) ChangeStreamOptionFn {
+	return func(opts *changeStreamOptions) error {
+		if start.IsZero() {
+			return errors.New("start timestamp must not be zero")
+		}
+
+		opts.StartTimestamp = start
+		return nil
+	}
+}
+
+// WithEndTimestamp sets the commit timestamp to stop reading the change stream at, inclusive. By default, the
+// change stream is read until the pipeline is drained or cancelled.
+func WithEndTimestamp(end time.Time) ChangeStreamOptionFn {
+	return func(opts *changeStreamOptions) error {
+		if end.IsZero() {
+			return errors.New("end timestamp must not be zero")
+		}
+
+		opts.EndTimestamp = end
+		return nil
+	}
+}
+
+// WithHeartbeatInterval sets the interval at which Spanner reports the progress of partitions without changes,
+// which advances the watermark. Must be at least 1 second, default is 2 seconds.
+func WithHeartbeatInterval(interval time.Duration) ChangeStreamOptionFn {
+	return func(opts *changeStreamOptions) error {
+		if interval < minHeartbeatInterval {
+			return fmt.Errorf("heartbeat interval must be at least %v, got %v", minHeartbeatInterval, interval)
+		}
+
+		opts.HeartbeatInterval = interval
+		return nil
+	}
+}
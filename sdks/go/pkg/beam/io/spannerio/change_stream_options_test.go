@@ -0,0 +1,123 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spannerio
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWithStartTimestamp(t *testing.T) {
+	tests := []struct {
+		name    string
+		start   time.Time
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:    "Set start timestamp",
+			start:   ts0,
+			want:    ts0,
+			wantErr: false,
+		},
+		{
+			name:    "Error - start timestamp must not be zero",
+			start:   time.Time{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var option changeStreamOptions
+
+			if err := WithStartTimestamp(tt.start)(&option); (err != nil) != tt.wantErr {
+				t.Fatalf("WithStartTimestamp() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !option.StartTimestamp.Equal(tt.want) {
+				t.Errorf("option.StartTimestamp = %v, want %v", option.StartTimestamp, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithEndTimestamp(t *testing.T) {
+	tests := []struct {
+		name    string
+		end     time.Time
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name:    "Set end timestamp",
+			end:     ts1,
+			want:    ts1,
+			wantErr: false,
+		},
+		{
+			name:    "Error - end timestamp must not be zero",
+			end:     time.Time{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var option changeStreamOptions
+
+			if err := WithEndTimestamp(tt.end)(&option); (err != nil) != tt.wantErr {
+				t.Fatalf("WithEndTimestamp() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !option.EndTimestamp.Equal(tt.want) {
+				t.Errorf("option.EndTimestamp = %v, want %v", option.EndTimestamp, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithHeartbeatInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		want     time.Duration
+		wantErr  bool
+	}{
+		{
+			name:     "Set heartbeat interval to 10s",
+			interval: 10 * time.Second,
+			want:     10 * time.Second,
+			wantErr:  false,
+		},
+		{
+			name:     "Error - heartbeat interval must be at least 1s",
+			interval: 100 * time.Millisecond,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var option changeStreamOptions
+
+			if err := WithHeartbeatInterval(tt.interval)(&option); (err != nil) != tt.wantErr {
+				t.Fatalf("WithHeartbeatInterval() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if option.HeartbeatInterval != tt.want {
+				t.Errorf("option.HeartbeatInterval = %v, want %v", option.HeartbeatInterval, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spannerio
+
+import (
+	"reflect"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*DataChangeRecord)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*ColumnType)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*Mod)(nil)).Elem())
+}
+
+// ModType is the type of change of a DataChangeRecord.
+type ModType string
+
+// The types of change of data change records.
+const (
+	ModTypeInsert ModType = "INSERT"
+	ModTypeUpdate ModType = "UPDATE"
+	ModTypeDelete ModType = "DELETE"
+)
+
+// DataChangeRecord is a change of the rows of a table in a transaction, as read from a change stream
+// partition. The records of a transaction are identified by its ServerTransactionID, and within a
+// partition are ordered by RecordSequence.
+type DataChangeRecord struct {
+	PartitionToken                       string       // Token of the partition the record was read from.
+	CommitTimestamp                      time.Time    // Commit timestamp of the transaction.
+	RecordSequence                       string       // Sequence number of the record within the transaction.
+	ServerTransactionID                  string       // Globally unique identifier of the transaction.
+	IsLastRecordInTransactionInPartition bool         // Whether this is the last record of the transaction in the partition.
+	TableName                            string       // Name of the changed table.
+	ColumnTypes                          []ColumnType // Columns of the changed table present in the mods.
+	Mods                                 []Mod        // Changes of the rows.
+	ModType                              ModType      // Type of the changes.
+	ValueCaptureType                     string       // Value capture type of the change stream, such as OLD_AND_NEW_VALUES.
+	NumberOfRecordsInTransaction         int64        // Number of data change records of the transaction in all partitions.
+	NumberOfPartitionsInTransaction      int64        // Number of partitions with records of the transaction.
+	TransactionTag                       string       // Transaction tag of the transaction.
+	IsSystemTransaction                  bool         // Whether the transaction is a system transaction, such as a TTL deletion.
+}
+
+// ColumnType describes a column of a DataChangeRecord.
+type ColumnType struct {
+	Name            string // Name of the column.
+	Type            string // JSON encoded Spanner type of the column, such as {"code":"INT64"}.
+	IsPrimaryKey    bool   // Whether the column is part of the primary key.
+	OrdinalPosition int64  // Position of the column in the table definition.
+}
+
+// Mod is the change of a row of a DataChangeRecord. The values are JSON objects mapping column names to
+// values, which are empty if not captured for the mod type and value capture type.
+type Mod struct {
+	Keys      string // JSON encoded primary key columns of the changed row.
+	NewValues string // JSON encoded values of the row after the change.
+	OldValues string // JSON encoded values of the row before the change.
+}
+
+// changeRecordRow is a change record of a change stream query. Each change record holds exactly one record.
+type changeRecordRow struct {
+	DataChangeRecords      []*dataChangeRecordRow      `spanner:"data_change_record"`
+	HeartbeatRecords       []*heartbeatRecordRow       `spanner:"heartbeat_record"`
+	ChildPartitionsRecords []*childPartitionsRecordRow `spanner:"child_partitions_record"`
+}
+
+type dataChangeRecordRow struct {
+	CommitTimestamp                      time.Time        `spanner:"commit_timestamp"`
+	RecordSequence                       string           `spanner:"record_sequence"`
+	ServerTransactionID                  string           `spanner:"server_transaction_id"`
+	IsLastRecordInTransactionInPartition bool             `spanner:"is_last_record_in_transaction_in_partition"`
+	TableName                            string           `spanner:"table_name"`
+	ColumnTypes                          []*columnTypeRow `spanner:"column_types"`
+	Mods                                 []*modRow        `spanner:"mods"`
+	ModType                              string           `spanner:"mod_type"`
+	ValueCaptureType                     string           `spanner:"value_capture_type"`
+	NumberOfRecordsInTransaction         int64            `spanner:"number_of_records_in_transaction"`
+	NumberOfPartitionsInTransaction      int64            `spanner:"number_of_partitions_in_transaction"`
+	TransactionTag                       string           `spanner:"transaction_tag"`
+	IsSystemTransaction                  bool             `spanner:"is_system_transaction"`
+}
+
+type columnTypeRow struct {
+	Name            string           `spanner:"name"`
+	Type            spanner.NullJSON `spanner:"type"`
+	IsPrimaryKey    bool             `spanner:"is_primary_key"`
+	OrdinalPosition int64            `spanner:"ordinal_position"`
+}
+
+type modRow struct {
+	Keys      spanner.NullJSON `spanner:"keys"`
+	NewValues spanner.NullJSON `spanner:"new_values"`
+	OldValues spanner.NullJSON `spanner:"old_values"`
+}
+
+type heartbeatRecordRow struct {
+	Timestamp time.Time `spanner:"timestamp"`
+}
+
+type childPartitionsRecordRow struct {
+	StartTimestamp  time.Time            `spanner:"start_timestamp"`
+	RecordSequence  string               `spanner:"record_sequence"`
+	ChildPartitions []*childPartitionRow `spanner:"child_partitions"`
+}
+
+type childPartitionRow struct {
+	Token                 string   `spanner:"token"`
+	ParentPartitionTokens []string `spanner:"parent_partition_tokens"`
+}
+
+// toDataChangeRecord converts a data change record of the partition with the given token.
+func (r *dataChangeRecordRow) toDataChangeRecord(token string) DataChangeRecord {
+	record := DataChangeRecord{
+		PartitionToken:                       token,
+		CommitTimestamp:                      r.CommitTimestamp,
+		RecordSequence:                       r.RecordSequence,
+		ServerTransactionID:                  r.ServerTransactionID,
+		IsLastRecordInTransactionInPartition: r.IsLastRecordInTransactionInPartition,
+		TableName:                            r.TableName,
+		ModType:                              ModType(r.ModType),
+		ValueCaptureType:                     r.ValueCaptureType,
+		NumberOfRecordsInTransaction:         r.NumberOfRecordsInTransaction,
+		NumberOfPartitionsInTransaction:      r.NumberOfPartitionsInTransaction,
+		TransactionTag:                       r.TransactionTag,
+		IsSystemTransaction:                  r.IsSystemTransaction,
+	}
+
+	for _, c := range r.ColumnTypes {
+		record.ColumnTypes = append(record.ColumnTypes, ColumnType{
+			Name:            c.Name,
+			Type:            jsonString(c.Type),
+			IsPrimaryKey:    c.IsPrimaryKey,
+			OrdinalPosition: c.OrdinalPosition,
+		})
+	}
+
+	for _, m := range r.Mods {
+		record.Mods = append(record.Mods, Mod{
+			Keys:      jsonString(m.Keys),
+			NewValues: jsonString(m.NewValues),
+			OldValues: jsonString(m.OldValues),
+		})
+	}
+
+	return record
+}
+
+// jsonString returns the JSON encoding of a JSON value, or the empty string if it is NULL.
+func jsonString(v spanner.NullJSON) string {
+	if !v.Valid {
+		return ""
+	}
+
+	return v.String()
+}
@@ -0,0 +1,242 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spannerio
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestReadChangeStream(t *testing.T) {
+	_, s := beam.NewPipelineWithRoot()
+
+	col := ReadChangeStream(s, "projects/fake-proj/instances/fake-instance/databases/fake-db", "Changes")
+
+	if got, want := col.Type().Type(), reflect.TypeOf(DataChangeRecord{}); got != want {
+		t.Errorf("ReadChangeStream() element type = %v, want %v", got, want)
+	}
+}
+
+func TestReadChangeStream_panics(t *testing.T) {
+	testCases := []struct {
+		name         string
+		database     string
+		changeStream string
+		options      []ChangeStreamOptionFn
+	}{
+		{
+			name:         "No database",
+			changeStream: "Changes",
+		},
+		{
+			name:         "Invalid change stream name",
+			database:     "projects/fake-proj/instances/fake-instance/databases/fake-db",
+			changeStream: "Changes; DROP TABLE Test",
+		},
+		{
+			name:         "End before start",
+			database:     "projects/fake-proj/instances/fake-instance/databases/fake-db",
+			changeStream: "Changes",
+			options:      []ChangeStreamOptionFn{WithStartTimestamp(ts1), WithEndTimestamp(ts0)},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("ReadChangeStream() does not panic")
+				}
+			}()
+
+			_, s := beam.NewPipelineWithRoot()
+			ReadChangeStream(s, testCase.database, testCase.changeStream, testCase.options...)
+		})
+	}
+}
+
+func TestChangeStreamFn_CreateInitialRestriction(t *testing.T) {
+	fn := newChangeStreamFn("db", "Changes", newChangeStreamOptions(WithStartTimestamp(ts0), WithEndTimestamp(ts2)))
+
+	got := fn.CreateInitialRestriction(nil)
+
+	want := changeStreamRestriction{Partitions: []partitionPosition{{Start: ts0}}, End: ts2}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("CreateInitialRestriction() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestChangeStreamFn_TruncateRestriction(t *testing.T) {
+	testCases := []struct {
+		name string
+		rest changeStreamRestriction
+		want changeStreamRestriction
+	}{
+		{
+			name: "Unbounded restriction is dropped",
+			rest: changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts0}}},
+			want: changeStreamRestriction{},
+		},
+		{
+			name: "Bounded restriction is kept",
+			rest: changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts0}}, End: ts2},
+			want: changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts0}}, End: ts2},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			fn := newChangeStreamFn("db", "Changes", newChangeStreamOptions())
+
+			got := fn.TruncateRestriction(fn.CreateTracker(testCase.rest), nil)
+
+			if diff := cmp.Diff(testCase.want, got); diff != "" {
+				t.Errorf("TruncateRestriction() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChildPartitionsRecordRow_ownedChildren(t *testing.T) {
+	merge := &childPartitionRow{Token: "merge", ParentPartitionTokens: []string{"a", "b"}}
+	split := &childPartitionRow{Token: "split", ParentPartitionTokens: []string{"b"}}
+
+	testCases := []struct {
+		name   string
+		token  string
+		record *childPartitionsRecordRow
+		want   []partitionPosition
+	}{
+		{
+			name:   "Parent with smallest token owns merged child",
+			token:  "a",
+			record: &childPartitionsRecordRow{StartTimestamp: ts1, ChildPartitions: []*childPartitionRow{merge}},
+			want:   []partitionPosition{{Token: "merge", Start: ts1}},
+		},
+		{
+			name:   "Other parent owns only its split children",
+			token:  "b",
+			record: &childPartitionsRecordRow{StartTimestamp: ts1, ChildPartitions: []*childPartitionRow{split, merge}},
+			want:   []partitionPosition{{Token: "split", Start: ts1}},
+		},
+		{
+			name:   "Root query owns root partitions",
+			token:  "",
+			record: &childPartitionsRecordRow{StartTimestamp: ts0, ChildPartitions: []*childPartitionRow{{Token: "root"}}},
+			want:   []partitionPosition{{Token: "root", Start: ts0}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.record.ownedChildren(testCase.token)
+
+			if diff := cmp.Diff(testCase.want, got); diff != "" {
+				t.Errorf("ownedChildren() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeStreamStatement(t *testing.T) {
+	got := changeStreamStatement("Changes", partitionPosition{Token: "a", Start: ts0}, ts1, 2*time.Second)
+
+	wantSQL := "SELECT ChangeRecord FROM READ_Changes(start_timestamp => @startTimestamp, end_timestamp => @endTimestamp, " +
+		"partition_token => @partitionToken, heartbeat_milliseconds => @heartbeatMilliseconds)"
+	if got.SQL != wantSQL {
+		t.Errorf("changeStreamStatement() SQL = %v, want %v", got.SQL, wantSQL)
+	}
+
+	wantParams := map[string]any{
+		"startTimestamp":        ts0,
+		"endTimestamp":          ts1,
+		"partitionToken":        spanner.NullString{StringVal: "a", Valid: true},
+		"heartbeatMilliseconds": int64(2000),
+	}
+	if diff := cmp.Diff(wantParams, got.Params); diff != "" {
+		t.Errorf("changeStreamStatement() params mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestChangeRecordRow_decode(t *testing.T) {
+	records := []*changeRecordRow{
+		{
+			DataChangeRecords: []*dataChangeRecordRow{
+				{
+					CommitTimestamp:                      ts1,
+					RecordSequence:                       "00000001",
+					ServerTransactionID:                  "tx",
+					IsLastRecordInTransactionInPartition: true,
+					TableName:                            "Test",
+					ColumnTypes: []*columnTypeRow{
+						{Name: "Id", Type: spanner.NullJSON{Value: map[string]any{"code": "INT64"}, Valid: true}, IsPrimaryKey: true, OrdinalPosition: 1},
+					},
+					Mods: []*modRow{
+						{
+							Keys:      spanner.NullJSON{Value: map[string]any{"Id": "1"}, Valid: true},
+							NewValues: spanner.NullJSON{Value: map[string]any{"Name": "one"}, Valid: true},
+						},
+					},
+					ModType:                         "INSERT",
+					ValueCaptureType:                "OLD_AND_NEW_VALUES",
+					NumberOfRecordsInTransaction:    1,
+					NumberOfPartitionsInTransaction: 1,
+				},
+			},
+			HeartbeatRecords:       []*heartbeatRecordRow{},
+			ChildPartitionsRecords: []*childPartitionsRecordRow{},
+		},
+	}
+
+	row, err := spanner.NewRow([]string{"ChangeRecord"}, []any{records})
+	if err != nil {
+		t.Fatalf("NewRow() error = %v", err)
+	}
+
+	var decoded []*changeRecordRow
+	if err := row.Column(0, &decoded); err != nil {
+		t.Fatalf("Column() error = %v", err)
+	}
+
+	if len(decoded) != 1 || len(decoded[0].DataChangeRecords) != 1 {
+		t.Fatalf("decoded records = %v, want 1 data change record", decoded)
+	}
+
+	got := decoded[0].DataChangeRecords[0].toDataChangeRecord("a")
+
+	want := DataChangeRecord{
+		PartitionToken:                       "a",
+		CommitTimestamp:                      ts1,
+		RecordSequence:                       "00000001",
+		ServerTransactionID:                  "tx",
+		IsLastRecordInTransactionInPartition: true,
+		TableName:                            "Test",
+		ColumnTypes:                          []ColumnType{{Name: "Id", Type: `{"code":"INT64"}`, IsPrimaryKey: true, OrdinalPosition: 1}},
+		Mods:                                 []Mod{{Keys: `{"Id":"1"}`, NewValues: `{"Name":"one"}`}},
+		ModType:                              ModTypeInsert,
+		ValueCaptureType:                     "OLD_AND_NEW_VALUES",
+		NumberOfRecordsInTransaction:         1,
+		NumberOfPartitionsInTransaction:      1,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("toDataChangeRecord() mismatch (-want +got):\n%s", diff)
+	}
+}
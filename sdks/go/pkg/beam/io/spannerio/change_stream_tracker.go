@@ -0,0 +1,211 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spannerio
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*changeStreamRestriction)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*partitionPosition)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*changeStreamTracker)(nil)))
+}
+
+// partitionPosition is the position of reading a change stream partition.
+type partitionPosition struct {
+	Token string    `json:"token"` // Token of the partition, empty for the initial query of the root partitions.
+	Start time.Time `json:"start"` // Commit timestamp to continue reading the partition at, inclusive.
+}
+
+// changeStreamRestriction is a set of change stream partitions to read. When a partition ends, it is
+// replaced by its child partitions, so the restriction follows partition splits and merges. The
+// restriction is done once it holds no partitions.
+type changeStreamRestriction struct {
+	Partitions []partitionPosition `json:"partitions"` // Partitions to read, ordered by token.
+	End        time.Time           `json:"end"`        // Commit timestamp to stop reading at, inclusive. Zero if unbounded.
+}
+
+// watermark returns the earliest commit timestamp that is yet to be read from the partitions, or the end of the
+// restriction if it holds no partitions.
+func (r changeStreamRestriction) watermark() time.Time {
+	if len(r.Partitions) == 0 {
+		return r.End
+	}
+
+	min := r.Partitions[0].Start
+	for _, p := range r.Partitions[1:] {
+		if p.Start.Before(min) {
+			min = p.Start
+		}
+	}
+
+	return min
+}
+
+// recordClaim claims a data change record of a partition.
+type recordClaim struct {
+	Token           string
+	CommitTimestamp time.Time
+}
+
+// progressClaim claims that all records of a partition up to and including a commit timestamp have been read.
+type progressClaim struct {
+	Token     string
+	Timestamp time.Time
+}
+
+// childPartitionsClaim claims the end of a partition, which is replaced by the given child partitions.
+type childPartitionsClaim struct {
+	Token    string
+	Children []partitionPosition
+}
+
+// changeStreamTracker tracks a changeStreamRestriction. Claims move the position of their partition, so that
+// a checkpoint resumes each partition after the records that have been read. Records with the same commit
+// timestamp as the last claimed record may be read again after a split.
+type changeStreamTracker struct {
+	rest changeStreamRestriction
+	err  error
+}
+
+func newChangeStreamTracker(rest changeStreamRestriction) *changeStreamTracker {
+	return &changeStreamTracker{rest: rest}
+}
+
+// TryClaim claims a recordClaim, progressClaim or childPartitionsClaim. Claims fail if the partition is not
+// part of the restriction, such as after it was split off, or if the record is after the end of the restriction.
+func (rt *changeStreamTracker) TryClaim(pos any) bool {
+	switch c := pos.(type) {
+	case recordClaim:
+		i, ok := rt.find(c.Token)
+		if !ok || rt.afterEnd(c.CommitTimestamp) {
+			return false
+		}
+		rt.rest.Partitions[i].Start = c.CommitTimestamp
+		return true
+	case progressClaim:
+		i, ok := rt.find(c.Token)
+		if !ok {
+			return false
+		}
+		next := c.Timestamp.Add(time.Nanosecond)
+		if rt.afterEnd(next) {
+			rt.remove(i)
+		} else if next.After(rt.rest.Partitions[i].Start) {
+			rt.rest.Partitions[i].Start = next
+		}
+		return true
+	case childPartitionsClaim:
+		i, ok := rt.find(c.Token)
+		if !ok {
+			return false
+		}
+		rt.remove(i)
+		for _, child := range c.Children {
+			if _, ok := rt.find(child.Token); ok || rt.afterEnd(child.Start) {
+				continue
+			}
+			rt.rest.Partitions = append(rt.rest.Partitions, child)
+		}
+		sortPartitions(rt.rest.Partitions)
+		return true
+	default:
+		rt.err = fmt.Errorf("invalid pos type: %T", pos)
+		return false
+	}
+}
+
+func (rt *changeStreamTracker) find(token string) (int, bool) {
+	for i, p := range rt.rest.Partitions {
+		if p.Token == token {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (rt *changeStreamTracker) remove(i int) {
+	rt.rest.Partitions = append(rt.rest.Partitions[:i:i], rt.rest.Partitions[i+1:]...)
+}
+
+func (rt *changeStreamTracker) afterEnd(t time.Time) bool {
+	return !rt.rest.End.IsZero() && t.After(rt.rest.End)
+}
+
+// GetError returns the error associated with the tracker, if any.
+func (rt *changeStreamTracker) GetError() error {
+	return rt.err
+}
+
+// TrySplit splits off partitions of the restriction into the residual. At a fraction of 0 all partitions
+// are split off, otherwise the primary keeps the given fraction of the partitions, but at least one.
+func (rt *changeStreamTracker) TrySplit(fraction float64) (primary, residual any, err error) {
+	if fraction < 0 || fraction > 1 {
+		return nil, nil, errors.New("fraction must be between 0 and 1")
+	}
+
+	keep := int(math.Ceil(fraction * float64(len(rt.rest.Partitions))))
+	if fraction > 0 && keep == 0 {
+		keep = 1
+	}
+	if rt.IsDone() || keep >= len(rt.rest.Partitions) {
+		return rt.rest, nil, nil
+	}
+
+	partitions := rt.rest.Partitions
+	res := changeStreamRestriction{
+		Partitions: append([]partitionPosition(nil), partitions[keep:]...),
+		End:        rt.rest.End,
+	}
+	rt.rest.Partitions = partitions[:keep:keep]
+
+	return rt.rest, res, nil
+}
+
+// GetProgress reports the partitions of the restriction as remaining work, as the amount of unread records is
+// unknown.
+func (rt *changeStreamTracker) GetProgress() (done float64, remaining float64) {
+	return 0, float64(len(rt.rest.Partitions))
+}
+
+// IsDone returns whether the restriction holds no partitions.
+func (rt *changeStreamTracker) IsDone() bool {
+	return len(rt.rest.Partitions) == 0
+}
+
+// GetRestriction returns the restriction the tracker is tracking.
+func (rt *changeStreamTracker) GetRestriction() any {
+	return rt.rest
+}
+
+// IsBounded returns whether the restriction has an end timestamp.
+func (rt *changeStreamTracker) IsBounded() bool {
+	return !rt.rest.End.IsZero()
+}
+
+func sortPartitions(partitions []partitionPosition) {
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].Token < partitions[j].Token
+	})
+}
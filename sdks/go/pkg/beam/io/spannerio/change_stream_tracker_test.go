@@ -0,0 +1,214 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spannerio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var (
+	ts0 = time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	ts1 = ts0.Add(time.Second)
+	ts2 = ts0.Add(2 * time.Second)
+)
+
+func TestChangeStreamTracker_TryClaim(t *testing.T) {
+	tests := []struct {
+		name   string
+		rest   changeStreamRestriction
+		pos    any
+		wantOK bool
+		want   changeStreamRestriction
+	}{
+		{
+			name:   "Claim record moves partition to its commit timestamp",
+			rest:   changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts0}}},
+			pos:    recordClaim{Token: "a", CommitTimestamp: ts1},
+			wantOK: true,
+			want:   changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts1}}},
+		},
+		{
+			name:   "Claim record of unknown partition fails",
+			rest:   changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts0}}},
+			pos:    recordClaim{Token: "b", CommitTimestamp: ts1},
+			wantOK: false,
+			want:   changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts0}}},
+		},
+		{
+			name:   "Claim record after end fails",
+			rest:   changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts0}}, End: ts1},
+			pos:    recordClaim{Token: "a", CommitTimestamp: ts2},
+			wantOK: false,
+			want:   changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts0}}, End: ts1},
+		},
+		{
+			name:   "Claim progress moves partition after timestamp",
+			rest:   changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts0}}},
+			pos:    progressClaim{Token: "a", Timestamp: ts1},
+			wantOK: true,
+			want:   changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts1.Add(time.Nanosecond)}}},
+		},
+		{
+			name:   "Claim progress up to end removes partition",
+			rest:   changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts0}}, End: ts1},
+			pos:    progressClaim{Token: "a", Timestamp: ts1},
+			wantOK: true,
+			want:   changeStreamRestriction{Partitions: []partitionPosition{}, End: ts1},
+		},
+		{
+			name: "Claim child partitions replaces partition",
+			rest: changeStreamRestriction{Partitions: []partitionPosition{{Token: "b", Start: ts0}, {Token: "d", Start: ts0}}},
+			pos: childPartitionsClaim{Token: "b", Children: []partitionPosition{
+				{Token: "e", Start: ts1},
+				{Token: "a", Start: ts1},
+			}},
+			wantOK: true,
+			want: changeStreamRestriction{Partitions: []partitionPosition{
+				{Token: "a", Start: ts1},
+				{Token: "d", Start: ts0},
+				{Token: "e", Start: ts1},
+			}},
+		},
+		{
+			name: "Claim child partitions drops children after end",
+			rest: changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts0}}, End: ts1},
+			pos: childPartitionsClaim{Token: "a", Children: []partitionPosition{
+				{Token: "b", Start: ts1},
+				{Token: "c", Start: ts2},
+			}},
+			wantOK: true,
+			want:   changeStreamRestriction{Partitions: []partitionPosition{{Token: "b", Start: ts1}}, End: ts1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := newChangeStreamTracker(tt.rest)
+
+			if got := rt.TryClaim(tt.pos); got != tt.wantOK {
+				t.Errorf("TryClaim() = %v, want %v", got, tt.wantOK)
+			}
+
+			if err := rt.GetError(); err != nil {
+				t.Errorf("GetError() = %v, want nil", err)
+			}
+
+			if diff := cmp.Diff(tt.want, rt.GetRestriction()); diff != "" {
+				t.Errorf("GetRestriction() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChangeStreamTracker_TryClaim_invalidPosition(t *testing.T) {
+	rt := newChangeStreamTracker(changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts0}}})
+
+	if rt.TryClaim(ts1) {
+		t.Errorf("TryClaim() = true, want false")
+	}
+
+	if rt.GetError() == nil {
+		t.Errorf("GetError() = nil, want error")
+	}
+}
+
+func TestChangeStreamTracker_TrySplit(t *testing.T) {
+	partitions := []partitionPosition{{Token: "a", Start: ts0}, {Token: "b", Start: ts1}, {Token: "c", Start: ts2}}
+
+	tests := []struct {
+		name         string
+		fraction     float64
+		wantPrimary  any
+		wantResidual any
+		wantDone     bool
+	}{
+		{
+			name:         "Checkpoint splits off all partitions",
+			fraction:     0,
+			wantPrimary:  changeStreamRestriction{Partitions: []partitionPosition{}},
+			wantResidual: changeStreamRestriction{Partitions: partitions},
+			wantDone:     true,
+		},
+		{
+			name:         "Split keeps fraction of partitions",
+			fraction:     0.5,
+			wantPrimary:  changeStreamRestriction{Partitions: partitions[:2]},
+			wantResidual: changeStreamRestriction{Partitions: partitions[2:]},
+		},
+		{
+			name:         "Split keeps at least one partition",
+			fraction:     0.1,
+			wantPrimary:  changeStreamRestriction{Partitions: partitions[:1]},
+			wantResidual: changeStreamRestriction{Partitions: partitions[1:]},
+		},
+		{
+			name:         "No split at fraction 1",
+			fraction:     1,
+			wantPrimary:  changeStreamRestriction{Partitions: partitions},
+			wantResidual: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rt := newChangeStreamTracker(changeStreamRestriction{Partitions: append([]partitionPosition(nil), partitions...)})
+
+			primary, residual, err := rt.TrySplit(tt.fraction)
+			if err != nil {
+				t.Fatalf("TrySplit() error = %v", err)
+			}
+
+			if diff := cmp.Diff(tt.wantPrimary, primary); diff != "" {
+				t.Errorf("TrySplit() primary mismatch (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(tt.wantResidual, residual); diff != "" {
+				t.Errorf("TrySplit() residual mismatch (-want +got):\n%s", diff)
+			}
+
+			if got := rt.IsDone(); got != tt.wantDone {
+				t.Errorf("IsDone() = %v, want %v", got, tt.wantDone)
+			}
+		})
+	}
+}
+
+func TestChangeStreamRestriction_watermark(t *testing.T) {
+	tests := []struct {
+		name string
+		rest changeStreamRestriction
+		want time.Time
+	}{
+		{
+			name: "Earliest position of partitions",
+			rest: changeStreamRestriction{Partitions: []partitionPosition{{Token: "a", Start: ts2}, {Token: "b", Start: ts1}}},
+			want: ts1,
+		},
+		{
+			name: "End without partitions",
+			rest: changeStreamRestriction{End: ts2},
+			want: ts2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rest.watermark(); !got.Equal(tt.want) {
+				t.Errorf("watermark() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,448 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spannerio
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"cloud.google.com/go/spanner"
+	sppb "cloud.google.com/go/spanner/apiv1/spannerpb"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*Mutation)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*MutationGroup)(nil)).Elem())
+	beam.RegisterCoder(reflect.TypeOf((*Mutation)(nil)).Elem(), encodeMutation, decodeMutation)
+	beam.RegisterCoder(reflect.TypeOf((*MutationGroup)(nil)).Elem(), encodeMutationGroup, decodeMutationGroup)
+}
+
+// Mutation is a spanner mutation that can be an element of a PCollection, unlike spanner.Mutation. Values are
+// encoded like the values of spanner.Mutation, so spanner.CommitTimestamp can be written to commit timestamp
+// columns.
+type Mutation struct {
+	pb *sppb.Mutation
+}
+
+// MutationGroup is a group of mutations that is applied atomically by WriteMutationGroups.
+type MutationGroup struct {
+	Mutations []Mutation
+}
+
+// NewMutationGroup returns a MutationGroup of the given mutations.
+func NewMutationGroup(mutations ...Mutation) MutationGroup {
+	return MutationGroup{Mutations: mutations}
+}
+
+// Insert returns a Mutation inserting a row into a table. The mutation fails if the row already exists.
+func Insert(table string, cols []string, vals []any) (Mutation, error) {
+	return newWrite(table, cols, vals, func(w *sppb.Mutation_Write) *sppb.Mutation {
+		return &sppb.Mutation{Operation: &sppb.Mutation_Insert{Insert: w}}
+	})
+}
+
+// Update returns a Mutation updating a row of a table. The mutation fails if the row does not exist.
+func Update(table string, cols []string, vals []any) (Mutation, error) {
+	return newWrite(table, cols, vals, func(w *sppb.Mutation_Write) *sppb.Mutation {
+		return &sppb.Mutation{Operation: &sppb.Mutation_Update{Update: w}}
+	})
+}
+
+// InsertOrUpdate returns a Mutation inserting a row into a table, or updating the given columns of the row if it
+// already exists.
+func InsertOrUpdate(table string, cols []string, vals []any) (Mutation, error) {
+	return newWrite(table, cols, vals, func(w *sppb.Mutation_Write) *sppb.Mutation {
+		return &sppb.Mutation{Operation: &sppb.Mutation_InsertOrUpdate{InsertOrUpdate: w}}
+	})
+}
+
+// Replace returns a Mutation inserting a row into a table, replacing the row if it already exists. Columns that
+// are not given are set to NULL.
+func Replace(table string, cols []string, vals []any) (Mutation, error) {
+	return newWrite(table, cols, vals, func(w *sppb.Mutation_Write) *sppb.Mutation {
+		return &sppb.Mutation{Operation: &sppb.Mutation_Replace{Replace: w}}
+	})
+}
+
+// InsertStruct returns a Mutation inserting the fields of a struct as a row, like Insert. The columns are the
+// struct fields, named by their "spanner" tags.
+func InsertStruct(table string, in any) (Mutation, error) {
+	return newStructWrite(table, in, Insert)
+}
+
+// UpdateStruct returns a Mutation updating a row with the fields of a struct, like Update.
+func UpdateStruct(table string, in any) (Mutation, error) {
+	return newStructWrite(table, in, Update)
+}
+
+// InsertOrUpdateStruct returns a Mutation inserting or updating a row with the fields of a struct, like
+// InsertOrUpdate.
+func InsertOrUpdateStruct(table string, in any) (Mutation, error) {
+	return newStructWrite(table, in, InsertOrUpdate)
+}
+
+// ReplaceStruct returns a Mutation replacing a row with the fields of a struct, like Replace.
+func ReplaceStruct(table string, in any) (Mutation, error) {
+	return newStructWrite(table, in, Replace)
+}
+
+// Delete returns a Mutation deleting the rows of a table with the given primary keys.
+func Delete(table string, keys ...spanner.Key) (Mutation, error) {
+	ks := &sppb.KeySet{}
+	for _, key := range keys {
+		k, err := encodeValues(key)
+		if err != nil {
+			return Mutation{}, err
+		}
+		ks.Keys = append(ks.Keys, k)
+	}
+
+	return newDelete(table, ks), nil
+}
+
+// DeleteKeyRange returns a Mutation deleting the rows of a table with primary keys in the given range.
+func DeleteKeyRange(table string, r spanner.KeyRange) (Mutation, error) {
+	start, err := encodeValues(r.Start)
+	if err != nil {
+		return Mutation{}, err
+	}
+
+	end, err := encodeValues(r.End)
+	if err != nil {
+		return Mutation{}, err
+	}
+
+	kr := &sppb.KeyRange{}
+	switch r.Kind {
+	case spanner.ClosedOpen:
+		kr.StartKeyType, kr.EndKeyType = &sppb.KeyRange_StartClosed{StartClosed: start}, &sppb.KeyRange_EndOpen{EndOpen: end}
+	case spanner.ClosedClosed:
+		kr.StartKeyType, kr.EndKeyType = &sppb.KeyRange_StartClosed{StartClosed: start}, &sppb.KeyRange_EndClosed{EndClosed: end}
+	case spanner.OpenClosed:
+		kr.StartKeyType, kr.EndKeyType = &sppb.KeyRange_StartOpen{StartOpen: start}, &sppb.KeyRange_EndClosed{EndClosed: end}
+	case spanner.OpenOpen:
+		kr.StartKeyType, kr.EndKeyType = &sppb.KeyRange_StartOpen{StartOpen: start}, &sppb.KeyRange_EndOpen{EndOpen: end}
+	default:
+		return Mutation{}, fmt.Errorf("invalid key range kind: %v", r.Kind)
+	}
+
+	return newDelete(table, &sppb.KeySet{Ranges: []*sppb.KeyRange{kr}}), nil
+}
+
+// DeleteAll returns a Mutation deleting all rows of a table.
+func DeleteAll(table string) Mutation {
+	return newDelete(table, &sppb.KeySet{All: true})
+}
+
+// Table returns the table the mutation applies to.
+func (m Mutation) Table() string {
+	if w := m.write(); w != nil {
+		return w.GetTable()
+	}
+	return m.pb.GetDelete().GetTable()
+}
+
+func (m Mutation) write() *sppb.Mutation_Write {
+	switch op := m.pb.GetOperation().(type) {
+	case *sppb.Mutation_Insert:
+		return op.Insert
+	case *sppb.Mutation_Update:
+		return op.Update
+	case *sppb.Mutation_InsertOrUpdate:
+		return op.InsertOrUpdate
+	case *sppb.Mutation_Replace:
+		return op.Replace
+	default:
+		return nil
+	}
+}
+
+// withCommitTimestamp returns a copy of a write mutation that writes the commit timestamp to the given columns.
+func (m Mutation) withCommitTimestamp(cols []string) (Mutation, error) {
+	if len(cols) == 0 {
+		return m, nil
+	}
+
+	pb := proto.Clone(m.pb).(*sppb.Mutation)
+	w := Mutation{pb: pb}.write()
+	if w == nil {
+		return Mutation{}, errors.New("commit timestamps can only be written by insert, update or replace mutations")
+	}
+
+	ts, err := encodeValues([]any{spanner.CommitTimestamp})
+	if err != nil {
+		return Mutation{}, err
+	}
+
+	for _, col := range cols {
+		i := indexOf(w.Columns, col)
+		if i < 0 {
+			w.Columns = append(w.Columns, col)
+			for _, row := range w.Values {
+				row.Values = append(row.Values, ts.Values[0])
+			}
+			continue
+		}
+		for _, row := range w.Values {
+			row.Values[i] = ts.Values[0]
+		}
+	}
+
+	return Mutation{pb: pb}, nil
+}
+
+// toSpanner converts the mutation to a spanner.Mutation.
+func (m Mutation) toSpanner() (*spanner.Mutation, error) {
+	if w := m.write(); w != nil {
+		if len(w.Values) != 1 {
+			return nil, fmt.Errorf("write mutation of table %v must have exactly one row, got %v", w.Table, len(w.Values))
+		}
+		vals := decodeValues(w.Values[0])
+
+		switch m.pb.GetOperation().(type) {
+		case *sppb.Mutation_Insert:
+			return spanner.Insert(w.Table, w.Columns, vals), nil
+		case *sppb.Mutation_Update:
+			return spanner.Update(w.Table, w.Columns, vals), nil
+		case *sppb.Mutation_InsertOrUpdate:
+			return spanner.InsertOrUpdate(w.Table, w.Columns, vals), nil
+		default:
+			return spanner.Replace(w.Table, w.Columns, vals), nil
+		}
+	}
+
+	d := m.pb.GetDelete()
+	if d == nil {
+		return nil, errors.New("mutation has no operation")
+	}
+
+	return spanner.Delete(d.Table, toKeySet(d.KeySet)), nil
+}
+
+func toKeySet(ks *sppb.KeySet) spanner.KeySet {
+	if ks.GetAll() {
+		return spanner.AllKeys()
+	}
+
+	var sets []spanner.KeySet
+	for _, k := range ks.GetKeys() {
+		sets = append(sets, decodeKey(k))
+	}
+
+	for _, r := range ks.GetRanges() {
+		kr := spanner.KeyRange{}
+		startClosed, endClosed := r.GetStartClosed() != nil, r.GetEndClosed() != nil
+		if startClosed {
+			kr.Start = decodeKey(r.GetStartClosed())
+		} else {
+			kr.Start = decodeKey(r.GetStartOpen())
+		}
+		if endClosed {
+			kr.End = decodeKey(r.GetEndClosed())
+		} else {
+			kr.End = decodeKey(r.GetEndOpen())
+		}
+
+		switch {
+		case startClosed && endClosed:
+			kr.Kind = spanner.ClosedClosed
+		case startClosed:
+			kr.Kind = spanner.ClosedOpen
+		case endClosed:
+			kr.Kind = spanner.OpenClosed
+		default:
+			kr.Kind = spanner.OpenOpen
+		}
+		sets = append(sets, kr)
+	}
+
+	return spanner.KeySets(sets...)
+}
+
+func newWrite(
+	table string,
+	cols []string,
+	vals []any,
+	op func(*sppb.Mutation_Write) *sppb.Mutation,
+) (Mutation, error) {
+	if len(cols) != len(vals) {
+		return Mutation{}, fmt.Errorf("different number of columns (%v) and values (%v)", len(cols), len(vals))
+	}
+
+	row, err := encodeValues(vals)
+	if err != nil {
+		return Mutation{}, err
+	}
+
+	w := &sppb.Mutation_Write{
+		Table:   table,
+		Columns: append([]string(nil), cols...),
+		Values:  []*structpb.ListValue{row},
+	}
+
+	return Mutation{pb: op(w)}, nil
+}
+
+func newStructWrite(
+	table string,
+	in any,
+	write func(table string, cols []string, vals []any) (Mutation, error),
+) (Mutation, error) {
+	if in == nil {
+		return Mutation{}, errors.New("struct must not be nil")
+	}
+
+	t := reflect.TypeOf(in)
+	if t.Kind() == reflect.Ptr {
+		if reflect.ValueOf(in).IsNil() {
+			return Mutation{}, errors.New("struct must not be nil")
+		}
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return Mutation{}, fmt.Errorf("expected a struct, got %T", in)
+	}
+
+	// Spanner encodes a struct as the list of its field values, typed with the field names.
+	row, err := spanner.NewRow([]string{""}, []any{in})
+	if err != nil {
+		return Mutation{}, err
+	}
+
+	var v spanner.GenericColumnValue
+	if err := row.Column(0, &v); err != nil {
+		return Mutation{}, err
+	}
+
+	var cols []string
+	var vals []any
+	for i, f := range v.Type.GetStructType().GetFields() {
+		if f.Name == "-" {
+			continue
+		}
+		cols = append(cols, f.Name)
+		vals = append(vals, spanner.GenericColumnValue{Type: f.Type, Value: v.Value.GetListValue().Values[i]})
+	}
+
+	return write(table, cols, vals)
+}
+
+func newDelete(table string, ks *sppb.KeySet) Mutation {
+	return Mutation{pb: &sppb.Mutation{
+		Operation: &sppb.Mutation_Delete_{Delete: &sppb.Mutation_Delete{Table: table, KeySet: ks}},
+	}}
+}
+
+// encodeValues encodes values as spanner encodes the values of mutations.
+func encodeValues(vals []any) (*structpb.ListValue, error) {
+	names := make([]string, len(vals))
+	row, err := spanner.NewRow(names, vals)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &structpb.ListValue{Values: make([]*structpb.Value, len(vals))}
+	for i, val := range vals {
+		if val == nil {
+			// Untyped nil is written as NULL, but can't be decoded as it has no type.
+			list.Values[i] = structpb.NewNullValue()
+			continue
+		}
+
+		var v spanner.GenericColumnValue
+		if err := row.Column(i, &v); err != nil {
+			return nil, err
+		}
+		list.Values[i] = v.Value
+	}
+
+	return list, nil
+}
+
+// decodeValues returns values that spanner encodes as the given encoded values. Mutations don't hold the types of
+// their values, as spanner infers them from the table schema.
+func decodeValues(list *structpb.ListValue) []any {
+	vals := make([]any, len(list.GetValues()))
+	for i, v := range list.GetValues() {
+		vals[i] = spanner.GenericColumnValue{Type: &sppb.Type{}, Value: v}
+	}
+	return vals
+}
+
+// decodeKey returns a key that spanner encodes as the given encoded key. Key parts can't be
+// spanner.GenericColumnValue, so each part is decoded to a Go value of the same encoding.
+func decodeKey(list *structpb.ListValue) spanner.Key {
+	key := make(spanner.Key, len(list.GetValues()))
+	for i, v := range list.GetValues() {
+		switch v.GetKind().(type) {
+		case *structpb.Value_NumberValue:
+			key[i] = v.GetNumberValue()
+		case *structpb.Value_BoolValue:
+			key[i] = v.GetBoolValue()
+		case *structpb.Value_StringValue:
+			key[i] = v.GetStringValue()
+		default:
+			key[i] = spanner.NullString{}
+		}
+	}
+	return key
+}
+
+func indexOf(cols []string, col string) int {
+	for i, c := range cols {
+		if c == col {
+			return i
+		}
+	}
+	return -1
+}
+
+func encodeMutation(m Mutation) ([]byte, error) {
+	return proto.Marshal(m.pb)
+}
+
+func decodeMutation(b []byte) (Mutation, error) {
+	pb := &sppb.Mutation{}
+	if err := proto.Unmarshal(b, pb); err != nil {
+		return Mutation{}, err
+	}
+	return Mutation{pb: pb}, nil
+}
+
+func encodeMutationGroup(g MutationGroup) ([]byte, error) {
+	pb := &sppb.BatchWriteRequest_MutationGroup{}
+	for _, m := range g.Mutations {
+		pb.Mutations = append(pb.Mutations, m.pb)
+	}
+	return proto.Marshal(pb)
+}
+
+func decodeMutationGroup(b []byte) (MutationGroup, error) {
+	pb := &sppb.BatchWriteRequest_MutationGroup{}
+	if err := proto.Unmarshal(b, pb); err != nil {
+		return MutationGroup{}, err
+	}
+
+	g := MutationGroup{}
+	for _, m := range pb.Mutations {
+		g.Mutations = append(g.Mutations, Mutation{pb: m})
+	}
+	return g, nil
+}
@@ -0,0 +1,217 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spannerio
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	sppb "cloud.google.com/go/spanner/apiv1/spannerpb"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// keyColumn is a primary key column of a table.
+type keyColumn struct {
+	Name string
+	Type string // Spanner type of the column, such as INT64 or STRING(MAX).
+	Desc bool   // Whether the column is sorted in descending order.
+}
+
+const primaryKeysQuery = `SELECT TABLE_NAME, COLUMN_NAME, SPANNER_TYPE, COLUMN_ORDERING
+FROM INFORMATION_SCHEMA.INDEX_COLUMNS
+WHERE TABLE_SCHEMA = '' AND INDEX_NAME = 'PRIMARY_KEY'
+ORDER BY TABLE_NAME, ORDINAL_POSITION`
+
+// readPrimaryKeys returns the primary key columns of the tables of a database.
+func readPrimaryKeys(ctx context.Context, client *spanner.Client) (map[string][]keyColumn, error) {
+	it := client.Single().Query(ctx, spanner.Statement{SQL: primaryKeysQuery})
+	defer it.Stop()
+
+	keys := make(map[string][]keyColumn)
+	for {
+		row, err := it.Next()
+		if err == iterator.Done {
+			return keys, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read primary keys: %v", err)
+		}
+
+		var table, column string
+		var typ, ordering spanner.NullString
+		if err := row.Columns(&table, &column, &typ, &ordering); err != nil {
+			return nil, fmt.Errorf("failed to read primary keys: %v", err)
+		}
+
+		keys[table] = append(keys[table], keyColumn{Name: column, Type: typ.StringVal, Desc: ordering.StringVal == "DESC"})
+	}
+}
+
+// mutationKey returns the table and the encoded primary key of the row of a mutation, or of the first row of a
+// delete mutation.
+func mutationKey(m Mutation, keys map[string][]keyColumn) (string, []*structpb.Value) {
+	table := m.Table()
+
+	if w := m.write(); w != nil {
+		var key []*structpb.Value
+		for _, c := range keys[table] {
+			if i := indexOf(w.Columns, c.Name); i >= 0 && len(w.Values) > 0 {
+				key = append(key, w.Values[0].Values[i])
+			} else {
+				key = append(key, structpb.NewNullValue())
+			}
+		}
+		return table, key
+	}
+
+	ks := m.pb.GetDelete().GetKeySet()
+	if len(ks.GetKeys()) > 0 {
+		return table, ks.GetKeys()[0].GetValues()
+	}
+	if len(ks.GetRanges()) > 0 {
+		r := ks.GetRanges()[0]
+		if r.GetStartClosed() != nil {
+			return table, r.GetStartClosed().GetValues()
+		}
+		return table, r.GetStartOpen().GetValues()
+	}
+	return table, nil
+}
+
+// sortGroups sorts mutation groups by the table and primary key of their first mutation, so that batches of
+// consecutive groups write to few splits of a table.
+func sortGroups(groups []MutationGroup, keys map[string][]keyColumn) {
+	type keyedGroup struct {
+		group MutationGroup
+		table string
+		key   []*structpb.Value
+	}
+
+	keyed := make([]keyedGroup, len(groups))
+	for i, g := range groups {
+		keyed[i].group = g
+		if len(g.Mutations) > 0 {
+			keyed[i].table, keyed[i].key = mutationKey(g.Mutations[0], keys)
+		}
+	}
+
+	sort.SliceStable(keyed, func(i, j int) bool {
+		a, b := keyed[i], keyed[j]
+		if a.table != b.table {
+			return a.table < b.table
+		}
+		return compareKeys(a.key, b.key, keys[a.table]) < 0
+	})
+
+	for i, k := range keyed {
+		groups[i] = k.group
+	}
+}
+
+// compareKeys compares encoded primary keys in the order of the key columns. Missing parts sort first.
+func compareKeys(a, b []*structpb.Value, cols []keyColumn) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		var col keyColumn
+		if i < len(cols) {
+			col = cols[i]
+		}
+		if c := compareValues(a[i], b[i], col.Type); c != 0 {
+			if col.Desc {
+				return -c
+			}
+			return c
+		}
+	}
+	return len(a) - len(b)
+}
+
+// compareValues compares encoded values of the given spanner type. NULL sorts first.
+func compareValues(a, b *structpb.Value, typ string) int {
+	_, aNull := a.GetKind().(*structpb.Value_NullValue)
+	_, bNull := b.GetKind().(*structpb.Value_NullValue)
+	switch {
+	case aNull && bNull:
+		return 0
+	case aNull:
+		return -1
+	case bNull:
+		return 1
+	}
+
+	switch {
+	case strings.HasPrefix(typ, sppb.TypeCode_INT64.String()):
+		x, errX := strconv.ParseInt(a.GetStringValue(), 10, 64)
+		y, errY := strconv.ParseInt(b.GetStringValue(), 10, 64)
+		if errX == nil && errY == nil {
+			return compareOrdered(x, y)
+		}
+	case strings.HasPrefix(typ, sppb.TypeCode_FLOAT64.String()):
+		return compareOrdered(a.GetNumberValue(), b.GetNumberValue())
+	case strings.HasPrefix(typ, sppb.TypeCode_BOOL.String()):
+		return compareOrdered(boolInt(a.GetBoolValue()), boolInt(b.GetBoolValue()))
+	case strings.HasPrefix(typ, sppb.TypeCode_BYTES.String()):
+		x, errX := base64.StdEncoding.DecodeString(a.GetStringValue())
+		y, errY := base64.StdEncoding.DecodeString(b.GetStringValue())
+		if errX == nil && errY == nil {
+			return bytes.Compare(x, y)
+		}
+	case strings.HasPrefix(typ, sppb.TypeCode_TIMESTAMP.String()):
+		x, errX := time.Parse(time.RFC3339Nano, a.GetStringValue())
+		y, errY := time.Parse(time.RFC3339Nano, b.GetStringValue())
+		if errX == nil && errY == nil {
+			return x.Compare(y)
+		}
+	case strings.HasPrefix(typ, sppb.TypeCode_NUMERIC.String()):
+		x, okX := new(big.Rat).SetString(a.GetStringValue())
+		y, okY := new(big.Rat).SetString(b.GetStringValue())
+		if okX && okY {
+			return x.Cmp(y)
+		}
+	}
+
+	return strings.Compare(a.GetStringValue(), b.GetStringValue())
+}
+
+type ordered interface {
+	~int | ~int64 | ~float64
+}
+
+func compareOrdered[T ordered](x, y T) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,133 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spannerio
+
+import (
+	"testing"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestSortGroups(t *testing.T) {
+	keys := map[string][]keyColumn{
+		"A": {{Name: "Id", Type: "INT64"}},
+		"B": {{Name: "Name", Type: "STRING(MAX)"}, {Name: "Version", Type: "INT64", Desc: true}},
+	}
+
+	insertA := func(id int64) Mutation {
+		return mustMutation(InsertOrUpdate("A", []string{"Value", "Id"}, []any{"v", id}))
+	}
+	insertB := func(name string, version int64) Mutation {
+		return mustMutation(InsertOrUpdate("B", []string{"Name", "Version"}, []any{name, version}))
+	}
+	deleteA := func(id int64) Mutation {
+		return mustMutation(Delete("A", spanner.Key{id}))
+	}
+
+	groups := []MutationGroup{
+		NewMutationGroup(insertB("b", 1)),
+		NewMutationGroup(insertA(10)),
+		NewMutationGroup(insertB("a", 1)),
+		NewMutationGroup(deleteA(9)),
+		NewMutationGroup(insertB("b", 2)),
+		NewMutationGroup(insertA(2), insertB("z", 0)),
+	}
+
+	sortGroups(groups, keys)
+
+	assertKey := func(i int, wantTable string, want ...string) {
+		t.Helper()
+		table, key := mutationKey(groups[i].Mutations[0], keys)
+		if table != wantTable {
+			t.Errorf("sortGroups() table of group %v = %v, want %v", i, table, wantTable)
+		}
+		var parts []string
+		for _, v := range key {
+			parts = append(parts, v.GetStringValue())
+		}
+		if diff := cmp.Diff(want, parts); diff != "" {
+			t.Errorf("sortGroups() key of group %v mismatch (-want +got):\n%s", i, diff)
+		}
+	}
+	assertKey(0, "A", "2")
+	assertKey(1, "A", "9")
+	assertKey(2, "A", "10")
+	assertKey(3, "B", "a", "1")
+	assertKey(4, "B", "b", "2")
+	assertKey(5, "B", "b", "1")
+}
+
+func TestCompareValues(t *testing.T) {
+	testCases := []struct {
+		name string
+		a, b *structpb.Value
+		typ  string
+		want int
+	}{
+		{
+			name: "INT64 compares numerically",
+			a:    structpb.NewStringValue("9"),
+			b:    structpb.NewStringValue("10"),
+			typ:  "INT64",
+			want: -1,
+		},
+		{
+			name: "NULL sorts first",
+			a:    structpb.NewStringValue("a"),
+			b:    structpb.NewNullValue(),
+			typ:  "STRING(MAX)",
+			want: 1,
+		},
+		{
+			name: "FLOAT64 compares numerically",
+			a:    structpb.NewNumberValue(1.5),
+			b:    structpb.NewNumberValue(1.5),
+			typ:  "FLOAT64",
+			want: 0,
+		},
+		{
+			name: "BYTES compares decoded bytes",
+			a:    structpb.NewStringValue("/w=="),
+			b:    structpb.NewStringValue("AA=="),
+			typ:  "BYTES(MAX)",
+			want: 1,
+		},
+		{
+			name: "TIMESTAMP compares times",
+			a:    structpb.NewStringValue("2023-04-01T12:00:00.5Z"),
+			b:    structpb.NewStringValue("2023-04-01T12:00:00Z"),
+			typ:  "TIMESTAMP",
+			want: 1,
+		},
+		{
+			name: "NUMERIC compares numerically",
+			a:    structpb.NewStringValue("2.5"),
+			b:    structpb.NewStringValue("10"),
+			typ:  "NUMERIC",
+			want: -1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := compareValues(testCase.a, testCase.b, testCase.typ); got != testCase.want {
+				t.Errorf("compareValues() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
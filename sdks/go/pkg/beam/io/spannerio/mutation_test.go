@@ -0,0 +1,230 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spannerio
+
+import (
+	"fmt"
+	"testing"
+
+	"cloud.google.com/go/spanner"
+	sppb "cloud.google.com/go/spanner/apiv1/spannerpb"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func mustMutation(m Mutation, err error) Mutation {
+	if err != nil {
+		panic(fmt.Sprintf("creating mutation: %v", err))
+	}
+
+	return m
+}
+
+func TestMutation_write(t *testing.T) {
+	testCases := []struct {
+		name     string
+		mutation func() (Mutation, error)
+		want     *sppb.Mutation
+	}{
+		{
+			name: "Insert",
+			mutation: func() (Mutation, error) {
+				return Insert("Test", []string{"One", "Two"}, []any{"one", int64(1)})
+			},
+			want: &sppb.Mutation{Operation: &sppb.Mutation_Insert{Insert: &sppb.Mutation_Write{
+				Table:   "Test",
+				Columns: []string{"One", "Two"},
+				Values:  []*structpb.ListValue{{Values: []*structpb.Value{structpb.NewStringValue("one"), structpb.NewStringValue("1")}}},
+			}}},
+		},
+		{
+			name: "Replace with NULL value",
+			mutation: func() (Mutation, error) {
+				return Replace("Test", []string{"One", "Two"}, []any{spanner.NullString{}, int64(2)})
+			},
+			want: &sppb.Mutation{Operation: &sppb.Mutation_Replace{Replace: &sppb.Mutation_Write{
+				Table:   "Test",
+				Columns: []string{"One", "Two"},
+				Values:  []*structpb.ListValue{{Values: []*structpb.Value{structpb.NewNullValue(), structpb.NewStringValue("2")}}},
+			}}},
+		},
+		{
+			name: "InsertOrUpdateStruct",
+			mutation: func() (Mutation, error) {
+				return InsertOrUpdateStruct("Test", TestDto{One: "one", Two: 3})
+			},
+			want: &sppb.Mutation{Operation: &sppb.Mutation_InsertOrUpdate{InsertOrUpdate: &sppb.Mutation_Write{
+				Table:   "Test",
+				Columns: []string{"One", "Two"},
+				Values:  []*structpb.ListValue{{Values: []*structpb.Value{structpb.NewStringValue("one"), structpb.NewStringValue("3")}}},
+			}}},
+		},
+		{
+			name: "UpdateStruct of pointer",
+			mutation: func() (Mutation, error) {
+				return UpdateStruct("Test", &TestDto{One: "one", Two: 4})
+			},
+			want: &sppb.Mutation{Operation: &sppb.Mutation_Update{Update: &sppb.Mutation_Write{
+				Table:   "Test",
+				Columns: []string{"One", "Two"},
+				Values:  []*structpb.ListValue{{Values: []*structpb.Value{structpb.NewStringValue("one"), structpb.NewStringValue("4")}}},
+			}}},
+		},
+		{
+			name: "Delete keys",
+			mutation: func() (Mutation, error) {
+				return Delete("Test", spanner.Key{int64(1)}, spanner.Key{int64(2)})
+			},
+			want: &sppb.Mutation{Operation: &sppb.Mutation_Delete_{Delete: &sppb.Mutation_Delete{
+				Table: "Test",
+				KeySet: &sppb.KeySet{Keys: []*structpb.ListValue{
+					{Values: []*structpb.Value{structpb.NewStringValue("1")}},
+					{Values: []*structpb.Value{structpb.NewStringValue("2")}},
+				}},
+			}}},
+		},
+		{
+			name: "Delete key range",
+			mutation: func() (Mutation, error) {
+				return DeleteKeyRange("Test", spanner.KeyRange{Start: spanner.Key{int64(1)}, End: spanner.Key{int64(3)}, Kind: spanner.ClosedOpen})
+			},
+			want: &sppb.Mutation{Operation: &sppb.Mutation_Delete_{Delete: &sppb.Mutation_Delete{
+				Table: "Test",
+				KeySet: &sppb.KeySet{Ranges: []*sppb.KeyRange{{
+					StartKeyType: &sppb.KeyRange_StartClosed{StartClosed: &structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("1")}}},
+					EndKeyType:   &sppb.KeyRange_EndOpen{EndOpen: &structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("3")}}},
+				}}},
+			}}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			m := mustMutation(testCase.mutation())
+
+			if diff := cmp.Diff(testCase.want, m.pb, protocmp.Transform()); diff != "" {
+				t.Errorf("mutation mismatch (-want +got):\n%s", diff)
+			}
+
+			encoded, err := encodeMutation(m)
+			if err != nil {
+				t.Fatalf("encodeMutation() error = %v", err)
+			}
+
+			decoded, err := decodeMutation(encoded)
+			if err != nil {
+				t.Fatalf("decodeMutation() error = %v", err)
+			}
+
+			if diff := cmp.Diff(testCase.want, decoded.pb, protocmp.Transform()); diff != "" {
+				t.Errorf("decoded mutation mismatch (-want +got):\n%s", diff)
+			}
+
+			if _, err := decoded.toSpanner(); err != nil {
+				t.Errorf("toSpanner() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestMutation_errors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		mutation func() (Mutation, error)
+	}{
+		{
+			name: "Mismatched columns and values",
+			mutation: func() (Mutation, error) {
+				return Insert("Test", []string{"One", "Two"}, []any{"one"})
+			},
+		},
+		{
+			name: "Unsupported value",
+			mutation: func() (Mutation, error) {
+				return Insert("Test", []string{"One"}, []any{make(chan int)})
+			},
+		},
+		{
+			name: "Struct of non-struct",
+			mutation: func() (Mutation, error) {
+				return InsertStruct("Test", "one")
+			},
+		},
+		{
+			name: "Struct of nil pointer",
+			mutation: func() (Mutation, error) {
+				return InsertStruct("Test", (*TestDto)(nil))
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if _, err := testCase.mutation(); err == nil {
+				t.Errorf("mutation error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestMutation_withCommitTimestamp(t *testing.T) {
+	m := mustMutation(InsertOrUpdate("Test", []string{"One", "Updated"}, []any{"one", nil}))
+
+	got, err := m.withCommitTimestamp([]string{"Updated", "Created"})
+	if err != nil {
+		t.Fatalf("withCommitTimestamp() error = %v", err)
+	}
+
+	commitTimestamp := structpb.NewStringValue("spanner.commit_timestamp()")
+	want := &sppb.Mutation{Operation: &sppb.Mutation_InsertOrUpdate{InsertOrUpdate: &sppb.Mutation_Write{
+		Table:   "Test",
+		Columns: []string{"One", "Updated", "Created"},
+		Values:  []*structpb.ListValue{{Values: []*structpb.Value{structpb.NewStringValue("one"), commitTimestamp, commitTimestamp}}},
+	}}}
+	if diff := cmp.Diff(want, got.pb, protocmp.Transform()); diff != "" {
+		t.Errorf("withCommitTimestamp() mismatch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff([]string{"One", "Updated"}, m.write().Columns); diff != "" {
+		t.Errorf("withCommitTimestamp() modified the original mutation (-want +got):\n%s", diff)
+	}
+
+	if _, err := DeleteAll("Test").withCommitTimestamp([]string{"Updated"}); err == nil {
+		t.Errorf("withCommitTimestamp() of delete mutation error = nil, want error")
+	}
+}
+
+func TestMutationGroup_coder(t *testing.T) {
+	group := NewMutationGroup(
+		mustMutation(InsertOrUpdate("Test", []string{"One", "Two"}, []any{"one", int64(1)})),
+		DeleteAll("Other"),
+	)
+
+	encoded, err := encodeMutationGroup(group)
+	if err != nil {
+		t.Fatalf("encodeMutationGroup() error = %v", err)
+	}
+
+	decoded, err := decodeMutationGroup(encoded)
+	if err != nil {
+		t.Fatalf("decodeMutationGroup() error = %v", err)
+	}
+
+	if diff := cmp.Diff(group, decoded, cmp.AllowUnexported(Mutation{}), protocmp.Transform()); diff != "" {
+		t.Errorf("decodeMutationGroup() mismatch (-want +got):\n%s", diff)
+	}
+}
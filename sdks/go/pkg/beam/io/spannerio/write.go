@@ -17,6 +17,8 @@ package spannerio
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
 
 	"cloud.google.com/go/spanner"
@@ -27,23 +29,75 @@ import (
 
 func init() {
 	register.DoFn2x1[context.Context, beam.X, error]((*writeFn)(nil))
+	register.DoFn2x1[context.Context, MutationGroup, error]((*writeMutationGroupsFn)(nil))
 }
 
 // WriteOptionsFn is a function that can be passed to Write to configure options for writing to spanner.
 type WriteOptionsFn func(qo *writeOptions) error
 
 type writeOptions struct {
-	BatchSize int
+	BatchSize              int      `json:"batchSize"`              // Maximum number of mutations per transaction.
+	GroupingFactor         int      `json:"groupingFactor"`         // Number of batches to sort by primary key before writing.
+	CommitTimestampColumns []string `json:"commitTimestampColumns"` // Columns to write the commit timestamp to.
+}
+
+func newWriteOptions(options ...WriteOptionsFn) writeOptions {
+	opts := writeOptions{
+		BatchSize:      1000, // default
+		GroupingFactor: 1,
+	}
+
+	for _, opt := range options {
+		if err := opt(&opts); err != nil {
+			panic(fmt.Sprintf("spannerio.Write: invalid option: %v", err))
+		}
+	}
+
+	return opts
 }
 
 // UseBatchSize explicitly sets the batch size per transaction for writes.
 func UseBatchSize(batchSize int) WriteOptionsFn {
 	return func(qo *writeOptions) error {
+		if batchSize <= 0 {
+			return errors.New("batch size must be greater than 0")
+		}
+
 		qo.BatchSize = batchSize
 		return nil
 	}
 }
 
+// UseGroupingFactor buffers the given number of batches and sorts the buffered mutation groups by the primary
+// key of their first mutation before writing them. Each batch then writes a narrow range of keys, which spans
+// few splits of the table and so commits faster. The primary keys are read from the information schema of the
+// database. The default of 1 writes mutation groups in the order they are processed.
+func UseGroupingFactor(factor int) WriteOptionsFn {
+	return func(qo *writeOptions) error {
+		if factor <= 0 {
+			return errors.New("grouping factor must be greater than 0")
+		}
+
+		qo.GroupingFactor = factor
+		return nil
+	}
+}
+
+// UseCommitTimestamp writes the commit timestamp of the transaction to the given columns of the rows written by
+// Write, which must be TIMESTAMP columns with the allow_commit_timestamp option. Values of these columns in the
+// elements are ignored. Mutations passed to WriteMutationGroups write commit timestamps by using
+// spanner.CommitTimestamp as a value instead.
+func UseCommitTimestamp(columns ...string) WriteOptionsFn {
+	return func(qo *writeOptions) error {
+		if len(columns) == 0 {
+			return errors.New("no commit timestamp columns provided")
+		}
+
+		qo.CommitTimestampColumns = columns
+		return nil
+	}
+}
+
 // Write writes the elements of the given PCollection<T> to spanner. T is required
 // to be the schema type.
 func Write(s beam.Scope, db string, table string, col beam.PCollection, options ...WriteOptionsFn) {
@@ -60,66 +114,153 @@ func Write(s beam.Scope, db string, table string, col beam.PCollection, options
 	beam.ParDo0(s, newWriteFn(db, table, col.Type().Type(), options...), col)
 }
 
-type writeFn struct {
-	spannerFn
-	Table     string           `json:"table"`   // The table to write to
-	Type      beam.EncodedType `json:"type"`    // Type is the encoded schema type.
-	Options   writeOptions     `json:"options"` // Spanner write options
-	mutations []*spanner.Mutation
-}
+// WriteMutationGroups applies the mutation groups of the given PCollection<MutationGroup> to spanner. Each group
+// is applied atomically, together with other groups of the same batch. Groups are written at least once, so the
+// mutations should be idempotent, such as InsertOrUpdate, Replace and Delete mutations.
+func WriteMutationGroups(s beam.Scope, db string, col beam.PCollection, options ...WriteOptionsFn) {
+	if db == "" {
+		panic("no database provided!")
+	}
 
-func newWriteFn(db string, table string, t reflect.Type, options ...WriteOptionsFn) *writeFn {
-	writeOptions := writeOptions{
-		BatchSize: 1000, // default
+	if t := col.Type().Type(); t != reflect.TypeOf(MutationGroup{}) {
+		panic(fmt.Sprintf("spannerio.WriteMutationGroups: unsupported collection type %v, expected MutationGroup", t))
 	}
 
-	for _, opt := range options {
-		if err := opt(&writeOptions); err != nil {
-			panic(err)
-		}
+	opts := newWriteOptions(options...)
+	if len(opts.CommitTimestampColumns) > 0 {
+		panic("spannerio.WriteMutationGroups: UseCommitTimestamp is not supported, use spanner.CommitTimestamp values instead.")
 	}
 
-	return &writeFn{spannerFn: newSpannerFn(db), Table: table, Type: beam.EncodedType{T: t}, Options: writeOptions}
+	s = s.Scope("spanner.WriteMutationGroups")
+
+	beam.ParDo0(s, &writeMutationGroupsFn{mutationWriter: newMutationWriter(db, opts)}, col)
 }
 
-func (f *writeFn) Setup(ctx context.Context) error {
-	return f.spannerFn.Setup(ctx)
+// mutationWriter applies mutation groups to spanner in batches.
+type mutationWriter struct {
+	spannerFn
+	Options writeOptions `json:"options"` // Spanner write options
+	keys    map[string][]keyColumn
+	groups  []MutationGroup
+	size    int
 }
 
-func (f *writeFn) Teardown() {
-	f.spannerFn.Teardown()
+func newMutationWriter(db string, options writeOptions) mutationWriter {
+	return mutationWriter{spannerFn: newSpannerFn(db), Options: options}
 }
 
-func (f *writeFn) ProcessElement(ctx context.Context, value beam.X) error {
-	mutation, err := spanner.InsertOrUpdateStruct(f.Table, value)
-	if err != nil {
+func (f *mutationWriter) Setup(ctx context.Context) error {
+	if err := f.spannerFn.Setup(ctx); err != nil {
 		return err
 	}
 
-	f.mutations = append(f.mutations, mutation)
+	if f.Options.GroupingFactor > 1 && f.keys == nil {
+		keys, err := readPrimaryKeys(ctx, f.client)
+		if err != nil {
+			return err
+		}
+		f.keys = keys
+	}
+
+	return nil
+}
+
+func (f *mutationWriter) Teardown() {
+	f.spannerFn.Teardown()
+}
+
+// add buffers a mutation group, and writes the buffered groups once they fill all batches.
+func (f *mutationWriter) add(ctx context.Context, group MutationGroup) error {
+	f.groups = append(f.groups, group)
+	f.size += len(group.Mutations)
 
-	if len(f.mutations)+1 > f.Options.BatchSize {
-		f.flush(ctx)
+	if f.size >= f.Options.BatchSize*f.Options.GroupingFactor {
+		return f.flush(ctx)
 	}
 
 	return nil
 }
 
-func (f *writeFn) FinishBundle(ctx context.Context) error {
-	if len(f.mutations) > 0 {
+func (f *mutationWriter) FinishBundle(ctx context.Context) error {
+	if len(f.groups) > 0 {
 		return f.flush(ctx)
 	}
 
 	return nil
 }
 
-func (f *writeFn) flush(ctx context.Context) error {
-	_, err := f.client.Apply(ctx, f.mutations)
-	if err != nil {
+// flush writes the buffered groups in batches of at most BatchSize mutations. A group with more mutations is
+// written in a batch of its own.
+func (f *mutationWriter) flush(ctx context.Context) error {
+	if f.Options.GroupingFactor > 1 {
+		sortGroups(f.groups, f.keys)
+	}
+
+	var batch []*spanner.Mutation
+	for _, group := range f.groups {
+		if len(batch) > 0 && len(batch)+len(group.Mutations) > f.Options.BatchSize {
+			if err := f.apply(ctx, batch); err != nil {
+				return err
+			}
+			batch = nil
+		}
+
+		for _, m := range group.Mutations {
+			mutation, err := m.toSpanner()
+			if err != nil {
+				return err
+			}
+			batch = append(batch, mutation)
+		}
+	}
+
+	if err := f.apply(ctx, batch); err != nil {
 		return err
 	}
 
-	f.mutations = nil
+	f.groups = nil
+	f.size = 0
 
 	return nil
 }
+
+func (f *mutationWriter) apply(ctx context.Context, batch []*spanner.Mutation) error {
+	if len(batch) == 0 {
+		return nil
+	}
+
+	_, err := f.client.Apply(ctx, batch)
+	return err
+}
+
+type writeFn struct {
+	mutationWriter
+	Table string           `json:"table"` // The table to write to
+	Type  beam.EncodedType `json:"type"`  // Type is the encoded schema type.
+}
+
+func newWriteFn(db string, table string, t reflect.Type, options ...WriteOptionsFn) *writeFn {
+	return &writeFn{mutationWriter: newMutationWriter(db, newWriteOptions(options...)), Table: table, Type: beam.EncodedType{T: t}}
+}
+
+func (f *writeFn) ProcessElement(ctx context.Context, value beam.X) error {
+	mutation, err := InsertOrUpdateStruct(f.Table, value)
+	if err != nil {
+		return err
+	}
+
+	mutation, err = mutation.withCommitTimestamp(f.Options.CommitTimestampColumns)
+	if err != nil {
+		return err
+	}
+
+	return f.add(ctx, NewMutationGroup(mutation))
+}
+
+type writeMutationGroupsFn struct {
+	mutationWriter
+}
+
+func (f *writeMutationGroupsFn) ProcessElement(ctx context.Context, group MutationGroup) error {
+	return f.add(ctx, group)
+}
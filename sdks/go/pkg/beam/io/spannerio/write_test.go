@@ -18,11 +18,13 @@ package spannerio
 import (
 	"context"
 	"testing"
+	"time"
 
 	"cloud.google.com/go/spanner"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
 	spannertest "github.com/Beamdust/beam-fork/sdks/v3/go/test/integration/io/spannerio"
+	"github.com/google/go-cmp/cmp"
 	"google.golang.org/api/iterator"
 )
 
@@ -104,3 +106,166 @@ func TestWrite(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteMutationGroups(t *testing.T) {
+	ctx := context.Background()
+	database := "projects/fake-proj/instances/fake-instance/databases/fake-db-mutation-groups"
+	table := "MutationGroups"
+
+	srv := newServer(t)
+
+	adminClient := spannertest.NewAdminClient(ctx, t, srv.Addr)
+	spannertest.CreateTable(ctx, t, adminClient, database, []string{`CREATE TABLE ` + table + ` (
+			One STRING(20),
+			Two INT64,
+		) PRIMARY KEY (Two)`})
+
+	client := spannertest.NewClient(ctx, t, srv.Addr, database)
+	var existing []*spanner.Mutation
+	for i := int64(10); i < 15; i++ {
+		existing = append(existing, spanner.Insert(table, []string{"One", "Two"}, []any{"existing", i}))
+	}
+	if _, err := client.Apply(ctx, existing); err != nil {
+		t.Fatalf("Applying mutations: %v", err)
+	}
+
+	groups := []MutationGroup{
+		NewMutationGroup(
+			mustMutation(InsertOrUpdate(table, []string{"One", "Two"}, []any{"one", int64(1)})),
+			mustMutation(InsertOrUpdateStruct(table, TestDto{One: "two", Two: 2})),
+		),
+		NewMutationGroup(
+			mustMutation(Update(table, []string{"One", "Two"}, []any{"updated", int64(10)})),
+		),
+		NewMutationGroup(
+			mustMutation(DeleteKeyRange(table, spanner.KeyRange{Start: spanner.Key{int64(11)}, End: spanner.Key{int64(13)}, Kind: spanner.ClosedClosed})),
+		),
+	}
+
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.CreateList(s, groups)
+
+	fn := &writeMutationGroupsFn{mutationWriter: newMutationWriter(database, newWriteOptions(UseBatchSize(2)))}
+	fn.TestEndpoint = srv.Addr
+
+	beam.ParDo0(s, fn, col)
+
+	ptest.RunAndValidate(t, p)
+
+	want := []TestDto{{One: "one", Two: 1}, {One: "two", Two: 2}, {One: "updated", Two: 10}, {One: "existing", Two: 14}}
+	if diff := cmp.Diff(want, readTestDtos(ctx, t, client, table)); diff != "" {
+		t.Errorf("Rows mismatch after writing mutation groups (-want +got):\n%s", diff)
+	}
+}
+
+func TestWrite_commitTimestamp(t *testing.T) {
+	ctx := context.Background()
+	database := "projects/fake-proj/instances/fake-instance/databases/fake-db-commit-timestamp"
+	table := "CommitTimestamp"
+
+	srv := newServer(t)
+
+	adminClient := spannertest.NewAdminClient(ctx, t, srv.Addr)
+	spannertest.CreateTable(ctx, t, adminClient, database, []string{`CREATE TABLE ` + table + ` (
+			One STRING(20),
+			Two INT64,
+			Updated TIMESTAMP OPTIONS (allow_commit_timestamp = true),
+		) PRIMARY KEY (Two)`})
+
+	start := time.Now()
+
+	p, s, col := ptest.CreateList([]TestDto{{One: "one", Two: 1}, {One: "two", Two: 2}})
+
+	fn := newWriteFn(database, table, col.Type().Type(), UseCommitTimestamp("Updated"))
+	fn.TestEndpoint = srv.Addr
+
+	beam.ParDo0(s, fn, col)
+
+	ptest.RunAndValidate(t, p)
+
+	client := spannertest.NewClient(ctx, t, srv.Addr, database)
+	it := client.Single().Query(ctx, spanner.Statement{SQL: "SELECT Updated FROM " + table})
+	defer it.Stop()
+
+	var count int
+	err := it.Do(func(row *spanner.Row) error {
+		var updated time.Time
+		if err := row.Column(0, &updated); err != nil {
+			return err
+		}
+		if updated.Before(start) {
+			t.Errorf("Updated = %v, want commit timestamp after %v", updated, start)
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Querying rows: %v", err)
+	}
+
+	if count != 2 {
+		t.Errorf("Got %v rows, want 2", count)
+	}
+}
+
+func TestWriteMutationGroups_panics(t *testing.T) {
+	testCases := []struct {
+		name    string
+		db      string
+		input   any
+		options []WriteOptionsFn
+	}{
+		{
+			name:  "No database",
+			input: NewMutationGroup(DeleteAll("Test")),
+		},
+		{
+			name:  "Not a PCollection of mutation groups",
+			db:    "projects/fake-proj/instances/fake-instance/databases/fake-db",
+			input: TestDto{},
+		},
+		{
+			name:    "Commit timestamp columns",
+			db:      "projects/fake-proj/instances/fake-instance/databases/fake-db",
+			input:   NewMutationGroup(DeleteAll("Test")),
+			options: []WriteOptionsFn{UseCommitTimestamp("Updated")},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, s := beam.NewPipelineWithRoot()
+			col := beam.Create(s, testCase.input)
+
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("WriteMutationGroups() does not panic")
+				}
+			}()
+
+			WriteMutationGroups(s, testCase.db, col, testCase.options...)
+		})
+	}
+}
+
+func readTestDtos(ctx context.Context, t *testing.T, client *spanner.Client, table string) []TestDto {
+	t.Helper()
+
+	it := client.Single().Query(ctx, spanner.Statement{SQL: "SELECT One, Two FROM " + table + " ORDER BY Two"})
+	defer it.Stop()
+
+	var rows []TestDto
+	err := it.Do(func(row *spanner.Row) error {
+		var dto TestDto
+		if err := row.ToStruct(&dto); err != nil {
+			return err
+		}
+		rows = append(rows, dto)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Querying rows: %v", err)
+	}
+
+	return rows
+}
@@ -0,0 +1,191 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttling
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+)
+
+const (
+	// DefaultSamplePeriod is the default period over which request outcomes are remembered.
+	DefaultSamplePeriod = time.Minute
+	// DefaultBucketSize is the default granularity of the remembered outcomes.
+	DefaultBucketSize = time.Second
+	// DefaultOverloadRatio is the default ratio of requests to accepted requests that a
+	// backend is assumed to sustain before requests are rejected client side.
+	DefaultOverloadRatio = 2.0
+	// DefaultThrottleDelay is the default delay before a throttled request is reconsidered.
+	DefaultThrottleDelay = time.Second
+)
+
+// AdaptiveThrottler rejects requests client side with a probability that grows with the
+// fraction of recent requests the backend rejected, as described in the "Handling Overload"
+// chapter of the Site Reliability Engineering book. This keeps workers from adding load to
+// an overloaded backend while still probing it for recovery. It is safe for concurrent use.
+//
+// Callers ask ThrottleRequest whether to send each request, and report the requests that
+// the backend accepted with SuccessfulRequest.
+type AdaptiveThrottler struct {
+	mu            sync.Mutex
+	bucketSize    time.Duration
+	overloadRatio float64
+	delay         time.Duration
+	// buckets is a ring of per-bucket counts, indexed by bucket number.
+	buckets []requestCounts
+	rand    func() float64
+	counter beam.Counter
+	now     func() time.Time
+	sleep   func(context.Context, time.Duration) error
+}
+
+type requestCounts struct {
+	number   int64
+	requests int64
+	accepts  int64
+}
+
+// AdaptiveThrottlerOption configures an AdaptiveThrottler.
+type AdaptiveThrottlerOption func(t *AdaptiveThrottler)
+
+// WithSamplePeriod sets the period over which request outcomes are remembered, and the
+// granularity at which they expire.
+func WithSamplePeriod(period, bucketSize time.Duration) AdaptiveThrottlerOption {
+	if bucketSize <= 0 || period < bucketSize {
+		panic("throttling.WithSamplePeriod: bucket size must be greater than 0 and at most the period")
+	}
+	return func(t *AdaptiveThrottler) {
+		t.bucketSize = bucketSize
+		t.buckets = make([]requestCounts, period/bucketSize)
+	}
+}
+
+// WithOverloadRatio sets the ratio of requests to accepted requests that the backend is
+// assumed to sustain. Lower ratios throttle sooner.
+func WithOverloadRatio(ratio float64) AdaptiveThrottlerOption {
+	if ratio < 1 {
+		panic("throttling.WithOverloadRatio: ratio must be at least 1")
+	}
+	return func(t *AdaptiveThrottler) {
+		t.overloadRatio = ratio
+	}
+}
+
+// WithThrottleDelay sets the delay of Wait before a throttled request is reconsidered.
+func WithThrottleDelay(delay time.Duration) AdaptiveThrottlerOption {
+	if delay <= 0 {
+		panic("throttling.WithThrottleDelay: delay must be greater than 0")
+	}
+	return func(t *AdaptiveThrottler) {
+		t.delay = delay
+	}
+}
+
+// WithRandom sets the source of the random numbers in [0, 1) that decide whether requests
+// are throttled. It defaults to rand.Float64.
+func WithRandom(random func() float64) AdaptiveThrottlerOption {
+	return func(t *AdaptiveThrottler) {
+		t.rand = random
+	}
+}
+
+// NewAdaptiveThrottler returns an AdaptiveThrottler reporting the time that Wait spends
+// throttled to the throttling counter of the namespace.
+func NewAdaptiveThrottler(namespace string, opts ...AdaptiveThrottlerOption) *AdaptiveThrottler {
+	t := &AdaptiveThrottler{
+		bucketSize:    DefaultBucketSize,
+		overloadRatio: DefaultOverloadRatio,
+		delay:         DefaultThrottleDelay,
+		buckets:       make([]requestCounts, DefaultSamplePeriod/DefaultBucketSize),
+		rand:          rand.Float64,
+		counter:       NewCounter(namespace),
+		now:           time.Now,
+		sleep:         sleep,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// bucket returns the counts of the bucket containing now.
+func (t *AdaptiveThrottler) bucket(now time.Time) *requestCounts {
+	number := now.UnixNano() / int64(t.bucketSize)
+	b := &t.buckets[number%int64(len(t.buckets))]
+	if b.number != number {
+		*b = requestCounts{number: number}
+	}
+	return b
+}
+
+// RejectionProbability returns the probability that a request at now is throttled.
+func (t *AdaptiveThrottler) RejectionProbability(now time.Time) float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.rejectionProbability(now)
+}
+
+func (t *AdaptiveThrottler) rejectionProbability(now time.Time) float64 {
+	number := now.UnixNano() / int64(t.bucketSize)
+	var requests, accepts int64
+	for _, b := range t.buckets {
+		if age := number - b.number; age >= 0 && age < int64(len(t.buckets)) {
+			requests += b.requests
+			accepts += b.accepts
+		}
+	}
+	p := (float64(requests) - t.overloadRatio*float64(accepts)) / float64(requests+1)
+	if p < 0 {
+		return 0
+	}
+	return p
+}
+
+// ThrottleRequest returns whether the request at now should be delayed rather than sent.
+// The request is counted whether it is throttled or not.
+func (t *AdaptiveThrottler) ThrottleRequest(now time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	throttled := t.rand() < t.rejectionProbability(now)
+	t.bucket(now).requests++
+	return throttled
+}
+
+// SuccessfulRequest records that a request sent at now was accepted by the backend.
+func (t *AdaptiveThrottler) SuccessfulRequest(now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.bucket(now).accepts++
+}
+
+// Wait blocks until a request isn't throttled, or ctx is done, reporting the time spent
+// throttled to the throttling counter.
+func (t *AdaptiveThrottler) Wait(ctx context.Context) error {
+	for t.ThrottleRequest(t.now()) {
+		t.counter.Inc(ctx, t.delay.Milliseconds())
+		if err := t.sleep(ctx, t.delay); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttling
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAdaptiveThrottler(t *testing.T) {
+	start := time.Unix(1000, 0)
+	th := NewAdaptiveThrottler("test", WithRandom(func() float64 { return 0.5 }))
+
+	if got := th.RejectionProbability(start); got != 0 {
+		t.Errorf("RejectionProbability() without requests = %v, want 0", got)
+	}
+
+	// Requests that are all accepted are never throttled.
+	for i := 0; i < 100; i++ {
+		if th.ThrottleRequest(start) {
+			t.Fatalf("ThrottleRequest() = true with all requests accepted")
+		}
+		th.SuccessfulRequest(start)
+	}
+
+	// Once most recent requests fail, requests are throttled.
+	now := start.Add(DefaultSamplePeriod / 2)
+	throttled := false
+	for i := 0; i < 1000 && !throttled; i++ {
+		throttled = th.ThrottleRequest(now)
+	}
+	if !throttled {
+		t.Errorf("ThrottleRequest() = false with most requests rejected, probability %v", th.RejectionProbability(now))
+	}
+
+	// Outcomes older than the sample period are forgotten.
+	later := now.Add(DefaultSamplePeriod)
+	if got := th.RejectionProbability(later); got != 0 {
+		t.Errorf("RejectionProbability() after the sample period = %v, want 0", got)
+	}
+}
+
+func TestAdaptiveThrottler_options(t *testing.T) {
+	start := time.Unix(1000, 0)
+	th := NewAdaptiveThrottler("test", WithSamplePeriod(10*time.Second, time.Second), WithOverloadRatio(1))
+
+	// With a ratio of 1, each rejected request raises the rejection probability.
+	th.ThrottleRequest(start)
+	th.SuccessfulRequest(start)
+	th.ThrottleRequest(start)
+	if got, want := th.RejectionProbability(start), 1.0/3; got != want {
+		t.Errorf("RejectionProbability() = %v, want %v", got, want)
+	}
+	if got := th.RejectionProbability(start.Add(10 * time.Second)); got != 0 {
+		t.Errorf("RejectionProbability() after the sample period = %v, want 0", got)
+	}
+}
+
+func TestAdaptiveThrottler_Wait(t *testing.T) {
+	ctx := newMetricsContext()
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	random := []float64{0, 0, 0.99}
+	th := NewAdaptiveThrottler("test", WithThrottleDelay(time.Second), WithRandom(func() float64 {
+		r := random[0]
+		random = random[1:]
+		return r
+	}))
+	th.now = clock.now
+	th.sleep = clock.sleep
+
+	// Requests that are never accepted make the next requests likely to be throttled.
+	th.ThrottleRequest(clock.t)
+	th.ThrottleRequest(clock.t)
+	random = []float64{0, 0, 0.99}
+
+	if err := th.Wait(ctx); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if got, want := len(clock.sleeps), 2; got != want {
+		t.Errorf("Sleeps = %v, want %v", got, want)
+	}
+	if got, want := throttledMsecs(ctx), int64(2000); got != want {
+		t.Errorf("Throttled msecs = %v, want %v", got, want)
+	}
+}
+
+func TestAdaptiveThrottlerOptions_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  func()
+	}{
+		{
+			name: "Bucket larger than the sample period",
+			opt:  func() { WithSamplePeriod(time.Second, time.Minute) },
+		},
+		{
+			name: "Overload ratio below 1",
+			opt:  func() { WithOverloadRatio(0.5) },
+		},
+		{
+			name: "Delay of 0",
+			opt:  func() { WithThrottleDelay(0) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("option did not panic, want panic")
+				}
+			}()
+			tt.opt()
+		})
+	}
+}
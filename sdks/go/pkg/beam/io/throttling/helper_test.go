@@ -0,0 +1,57 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttling
+
+import (
+	"context"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/metrics"
+)
+
+func newMetricsContext() context.Context {
+	ctx := metrics.SetBundleID(context.Background(), "bundle")
+	return metrics.SetPTransformID(ctx, "transform")
+}
+
+// throttledMsecs returns the value of the throttling counter in ctx.
+func throttledMsecs(ctx context.Context) int64 {
+	for _, c := range metrics.ResultsExtractor(ctx).AllMetrics().Counters() {
+		if c.Name() == CounterName {
+			return c.Result()
+		}
+	}
+	return 0
+}
+
+// fakeClock is a clock whose sleeps advance the time.
+type fakeClock struct {
+	t      time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func (c *fakeClock) sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c.sleeps = append(c.sleeps, d)
+	c.t = c.t.Add(d)
+	return nil
+}
@@ -0,0 +1,51 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package throttling contains client-side throttlers for DoFns calling backends that may be
+// overloaded, such as databases or APIs.
+//
+// A TokenBucket limits the rate of requests of a worker, and an AdaptiveThrottler rejects
+// requests client side once the backend starts rejecting them. Time spent throttled is
+// reported to the "throttling-msecs" counter, which runners use to tell workers held back
+// by a backend from busy ones when deciding whether to scale up.
+package throttling
+
+import (
+	"context"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+)
+
+// CounterName is the name of the counter of milliseconds spent throttled.
+const CounterName = "throttling-msecs"
+
+// NewCounter returns the counter of milliseconds spent throttled in the namespace.
+func NewCounter(namespace string) beam.Counter {
+	return beam.NewCounter(namespace, CounterName)
+}
+
+// sleep sleeps for d, or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
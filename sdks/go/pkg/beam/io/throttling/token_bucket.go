@@ -0,0 +1,120 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttling
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+)
+
+// TokenBucket limits the rate of requests. Tokens are added at a steady rate, up to a
+// burst, and each request takes a token. It is safe for concurrent use.
+type TokenBucket struct {
+	mu      sync.Mutex
+	rate    float64 // tokens per second
+	burst   float64
+	tokens  float64
+	last    time.Time
+	counter beam.Counter
+	now     func() time.Time
+	sleep   func(context.Context, time.Duration) error
+}
+
+// NewTokenBucket returns a full token bucket that adds rate tokens per second, up to
+// burst tokens. Time spent waiting for tokens is reported to the throttling counter of the
+// namespace.
+func NewTokenBucket(namespace string, rate float64, burst int) *TokenBucket {
+	if rate <= 0 || burst <= 0 {
+		panic("throttling.NewTokenBucket: rate and burst must be greater than 0")
+	}
+
+	return &TokenBucket{
+		rate:    rate,
+		burst:   float64(burst),
+		tokens:  float64(burst),
+		counter: NewCounter(namespace),
+		now:     time.Now,
+		sleep:   sleep,
+	}
+}
+
+var (
+	sharedMu      sync.Mutex
+	sharedBuckets = make(map[string]*TokenBucket)
+)
+
+// SharedTokenBucket returns the token bucket of the worker for key, creating it with
+// NewTokenBucket if there is none. DoFn instances of a worker using the same key share the
+// rate, so the rate of a worker doesn't grow with the number of instances it runs.
+func SharedTokenBucket(key, namespace string, rate float64, burst int) *TokenBucket {
+	sharedMu.Lock()
+	defer sharedMu.Unlock()
+
+	b, ok := sharedBuckets[key]
+	if !ok {
+		b = NewTokenBucket(namespace, rate, burst)
+		sharedBuckets[key] = b
+	}
+	return b
+}
+
+// Wait blocks until a token is available, or ctx is done. The token is taken unless ctx is
+// done first.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	d := b.reserve()
+	if d <= 0 {
+		return nil
+	}
+
+	b.counter.Inc(ctx, d.Milliseconds())
+	if err := b.sleep(ctx, d); err != nil {
+		b.cancel()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token, and returns the time to wait until it is available.
+func (b *TokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token.
+func (b *TokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens++
+}
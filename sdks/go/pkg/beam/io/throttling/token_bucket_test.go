@@ -0,0 +1,96 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package throttling
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func newTestTokenBucket(rate float64, burst int) (*TokenBucket, *fakeClock) {
+	clock := &fakeClock{t: time.Unix(1000, 0)}
+	b := NewTokenBucket("test", rate, burst)
+	b.now = clock.now
+	b.sleep = clock.sleep
+	return b, clock
+}
+
+func TestTokenBucket_Wait(t *testing.T) {
+	ctx := newMetricsContext()
+	b, clock := newTestTokenBucket(10, 2)
+
+	// The burst is available immediately, and later tokens every 100ms.
+	for i := 0; i < 4; i++ {
+		if err := b.Wait(ctx); err != nil {
+			t.Fatalf("Wait() error = %v", err)
+		}
+	}
+	want := []time.Duration{100 * time.Millisecond, 100 * time.Millisecond}
+	if diff := cmp.Diff(want, clock.sleeps); diff != "" {
+		t.Errorf("Sleeps mismatch (-want +got):\n%s", diff)
+	}
+	if got, want := throttledMsecs(ctx), int64(200); got != want {
+		t.Errorf("Throttled msecs = %v, want %v", got, want)
+	}
+
+	// Tokens accumulate up to the burst while idle.
+	clock.t = clock.t.Add(time.Minute)
+	clock.sleeps = nil
+	for i := 0; i < 3; i++ {
+		if err := b.Wait(ctx); err != nil {
+			t.Fatalf("Wait() error = %v", err)
+		}
+	}
+	want = []time.Duration{100 * time.Millisecond}
+	if diff := cmp.Diff(want, clock.sleeps); diff != "" {
+		t.Errorf("Sleeps after idling mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTokenBucket_Wait_canceled(t *testing.T) {
+	b, clock := newTestTokenBucket(1, 1)
+	ctx, cancel := context.WithCancel(newMetricsContext())
+
+	if err := b.Wait(ctx); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	cancel()
+	if err := b.Wait(ctx); err == nil {
+		t.Fatal("Wait() with canceled context error = nil, want error")
+	}
+
+	// The token of the canceled wait is returned.
+	clock.t = clock.t.Add(time.Second)
+	if err := b.Wait(newMetricsContext()); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if len(clock.sleeps) != 0 {
+		t.Errorf("Sleeps = %v, want none", clock.sleeps)
+	}
+}
+
+func TestSharedTokenBucket(t *testing.T) {
+	a := SharedTokenBucket("TestSharedTokenBucket/a", "test", 1, 1)
+	if got := SharedTokenBucket("TestSharedTokenBucket/a", "test", 2, 2); got != a {
+		t.Error("SharedTokenBucket() with the same key returned another bucket")
+	}
+	if got := SharedTokenBucket("TestSharedTokenBucket/b", "test", 1, 1); got == a {
+		t.Error("SharedTokenBucket() with another key returned the same bucket")
+	}
+}
@@ -48,6 +48,14 @@ func (p PCollection) IsValid() bool {
 	return p.n != nil
 }
 
+// IsBounded returns true iff the PCollection has a bounded number of elements.
+func (p PCollection) IsBounded() bool {
+	if !p.IsValid() {
+		panic("Invalid PCollection")
+	}
+	return p.n.Bounded()
+}
+
 // TODO(herohde) 5/30/2017: add name for PCollections? Java supports it.
 
 // Type returns the full type 'A' of the elements. 'A' must be a concrete
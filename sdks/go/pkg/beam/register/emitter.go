@@ -28,6 +28,7 @@ type emit struct {
 	est *sdf.WatermarkEstimator
 
 	ctx   context.Context
+	pn    typex.PaneInfo
 	ws    []typex.Window
 	et    typex.EventTime
 	value exec.FullValue
@@ -40,6 +41,10 @@ func (e *emit) Init(ctx context.Context, ws []typex.Window, et typex.EventTime)
 	return nil
 }
 
+func (e *emit) SetPane(pn typex.PaneInfo) {
+	e.pn = pn
+}
+
 func (e *emit) AttachEstimator(est *sdf.WatermarkEstimator) {
 	e.est = est
 }
@@ -54,7 +59,7 @@ func (e *emit1[T]) Value() any {
 }
 
 func (e *emit1[T]) invoke(val T) {
-	e.value = exec.FullValue{Windows: e.ws, Timestamp: e.et, Elm: val}
+	e.value = exec.FullValue{Pane: e.pn, Windows: e.ws, Timestamp: e.et, Elm: val}
 	if e.est != nil {
 		(*e.est).(sdf.TimestampObservingEstimator).ObserveTimestamp(e.et.ToTime())
 	}
@@ -73,7 +78,7 @@ func (e *emit2[T1, T2]) Value() any {
 }
 
 func (e *emit2[T1, T2]) invoke(key T1, val T2) {
-	e.value = exec.FullValue{Windows: e.ws, Timestamp: e.et, Elm: key, Elm2: val}
+	e.value = exec.FullValue{Pane: e.pn, Windows: e.ws, Timestamp: e.et, Elm: key, Elm2: val}
 	if e.est != nil {
 		(*e.est).(sdf.TimestampObservingEstimator).ObserveTimestamp(e.et.ToTime())
 	}
@@ -92,7 +97,7 @@ func (e *emit1WithTimestamp[T]) Value() any {
 }
 
 func (e *emit1WithTimestamp[T]) invoke(et typex.EventTime, val T) {
-	e.value = exec.FullValue{Windows: e.ws, Timestamp: et, Elm: val}
+	e.value = exec.FullValue{Pane: e.pn, Windows: e.ws, Timestamp: et, Elm: val}
 	if e.est != nil {
 		(*e.est).(sdf.TimestampObservingEstimator).ObserveTimestamp(et.ToTime())
 	}
@@ -111,7 +116,7 @@ func (e *emit2WithTimestamp[T1, T2]) Value() any {
 }
 
 func (e *emit2WithTimestamp[T1, T2]) invoke(et typex.EventTime, key T1, val T2) {
-	e.value = exec.FullValue{Windows: e.ws, Timestamp: et, Elm: key, Elm2: val}
+	e.value = exec.FullValue{Pane: e.pn, Windows: e.ws, Timestamp: et, Elm: key, Elm2: val}
 	if e.est != nil {
 		(*e.est).(sdf.TimestampObservingEstimator).ObserveTimestamp(et.ToTime())
 	}
@@ -103,6 +103,7 @@ func TestEmitter3(t *testing.T) {
 
 func TestEmit1(t *testing.T) {
 	e := &emit1[int]{n: &elementProcessor{}}
+	e.SetPane(typex.NoFiringPane())
 	e.Init(context.Background(), []typex.Window{}, mtime.ZeroTimestamp)
 	fn := e.Value().(func(int))
 	fn(3)
@@ -115,10 +116,14 @@ func TestEmit1(t *testing.T) {
 	if got, want := e.n.(*elementProcessor).inFV.Timestamp, mtime.ZeroTimestamp; got != want {
 		t.Errorf("e.Value.(func(int))(3).n.inFV.Timestamp=%v, want %v", got, want)
 	}
+	if got, want := e.n.(*elementProcessor).inFV.Pane, typex.NoFiringPane(); got != want {
+		t.Errorf("e.Value.(func(int))(3).n.inFV.Pane=%v, want %v", got, want)
+	}
 }
 
 func TestEmit2(t *testing.T) {
 	e := &emit2[int, string]{n: &elementProcessor{}}
+	e.SetPane(typex.NoFiringPane())
 	e.Init(context.Background(), []typex.Window{}, mtime.ZeroTimestamp)
 	fn := e.Value().(func(int, string))
 	fn(3, "hello")
@@ -131,10 +136,14 @@ func TestEmit2(t *testing.T) {
 	if got, want := e.n.(*elementProcessor).inFV.Timestamp, mtime.ZeroTimestamp; got != want {
 		t.Errorf("e.Value.(func(int))(3).n.inFV.Timestamp=%v, want %v", got, want)
 	}
+	if got, want := e.n.(*elementProcessor).inFV.Pane, typex.NoFiringPane(); got != want {
+		t.Errorf("e.Value.(func(int))(3).n.inFV.Pane=%v, want %v", got, want)
+	}
 }
 
 func TestEmit1WithTimestamp(t *testing.T) {
 	e := &emit1WithTimestamp[int]{n: &elementProcessor{}}
+	e.SetPane(typex.NoFiringPane())
 	e.Init(context.Background(), []typex.Window{}, mtime.ZeroTimestamp)
 	fn := e.Value().(func(typex.EventTime, int))
 	fn(mtime.MaxTimestamp, 3)
@@ -147,10 +156,14 @@ func TestEmit1WithTimestamp(t *testing.T) {
 	if got, want := e.n.(*elementProcessor).inFV.Timestamp, mtime.MaxTimestamp; got != want {
 		t.Errorf("e.Value.(func(int))(3).n.inFV.Timestamp=%v, want %v", got, want)
 	}
+	if got, want := e.n.(*elementProcessor).inFV.Pane, typex.NoFiringPane(); got != want {
+		t.Errorf("e.Value.(func(int))(3).n.inFV.Pane=%v, want %v", got, want)
+	}
 }
 
 func TestEmit2WithTimestamp(t *testing.T) {
 	e := &emit2WithTimestamp[int, string]{n: &elementProcessor{}}
+	e.SetPane(typex.NoFiringPane())
 	e.Init(context.Background(), []typex.Window{}, mtime.ZeroTimestamp)
 	fn := e.Value().(func(typex.EventTime, int, string))
 	fn(mtime.MaxTimestamp, 3, "hello")
@@ -163,4 +176,7 @@ func TestEmit2WithTimestamp(t *testing.T) {
 	if got, want := e.n.(*elementProcessor).inFV.Timestamp, mtime.MaxTimestamp; got != want {
 		t.Errorf("e.Value.(func(int))(3).n.inFV.Timestamp=%v, want %v", got, want)
 	}
+	if got, want := e.n.(*elementProcessor).inFV.Pane, typex.NoFiringPane(); got != want {
+		t.Errorf("e.Value.(func(int))(3).n.inFV.Pane=%v, want %v", got, want)
+	}
 }
@@ -758,7 +758,7 @@ func (em *ElementManager) triageTimers(d TentativeData, inputInfo PColInfo, stag
 	for tentativeKey, timers := range d.timers {
 		keyToTimers := map[timerKey]element{}
 		for _, t := range timers {
-			key, tag, elms := decodeTimer(inputInfo.KeyDec, true, t)
+			key, tag, elms := decodeTimer(inputInfo.KeyDec, inputInfo.WDec, t)
 			for _, e := range elms {
 				keyToTimers[timerKey{key: string(key), tag: tag, win: e.window}] = e
 			}
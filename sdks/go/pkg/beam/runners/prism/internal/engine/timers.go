@@ -24,7 +24,7 @@ import (
 
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/coder"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
-	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/runtime/exec"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
 	"google.golang.org/protobuf/encoding/protowire"
 )
@@ -33,24 +33,18 @@ import (
 // Returns the key bytes, tag, window exploded elements, and the hold timestamp.
 // If the timer has been cleared, no elements will be returned. Any existing timers
 // for the tag *must* be cleared from the pending queue.
-func decodeTimer(keyDec func(io.Reader) []byte, usesGlobalWindow bool, raw []byte) ([]byte, string, []element) {
+func decodeTimer(keyDec func(io.Reader) []byte, winDec exec.WindowDecoder, raw []byte) ([]byte, string, []element) {
 	keyBytes := keyDec(bytes.NewBuffer(raw))
 
 	d := decoder{raw: raw, cursor: len(keyBytes)}
 	tag := string(d.Bytes())
 
-	var ws []typex.Window
-	numWin := d.Fixed32()
-	if usesGlobalWindow {
-		for i := 0; i < int(numWin); i++ {
-			ws = append(ws, window.GlobalWindow{})
-		}
-	} else {
-		// Assume interval windows here, since we don't understand custom windows yet.
-		for i := 0; i < int(numWin); i++ {
-			ws = append(ws, d.IntervalWindow())
-		}
+	r := bytes.NewReader(raw[d.cursor:])
+	ws, err := winDec.Decode(r)
+	if err != nil {
+		panic(fmt.Sprintf("unable to decode timer windows: %v", err))
 	}
+	d.cursor = len(raw) - r.Len()
 
 	clear := d.Bool()
 	hold := mtime.MaxTimestamp
@@ -105,23 +99,6 @@ func (d *decoder) Timestamp() mtime.Time {
 	return mtime.Time((int64)(msec) + math.MinInt64)
 }
 
-// Fixed32 decodes a fixed length encoding of uint32, for window decoding.
-func (d *decoder) Fixed32() uint32 {
-	defer func() {
-		d.cursor += 4
-	}()
-	return binary.BigEndian.Uint32(d.raw[d.cursor : d.cursor+4])
-}
-
-func (d *decoder) IntervalWindow() window.IntervalWindow {
-	end := d.Timestamp()
-	dur := d.Varint()
-	return window.IntervalWindow{
-		End:   end,
-		Start: mtime.FromMilliseconds(end.Milliseconds() - dur),
-	}
-}
-
 func (d *decoder) Byte() byte {
 	defer func() {
 		d.cursor += 1
@@ -0,0 +1,89 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/coder"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/runtime/exec"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/timers"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDecodeTimer(t *testing.T) {
+	// keyDec decodes a length prefixed key shorter than 128 bytes.
+	keyDec := func(r io.Reader) []byte {
+		b := make([]byte, 1)
+		r.Read(b)
+		key := make([]byte, b[0])
+		r.Read(key)
+		return append(b, key...)
+	}
+
+	tests := []struct {
+		name string
+		wc   *coder.WindowCoder
+		w    typex.Window
+	}{
+		{"global", coder.NewGlobalWindow(), window.GlobalWindow{}},
+		{"interval", coder.NewIntervalWindow(), window.IntervalWindow{Start: 0, End: 60000}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			enc := exec.MakeElementEncoder(coder.NewT(coder.NewBytes(), test.wc))
+			var buf bytes.Buffer
+			tm := exec.TimerRecv{
+				Key:     &exec.FullValue{Elm: []byte("key")},
+				Windows: []typex.Window{test.w},
+				Pane:    typex.NoFiringPane(),
+				TimerMap: timers.TimerMap{
+					Family:        "family",
+					Tag:           "tag",
+					FireTimestamp: 59999,
+					HoldTimestamp: 10,
+				},
+			}
+			if err := enc.Encode(&exec.FullValue{Elm: tm}, &buf); err != nil {
+				t.Fatalf("Encode() failed: %v", err)
+			}
+
+			key, tag, elms := decodeTimer(keyDec, exec.MakeWindowDecoder(test.wc), buf.Bytes())
+			if got, want := string(key), "\x03key"; got != want {
+				t.Errorf("key = %q, want %q", got, want)
+			}
+			if got, want := tag, "tag"; got != want {
+				t.Errorf("tag = %q, want %q", got, want)
+			}
+			want := []element{{
+				tag:           "tag",
+				keyBytes:      key,
+				window:        test.w,
+				timestamp:     mtime.Time(59999),
+				holdTimestamp: mtime.Time(10),
+				pane:          typex.NoFiringPane(),
+			}}
+			if diff := cmp.Diff(want, elms, cmp.AllowUnexported(element{})); diff != "" {
+				t.Errorf("decodeTimer() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/runners/prism/internal/jobservices"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/runners/prism/internal/urns"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/runners/prism/internal/worker"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/util/grpcx"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slog"
 	"golang.org/x/sync/errgroup"
@@ -273,8 +274,15 @@ func executePipeline(ctx context.Context, wks map[string]*worker.W, j *jobservic
 					}
 				}
 
+				events := pyld.GetEvents()
+				if url := pyld.GetEndpoint().GetUrl(); url != "" {
+					if events, err = fetchTestStreamEvents(ctx, url, maps.Keys(t.GetOutputs())); err != nil {
+						return fmt.Errorf("prism error building stage %v - reading TestStreamService events: \n%w", stage.ID, err)
+					}
+				}
+
 				tsb := em.AddTestStream(stage.ID, t.Outputs)
-				for _, e := range pyld.GetEvents() {
+				for _, e := range events {
 					switch ev := e.GetEvent().(type) {
 					case *pipepb.TestStreamPayload_Event_ElementEvent:
 						var elms []engine.TestStreamElement
@@ -354,6 +362,31 @@ func executePipeline(ctx context.Context, wks map[string]*worker.W, j *jobservic
 	}
 }
 
+// fetchTestStreamEvents reads all the events of the given output tags from a TestStreamService.
+func fetchTestStreamEvents(ctx context.Context, url string, tags []string) ([]*pipepb.TestStreamPayload_Event, error) {
+	conn, err := grpcx.DefaultDial(ctx, url, 30*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	stream, err := pipepb.NewTestStreamServiceClient(conn).Events(ctx, &pipepb.EventsRequest{OutputIds: tags})
+	if err != nil {
+		return nil, err
+	}
+	var events []*pipepb.TestStreamPayload_Event
+	for {
+		e, err := stream.Recv()
+		if err == io.EOF {
+			return events, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+}
+
 func collectionPullDecoder(coldCId string, coders map[string]*pipepb.Coder, comps *pipepb.Components) func(io.Reader) []byte {
 	cID, err := lpUnknownCoders(coldCId, coders, comps.GetCoders())
 	if err != nil {
@@ -504,6 +504,22 @@ func TestRunner_Metrics(t *testing.T) {
 	})
 }
 
+func TestRunner_Finalization(t *testing.T) {
+	initRunner(t)
+
+	finalizedBundles.Store(0)
+	p, s := beam.NewPipelineWithRoot()
+	imp := beam.Impulse(s)
+	col := beam.ParDo(s, dofnFinalize, imp)
+	passert.Count(s, col, "finalized", 1)
+	if _, err := executeWithT(context.Background(), t, p); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := finalizedBundles.Load(), int64(1); got != want {
+		t.Errorf("finalized bundles = %v, want %v", got, want)
+	}
+}
+
 func TestFailure(t *testing.T) {
 	initRunner(t)
 
@@ -384,6 +384,9 @@ func gbkBytes(ws *pipepb.WindowingStrategy, wc, kc, vc *pipepb.Coder, toAggregat
 	}
 	// Everything's aggregated!
 	// Time to turn things into a windowed KV<K, Iterable<V>>
+	// Each window is fired once, when the watermark passes its end, so its
+	// aggregate is the only pane, and on time.
+	onTime := typex.PaneInfo{Timing: typex.PaneOnTime, IsFirst: true, IsLast: true}
 
 	var buf bytes.Buffer
 	for _, w := range windows {
@@ -392,7 +395,7 @@ func gbkBytes(ws *pipepb.WindowingStrategy, wc, kc, vc *pipepb.Coder, toAggregat
 				wEnc,
 				[]typex.Window{kt.w},
 				kt.time,
-				typex.NoFiringPane(),
+				onTime,
 				&buf,
 			)
 			buf.Write(kt.key)
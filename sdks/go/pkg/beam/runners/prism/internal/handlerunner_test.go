@@ -0,0 +1,92 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/coder"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/runtime/exec"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+	pipepb "github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/model/pipeline_v1"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/runners/prism/internal/urns"
+)
+
+func Test_gbkBytes(t *testing.T) {
+	wc := &pipepb.Coder{Spec: &pipepb.FunctionSpec{Urn: urns.CoderIntervalWindow}}
+	kc := &pipepb.Coder{Spec: &pipepb.FunctionSpec{Urn: urns.CoderBytes}}
+	vc := &pipepb.Coder{Spec: &pipepb.FunctionSpec{Urn: urns.CoderVarInt}}
+	ws := &pipepb.WindowingStrategy{OutputTime: pipepb.OutputTime_END_OF_WINDOW}
+	wDec, wEnc := makeWindowCoders(wc)
+
+	first := window.IntervalWindow{Start: 0, End: 60000}
+	second := window.IntervalWindow{Start: 60000, End: 120000}
+	var in bytes.Buffer
+	for _, e := range []struct {
+		w  typex.Window
+		ts mtime.Time
+		v  int64
+	}{
+		{first, 10, 1},
+		{first, 20, 2},
+		{second, 70000, 3},
+	} {
+		exec.EncodeWindowedValueHeader(wEnc, []typex.Window{e.w}, e.ts, typex.NoFiringPane(), &in)
+		coder.EncodeBytes([]byte("key"), &in)
+		coder.EncodeVarInt(e.v, &in)
+	}
+
+	out := bytes.NewBuffer(gbkBytes(ws, wc, kc, vc, [][]byte{in.Bytes()}, map[string]*pipepb.Coder{}, mtime.MaxTimestamp))
+
+	counts := map[typex.Window]int32{}
+	for out.Len() > 0 {
+		gotWs, ts, pane, err := exec.DecodeWindowedValueHeader(wDec, out)
+		if err != nil {
+			t.Fatalf("DecodeWindowedValueHeader() failed: %v", err)
+		}
+		if len(gotWs) != 1 {
+			t.Fatalf("aggregate in windows %v, want a single window", gotWs)
+		}
+		w := gotWs[0]
+		if want := w.MaxTimestamp(); ts != want {
+			t.Errorf("aggregate of %v at %v, want the end of its window %v", w, ts, want)
+		}
+		// Each window fires once, when the watermark passes its end.
+		if want := (typex.PaneInfo{Timing: typex.PaneOnTime, IsFirst: true, IsLast: true}); pane != want {
+			t.Errorf("aggregate of %v in pane %+v, want %+v", w, pane, want)
+		}
+		if key, err := coder.DecodeBytes(out); err != nil || string(key) != "key" {
+			t.Fatalf("aggregate key = %q, %v, want \"key\"", key, err)
+		}
+		n, err := coder.DecodeInt32(out)
+		if err != nil {
+			t.Fatalf("DecodeInt32() failed: %v", err)
+		}
+		for i := int32(0); i < n; i++ {
+			if _, err := coder.DecodeVarInt(out); err != nil {
+				t.Fatalf("DecodeVarInt() failed: %v", err)
+			}
+		}
+		counts[w] = n
+	}
+	want := map[typex.Window]int32{first: 2, second: 1}
+	if len(counts) != len(want) || counts[first] != want[first] || counts[second] != want[second] {
+		t.Errorf("aggregated values per window = %v, want %v", counts, want)
+	}
+}
@@ -184,6 +184,12 @@ func (s *Server) Prepare(ctx context.Context, req *jobpb.PrepareJobRequest) (*jo
 
 			t.EnvironmentId = "" // Unset the environment, to ensure it's handled prism side.
 			testStreamIds = append(testStreamIds, tid)
+		case urns.TransformPubSubRead, urns.TransformPubSubWrite:
+			// Standard composites that Prism has no native implementation for,
+			// so it executes their subtransforms.
+			if len(t.GetSubtransforms()) == 0 {
+				check("PTransform.Subtransforms", urn+" "+t.GetUniqueName(), "<composite expansion>")
+			}
 		default:
 			check("PTransform.Spec.Urn", urn+" "+t.GetUniqueName(), "<doesn't exist>")
 		}
@@ -269,6 +269,48 @@ func TestServer(t *testing.T) {
 	}
 }
 
+func TestServer_PrepareComposites(t *testing.T) {
+	pipeline := func(urn string, subtransforms ...string) *pipepb.Pipeline {
+		return &pipepb.Pipeline{
+			Components: &pipepb.Components{
+				Transforms: map[string]*pipepb.PTransform{
+					"composite": {
+						UniqueName:    "composite",
+						Spec:          &pipepb.FunctionSpec{Urn: urn},
+						Subtransforms: subtransforms,
+					},
+					"impulse": {
+						UniqueName: "composite/impulse",
+						Spec:       &pipepb.FunctionSpec{Urn: urns.TransformImpulse},
+					},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name     string
+		pipeline *pipepb.Pipeline
+		wantErr  bool
+	}{
+		{name: "pubsub read", pipeline: pipeline(urns.TransformPubSubRead, "impulse")},
+		{name: "pubsub write", pipeline: pipeline(urns.TransformPubSubWrite, "impulse")},
+		{name: "pubsub read without expansion", pipeline: pipeline(urns.TransformPubSubRead), wantErr: true},
+		{name: "unknown composite", pipeline: pipeline("beam:transform:unknown:v1", "impulse"), wantErr: true},
+	}
+	undertest := NewServer(0, func(j *Job) {})
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := undertest.Prepare(context.Background(), &jobpb.PrepareJobRequest{
+				Pipeline: test.pipeline,
+				JobName:  test.name,
+			})
+			if (err != nil) != test.wantErr {
+				t.Errorf("Prepare(%v) = %v, want error %v", test.name, err, test.wantErr)
+			}
+		})
+	}
+}
+
 func TestGetMessageStream(t *testing.T) {
 	wantName := "testJob"
 	wantPipeline := &pipepb.Pipeline{
@@ -270,6 +270,15 @@ progress:
 	}
 	em.PersistBundle(rb, s.OutputsToCoders, b.OutputData, s.inputInfo, residuals)
 	b.OutputData = engine.TentativeData{} // Clear the data.
+
+	// The bundle's outputs are now durable, so the SDK may perform any side effects
+	// it deferred until the bundle was committed, such as acknowledging messages.
+	if resp.GetRequiresFinalization() {
+		if err := b.Finalize(ctx, wk); err != nil {
+			slog.Error("Execute: finalizing bundle", "bundle", rb, "error", err)
+			return err
+		}
+	}
 	return nil
 }
 
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"sync/atomic"
 	"time"
 
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
@@ -59,6 +60,7 @@ func init() {
 	register.Function3x0(dofn1Counter)
 	register.Function2x0(dofnSink)
 	register.Function3x1(doFnFail)
+	register.Function3x0(dofnFinalize)
 
 	register.Function2x1(combineIntSum)
 
@@ -283,6 +285,16 @@ func doFnFail(ctx context.Context, _ []byte, emit func(int64)) error {
 	return fmt.Errorf("doFnFail: failing as intended")
 }
 
+var finalizedBundles atomic.Int64
+
+func dofnFinalize(bf beam.BundleFinalization, _ []byte, emit func(int64)) {
+	bf.RegisterCallback(time.Minute, func() error {
+		finalizedBundles.Add(1)
+		return nil
+	})
+	emit(1)
+}
+
 func combineIntSum(a, b int64) int64 {
 	return a + b
 }
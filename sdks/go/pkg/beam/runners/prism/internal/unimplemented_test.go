@@ -167,6 +167,8 @@ func TestTestStream(t *testing.T) {
 		{pipeline: primitives.TestStreamTwoBoolSequences},
 		{pipeline: primitives.TestStreamTwoFloat64Sequences},
 		{pipeline: primitives.TestStreamTwoInt64Sequences},
+		{pipeline: primitives.TestStreamTaggedSequences},
+		{pipeline: primitives.TestStreamTaggedWithEndpoint},
 	}
 
 	for _, test := range tests {
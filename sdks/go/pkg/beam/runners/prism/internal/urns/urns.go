@@ -68,6 +68,8 @@ var (
 	TransformSplitAndSize         = sdfUrn(pipepb.StandardPTransforms_SPLIT_AND_SIZE_RESTRICTIONS)
 	TransformProcessSizedElements = sdfUrn(pipepb.StandardPTransforms_PROCESS_SIZED_ELEMENTS_AND_RESTRICTIONS)
 	TransformTruncate             = sdfUrn(pipepb.StandardPTransforms_TRUNCATE_SIZED_RESTRICTION)
+	TransformPubSubRead           = ctUrn(pipepb.StandardPTransforms_PUBSUB_READ)
+	TransformPubSubWrite          = ctUrn(pipepb.StandardPTransforms_PUBSUB_WRITE)
 
 	// Window Manipulation
 	TransformAssignWindows = ptUrn(pipepb.StandardPTransforms_ASSIGN_WINDOWS)
@@ -220,6 +220,22 @@ func (b *B) Progress(ctx context.Context, wk *W) (*fnpb.ProcessBundleProgressRes
 	return resp.GetProcessBundleProgress(), nil
 }
 
+// Finalize sends a finalization request for the given bundle to the passed in worker, blocking on the response.
+// It must only be sent once the outputs of the bundle have been committed.
+func (b *B) Finalize(ctx context.Context, wk *W) error {
+	resp := wk.sendInstruction(ctx, &fnpb.InstructionRequest{
+		Request: &fnpb.InstructionRequest_FinalizeBundle{
+			FinalizeBundle: &fnpb.FinalizeBundleRequest{
+				InstructionId: b.InstID,
+			},
+		},
+	})
+	if resp.GetError() != "" {
+		return fmt.Errorf("finalize[%v] error from SDK: %v", b.InstID, resp.GetError())
+	}
+	return nil
+}
+
 // Split sends a split request for the given bundle to the passed in worker, blocking on the response.
 func (b *B) Split(ctx context.Context, wk *W, fraction float64, allowedSplits []int64) (*fnpb.ProcessBundleSplitResponse, error) {
 	resp := wk.sendInstruction(ctx, &fnpb.InstructionRequest{
@@ -165,6 +165,44 @@ func TestWorker_Control_HappyPath(t *testing.T) {
 	}
 }
 
+func TestWorker_Control_Finalize(t *testing.T) {
+	ctx, wk, clientConn := serveTestWorker(t)
+
+	ctrlCli := fnpb.NewBeamFnControlClient(clientConn)
+	ctrlStream, err := ctrlCli.Control(ctx)
+	if err != nil {
+		t.Fatal("couldn't create control client:", err)
+	}
+
+	b := &B{InstID: "finalizeBundle"}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- b.Finalize(ctx, wk)
+	}()
+
+	req, err := ctrlStream.Recv()
+	if err != nil {
+		t.Fatal("couldn't receive finalize request:", err)
+	}
+	if got, want := req.GetFinalizeBundle().GetInstructionId(), b.InstID; got != want {
+		t.Fatalf("finalize request for bundle %q, want %q", got, want)
+	}
+
+	ctrlStream.Send(&fnpb.InstructionResponse{
+		InstructionId: req.GetInstructionId(),
+		Response: &fnpb.InstructionResponse_FinalizeBundle{
+			FinalizeBundle: &fnpb.FinalizeBundleResponse{},
+		},
+	})
+
+	if err := <-errCh; err != nil {
+		t.Errorf("Finalize() = %v, want nil", err)
+	}
+	if err := ctrlStream.CloseSend(); err != nil {
+		t.Errorf("ctrlStream.CloseSend() = %v", err)
+	}
+}
+
 func TestWorker_Data_HappyPath(t *testing.T) {
 	ctx, wk, clientConn := serveTestWorker(t)
 
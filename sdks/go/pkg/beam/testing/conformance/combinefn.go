@@ -0,0 +1,364 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/funcx"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/runtime/exec"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+)
+
+// CombineFn checks the given CombineFn with TryCombineFn, failing the test if
+// a property is violated.
+func CombineFn(t testing.TB, fn any, opts ...Option) {
+	t.Helper()
+	if err := TryCombineFn(fn, opts...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TryCombineFn checks that the given CombineFn produces the same output for
+// generated inputs regardless of input order, bundling, merge tree, merging of
+// empty accumulators and accumulator encoding through the inferred coder.
+// Structural CombineFns are serialized and deserialized before each bundle,
+// as a runner would. Returns a *Failure for the first violated property.
+func TryCombineFn(fn any, opts ...Option) error {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+	c, err := newCombiner(fn)
+	if err != nil {
+		return err
+	}
+	gen, err := generator(o, c.inputT)
+	if err != nil {
+		return errors.WithContextf(err, "checking CombineFn %v", c.fn.Name())
+	}
+	equal, err := c.equalFn(o)
+	if err != nil {
+		return errors.WithContextf(err, "checking CombineFn %v", c.fn.Name())
+	}
+
+	// check evaluates the CombineFn with the given strategy and compares the
+	// result to a single accumulator reference evaluation.
+	check := func(eval func([]any, *rand.Rand) (any, error)) func([]any, int64) error {
+		return func(inputs []any, seed int64) error {
+			want, err := c.combine(inputs, false)
+			if err != nil {
+				return errors.WithContext(err, "reference evaluation")
+			}
+			got, err := eval(inputs, rand.New(rand.NewSource(seed)))
+			if err != nil {
+				return err
+			}
+			if !equal(got, want) {
+				return errors.Errorf("got output %v, want %v", got, want)
+			}
+			return nil
+		}
+	}
+	props := []property{
+		{"input order", check(func(inputs []any, rng *rand.Rand) (any, error) {
+			return c.combine(shuffled(rng, inputs), false)
+		})},
+		{"bundling and merge tree", check(func(inputs []any, rng *rand.Rand) (any, error) {
+			return c.bundled(rng, inputs, false, false)
+		})},
+		{"empty accumulators", check(func(inputs []any, rng *rand.Rand) (any, error) {
+			return c.bundled(rng, inputs, true, false)
+		})},
+		{"accumulator encoding", check(func(inputs []any, rng *rand.Rand) (any, error) {
+			return c.bundled(rng, inputs, false, true)
+		})},
+	}
+	return run(c.fn.Name(), o, gen, props)
+}
+
+// combiner evaluates a CombineFn outside of a pipeline.
+type combiner struct {
+	fn                   *graph.CombineFn
+	inputT, accumT, outT reflect.Type
+	accumEnc             beam.ElementEncoder
+	accumDec             beam.ElementDecoder
+	ctx                  context.Context
+}
+
+func newCombiner(fn any) (*combiner, error) {
+	cfn, err := graph.NewCombineFn(fn)
+	if err != nil {
+		return nil, err
+	}
+	c := &combiner{fn: cfn, ctx: context.Background()}
+	c.accumT = cfn.MergeAccumulatorsFn().Ret[0].T
+	c.inputT, c.outT = c.accumT, c.accumT
+	if ai := cfn.AddInputFn(); ai != nil {
+		in := ai.Params(funcx.FnValue)
+		c.inputT = ai.Param[in[len(in)-1]].T
+	}
+	if eo := cfn.ExtractOutputFn(); eo != nil {
+		c.outT = eo.Ret[0].T
+	}
+	return c, nil
+}
+
+// equalFn returns the output comparison, defaulting to coder equality.
+func (c *combiner) equalFn(o *options) (func(a, b any) bool, error) {
+	if o.equal.IsValid() {
+		et := o.equal.Type()
+		if et.Kind() != reflect.Func || et.NumIn() != 2 || et.NumOut() != 1 || et.Out(0).Kind() != reflect.Bool ||
+			!c.outT.AssignableTo(et.In(0)) || !c.outT.AssignableTo(et.In(1)) {
+			return nil, errors.Errorf("equal %v must be a func(a, b %v) bool", et, c.outT)
+		}
+		return func(a, b any) bool {
+			return o.equal.Call([]reflect.Value{value(a, c.outT), value(b, c.outT)})[0].Bool()
+		}, nil
+	}
+	enc, err := encoder(c.outT)
+	if err != nil {
+		return nil, errors.WithContext(err, "comparing outputs; use WithEqual")
+	}
+	return func(a, b any) bool {
+		var ab, bb bytes.Buffer
+		if err := enc.Encode(a, &ab); err != nil {
+			return false
+		}
+		if err := enc.Encode(b, &bb); err != nil {
+			return false
+		}
+		return bytes.Equal(ab.Bytes(), bb.Bytes())
+	}, nil
+}
+
+// instance returns a freshly deserialized and set up copy of the CombineFn.
+func (c *combiner) instance() (*graph.CombineFn, error) {
+	if c.fn.Recv == nil {
+		return c.fn, nil
+	}
+	recv, err := clone(c.fn.Recv)
+	if err != nil {
+		return nil, err
+	}
+	fn, err := graph.NewCombineFn(recv)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := exec.InvokeWithoutEventTime(c.ctx, fn.SetupFn(), nil, nil, nil, nil, nil); err != nil {
+		return nil, errors.WithContext(err, "invoking Setup")
+	}
+	return fn, nil
+}
+
+func (c *combiner) teardown(fn *graph.CombineFn) error {
+	if _, err := exec.InvokeWithoutEventTime(c.ctx, fn.TeardownFn(), nil, nil, nil, nil, nil); err != nil {
+		return errors.WithContext(err, "invoking Teardown")
+	}
+	return nil
+}
+
+// combine adds all inputs to a single accumulator and extracts the output.
+func (c *combiner) combine(inputs []any, encode bool) (any, error) {
+	fn, err := c.instance()
+	if err != nil {
+		return nil, err
+	}
+	a, err := c.accumulate(fn, inputs)
+	if err != nil {
+		return nil, err
+	}
+	if encode {
+		if a, err = c.roundTrip(fn, a); err != nil {
+			return nil, err
+		}
+	}
+	out, err := c.extract(fn, a)
+	if err != nil {
+		return nil, err
+	}
+	return out, c.teardown(fn)
+}
+
+// bundled splits the inputs into bundles, accumulates each bundle on its own
+// instance and merges the partial accumulators in a random merge tree. Empty
+// accumulators may be added to the merge, and accumulators may be encoded
+// and decoded before merging.
+func (c *combiner) bundled(rng *rand.Rand, inputs []any, empties, encode bool) (any, error) {
+	var accums []any
+	for _, bundle := range partition(rng, inputs) {
+		fn, err := c.instance()
+		if err != nil {
+			return nil, err
+		}
+		a, err := c.accumulate(fn, bundle)
+		if err != nil {
+			return nil, err
+		}
+		if encode {
+			if a, err = c.roundTrip(fn, a); err != nil {
+				return nil, err
+			}
+		}
+		accums = append(accums, a)
+		if empties && rng.Intn(2) == 0 {
+			e, err := c.create(fn)
+			if err != nil {
+				return nil, err
+			}
+			accums = append(accums, e)
+		}
+		if err := c.teardown(fn); err != nil {
+			return nil, err
+		}
+	}
+
+	fn, err := c.instance()
+	if err != nil {
+		return nil, err
+	}
+	for len(accums) > 1 {
+		i := rng.Intn(len(accums))
+		a := accums[i]
+		accums = append(accums[:i], accums[i+1:]...)
+		j := rng.Intn(len(accums))
+		m, err := c.merge(fn, a, accums[j])
+		if err != nil {
+			return nil, err
+		}
+		if encode {
+			if m, err = c.roundTrip(fn, m); err != nil {
+				return nil, err
+			}
+		}
+		accums[j] = m
+	}
+	out, err := c.extract(fn, accums[0])
+	if err != nil {
+		return nil, err
+	}
+	return out, c.teardown(fn)
+}
+
+func (c *combiner) accumulate(fn *graph.CombineFn, inputs []any) (any, error) {
+	a, err := c.create(fn)
+	if err != nil {
+		return nil, err
+	}
+	for i, in := range inputs {
+		if fn.AddInputFn() == nil {
+			// Merge only CombineFns take accumulators as input.
+			if i == 0 {
+				a = in
+				continue
+			}
+			if a, err = c.merge(fn, a, in); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		opt := &exec.MainInput{Key: exec.FullValue{Elm: a}}
+		val, err := exec.InvokeWithoutEventTime(c.ctx, fn.AddInputFn(), opt, nil, nil, nil, nil, in)
+		if err != nil {
+			return nil, errors.WithContext(err, "invoking AddInput")
+		}
+		a = val.Elm
+	}
+	return a, nil
+}
+
+func (c *combiner) create(fn *graph.CombineFn) (any, error) {
+	if fn.CreateAccumulatorFn() == nil {
+		return reflect.Zero(c.accumT).Interface(), nil
+	}
+	val, err := exec.InvokeWithoutEventTime(c.ctx, fn.CreateAccumulatorFn(), nil, nil, nil, nil, nil)
+	if err != nil {
+		return nil, errors.WithContext(err, "invoking CreateAccumulator")
+	}
+	return val.Elm, nil
+}
+
+func (c *combiner) merge(fn *graph.CombineFn, a, b any) (any, error) {
+	opt := &exec.MainInput{Key: exec.FullValue{Elm: a}}
+	val, err := exec.InvokeWithoutEventTime(c.ctx, fn.MergeAccumulatorsFn(), opt, nil, nil, nil, nil, b)
+	if err != nil {
+		return nil, errors.WithContext(err, "invoking MergeAccumulators")
+	}
+	return val.Elm, nil
+}
+
+func (c *combiner) extract(fn *graph.CombineFn, a any) (any, error) {
+	if fn.ExtractOutputFn() == nil {
+		return a, nil
+	}
+	val, err := exec.InvokeWithoutEventTime(c.ctx, fn.ExtractOutputFn(), nil, nil, nil, nil, nil, a)
+	if err != nil {
+		return nil, errors.WithContext(err, "invoking ExtractOutput")
+	}
+	return val.Elm, nil
+}
+
+// roundTrip compacts the accumulator, if the CombineFn supports it, and
+// encodes and decodes it with the coder inferred for the accumulator type.
+func (c *combiner) roundTrip(fn *graph.CombineFn, a any) (any, error) {
+	if fn.CompactFn() != nil {
+		val, err := exec.InvokeWithoutEventTime(c.ctx, fn.CompactFn(), nil, nil, nil, nil, nil, a)
+		if err != nil {
+			return nil, errors.WithContext(err, "invoking Compact")
+		}
+		a = val.Elm
+	}
+	if c.accumEnc == nil {
+		enc, err := encoder(c.accumT)
+		if err != nil {
+			return nil, errors.WithContext(err, "encoding accumulator")
+		}
+		c.accumEnc, c.accumDec = enc, beam.NewElementDecoder(c.accumT)
+	}
+	var buf bytes.Buffer
+	if err := c.accumEnc.Encode(a, &buf); err != nil {
+		return nil, errors.Wrapf(err, "encoding accumulator %v", a)
+	}
+	ret, err := c.accumDec.Decode(&buf)
+	if err != nil {
+		return nil, errors.Wrapf(err, "decoding accumulator %v", a)
+	}
+	return ret, nil
+}
+
+// encoder returns the element encoder for the inferred coder of t.
+func encoder(t reflect.Type) (enc beam.ElementEncoder, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("no coder for type %v: %v", t, r)
+		}
+	}()
+	return beam.NewElementEncoder(t), nil
+}
+
+// value converts a possibly nil value to a reflect.Value of type t.
+func value(v any, t reflect.Type) reflect.Value {
+	if v == nil {
+		return reflect.Zero(t)
+	}
+	return reflect.ValueOf(v)
+}
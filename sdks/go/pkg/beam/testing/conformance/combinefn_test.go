@@ -0,0 +1,209 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func sumFn(a, b int) int {
+	return a + b
+}
+
+type meanAccum struct {
+	Sum, Count int
+}
+
+type meanFn struct{}
+
+func (fn *meanFn) CreateAccumulator() meanAccum {
+	return meanAccum{}
+}
+
+func (fn *meanFn) AddInput(a meanAccum, x int16) meanAccum {
+	return meanAccum{Sum: a.Sum + int(x), Count: a.Count + 1}
+}
+
+func (fn *meanFn) MergeAccumulators(a, b meanAccum) meanAccum {
+	return meanAccum{Sum: a.Sum + b.Sum, Count: a.Count + b.Count}
+}
+
+func (fn *meanFn) ExtractOutput(a meanAccum) float64 {
+	if a.Count == 0 {
+		return math.NaN()
+	}
+	return float64(a.Sum) / float64(a.Count)
+}
+
+// lossyMergeFn drops the second accumulator when merging.
+type lossyMergeFn struct{}
+
+func (fn *lossyMergeFn) AddInput(a, x int) int {
+	return a + x
+}
+
+func (fn *lossyMergeFn) MergeAccumulators(a, _ int) int {
+	return a
+}
+
+// firstFn keeps the first input it sees, which depends on the input order.
+type firstFn struct{}
+
+type firstAccum struct {
+	Set   bool
+	Value int
+}
+
+func (fn *firstFn) AddInput(a firstAccum, x int) firstAccum {
+	if a.Set {
+		return a
+	}
+	return firstAccum{Set: true, Value: x}
+}
+
+func (fn *firstFn) MergeAccumulators(a, b firstAccum) firstAccum {
+	if a.Set {
+		return a
+	}
+	return b
+}
+
+func (fn *firstFn) ExtractOutput(a firstAccum) int {
+	return a.Value
+}
+
+// hiddenCountFn keeps part of its accumulator in an unexported field, which
+// doesn't survive encoding.
+type hiddenCountFn struct{}
+
+type hiddenAccum struct {
+	Sum   int
+	count int
+}
+
+func (fn *hiddenCountFn) AddInput(a hiddenAccum, x int8) hiddenAccum {
+	return hiddenAccum{Sum: a.Sum + int(x), count: a.count + 1}
+}
+
+func (fn *hiddenCountFn) MergeAccumulators(a, b hiddenAccum) hiddenAccum {
+	return hiddenAccum{Sum: a.Sum + b.Sum, count: a.count + b.count}
+}
+
+func (fn *hiddenCountFn) ExtractOutput(a hiddenAccum) []int {
+	return []int{a.Sum, a.count}
+}
+
+func TestCombineFn(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   any
+		opts []Option
+	}{
+		{"binary merge", sumFn, nil},
+		{"mean", &meanFn{}, []Option{WithEqual(func(a, b float64) bool {
+			return a == b || math.IsNaN(a) && math.IsNaN(b)
+		})}},
+		{"float sum with tolerance", func(a, b float64) float64 { return a + b }, []Option{
+			WithGenerator(func(rng *rand.Rand) float64 { return rng.Float64() }),
+			WithEqual(func(a, b float64) bool { return math.Abs(a-b) < 1e-9 }),
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			CombineFn(t, test.fn, test.opts...)
+		})
+	}
+}
+
+func TestTryCombineFn_Violations(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       any
+		property string
+	}{
+		{"lossy merge", &lossyMergeFn{}, "bundling and merge tree"},
+		{"order dependent", &firstFn{}, "input order"},
+		{"unexported accumulator field", &hiddenCountFn{}, "accumulator encoding"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := TryCombineFn(test.fn)
+			var f *Failure
+			if !errors.As(err, &f) {
+				t.Fatalf("TryCombineFn(%T) = %v, want a *Failure", test.fn, err)
+			}
+			if f.Property != test.property {
+				t.Errorf("TryCombineFn(%T) violated %q, want %q: %v", test.fn, f.Property, test.property, f)
+			}
+			if len(f.Input) == 0 || len(f.Input) > 3 {
+				t.Errorf("TryCombineFn(%T) minimal input = %v, want a shrunk input", test.fn, f.Input)
+			}
+		})
+	}
+}
+
+func TestTryCombineFn_Deterministic(t *testing.T) {
+	a := TryCombineFn(&lossyMergeFn{}, WithSeed(42))
+	b := TryCombineFn(&lossyMergeFn{}, WithSeed(42))
+	if a == nil || b == nil || a.Error() != b.Error() {
+		t.Errorf("TryCombineFn with the same seed = %v and %v, want the same failure", a, b)
+	}
+}
+
+func TestTryCombineFn_BadOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   any
+		opts []Option
+	}{
+		{"generator type", sumFn, []Option{WithGenerator(func(rng *rand.Rand) string { return "" })}},
+		{"generator signature", sumFn, []Option{WithGenerator(func() int { return 0 })}},
+		{"equal type", sumFn, []Option{WithEqual(func(a, b string) bool { return a == b })}},
+		{"not a CombineFn", struct{}{}, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := TryCombineFn(test.fn, test.opts...)
+			var f *Failure
+			if err == nil || errors.As(err, &f) {
+				t.Errorf("TryCombineFn(%T) = %v, want a configuration error", test.fn, err)
+			}
+		})
+	}
+}
+
+func TestShrink(t *testing.T) {
+	// Fails whenever the input contains both 3 and 7.
+	check := func(inputs []any, _ int64) error {
+		var three, seven bool
+		for _, in := range inputs {
+			three = three || in == 3
+			seven = seven || in == 7
+		}
+		if three && seven {
+			return errors.New("contains 3 and 7")
+		}
+		return nil
+	}
+	inputs := []any{1, 7, 2, 4, 5, 3, 6, 8, 9}
+	got, err := shrink(check, inputs, 0, check(inputs, 0))
+	if err == nil || len(got) != 2 || got[0] != 7 || got[1] != 3 {
+		t.Errorf("shrink(%v) = %v, %v, want [7 3]", inputs, got, err)
+	}
+}
@@ -0,0 +1,297 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package conformance contains a property-based harness that checks user
+// CombineFns and DoFns against the guarantees a runner relies on, without
+// running a pipeline.
+//
+// A runner may split input into bundles of any size, process bundles on
+// separately deserialized instances of a DoFn, combine partial accumulators
+// in any order and merge tree, and encode accumulators between stages. Fns
+// that only work for the bundling of a particular local run tend to break
+// in production. The harness generates random inputs and evaluates the fn
+// under varied bundle sizes, merge trees, accumulator encode/decode round
+// trips through the inferred coder, and split points, comparing each result
+// to a single bundle reference evaluation. When a property is violated, the
+// input is shrunk to a minimal failing input, which is reported in a Failure.
+//
+//	func TestSumFn(t *testing.T) {
+//		conformance.CombineFn(t, &sumFn{})
+//	}
+//
+// Inputs are generated with testing/quick by default; use WithGenerator for
+// types quick can't generate or to constrain the input domain. Runs are
+// deterministic for a given seed.
+package conformance
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing/quick"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/util/jsonx"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+)
+
+const (
+	defaultTrials    = 100
+	defaultMaxInputs = 16
+	// maxShrinkRuns bounds the number of evaluations spent shrinking a failing input.
+	maxShrinkRuns = 1000
+)
+
+// Option configures a conformance check.
+type Option func(*options)
+
+type options struct {
+	seed      int64
+	trials    int
+	maxInputs int
+	generator reflect.Value
+	equal     reflect.Value
+}
+
+func defaultOptions() *options {
+	return &options{trials: defaultTrials, maxInputs: defaultMaxInputs}
+}
+
+// WithSeed sets the seed for input generation, bundling and merge orders.
+// The default seed is 0, so runs are reproducible.
+func WithSeed(seed int64) Option {
+	return func(o *options) {
+		o.seed = seed
+	}
+}
+
+// WithTrials sets the number of generated inputs to check. Defaults to 100.
+func WithTrials(n int) Option {
+	return func(o *options) {
+		o.trials = n
+	}
+}
+
+// WithMaxInputs sets the maximum number of elements in a generated input.
+// Defaults to 16.
+func WithMaxInputs(n int) Option {
+	return func(o *options) {
+		o.maxInputs = n
+	}
+}
+
+// WithGenerator sets the function used to generate input elements. It must
+// have the form func(*rand.Rand) T for an input element of type T, or
+// func(*rand.Rand) (K, V) for DoFns on KV inputs.
+func WithGenerator(fn any) Option {
+	return func(o *options) {
+		o.generator = reflect.ValueOf(fn)
+	}
+}
+
+// WithEqual sets the function used to compare CombineFn outputs, of the form
+// func(a, b O) bool. This allows tolerances for floating point outputs, whose
+// value legitimately depends on the merge order. By default, outputs are
+// compared under coder equality.
+func WithEqual(fn any) Option {
+	return func(o *options) {
+		o.equal = reflect.ValueOf(fn)
+	}
+}
+
+// Failure reports a violated property together with the smallest input found
+// that still violates it.
+type Failure struct {
+	// Fn is the name of the checked fn.
+	Fn string
+	// Property names the violated property.
+	Property string
+	// Seed reproduces the bundling and merge order of the failing evaluation.
+	Seed int64
+	// Input is the minimal failing input.
+	Input []any
+	// Err describes how the property was violated.
+	Err error
+}
+
+func (f *Failure) Error() string {
+	return fmt.Sprintf("%v violates %q (seed %d) on minimal input %v: %v", f.Fn, f.Property, f.Seed, f.Input, f.Err)
+}
+
+func (f *Failure) Unwrap() error {
+	return f.Err
+}
+
+// property is a check run on a given input. It reports a violation as an
+// error, and must be deterministic for a given seed.
+type property struct {
+	name  string
+	check func(inputs []any, seed int64) error
+}
+
+// run evaluates all properties over generated inputs, returning the first
+// failure found.
+func run(name string, o *options, gen func(*rand.Rand) any, props []property) error {
+	for i := 0; i < o.trials; i++ {
+		seed := o.seed + int64(i)
+		rng := rand.New(rand.NewSource(seed))
+		inputs := make([]any, rng.Intn(o.maxInputs+1))
+		for j := range inputs {
+			inputs[j] = gen(rng)
+		}
+		for _, p := range props {
+			if err := safeCheck(p.check, inputs, seed); err != nil {
+				inputs, err = shrink(p.check, inputs, seed, err)
+				return &Failure{Fn: name, Property: p.name, Seed: seed, Input: inputs, Err: err}
+			}
+		}
+	}
+	return nil
+}
+
+// safeCheck runs the check, converting panics in user code into errors.
+func safeCheck(check func([]any, int64) error, inputs []any, seed int64) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("panic: %v", r)
+		}
+	}()
+	return check(inputs, seed)
+}
+
+// shrink removes chunks of decreasing size from a failing input for as long as
+// the check keeps failing, returning the smallest input found and its error.
+func shrink(check func([]any, int64) error, inputs []any, seed int64, err error) ([]any, error) {
+	runs := 0
+	for chunk := len(inputs) / 2; chunk >= 1; chunk /= 2 {
+		for start := 0; start < len(inputs) && runs < maxShrinkRuns; {
+			end := start + chunk
+			if end > len(inputs) {
+				end = len(inputs)
+			}
+			candidate := append(append([]any{}, inputs[:start]...), inputs[end:]...)
+			runs++
+			if cerr := safeCheck(check, candidate, seed); cerr != nil {
+				// Retry at the same position with the reduced input.
+				inputs, err = candidate, cerr
+				continue
+			}
+			start += chunk
+		}
+	}
+	return inputs, err
+}
+
+// generator returns the element generator for the given input types, using
+// the user generator if one was configured.
+func generator(o *options, types ...reflect.Type) (func(*rand.Rand) any, error) {
+	if o.generator.IsValid() {
+		gt := o.generator.Type()
+		if gt.Kind() != reflect.Func || gt.NumIn() != 1 || gt.In(0) != reflect.TypeOf((*rand.Rand)(nil)) || gt.NumOut() != len(types) {
+			return nil, errors.Errorf("generator %v must be a func(*rand.Rand) returning %v", gt, types)
+		}
+		for i, t := range types {
+			if !gt.Out(i).AssignableTo(t) {
+				return nil, errors.Errorf("generator %v returns %v at position %d, want %v", gt, gt.Out(i), i, t)
+			}
+		}
+		return func(rng *rand.Rand) any {
+			return element(o.generator.Call([]reflect.Value{reflect.ValueOf(rng)}))
+		}, nil
+	}
+	for _, t := range types {
+		if t.Kind() == reflect.Interface {
+			return nil, errors.Errorf("can't generate inputs of type %v: use WithGenerator", t)
+		}
+	}
+	return func(rng *rand.Rand) any {
+		vals := make([]reflect.Value, len(types))
+		for i, t := range types {
+			v, ok := quick.Value(t, rng)
+			if !ok {
+				panic(fmt.Sprintf("can't generate inputs of type %v: use WithGenerator", t))
+			}
+			vals[i] = v
+		}
+		return element(vals)
+	}, nil
+}
+
+// kv is a generated input element for a DoFn on KV inputs.
+type kv struct {
+	Key, Value any
+}
+
+func (e kv) String() string {
+	return fmt.Sprintf("(%v, %v)", e.Key, e.Value)
+}
+
+func element(vals []reflect.Value) any {
+	if len(vals) == 2 {
+		return kv{Key: vals[0].Interface(), Value: vals[1].Interface()}
+	}
+	return vals[0].Interface()
+}
+
+// clone returns a copy of a structural fn, serialized and deserialized the way
+// runners ship fns to workers. Unexported fields are not preserved.
+func clone(recv any) (any, error) {
+	data, err := jsonx.Marshal(recv)
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshalling %T", recv)
+	}
+	t := reflect.TypeOf(recv)
+	isPtr := t.Kind() == reflect.Ptr
+	if isPtr {
+		t = t.Elem()
+	}
+	elem := reflect.New(t)
+	if err := jsonx.Unmarshal(elem.Interface(), data); err != nil {
+		return nil, errors.Wrapf(err, "unmarshalling %T", recv)
+	}
+	if isPtr {
+		return elem.Interface(), nil
+	}
+	return elem.Elem().Interface(), nil
+}
+
+// partition splits the inputs into consecutive bundles at random split points.
+// Empty bundles are allowed.
+func partition(rng *rand.Rand, inputs []any) [][]any {
+	var bundles [][]any
+	for len(inputs) > 0 {
+		n := rng.Intn(len(inputs) + 1)
+		bundles = append(bundles, inputs[:n])
+		inputs = inputs[n:]
+	}
+	if len(bundles) == 0 {
+		bundles = append(bundles, nil)
+	}
+	return bundles
+}
+
+func shuffled(rng *rand.Rand, inputs []any) []any {
+	ret := append([]any{}, inputs...)
+	rng.Shuffle(len(ret), func(i, j int) { ret[i], ret[j] = ret[j], ret[i] })
+	return ret
+}
+
+func describe(vals []any) string {
+	parts := make([]string, len(vals))
+	for i, v := range vals {
+		parts[i] = fmt.Sprintf("%v", v)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
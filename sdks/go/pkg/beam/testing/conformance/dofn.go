@@ -0,0 +1,342 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/funcx"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/runtime/exec"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+)
+
+// DoFn checks the given DoFn with TryDoFn, failing the test if a property is
+// violated.
+func DoFn(t testing.TB, fn any, opts ...Option) {
+	t.Helper()
+	if err := TryDoFn(fn, opts...); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TryDoFn checks that the given DoFn emits the same outputs, per output, for
+// generated inputs regardless of element order, the split points between
+// bundles, and whether bundles are processed by one instance or by freshly
+// deserialized instances. All value parameters of ProcessElement are treated
+// as the main input, so DoFns on KV inputs are supported, but side inputs,
+// state, timers, bundle finalization and splittable DoFns are not. Returns a
+// *Failure for the first violated property.
+func TryDoFn(fn any, opts ...Option) error {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+	d, err := newDoer(fn)
+	if err != nil {
+		return err
+	}
+	gen, err := generator(o, d.inputTs...)
+	if err != nil {
+		return errors.WithContextf(err, "checking DoFn %v", d.fn.Name())
+	}
+
+	check := func(bundle func([]any, *rand.Rand) ([][]any, bool)) func([]any, int64) error {
+		return func(inputs []any, seed int64) error {
+			want, err := d.evaluate([][]any{inputs}, false)
+			if err != nil {
+				return errors.WithContext(err, "reference evaluation")
+			}
+			got, err := d.evaluate(bundle(inputs, rand.New(rand.NewSource(seed))))
+			if err != nil {
+				return err
+			}
+			return compareOutputs(got, want)
+		}
+	}
+	props := []property{
+		{"element order", check(func(inputs []any, rng *rand.Rand) ([][]any, bool) {
+			return [][]any{shuffled(rng, inputs)}, false
+		})},
+		{"bundle boundaries", check(func(inputs []any, rng *rand.Rand) ([][]any, bool) {
+			return partition(rng, inputs), false
+		})},
+		{"fresh instances", check(func(inputs []any, rng *rand.Rand) ([][]any, bool) {
+			return partition(rng, inputs), true
+		})},
+	}
+	return run(d.fn.Name(), o, gen, props)
+}
+
+// doer evaluates a DoFn outside of a pipeline.
+type doer struct {
+	fn      *graph.DoFn
+	inputTs []reflect.Type
+	emitTs  []reflect.Type
+	direct  int // number of values returned directly by ProcessElement
+	ctx     context.Context
+}
+
+func newDoer(fn any) (*doer, error) {
+	dfn, err := graph.NewDoFn(fn)
+	if err != nil {
+		return nil, err
+	}
+	pe := dfn.ProcessElementFn()
+	if dfn.IsSplittable() {
+		return nil, errors.Errorf("splittable DoFn %v isn't supported", dfn.Name())
+	}
+	if _, ok := pe.StateProvider(); ok {
+		return nil, errors.Errorf("stateful DoFn %v isn't supported", dfn.Name())
+	}
+	if _, ok := pe.TimerProvider(); ok {
+		return nil, errors.Errorf("DoFn %v with timers isn't supported", dfn.Name())
+	}
+	if _, ok := pe.BundleFinalization(); ok {
+		return nil, errors.Errorf("DoFn %v with bundle finalization isn't supported", dfn.Name())
+	}
+	if len(pe.Params(funcx.FnIter|funcx.FnReIter|funcx.FnMultiMap)) > 0 {
+		return nil, errors.Errorf("DoFn %v with side inputs isn't supported", dfn.Name())
+	}
+	values := pe.Params(funcx.FnValue)
+	if len(values) == 0 || len(values) > 2 {
+		return nil, errors.Errorf("DoFn %v must have one or two main input parameters, found %d", dfn.Name(), len(values))
+	}
+
+	d := &doer{fn: dfn, direct: len(pe.Returns(funcx.RetValue)), ctx: context.Background()}
+	for _, i := range values {
+		d.inputTs = append(d.inputTs, pe.Param[i].T)
+	}
+	for _, i := range pe.Params(funcx.FnEmit) {
+		d.emitTs = append(d.emitTs, pe.Param[i].T)
+	}
+	return d, nil
+}
+
+// instance returns a freshly deserialized and set up copy of the DoFn.
+func (d *doer) instance() (*graph.DoFn, error) {
+	if d.fn.Recv == nil {
+		return d.fn, nil
+	}
+	recv, err := clone(d.fn.Recv)
+	if err != nil {
+		return nil, err
+	}
+	fn, err := graph.NewDoFn(recv)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := exec.InvokeWithoutEventTime(d.ctx, fn.SetupFn(), nil, nil, nil, nil, nil); err != nil {
+		return nil, errors.WithContext(err, "invoking Setup")
+	}
+	return fn, nil
+}
+
+func (d *doer) teardown(fn *graph.DoFn) error {
+	if _, err := exec.InvokeWithoutEventTime(d.ctx, fn.TeardownFn(), nil, nil, nil, nil, nil); err != nil {
+		return errors.WithContext(err, "invoking Teardown")
+	}
+	return nil
+}
+
+// evaluate processes the bundles in order, on a single instance or on a fresh
+// instance per bundle, and returns the recorded outputs.
+func (d *doer) evaluate(bundles [][]any, fresh bool) (*outputs, error) {
+	out := newOutputs(d.direct, len(d.emitTs))
+	emitters := make([]any, len(d.emitTs))
+	for i, t := range d.emitTs {
+		tag := d.outputIndex(i)
+		emitters[i] = reflect.MakeFunc(t, func(args []reflect.Value) []reflect.Value {
+			vals := make([]any, len(args))
+			for j, a := range args {
+				vals[j] = a.Interface()
+			}
+			out.add(tag, vals)
+			return nil
+		}).Interface()
+	}
+
+	var fn *graph.DoFn
+	for i, bundle := range bundles {
+		if fn == nil || fresh {
+			var err error
+			if fn, err = d.instance(); err != nil {
+				return nil, err
+			}
+		}
+		if err := d.bundle(fn, bundle, emitters, out); err != nil {
+			return nil, errors.WithContextf(err, "processing bundle %d of %d", i+1, len(bundles))
+		}
+		if fresh || i == len(bundles)-1 {
+			if err := d.teardown(fn); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return out, nil
+}
+
+func (d *doer) bundle(fn *graph.DoFn, inputs []any, emitters []any, out *outputs) error {
+	if sb := fn.StartBundleFn(); sb != nil {
+		if _, err := exec.InvokeWithoutEventTime(d.ctx, sb, nil, nil, nil, nil, nil, d.bundleEmitters(sb, emitters)...); err != nil {
+			return errors.WithContext(err, "invoking StartBundle")
+		}
+	}
+	for _, in := range inputs {
+		opt := &exec.MainInput{Key: exec.FullValue{Elm: in}}
+		if e, ok := in.(kv); ok {
+			opt.Key = exec.FullValue{Elm: e.Key, Elm2: e.Value}
+		}
+		val, err := exec.InvokeWithoutEventTime(d.ctx, fn.ProcessElementFn(), opt, nil, nil, nil, nil, emitters...)
+		if err != nil {
+			return errors.WithContextf(err, "invoking ProcessElement on %v", in)
+		}
+		switch {
+		case d.direct == 1:
+			out.add(0, []any{val.Elm})
+		case d.direct == 2:
+			out.add(0, []any{val.Elm, val.Elm2})
+		}
+	}
+	if fb := fn.FinishBundleFn(); fb != nil {
+		if _, err := exec.InvokeWithoutEventTime(d.ctx, fb, nil, nil, nil, nil, nil, d.bundleEmitters(fb, emitters)...); err != nil {
+			return errors.WithContext(err, "invoking FinishBundle")
+		}
+	}
+	return nil
+}
+
+// bundleEmitters matches the emitters of a StartBundle or FinishBundle method
+// to the ProcessElement emitters of the same type, in order.
+func (d *doer) bundleEmitters(fn *funcx.Fn, emitters []any) []any {
+	var ret []any
+	used := make([]bool, len(emitters))
+	for _, i := range fn.Params(funcx.FnEmit) {
+		for j, t := range d.emitTs {
+			if !used[j] && t == fn.Param[i].T {
+				used[j] = true
+				ret = append(ret, emitters[j])
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// outputIndex returns the output index of the i-th emitter. Directly returned
+// values are the first output.
+func (d *doer) outputIndex(i int) int {
+	if d.direct > 0 {
+		return i + 1
+	}
+	return i
+}
+
+// outputs records the multiset of emitted values per output, keyed by their
+// encoded form.
+type outputs struct {
+	counts   []map[string]int
+	display  map[string]string
+	encoders map[reflect.Type]beam.ElementEncoder
+}
+
+func newOutputs(direct, emitters int) *outputs {
+	n := emitters
+	if direct > 0 {
+		n++
+	}
+	o := &outputs{counts: make([]map[string]int, n), display: map[string]string{}, encoders: map[reflect.Type]beam.ElementEncoder{}}
+	for i := range o.counts {
+		o.counts[i] = map[string]int{}
+	}
+	return o
+}
+
+func (o *outputs) add(tag int, vals []any) {
+	var key strings.Builder
+	for _, v := range vals {
+		key.WriteString(o.encode(v))
+		key.WriteString("|")
+	}
+	k := key.String()
+	o.counts[tag][k]++
+	if len(vals) == 1 {
+		o.display[k] = fmt.Sprintf("%v", vals[0])
+	} else {
+		o.display[k] = fmt.Sprintf("%v", vals)
+	}
+}
+
+// encode returns the coder encoding of v, or its Go syntax representation if
+// there's no coder for its type.
+func (o *outputs) encode(v any) string {
+	if v == nil {
+		return "nil"
+	}
+	t := reflect.TypeOf(v)
+	enc, ok := o.encoders[t]
+	if !ok {
+		enc, _ = encoder(t)
+		o.encoders[t] = enc
+	}
+	if enc != nil {
+		var buf bytes.Buffer
+		if err := enc.Encode(v, &buf); err == nil {
+			return buf.String()
+		}
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+// compareOutputs reports the unexpected and missing values of each output.
+func compareOutputs(got, want *outputs) error {
+	var diffs []string
+	for tag := range want.counts {
+		var unexpected, missing []any
+		for k, n := range got.counts[tag] {
+			for i := want.counts[tag][k]; i < n; i++ {
+				unexpected = append(unexpected, got.display[k])
+			}
+		}
+		for k, n := range want.counts[tag] {
+			for i := got.counts[tag][k]; i < n; i++ {
+				missing = append(missing, want.display[k])
+			}
+		}
+		if len(unexpected) == 0 && len(missing) == 0 {
+			continue
+		}
+		sortAny(unexpected)
+		sortAny(missing)
+		diffs = append(diffs, fmt.Sprintf("output %d: unexpected %v, missing %v", tag, describe(unexpected), describe(missing)))
+	}
+	if len(diffs) > 0 {
+		return errors.New(strings.Join(diffs, "; "))
+	}
+	return nil
+}
+
+func sortAny(vals []any) {
+	sort.Slice(vals, func(i, j int) bool { return fmt.Sprint(vals[i]) < fmt.Sprint(vals[j]) })
+}
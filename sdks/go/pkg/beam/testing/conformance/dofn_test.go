@@ -0,0 +1,144 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package conformance
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func doubleFn(x int, emit func(int)) {
+	emit(2 * x)
+}
+
+func swapFn(k string, v int) (int, string) {
+	return v, k
+}
+
+// batchFn buffers elements and emits them once a batch is full, flushing the
+// remainder at the end of each bundle if configured to.
+type batchFn struct {
+	Size  int
+	Flush bool
+
+	buf []int
+}
+
+func (fn *batchFn) StartBundle(_ func(int)) {
+	fn.buf = nil
+}
+
+func (fn *batchFn) ProcessElement(x int, emit func(int)) {
+	fn.buf = append(fn.buf, x)
+	if len(fn.buf) == fn.Size {
+		fn.flush(emit)
+	}
+}
+
+func (fn *batchFn) FinishBundle(emit func(int)) {
+	if fn.Flush {
+		fn.flush(emit)
+	}
+	fn.buf = nil
+}
+
+func (fn *batchFn) flush(emit func(int)) {
+	for _, x := range fn.buf {
+		emit(x)
+	}
+	fn.buf = nil
+}
+
+// countFn numbers its elements with an instance counter that is never reset.
+type countFn struct {
+	n int
+}
+
+func (fn *countFn) ProcessElement(_ int, emit func(int)) {
+	fn.n++
+	emit(fn.n)
+}
+
+// multiFn has several outputs, including direct returns.
+func multiFn(x int, small, large func(int)) int {
+	if x%2 == 0 {
+		small(x)
+	} else {
+		large(x)
+	}
+	return x
+}
+
+func TestDoFn(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   any
+	}{
+		{"emitter", doubleFn},
+		{"kv", swapFn},
+		{"multiple outputs", multiFn},
+		{"bundle flush", &batchFn{Size: 3, Flush: true}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			DoFn(t, test.fn)
+		})
+	}
+}
+
+func TestTryDoFn_Violations(t *testing.T) {
+	tests := []struct {
+		name     string
+		fn       any
+		property string
+	}{
+		{"dropped remainder", &batchFn{Size: 3}, "bundle boundaries"},
+		{"instance state", &countFn{}, "fresh instances"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := TryDoFn(test.fn)
+			var f *Failure
+			if !errors.As(err, &f) {
+				t.Fatalf("TryDoFn(%T) = %v, want a *Failure", test.fn, err)
+			}
+			if f.Property != test.property {
+				t.Errorf("TryDoFn(%T) violated %q, want %q: %v", test.fn, f.Property, test.property, f)
+			}
+			if !strings.Contains(f.Error(), "missing") {
+				t.Errorf("TryDoFn(%T) = %v, want missing outputs reported", test.fn, f)
+			}
+		})
+	}
+}
+
+func TestTryDoFn_Unsupported(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   any
+	}{
+		{"too many inputs", func(a, b, c int) int { return a + b + c }},
+		{"side input", func(a int, side func(*int) bool) int { return a }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := TryDoFn(test.fn); err == nil {
+				t.Errorf("TryDoFn(%T) = nil, want an error", test.fn)
+			}
+		})
+	}
+}
@@ -0,0 +1,464 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dofntest contains an in-process harness for unit testing a single
+// DoFn without building or running a pipeline.
+//
+// The Harness invokes the DoFn's lifecycle methods directly: Setup,
+// StartBundle, ProcessElement, OnTimer, FinishBundle and Teardown. Side
+// inputs are supplied as plain Go values, user state is kept in an in-memory
+// state.Provider per key and window, timers are kept in an in-memory
+// timers.Provider and fire when the test advances the watermark or processing
+// time, and outputs are captured per output. Stateful and timer DoFns can be
+// tested in microseconds:
+//
+//	h, err := dofntest.New(&bufferFn{})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	h.Process("key", 1)
+//	h.Process("key", 2)
+//	h.AdvanceWatermarkToInfinity()
+//	got := dofntest.OutputValues[int](h, 0)
+//
+// Outputs are numbered in the order of the ProcessElement signature: values
+// returned directly are output 0, followed by one output per emitter.
+// Elements are processed in the global window at the Unix epoch unless a
+// timestamp or window is given.
+package dofntest
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/funcx"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/runtime/exec"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/state"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/timers"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+)
+
+// Option configures a Harness.
+type Option func(*config)
+
+type config struct {
+	ctx            context.Context
+	sideInputs     []any
+	processingTime mtime.Time
+}
+
+// WithSideInputs provides the side inputs of the DoFn, in the order of the
+// ProcessElement parameters. A singleton side input is given as its value.
+// Iterable side inputs, func(*V) bool and func() func(*V) bool, are given as
+// a []V, or as a []KV for func(*K, *V) bool. A map side input,
+// func(K) func(*V) bool, is given as a map[K][]V.
+func WithSideInputs(inputs ...any) Option {
+	return func(c *config) {
+		c.sideInputs = inputs
+	}
+}
+
+// WithContext sets the context passed to the DoFn.
+func WithContext(ctx context.Context) Option {
+	return func(c *config) {
+		c.ctx = ctx
+	}
+}
+
+// WithProcessingTime sets the initial processing time, which defaults to the
+// Unix epoch.
+func WithProcessingTime(t time.Time) Option {
+	return func(c *config) {
+		c.processingTime = mtime.FromTime(t)
+	}
+}
+
+// KV is an element of an iterable side input over key value pairs.
+type KV struct {
+	Key, Value any
+}
+
+// Output is a captured output element.
+type Output struct {
+	// Key is the key of a KV output, and nil otherwise.
+	Key any
+	// Value is the output value.
+	Value     any
+	Timestamp time.Time
+	Window    typex.Window
+}
+
+// Harness runs a single DoFn instance in process. It isn't safe for
+// concurrent use.
+type Harness struct {
+	fn  *graph.DoFn
+	ctx context.Context
+
+	numMain    int
+	sideInputs []any
+	emitTs     []reflect.Type
+	emitters   []any
+	direct     bool
+	outputs    [][]Output
+
+	combineFns map[string]*graph.CombineFn
+	states     map[stateID]*StateProvider
+	keys       *keyEncoder
+	timers     *timerSet
+	finalizer  *bundleFinalizer
+
+	watermark, processingTime mtime.Time
+	setUp, inBundle, tornDown bool
+
+	// cur is the context of the current invocation, used for emitted outputs.
+	cur invocation
+}
+
+type stateID struct {
+	key    string
+	window typex.Window
+}
+
+// invocation holds the element context of a method invocation.
+type invocation struct {
+	ts     typex.EventTime
+	window typex.Window
+	pane   typex.PaneInfo
+	key    any
+	values []any
+}
+
+// New returns a Harness for the given DoFn, which may be a function or a
+// pointer to a structural DoFn. The DoFn is used as is, without being
+// serialized, so its fields can be inspected after processing. Splittable
+// DoFns aren't supported.
+func New(fn any, opts ...Option) (*Harness, error) {
+	c := &config{ctx: context.Background()}
+	for _, opt := range opts {
+		opt(c)
+	}
+	dfn, err := graph.NewDoFn(fn)
+	if err != nil {
+		return nil, err
+	}
+	if dfn.IsSplittable() {
+		return nil, errors.Errorf("splittable DoFn %v isn't supported", dfn.Name())
+	}
+	pe := dfn.ProcessElementFn()
+	inputs := pe.Params(funcx.FnValue | funcx.FnIter | funcx.FnReIter | funcx.FnMultiMap)
+	numMain := len(inputs) - len(c.sideInputs)
+	if numMain < 1 || numMain > 2 {
+		return nil, errors.Errorf("DoFn %v has %d input parameters for %d side inputs, want 1 or 2 main inputs", dfn.Name(), len(inputs), len(c.sideInputs))
+	}
+
+	h := &Harness{
+		fn:             dfn,
+		ctx:            c.ctx,
+		numMain:        numMain,
+		sideInputs:     c.sideInputs,
+		direct:         len(pe.Returns(funcx.RetValue)) > 0,
+		combineFns:     map[string]*graph.CombineFn{},
+		states:         map[stateID]*StateProvider{},
+		keys:           newKeyEncoder(),
+		finalizer:      &bundleFinalizer{},
+		watermark:      mtime.MinTimestamp,
+		processingTime: c.processingTime,
+	}
+	for _, i := range pe.Params(funcx.FnEmit) {
+		h.emitTs = append(h.emitTs, pe.Param[i].T)
+	}
+	h.outputs = make([][]Output, len(h.emitTs)+boolToInt(h.direct))
+	for i, t := range h.emitTs {
+		h.emitters = append(h.emitters, h.makeEmitter(t, i+boolToInt(h.direct)))
+	}
+
+	for _, ps := range dfn.PipelineState() {
+		if cps, ok := ps.(state.CombiningPipelineState); ok {
+			cfn, err := graph.NewCombineFn(cps.GetCombineFn())
+			if err != nil {
+				return nil, errors.WithContextf(err, "combining state %v", ps.StateKey())
+			}
+			h.combineFns[ps.StateKey()] = cfn
+		}
+	}
+	domains := map[string]timers.TimeDomain{}
+	pts, _ := dfn.PipelineTimers()
+	for _, pt := range pts {
+		for family, domain := range pt.Timers() {
+			domains[family] = domain
+		}
+	}
+	h.timers = newTimerSet(domains)
+	return h, nil
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// Setup invokes the Setup method. It's invoked automatically before the first
+// bundle.
+func (h *Harness) Setup() error {
+	if h.setUp {
+		return errors.New("Setup already invoked")
+	}
+	if h.tornDown {
+		return errors.New("DoFn was torn down")
+	}
+	h.setUp = true
+	if _, err := exec.InvokeWithoutEventTime(h.ctx, h.fn.SetupFn(), nil, nil, nil, nil, nil); err != nil {
+		return errors.WithContext(err, "invoking Setup")
+	}
+	return nil
+}
+
+// StartBundle invokes the StartBundle method, after Setup if necessary. A
+// bundle is started automatically when processing an element or firing
+// timers outside of a bundle.
+func (h *Harness) StartBundle() error {
+	if h.inBundle {
+		return errors.New("bundle already started")
+	}
+	if !h.setUp {
+		if err := h.Setup(); err != nil {
+			return err
+		}
+	}
+	h.inBundle = true
+	h.finalizer.callbacks = nil
+	h.cur = invocation{ts: mtime.ZeroTimestamp, window: window.GlobalWindow{}, pane: typex.NoFiringPane()}
+	sb := h.fn.StartBundleFn()
+	if _, err := exec.InvokeWithoutEventTime(h.ctx, sb, nil, nil, nil, nil, nil, h.emittersFor(sb)...); err != nil {
+		return errors.WithContext(err, "invoking StartBundle")
+	}
+	return nil
+}
+
+// FinishBundle invokes the FinishBundle method of the current bundle, and
+// then any bundle finalization callbacks registered during the bundle.
+func (h *Harness) FinishBundle() error {
+	if !h.inBundle {
+		return errors.New("no bundle in progress")
+	}
+	h.inBundle = false
+	h.cur = invocation{ts: mtime.ZeroTimestamp, window: window.GlobalWindow{}, pane: typex.NoFiringPane()}
+	fb := h.fn.FinishBundleFn()
+	if _, err := exec.InvokeWithoutEventTime(h.ctx, fb, nil, nil, nil, nil, nil, h.emittersFor(fb)...); err != nil {
+		return errors.WithContext(err, "invoking FinishBundle")
+	}
+	callbacks := h.finalizer.callbacks
+	h.finalizer.callbacks = nil
+	for _, cb := range callbacks {
+		if err := cb(); err != nil {
+			return errors.WithContext(err, "invoking bundle finalization callback")
+		}
+	}
+	return nil
+}
+
+// Teardown finishes the current bundle, if any, and invokes the Teardown
+// method. The Harness can't be used afterwards.
+func (h *Harness) Teardown() error {
+	if h.tornDown {
+		return errors.New("Teardown already invoked")
+	}
+	if h.inBundle {
+		if err := h.FinishBundle(); err != nil {
+			return err
+		}
+	}
+	h.tornDown = true
+	if _, err := exec.InvokeWithoutEventTime(h.ctx, h.fn.TeardownFn(), nil, nil, nil, nil, nil); err != nil {
+		return errors.WithContext(err, "invoking Teardown")
+	}
+	return nil
+}
+
+// Process invokes ProcessElement on an element in the global window at the
+// Unix epoch. The element is a single value, or a key and a value for DoFns
+// on KV inputs. An iterable main input, such as the values of a
+// GroupByKey result, is given as a slice.
+func (h *Harness) Process(values ...any) error {
+	return h.ProcessInWindow(window.GlobalWindow{}, time.UnixMilli(0), values...)
+}
+
+// ProcessAt invokes ProcessElement on an element with the given event time,
+// in the global window.
+func (h *Harness) ProcessAt(ts time.Time, values ...any) error {
+	return h.ProcessInWindow(window.GlobalWindow{}, ts, values...)
+}
+
+// ProcessInWindow invokes ProcessElement on an element with the given event
+// time in the given window. User state and timers are scoped to the key and
+// window of the element.
+func (h *Harness) ProcessInWindow(w typex.Window, ts time.Time, values ...any) error {
+	if len(values) != h.numMain {
+		return errors.Errorf("DoFn %v takes %d main input values, got %d", h.fn.Name(), h.numMain, len(values))
+	}
+	if err := h.ensureBundle(); err != nil {
+		return err
+	}
+	c := invocation{ts: mtime.FromTime(ts), window: w, pane: typex.NoFiringPane(), values: values}
+	if h.numMain == 2 {
+		c.key = values[0]
+	}
+	if err := h.invoke(h.fn.ProcessElementFn(), c); err != nil {
+		return errors.WithContextf(err, "invoking ProcessElement on %v", values)
+	}
+	return nil
+}
+
+func (h *Harness) ensureBundle() error {
+	if h.tornDown {
+		return errors.New("DoFn was torn down")
+	}
+	if !h.inBundle {
+		return h.StartBundle()
+	}
+	return nil
+}
+
+// Watermark returns the current input watermark. It starts at the minimum
+// timestamp.
+func (h *Harness) Watermark() time.Time {
+	return h.watermark.ToTime()
+}
+
+// ProcessingTime returns the current processing time.
+func (h *Harness) ProcessingTime() time.Time {
+	return h.processingTime.ToTime()
+}
+
+// AdvanceWatermark advances the input watermark to the given time, firing the
+// event time timers due up to that time in order, including timers set while
+// firing. The watermark can't move backwards.
+func (h *Harness) AdvanceWatermark(t time.Time) error {
+	return h.advanceWatermark(mtime.FromTime(t))
+}
+
+// AdvanceWatermarkToInfinity advances the input watermark to the end of time,
+// firing all event time timers.
+func (h *Harness) AdvanceWatermarkToInfinity() error {
+	return h.advanceWatermark(mtime.MaxTimestamp)
+}
+
+func (h *Harness) advanceWatermark(wm mtime.Time) error {
+	if wm < h.watermark {
+		return errors.Errorf("watermark %v is before the current watermark %v", wm, h.watermark)
+	}
+	if err := h.fire(timers.EventTimeDomain, wm); err != nil {
+		return err
+	}
+	h.watermark = wm
+	return nil
+}
+
+// AdvanceProcessingTime advances the processing time by the given duration,
+// firing the processing time timers due up to the new time in order.
+func (h *Harness) AdvanceProcessingTime(d time.Duration) error {
+	if d < 0 {
+		return errors.Errorf("processing time can't move backwards by %v", d)
+	}
+	now := h.processingTime.Add(d)
+	if err := h.fire(timers.ProcessingTimeDomain, now); err != nil {
+		return err
+	}
+	h.processingTime = now
+	return nil
+}
+
+// fire invokes OnTimer for each timer of the domain due at the given time.
+func (h *Harness) fire(domain timers.TimeDomain, now mtime.Time) error {
+	for {
+		id, t, ok := h.timers.next(domain, now)
+		if !ok {
+			return nil
+		}
+		onTimer, ok := h.fn.OnTimerFn()
+		if !ok {
+			return errors.Errorf("DoFn %v set timer %v but has no OnTimer method", h.fn.Name(), id.family)
+		}
+		if err := h.ensureBundle(); err != nil {
+			return err
+		}
+		c := invocation{
+			ts:     t.HoldTimestamp,
+			window: id.window,
+			pane:   typex.NoFiringPane(),
+			key:    t.key,
+			values: []any{t.key, timers.Context{Family: id.family, Tag: id.tag}},
+		}
+		if err := h.invoke(onTimer, c); err != nil {
+			return errors.WithContextf(err, "invoking OnTimer for timer %v of key %v", id.family, t.key)
+		}
+	}
+}
+
+// Timers returns the pending timers, ordered by firing time.
+func (h *Harness) Timers() []Timer {
+	return h.timers.list()
+}
+
+// State returns the user state of the given key in the global window. The
+// returned provider can be used with the state types, for example to set up
+// existing state before processing or to check state afterwards.
+func (h *Harness) State(key any) *StateProvider {
+	return h.StateInWindow(key, window.GlobalWindow{})
+}
+
+// StateInWindow returns the user state of the given key and window.
+func (h *Harness) StateInWindow(key any, w typex.Window) *StateProvider {
+	id := stateID{key: h.keys.encode(key), window: w}
+	s, ok := h.states[id]
+	if !ok {
+		s = NewStateProvider(h.combineFns)
+		h.states[id] = s
+	}
+	return s
+}
+
+// Outputs returns the elements captured for the output with the given
+// index.
+func (h *Harness) Outputs(i int) []Output {
+	if i < 0 || i >= len(h.outputs) {
+		return nil
+	}
+	return h.outputs[i]
+}
+
+// ClearOutputs discards all captured outputs.
+func (h *Harness) ClearOutputs() {
+	for i := range h.outputs {
+		h.outputs[i] = nil
+	}
+}
+
+// OutputValues returns the values captured for the output with the given
+// index, which must be of type T.
+func OutputValues[T any](h *Harness, i int) []T {
+	var ret []T
+	for _, o := range h.Outputs(i) {
+		ret = append(ret, o.Value.(T))
+	}
+	return ret
+}
@@ -0,0 +1,354 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dofntest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/state"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/timers"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+)
+
+func mustNew(t *testing.T, fn any, opts ...Option) *Harness {
+	t.Helper()
+	h, err := New(fn, opts...)
+	if err != nil {
+		t.Fatalf("New(%T) failed: %v", fn, err)
+	}
+	return h
+}
+
+func check(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func splitFn(ts typex.EventTime, x int, evens func(int), odds func(typex.EventTime, int)) int {
+	if x%2 == 0 {
+		evens(x)
+	} else {
+		odds(ts+1, x)
+	}
+	return 10 * x
+}
+
+func TestHarness_Outputs(t *testing.T) {
+	h := mustNew(t, splitFn)
+	check(t, h.Process(2))
+	check(t, h.ProcessAt(time.UnixMilli(5), 3))
+
+	if got, want := OutputValues[int](h, 0), []int{20, 30}; !reflect.DeepEqual(got, want) {
+		t.Errorf("returned values = %v, want %v", got, want)
+	}
+	if got, want := OutputValues[int](h, 1), []int{2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("evens = %v, want %v", got, want)
+	}
+	odds := h.Outputs(2)
+	if len(odds) != 1 || odds[0].Value != 3 || !odds[0].Timestamp.Equal(time.UnixMilli(6)) || odds[0].Window != (window.GlobalWindow{}) {
+		t.Errorf("odds = %+v, want 3 at 6ms in the global window", odds)
+	}
+	if got := h.Outputs(3); got != nil {
+		t.Errorf("Outputs(3) = %v, want nil", got)
+	}
+	h.ClearOutputs()
+	if got := h.Outputs(0); len(got) != 0 {
+		t.Errorf("Outputs(0) after ClearOutputs = %v, want none", got)
+	}
+}
+
+// lifecycleFn records its lifecycle calls.
+type lifecycleFn struct {
+	calls []string
+}
+
+func (fn *lifecycleFn) Setup() { fn.calls = append(fn.calls, "Setup") }
+func (fn *lifecycleFn) StartBundle(_ context.Context, _ func(string)) {
+	fn.calls = append(fn.calls, "StartBundle")
+}
+func (fn *lifecycleFn) ProcessElement(x string, emit func(string)) {
+	fn.calls = append(fn.calls, "ProcessElement")
+	emit(x)
+}
+func (fn *lifecycleFn) FinishBundle(emit func(string)) {
+	fn.calls = append(fn.calls, "FinishBundle")
+	emit("done")
+}
+func (fn *lifecycleFn) Teardown() { fn.calls = append(fn.calls, "Teardown") }
+
+func TestHarness_Lifecycle(t *testing.T) {
+	fn := &lifecycleFn{}
+	h := mustNew(t, fn)
+	check(t, h.Process("a"))
+	check(t, h.Process("b"))
+	check(t, h.FinishBundle())
+	check(t, h.Process("c"))
+	check(t, h.Teardown())
+
+	want := []string{"Setup", "StartBundle", "ProcessElement", "ProcessElement", "FinishBundle",
+		"StartBundle", "ProcessElement", "FinishBundle", "Teardown"}
+	if !reflect.DeepEqual(fn.calls, want) {
+		t.Errorf("lifecycle calls = %v, want %v", fn.calls, want)
+	}
+	if got, want := OutputValues[string](h, 0), []string{"a", "b", "done", "c", "done"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("outputs = %v, want %v", got, want)
+	}
+	if err := h.Process("d"); err == nil {
+		t.Error("Process after Teardown succeeded, want error")
+	}
+	if err := h.FinishBundle(); err == nil {
+		t.Error("FinishBundle without a bundle succeeded, want error")
+	}
+}
+
+// bufferFn sums the values of each key and emits the sum when the watermark
+// passes a minute after the first element of the key.
+type bufferFn struct {
+	Values state.Bag[int]
+	Flush  timers.EventTime
+}
+
+func (fn *bufferFn) ProcessElement(ts typex.EventTime, sp state.Provider, tp timers.Provider, key string, value int, _ func(string, int)) error {
+	vs, ok, err := fn.Values.Read(sp)
+	if err != nil {
+		return err
+	}
+	if !ok || len(vs) == 0 {
+		fn.Flush.Set(tp, ts.ToTime().Add(time.Minute))
+	}
+	return fn.Values.Add(sp, value)
+}
+
+func (fn *bufferFn) OnTimer(sp state.Provider, tp timers.Provider, key string, timer timers.Context, emit func(string, int)) error {
+	vs, _, err := fn.Values.Read(sp)
+	if err != nil {
+		return err
+	}
+	sum := 0
+	for _, v := range vs {
+		sum += v
+	}
+	emit(key, sum)
+	return fn.Values.Clear(sp)
+}
+
+func TestHarness_EventTimeTimers(t *testing.T) {
+	fn := &bufferFn{Values: state.MakeBagState[int]("values"), Flush: timers.InEventTime("flush")}
+	h := mustNew(t, fn)
+	check(t, h.ProcessAt(time.UnixMilli(0), "a", 1))
+	check(t, h.ProcessAt(time.UnixMilli(1000), "b", 10))
+	check(t, h.ProcessAt(time.UnixMilli(2000), "a", 2))
+
+	pending := h.Timers()
+	if len(pending) != 2 || pending[0].Key != "a" || !pending[0].Fire.Equal(time.UnixMilli(60000)) ||
+		pending[1].Key != "b" || pending[1].Domain != timers.EventTimeDomain {
+		t.Fatalf("Timers() = %+v, want timers for a at 60s and b at 61s", pending)
+	}
+	if vs, _, _ := fn.Values.Read(h.State("a")); !reflect.DeepEqual(vs, []int{1, 2}) {
+		t.Errorf("state of a = %v, want [1 2]", vs)
+	}
+
+	check(t, h.AdvanceWatermark(time.UnixMilli(60000)))
+	out := h.Outputs(0)
+	if len(out) != 1 || out[0].Key != "a" || out[0].Value != 3 || !out[0].Timestamp.Equal(time.UnixMilli(60000)) {
+		t.Fatalf("outputs at 60s = %+v, want (a, 3) at 60s", out)
+	}
+	if !h.State("a").Empty() {
+		t.Error("state of a not cleared after firing")
+	}
+	if got := h.Watermark(); !got.Equal(time.UnixMilli(60000)) {
+		t.Errorf("Watermark() = %v, want 60s", got)
+	}
+
+	check(t, h.AdvanceWatermarkToInfinity())
+	if out := h.Outputs(0); len(out) != 2 || out[1].Key != "b" || out[1].Value != 10 {
+		t.Errorf("outputs at infinity = %+v, want (b, 10) last", out)
+	}
+	if len(h.Timers()) != 0 {
+		t.Errorf("Timers() = %v, want none pending", h.Timers())
+	}
+	if err := h.AdvanceWatermark(time.UnixMilli(0)); err == nil {
+		t.Error("AdvanceWatermark backwards succeeded, want error")
+	}
+}
+
+// pollFn sets a processing time timer that resets itself a fixed number of
+// times, counting firings in state.
+type pollFn struct {
+	Poll  timers.ProcessingTime
+	Count state.Combining[int, int, int]
+	Times int
+}
+
+func (fn *pollFn) ProcessElement(sp state.Provider, tp timers.Provider, key string, _ int, _ func(int)) {
+	fn.Poll.Set(tp, time.UnixMilli(0).Add(10*time.Second))
+}
+
+func (fn *pollFn) OnTimer(ctx context.Context, sp state.Provider, tp timers.Provider, key string, timer timers.Context, emit func(int)) error {
+	if err := fn.Count.Add(sp, 1); err != nil {
+		return err
+	}
+	n, _, err := fn.Count.Read(sp)
+	if err != nil {
+		return err
+	}
+	emit(n)
+	if n < fn.Times {
+		fn.Poll.Set(tp, time.UnixMilli(int64(n+1)*10000))
+	}
+	return nil
+}
+
+func TestHarness_ProcessingTimeTimers(t *testing.T) {
+	fn := &pollFn{
+		Poll:  timers.InProcessingTime("poll"),
+		Count: state.MakeCombiningState[int, int, int]("count", func(a, b int) int { return a + b }),
+		Times: 3,
+	}
+	h := mustNew(t, fn)
+	check(t, h.Process("k", 0))
+
+	check(t, h.AdvanceProcessingTime(5*time.Second))
+	if got := h.Outputs(0); len(got) != 0 {
+		t.Fatalf("outputs after 5s = %v, want none", got)
+	}
+	check(t, h.AdvanceProcessingTime(time.Minute))
+	if got, want := OutputValues[int](h, 0), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("outputs after 65s = %v, want %v", got, want)
+	}
+	if got := h.ProcessingTime(); !got.Equal(time.UnixMilli(65000)) {
+		t.Errorf("ProcessingTime() = %v, want 65s", got)
+	}
+	if n, _, _ := fn.Count.Read(h.State("k")); n != 3 {
+		t.Errorf("count state = %v, want 3", n)
+	}
+}
+
+func sideInputFn(x int, offset int, words func(*string) bool, lookup func(int) func(*string) bool, emit func(string)) {
+	var w string
+	for words(&w) {
+		emit(w)
+	}
+	iter := lookup(x + offset)
+	for iter(&w) {
+		emit(w)
+	}
+}
+
+func TestHarness_SideInputs(t *testing.T) {
+	h := mustNew(t, sideInputFn, WithSideInputs(1, []string{"a", "b"}, map[int][]string{2: {"two"}, 3: {"three", "drei"}}))
+	check(t, h.Process(1))
+	check(t, h.Process(2))
+	check(t, h.Process(5))
+
+	want := []string{"a", "b", "two", "a", "b", "three", "drei", "a", "b"}
+	if got := OutputValues[string](h, 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("outputs = %v, want %v", got, want)
+	}
+}
+
+func groupedSumFn(key string, values func(*int) bool) (string, int) {
+	sum, v := 0, 0
+	for values(&v) {
+		sum += v
+	}
+	return key, sum
+}
+
+func TestHarness_IterableMainInput(t *testing.T) {
+	h := mustNew(t, groupedSumFn)
+	check(t, h.Process("a", []int{1, 2, 3}))
+	if out := h.Outputs(0); len(out) != 1 || out[0].Key != "a" || out[0].Value != 6 {
+		t.Errorf("outputs = %+v, want (a, 6)", out)
+	}
+}
+
+// commitFn registers a bundle finalization callback per bundle.
+type commitFn struct {
+	committed []int
+	pending   []int
+}
+
+func (fn *commitFn) ProcessElement(bf typex.BundleFinalization, x int) {
+	if len(fn.pending) == 0 {
+		bf.RegisterCallback(time.Minute, func() error {
+			fn.committed = append(fn.committed, fn.pending...)
+			fn.pending = nil
+			return nil
+		})
+	}
+	fn.pending = append(fn.pending, x)
+}
+
+func TestHarness_BundleFinalization(t *testing.T) {
+	fn := &commitFn{}
+	h := mustNew(t, fn)
+	check(t, h.Process(1))
+	check(t, h.Process(2))
+	if len(fn.committed) != 0 {
+		t.Fatalf("committed = %v before FinishBundle, want none", fn.committed)
+	}
+	check(t, h.FinishBundle())
+	if !reflect.DeepEqual(fn.committed, []int{1, 2}) {
+		t.Errorf("committed = %v, want [1 2]", fn.committed)
+	}
+}
+
+func TestHarness_Windows(t *testing.T) {
+	fn := &bufferFn{Values: state.MakeBagState[int]("values"), Flush: timers.InEventTime("flush")}
+	h := mustNew(t, fn)
+	w1 := window.IntervalWindow{Start: 0, End: mtime.FromMilliseconds(60000)}
+	w2 := window.IntervalWindow{Start: mtime.FromMilliseconds(60000), End: mtime.FromMilliseconds(120000)}
+	check(t, h.ProcessInWindow(w1, time.UnixMilli(10), "a", 1))
+	check(t, h.ProcessInWindow(w2, time.UnixMilli(60010), "a", 2))
+
+	if vs, _, _ := fn.Values.Read(h.StateInWindow("a", w2)); !reflect.DeepEqual(vs, []int{2}) {
+		t.Errorf("state of a in %v = %v, want [2]", w2, vs)
+	}
+	check(t, h.AdvanceWatermarkToInfinity())
+	out := h.Outputs(0)
+	if len(out) != 2 || out[0].Window != w1 || out[0].Value != 1 || out[1].Window != w2 || out[1].Value != 2 {
+		t.Errorf("outputs = %+v, want 1 in %v and 2 in %v", out, w1, w2)
+	}
+}
+
+func errFn(x int) error {
+	if x < 0 {
+		return errors.New("negative")
+	}
+	return nil
+}
+
+func TestHarness_Errors(t *testing.T) {
+	h := mustNew(t, errFn)
+	if err := h.Process(-1); err == nil {
+		t.Error("Process(-1) succeeded, want the DoFn error")
+	}
+	if err := h.Process(1, 2); err == nil {
+		t.Error("Process(1, 2) succeeded, want an input arity error")
+	}
+	if _, err := New(errFn, WithSideInputs(1)); err == nil {
+		t.Error("New with more side inputs than parameters succeeded, want error")
+	}
+}
@@ -0,0 +1,281 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dofntest
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/funcx"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+)
+
+// invoke calls a ProcessElement or OnTimer method. The runtime parameters are
+// populated from the invocation, the main input values are followed by the
+// side inputs, and emitters are matched to outputs by type.
+func (h *Harness) invoke(fn *funcx.Fn, c invocation) error {
+	h.cur = c
+	keyID := h.keys.encode(c.key)
+
+	args := make([]any, len(fn.Param))
+	values, sides := 0, 0
+	emitters := h.emittersFor(fn)
+	for i, p := range fn.Param {
+		switch p.Kind {
+		case funcx.FnContext:
+			args[i] = h.ctx
+		case funcx.FnEventTime:
+			args[i] = c.ts
+		case funcx.FnWindow:
+			args[i] = c.window
+		case funcx.FnPane:
+			args[i] = c.pane
+		case funcx.FnStateProvider:
+			args[i] = h.StateInWindow(c.key, c.window)
+		case funcx.FnTimerProvider:
+			args[i] = h.timers.provider(c.key, keyID, c.window)
+		case funcx.FnBundleFinalization:
+			args[i] = h.finalizer
+		case funcx.FnEmit:
+			args[i], emitters = emitters[0], emitters[1:]
+		case funcx.FnValue, funcx.FnIter, funcx.FnReIter, funcx.FnMultiMap:
+			var v any
+			if values < len(c.values) {
+				v = c.values[values]
+				values++
+			} else if sides < len(h.sideInputs) {
+				v = h.sideInputs[sides]
+				sides++
+			} else {
+				return errors.Errorf("no value for parameter %d of type %v", i, p.T)
+			}
+			arg, err := adapt(p, v)
+			if err != nil {
+				return errors.WithContextf(err, "parameter %d", i)
+			}
+			args[i] = arg
+		default:
+			return errors.Errorf("parameter %d of kind %v isn't supported", i, p.Kind)
+		}
+	}
+
+	ret := fn.Fn.Call(args)
+	out := Output{Timestamp: c.ts.ToTime(), Window: c.window}
+	var direct []any
+	for i, r := range fn.Ret {
+		switch r.Kind {
+		case funcx.RetError:
+			if ret[i] != nil {
+				return ret[i].(error)
+			}
+		case funcx.RetEventTime:
+			out.Timestamp = ret[i].(typex.EventTime).ToTime()
+		case funcx.RetValue:
+			direct = append(direct, ret[i])
+		}
+	}
+	switch len(direct) {
+	case 1:
+		out.Value = direct[0]
+		h.outputs[0] = append(h.outputs[0], out)
+	case 2:
+		out.Key, out.Value = direct[0], direct[1]
+		h.outputs[0] = append(h.outputs[0], out)
+	}
+	return nil
+}
+
+// emittersFor returns the emitters for the emitter parameters of a method,
+// matching the ProcessElement emitters of the same type in order.
+func (h *Harness) emittersFor(fn *funcx.Fn) []any {
+	if fn == nil {
+		return nil
+	}
+	var ret []any
+	used := make([]bool, len(h.emitters))
+	for _, i := range fn.Params(funcx.FnEmit) {
+		for j, t := range h.emitTs {
+			if !used[j] && t == fn.Param[i].T {
+				used[j] = true
+				ret = append(ret, h.emitters[j])
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// makeEmitter returns an emitter of the given type capturing values to the
+// output with the given index. Outputs take the timestamp and window of the
+// current invocation, unless the emitter takes an explicit timestamp.
+func (h *Harness) makeEmitter(t reflect.Type, output int) any {
+	return reflect.MakeFunc(t, func(args []reflect.Value) []reflect.Value {
+		out := Output{Timestamp: h.cur.ts.ToTime(), Window: h.cur.window}
+		if len(args) > 0 && args[0].Type() == typex.EventTimeType {
+			out.Timestamp = args[0].Interface().(typex.EventTime).ToTime()
+			args = args[1:]
+		}
+		if len(args) == 2 {
+			out.Key = args[0].Interface()
+			args = args[1:]
+		}
+		out.Value = args[0].Interface()
+		h.outputs[output] = append(h.outputs[output], out)
+		return nil
+	}).Interface()
+}
+
+// adapt converts a Go value to the form of the given input parameter.
+func adapt(p funcx.FnParam, v any) (any, error) {
+	switch p.Kind {
+	case funcx.FnIter:
+		elems, err := toSlice(v)
+		if err != nil {
+			return nil, err
+		}
+		return makeIter(p.T, elems).Interface(), nil
+	case funcx.FnReIter:
+		elems, err := toSlice(v)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.MakeFunc(p.T, func([]reflect.Value) []reflect.Value {
+			return []reflect.Value{makeIter(p.T.Out(0), elems)}
+		}).Interface(), nil
+	case funcx.FnMultiMap:
+		m := reflect.ValueOf(v)
+		if m.Kind() != reflect.Map {
+			return nil, errors.Errorf("map side input must be given as a map, got %T", v)
+		}
+		return reflect.MakeFunc(p.T, func(args []reflect.Value) []reflect.Value {
+			var elems []any
+			if k := convert(args[0].Interface(), m.Type().Key()); k.IsValid() {
+				if vs := m.MapIndex(k); vs.IsValid() {
+					elems, _ = toSlice(vs.Interface())
+				}
+			}
+			return []reflect.Value{makeIter(p.T.Out(0), elems)}
+		}).Interface(), nil
+	default:
+		return convert(v, p.T).Interface(), nil
+	}
+}
+
+func toSlice(v any) ([]any, error) {
+	rv := reflect.ValueOf(v)
+	if v == nil {
+		return nil, nil
+	}
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, errors.Errorf("iterable input must be given as a slice, got %T", v)
+	}
+	ret := make([]any, rv.Len())
+	for i := range ret {
+		ret[i] = rv.Index(i).Interface()
+	}
+	return ret, nil
+}
+
+// makeIter returns an iterator function of the given type over the elements.
+// Elements of iterators over key value pairs must be KVs.
+func makeIter(t reflect.Type, elems []any) reflect.Value {
+	i := 0
+	return reflect.MakeFunc(t, func(args []reflect.Value) []reflect.Value {
+		if i >= len(elems) {
+			return []reflect.Value{reflect.ValueOf(false)}
+		}
+		e := elems[i]
+		i++
+		if len(args) == 2 {
+			kv, ok := e.(KV)
+			if !ok {
+				panic(fmt.Sprintf("iterator %v requires KV elements, got %T", t, e))
+			}
+			args[0].Elem().Set(convert(kv.Key, args[0].Type().Elem()))
+			args[1].Elem().Set(convert(kv.Value, args[1].Type().Elem()))
+		} else {
+			args[0].Elem().Set(convert(e, args[0].Type().Elem()))
+		}
+		return []reflect.Value{reflect.ValueOf(true)}
+	})
+}
+
+// convert returns v as a value of type t, if possible.
+func convert(v any, t reflect.Type) reflect.Value {
+	if v == nil {
+		return reflect.Zero(t)
+	}
+	rv := reflect.ValueOf(v)
+	if !rv.Type().AssignableTo(t) && rv.Type().ConvertibleTo(t) {
+		return rv.Convert(t)
+	}
+	return rv
+}
+
+// keyEncoder identifies keys for user state and timers by their encoding
+// under the inferred coder, falling back to the Go syntax representation for
+// types without a coder.
+type keyEncoder struct {
+	encoders map[reflect.Type]beam.ElementEncoder
+}
+
+func newKeyEncoder() *keyEncoder {
+	return &keyEncoder{encoders: map[reflect.Type]beam.ElementEncoder{}}
+}
+
+func (e *keyEncoder) encode(key any) string {
+	if key == nil {
+		return ""
+	}
+	t := reflect.TypeOf(key)
+	enc, ok := e.encoders[t]
+	if !ok {
+		enc = newEncoder(t)
+		e.encoders[t] = enc
+	}
+	if enc != nil {
+		var buf bytes.Buffer
+		if err := enc.Encode(key, &buf); err == nil {
+			return buf.String()
+		}
+	}
+	return fmt.Sprintf("%#v", key)
+}
+
+func newEncoder(t reflect.Type) (enc beam.ElementEncoder) {
+	defer func() {
+		if recover() != nil {
+			enc = nil
+		}
+	}()
+	return beam.NewElementEncoder(t)
+}
+
+// bundleFinalizer records the callbacks registered during a bundle, which the
+// Harness invokes after the bundle finishes.
+type bundleFinalizer struct {
+	callbacks []func() error
+}
+
+// RegisterCallback registers a callback to invoke after the bundle finishes.
+// The harness doesn't expire callbacks.
+func (f *bundleFinalizer) RegisterCallback(_ time.Duration, callback func() error) {
+	f.callbacks = append(f.callbacks, callback)
+}
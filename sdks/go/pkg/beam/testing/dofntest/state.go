@@ -0,0 +1,198 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dofntest
+
+import (
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/state"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/util/reflectx"
+)
+
+// StateProvider is an in-memory state.Provider holding the user state of a
+// single key and window. Writes are applied immediately, so reads never
+// return buffered transactions.
+type StateProvider struct {
+	values     map[string]any
+	bags       map[string][]any
+	maps       map[string]*orderedMap
+	combineFns map[string]*graph.CombineFn
+}
+
+var _ state.Provider = (*StateProvider)(nil)
+
+// NewStateProvider returns an empty StateProvider. The combineFns are used
+// for combining state, by state key.
+func NewStateProvider(combineFns map[string]*graph.CombineFn) *StateProvider {
+	return &StateProvider{
+		values:     map[string]any{},
+		bags:       map[string][]any{},
+		maps:       map[string]*orderedMap{},
+		combineFns: combineFns,
+	}
+}
+
+// Empty returns whether no state is stored.
+func (s *StateProvider) Empty() bool {
+	return len(s.values) == 0 && len(s.bags) == 0 && len(s.maps) == 0
+}
+
+// ReadValueState reads a value state.
+func (s *StateProvider) ReadValueState(id string) (any, []state.Transaction, error) {
+	return s.values[id], nil, nil
+}
+
+// WriteValueState writes a value state.
+func (s *StateProvider) WriteValueState(val state.Transaction) error {
+	s.values[val.Key] = val.Val
+	return nil
+}
+
+// ClearValueState clears a value state.
+func (s *StateProvider) ClearValueState(val state.Transaction) error {
+	delete(s.values, val.Key)
+	return nil
+}
+
+// ReadBagState reads a bag state.
+func (s *StateProvider) ReadBagState(id string) ([]any, []state.Transaction, error) {
+	return append([]any{}, s.bags[id]...), nil, nil
+}
+
+// WriteBagState appends to a bag state.
+func (s *StateProvider) WriteBagState(val state.Transaction) error {
+	s.bags[val.Key] = append(s.bags[val.Key], val.Val)
+	return nil
+}
+
+// ClearBagState clears a bag state.
+func (s *StateProvider) ClearBagState(val state.Transaction) error {
+	delete(s.bags, val.Key)
+	return nil
+}
+
+// CreateAccumulatorFn returns the CreateAccumulator function of a combining
+// state, if present.
+func (s *StateProvider) CreateAccumulatorFn(userStateID string) reflectx.Func {
+	if ca := s.combineFn(userStateID).CreateAccumulatorFn(); ca != nil {
+		return ca.Fn
+	}
+	return nil
+}
+
+// AddInputFn returns the AddInput function of a combining state, if present.
+func (s *StateProvider) AddInputFn(userStateID string) reflectx.Func {
+	if ai := s.combineFn(userStateID).AddInputFn(); ai != nil {
+		return ai.Fn
+	}
+	return nil
+}
+
+// MergeAccumulatorsFn returns the MergeAccumulators function of a combining
+// state.
+func (s *StateProvider) MergeAccumulatorsFn(userStateID string) reflectx.Func {
+	if ma := s.combineFn(userStateID).MergeAccumulatorsFn(); ma != nil {
+		return ma.Fn
+	}
+	return nil
+}
+
+// ExtractOutputFn returns the ExtractOutput function of a combining state, if
+// present.
+func (s *StateProvider) ExtractOutputFn(userStateID string) reflectx.Func {
+	if eo := s.combineFn(userStateID).ExtractOutputFn(); eo != nil {
+		return eo.Fn
+	}
+	return nil
+}
+
+func (s *StateProvider) combineFn(userStateID string) *graph.CombineFn {
+	if fn, ok := s.combineFns[userStateID]; ok {
+		return fn
+	}
+	// A CombineFn without methods, so combining state without a registered
+	// CombineFn behaves as if the state were empty.
+	return &graph.CombineFn{}
+}
+
+// ReadMapStateValue reads the value of a key in a map or set state.
+func (s *StateProvider) ReadMapStateValue(userStateID string, key any) (any, []state.Transaction, error) {
+	if m, ok := s.maps[userStateID]; ok {
+		return m.values[key], nil, nil
+	}
+	return nil, nil, nil
+}
+
+// ReadMapStateKeys reads the keys of a map or set state, in insertion order.
+func (s *StateProvider) ReadMapStateKeys(userStateID string) ([]any, []state.Transaction, error) {
+	if m, ok := s.maps[userStateID]; ok {
+		return append([]any{}, m.keys...), nil, nil
+	}
+	return nil, nil, nil
+}
+
+// WriteMapState writes a key to a map or set state.
+func (s *StateProvider) WriteMapState(val state.Transaction) error {
+	m, ok := s.maps[val.Key]
+	if !ok {
+		m = &orderedMap{values: map[any]any{}}
+		s.maps[val.Key] = m
+	}
+	m.put(val.MapKey, val.Val)
+	return nil
+}
+
+// ClearMapStateKey removes a key from a map or set state.
+func (s *StateProvider) ClearMapStateKey(val state.Transaction) error {
+	if m, ok := s.maps[val.Key]; ok {
+		m.remove(val.MapKey)
+		if len(m.keys) == 0 {
+			delete(s.maps, val.Key)
+		}
+	}
+	return nil
+}
+
+// ClearMapState clears a map or set state.
+func (s *StateProvider) ClearMapState(val state.Transaction) error {
+	delete(s.maps, val.Key)
+	return nil
+}
+
+// orderedMap is a map that remembers the insertion order of its keys.
+type orderedMap struct {
+	keys   []any
+	values map[any]any
+}
+
+func (m *orderedMap) put(k, v any) {
+	if _, ok := m.values[k]; !ok {
+		m.keys = append(m.keys, k)
+	}
+	m.values[k] = v
+}
+
+func (m *orderedMap) remove(k any) {
+	if _, ok := m.values[k]; !ok {
+		return
+	}
+	delete(m.values, k)
+	for i, key := range m.keys {
+		if key == k {
+			m.keys = append(m.keys[:i], m.keys[i+1:]...)
+			break
+		}
+	}
+}
@@ -0,0 +1,139 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dofntest
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/timers"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+)
+
+// Timer is a timer set by the DoFn under test that hasn't fired yet.
+type Timer struct {
+	Family, Tag string
+	Domain      timers.TimeDomain
+	Key         any
+	Window      typex.Window
+	// Fire is when the timer fires, in its time domain.
+	Fire time.Time
+	// Hold is the output timestamp of the timer, which holds the output watermark.
+	Hold time.Time
+}
+
+// TimerProvider is an in-memory timers.Provider for a single key and window.
+type TimerProvider struct {
+	set    *timerSet
+	key    any
+	keyID  string
+	window typex.Window
+}
+
+var _ timers.Provider = (*TimerProvider)(nil)
+
+// Set sets or clears a timer.
+func (p *TimerProvider) Set(t timers.TimerMap) {
+	id := timerID{family: t.Family, tag: t.Tag, key: p.keyID, window: p.window}
+	if t.Clear {
+		delete(p.set.pending, id)
+		return
+	}
+	p.set.seq++
+	p.set.pending[id] = &pendingTimer{TimerMap: t, key: p.key, seq: p.set.seq}
+}
+
+type timerID struct {
+	family, tag, key string
+	window           typex.Window
+}
+
+type pendingTimer struct {
+	timers.TimerMap
+	key any
+	seq int
+}
+
+// timerSet holds the pending timers of all keys and windows. A timer is
+// identified by its family, tag, key and window, so setting it again
+// overwrites it.
+type timerSet struct {
+	domains map[string]timers.TimeDomain
+	pending map[timerID]*pendingTimer
+	seq     int
+}
+
+func newTimerSet(domains map[string]timers.TimeDomain) *timerSet {
+	return &timerSet{domains: domains, pending: map[timerID]*pendingTimer{}}
+}
+
+func (s *timerSet) provider(key any, keyID string, w typex.Window) *TimerProvider {
+	return &TimerProvider{set: s, key: key, keyID: keyID, window: w}
+}
+
+// next removes and returns the earliest timer of the domain due at the given
+// time. Timers due at the same time fire in the order they were set.
+func (s *timerSet) next(domain timers.TimeDomain, now mtime.Time) (timerID, *pendingTimer, bool) {
+	var (
+		bestID timerID
+		best   *pendingTimer
+	)
+	for id, t := range s.pending {
+		if s.domains[id.family] != domain || t.FireTimestamp > now {
+			continue
+		}
+		if best == nil || t.FireTimestamp < best.FireTimestamp || t.FireTimestamp == best.FireTimestamp && t.seq < best.seq {
+			bestID, best = id, t
+		}
+	}
+	if best == nil {
+		return timerID{}, nil, false
+	}
+	delete(s.pending, bestID)
+	return bestID, best, true
+}
+
+// list returns the pending timers ordered by firing time.
+func (s *timerSet) list() []Timer {
+	type entry struct {
+		Timer
+		seq int
+	}
+	var entries []entry
+	for id, t := range s.pending {
+		entries = append(entries, entry{Timer{
+			Family: id.family,
+			Tag:    id.tag,
+			Domain: s.domains[id.family],
+			Key:    t.key,
+			Window: id.window,
+			Fire:   t.FireTimestamp.ToTime(),
+			Hold:   t.HoldTimestamp.ToTime(),
+		}, t.seq})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].Fire.Equal(entries[j].Fire) {
+			return entries[i].Fire.Before(entries[j].Fire)
+		}
+		return entries[i].seq < entries[j].seq
+	})
+	ret := make([]Timer, len(entries))
+	for i, e := range entries {
+		ret[i] = e.Timer
+	}
+	return ret
+}
@@ -0,0 +1,175 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const partSeparator = "========="
+
+// diff renders the unexpected and missing lines of a comparison. Lines that
+// decode to JSON objects are paired with the most similar line on the other
+// side and reported field by field; the rest are listed verbatim.
+func diff(unexpected, missing []string) string {
+	got, want := decodeAll(unexpected), decodeAll(missing)
+
+	var changed []string
+	used := make([]bool, len(want))
+	var extra []string
+	for i, g := range got {
+		j := closest(g, want, used)
+		if j < 0 {
+			extra = append(extra, unexpected[i])
+			continue
+		}
+		used[j] = true
+		var fields []string
+		compare("", g, want[j], &fields)
+		changed = append(changed, fmt.Sprintf("%v\n%v", missing[j], strings.Join(fields, "\n")))
+	}
+	var absent []string
+	for j, ok := range used {
+		if !ok {
+			absent = append(absent, missing[j])
+		}
+	}
+
+	var sb strings.Builder
+	section(&sb, "changed", changed)
+	section(&sb, "unexpected", extra)
+	section(&sb, "missing", absent)
+	return sb.String()
+}
+
+func section(sb *strings.Builder, name string, entries []string) {
+	if len(entries) == 0 {
+		return
+	}
+	fmt.Fprintf(sb, "%v\n%v:\n", partSeparator, name)
+	for _, e := range entries {
+		sb.WriteString("\t")
+		sb.WriteString(strings.ReplaceAll(e, "\n", "\n\t"))
+		sb.WriteString("\n")
+	}
+}
+
+func decodeAll(lines []string) []any {
+	ret := make([]any, len(lines))
+	for i, line := range lines {
+		dec := json.NewDecoder(bytes.NewReader([]byte(line)))
+		dec.UseNumber()
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			// Not produced by this package, so it can only be compared verbatim.
+			v = nil
+		}
+		ret[i] = v
+	}
+	return ret
+}
+
+// closest returns the index of the unused object in candidates sharing the
+// most equal fields with v, or -1 if v isn't an object or nothing matches.
+func closest(v any, candidates []any, used []bool) int {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return -1
+	}
+	best, bestScore := -1, 0
+	for i, c := range candidates {
+		other, ok := c.(map[string]any)
+		if !ok || used[i] {
+			continue
+		}
+		score := 0
+		for k, fv := range obj {
+			if ov, ok := other[k]; ok && reflect.DeepEqual(fv, ov) {
+				score++
+			}
+		}
+		if score > bestScore {
+			best, bestScore = i, score
+		}
+	}
+	return best
+}
+
+// compare appends a line for each differing field between got and want,
+// recursing into nested objects and arrays.
+func compare(path string, got, want any, out *[]string) {
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			break
+		}
+		keys := make(map[string]bool)
+		for k := range w {
+			keys[k] = true
+		}
+		for k := range g {
+			keys[k] = true
+		}
+		for _, k := range sortedKeys(keys) {
+			gv, gok := g[k]
+			wv, wok := w[k]
+			switch {
+			case !gok:
+				*out = append(*out, fmt.Sprintf("%v.%v: missing, want %v", path, k, render(wv)))
+			case !wok:
+				*out = append(*out, fmt.Sprintf("%v.%v: got %v, want no field", path, k, render(gv)))
+			default:
+				compare(path+"."+k, gv, wv, out)
+			}
+		}
+		return
+	case []any:
+		g, ok := got.([]any)
+		if !ok || len(g) != len(w) {
+			break
+		}
+		for i := range w {
+			compare(fmt.Sprintf("%v[%d]", path, i), g[i], w[i], out)
+		}
+		return
+	}
+	if !reflect.DeepEqual(got, want) {
+		*out = append(*out, fmt.Sprintf("%v: got %v, want %v", path, render(got), render(want)))
+	}
+}
+
+func render(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+func sortedKeys(m map[string]bool) []string {
+	ret := make([]string, 0, len(m))
+	for k := range m {
+		ret = append(ret, k)
+	}
+	sort.Strings(ret)
+	return ret
+}
@@ -0,0 +1,285 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golden
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// format renders a single element as a line of a golden file.
+func format(v any) (string, error) {
+	var buf bytes.Buffer
+	if err := appendValue(&buf, reflect.ValueOf(v)); err != nil {
+		return "", errors.Wrapf(err, "golden: can't render element %v", v)
+	}
+	return buf.String(), nil
+}
+
+// formatKV renders a KV element as an object with "Key" and "Value" fields.
+func formatKV(k, v any) (string, error) {
+	var buf bytes.Buffer
+	buf.WriteString(`{"Key":`)
+	if err := appendValue(&buf, reflect.ValueOf(k)); err != nil {
+		return "", errors.Wrapf(err, "golden: can't render key %v", k)
+	}
+	buf.WriteString(`,"Value":`)
+	if err := appendValue(&buf, reflect.ValueOf(v)); err != nil {
+		return "", errors.Wrapf(err, "golden: can't render value %v", v)
+	}
+	buf.WriteString("}")
+	return buf.String(), nil
+}
+
+// checkType returns an error if values of type t can't be rendered. The
+// dynamic values of interfaces are only checked when they are rendered.
+func checkType(t reflect.Type) error {
+	return checkTypeSeen(t, map[reflect.Type]bool{})
+}
+
+func checkTypeSeen(t reflect.Type, seen map[reflect.Type]bool) error {
+	if seen[t] || hasMarshaler(t) {
+		return nil
+	}
+	seen[t] = true
+
+	switch t.Kind() {
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return errors.Errorf("%v values have no rendering", t)
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return checkTypeSeen(t.Elem(), seen)
+	case reflect.Map:
+		if err := checkTypeSeen(t.Key(), seen); err != nil {
+			return err
+		}
+		return checkTypeSeen(t.Elem(), seen)
+	case reflect.Struct:
+		fields := exportedFields(t)
+		if len(fields) == 0 {
+			return errors.Errorf("struct %v has no exported fields", t)
+		}
+		for _, f := range fields {
+			if err := checkTypeSeen(f.Type, seen); err != nil {
+				return errors.Wrapf(err, "field %v of %v", f.Name, t)
+			}
+		}
+	}
+	return nil
+}
+
+// hasMarshaler returns whether values of type t render themselves.
+func hasMarshaler(t reflect.Type) bool {
+	return t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType)
+}
+
+// exportedFields returns the exported fields of a struct type, which are the
+// fields of its schema.
+func exportedFields(t reflect.Type) []reflect.StructField {
+	var fields []reflect.StructField
+	for i := 0; i < t.NumField(); i++ {
+		if f := t.Field(i); f.IsExported() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// fieldName returns the schema name of a struct field.
+func fieldName(f reflect.StructField) string {
+	if tag := f.Tag.Get("beam"); tag != "" {
+		if name, _, _ := strings.Cut(tag, ","); name != "" {
+			return name
+		}
+	}
+	return f.Name
+}
+
+// appendValue appends the JSON rendering of v to buf.
+func appendValue(buf *bytes.Buffer, v reflect.Value) error {
+	if !v.IsValid() {
+		buf.WriteString("null")
+		return nil
+	}
+	t := v.Type()
+	if hasMarshaler(t) {
+		if isNil(v) {
+			buf.WriteString("null")
+			return nil
+		}
+		return appendJSON(buf, v.Interface())
+	}
+
+	switch v.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return appendJSON(buf, v.Interface())
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		switch {
+		case math.IsNaN(f):
+			buf.WriteString(`"NaN"`)
+		case math.IsInf(f, 1):
+			buf.WriteString(`"+Inf"`)
+		case math.IsInf(f, -1):
+			buf.WriteString(`"-Inf"`)
+		default:
+			return appendJSON(buf, v.Interface())
+		}
+		return nil
+	case reflect.Complex64, reflect.Complex128:
+		return appendJSON(buf, strconv.FormatComplex(v.Complex(), 'g', -1, t.Bits()))
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		return appendValue(buf, v.Elem())
+	case reflect.Slice:
+		if v.IsNil() {
+			buf.WriteString("null")
+			return nil
+		}
+		if t.Elem().Kind() == reflect.Uint8 && !hasMarshaler(t.Elem()) {
+			// Text is rendered as is, and other bytes as an array of numbers.
+			if b := v.Bytes(); utf8.Valid(b) {
+				return appendJSON(buf, string(b))
+			}
+		}
+		return appendElements(buf, v)
+	case reflect.Array:
+		return appendElements(buf, v)
+	case reflect.Map:
+		return appendMap(buf, v)
+	case reflect.Struct:
+		return appendStruct(buf, v)
+	}
+	return errors.Errorf("%v values have no rendering", t)
+}
+
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return v.IsNil()
+	}
+	return false
+}
+
+func appendJSON(buf *bytes.Buffer, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+	return nil
+}
+
+func appendElements(buf *bytes.Buffer, v reflect.Value) error {
+	buf.WriteString("[")
+	for i := 0; i < v.Len(); i++ {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		if err := appendValue(buf, v.Index(i)); err != nil {
+			return err
+		}
+	}
+	buf.WriteString("]")
+	return nil
+}
+
+// appendMap renders a map as an object sorted by key. Keys that aren't
+// strings are keyed by their rendering.
+func appendMap(buf *bytes.Buffer, v reflect.Value) error {
+	if v.IsNil() {
+		buf.WriteString("null")
+		return nil
+	}
+	type entry struct {
+		key string
+		val reflect.Value
+	}
+	entries := make([]entry, 0, v.Len())
+	iter := v.MapRange()
+	for iter.Next() {
+		k := iter.Key()
+		var key string
+		if k.Kind() == reflect.String && !hasMarshaler(k.Type()) {
+			key = k.String()
+		} else {
+			var kb bytes.Buffer
+			if err := appendValue(&kb, k); err != nil {
+				return err
+			}
+			key = kb.String()
+		}
+		entries = append(entries, entry{key, iter.Value()})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+
+	buf.WriteString("{")
+	for i, e := range entries {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		if err := appendJSON(buf, e.key); err != nil {
+			return err
+		}
+		buf.WriteString(":")
+		if err := appendValue(buf, e.val); err != nil {
+			return err
+		}
+	}
+	buf.WriteString("}")
+	return nil
+}
+
+// appendStruct renders the exported fields of a struct in declaration order.
+func appendStruct(buf *bytes.Buffer, v reflect.Value) error {
+	fields := exportedFields(v.Type())
+	if len(fields) == 0 {
+		return errors.Errorf("struct %v has no exported fields", v.Type())
+	}
+	buf.WriteString("{")
+	for i, f := range fields {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		if err := appendJSON(buf, fieldName(f)); err != nil {
+			return err
+		}
+		buf.WriteString(":")
+		if err := appendValue(buf, v.FieldByIndex(f.Index)); err != nil {
+			return err
+		}
+	}
+	buf.WriteString("}")
+	return nil
+}
@@ -0,0 +1,225 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package golden contains snapshot assertions for testing pipelines. The
+// contents of a PCollection are rendered one element per line, sorted, and
+// compared against a golden file under testdata. Running the test with the
+// -update flag rewrites the golden files from the actual pipeline output:
+//
+//	go test ./... -update
+//
+// Elements are rendered as JSON, so struct elements, such as schema rows,
+// retain their field names and mismatches are reported field by field.
+// Structs are rendered by their exported fields, named like schema fields,
+// and types with no exported fields are rejected unless they implement
+// encoding.TextMarshaler or json.Marshaler. Byte slices are rendered as text
+// when they are valid UTF-8, and NaN and infinite floats as "NaN", "+Inf" and
+// "-Inf". KV collections are rendered as objects with "Key" and "Value" fields.
+//
+// Updating golden files writes them from the worker that evaluates the
+// assertion, so -update requires a runner that executes the pipeline in the
+// test process, such as the default prism runner in loopback mode.
+package golden
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+)
+
+var update = flag.Bool("update", false, "Rewrite golden files with the actual pipeline output.")
+
+// dir is the directory golden files are read from and written to, relative to
+// the package under test.
+var dir = "testdata"
+
+const (
+	// commentPrefix marks lines of a golden file that aren't elements.
+	commentPrefix = "#"
+	header        = "# Golden file generated by golden.Assert. Regenerate with: go test -update"
+)
+
+func init() {
+	register.DoFn2x1[beam.T, func(string), error]((*formatFn)(nil))
+	register.DoFn3x1[beam.X, beam.Y, func(string), error]((*formatKVFn)(nil))
+	register.DoFn2x1[[]byte, func(*string) bool, error]((*writeFn)(nil))
+	register.DoFn4x1[[]byte, func(*string) bool, func(*string) bool, func(*string) bool, error]((*checkFn)(nil))
+	register.Emitter1[string]()
+	register.Iter1[string]()
+}
+
+// Path returns the path of the golden file for the given name.
+func Path(name string) string {
+	return filepath.Join(dir, name+".golden")
+}
+
+// Assert verifies that the contents of the given collection match the golden
+// file testdata/<name>.golden, under the rendered representation of each
+// element. If the test is run with -update, the golden file is rewritten
+// instead. The collection is returned for further chaining.
+func Assert(s beam.Scope, col beam.PCollection, name string) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("golden.Assert(%v)", name))
+
+	path, err := filepath.Abs(Path(name))
+	if err != nil {
+		panic(errors.Wrapf(err, "golden.Assert: invalid golden file name %q", name))
+	}
+	lines := Format(s, col)
+
+	if *update {
+		beam.ParDo0(s, &writeFn{Path: path}, beam.Impulse(s), beam.SideInput{Input: lines})
+		return col
+	}
+
+	want, err := readLines(path)
+	missing := os.IsNotExist(err)
+	if err != nil && !missing {
+		panic(errors.Wrapf(err, "golden.Assert: reading golden file %v", path))
+	}
+	unexpected, correct, absent := passert.Diff(s, lines, beam.CreateList(s, want))
+	fn := &checkFn{Path: Path(name), Missing: missing}
+	beam.ParDo0(s, fn, beam.Impulse(s), beam.SideInput{Input: unexpected}, beam.SideInput{Input: correct}, beam.SideInput{Input: absent})
+	return col
+}
+
+// Format returns a PCollection<string> with the golden file rendering of each
+// element of the given collection. The input must be a non-composite or KV
+// collection.
+func Format(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("golden.Format")
+	if typex.IsKV(col.Type()) {
+		for _, c := range col.Type().Components() {
+			mustRender(c.Type())
+		}
+		return beam.ParDo(s, &formatKVFn{}, col)
+	}
+	mustRender(beam.ValidateNonCompositeType(col).Type())
+	return beam.ParDo(s, &formatFn{}, col)
+}
+
+func mustRender(t reflect.Type) {
+	if err := checkType(t); err != nil {
+		panic(errors.Wrapf(err, "golden.Format: can't render elements of type %v", t))
+	}
+}
+
+type formatFn struct{}
+
+func (f *formatFn) ProcessElement(elm beam.T, emit func(string)) error {
+	line, err := format(elm)
+	if err != nil {
+		return err
+	}
+	emit(line)
+	return nil
+}
+
+type formatKVFn struct{}
+
+func (f *formatKVFn) ProcessElement(k beam.X, v beam.Y, emit func(string)) error {
+	line, err := formatKV(k, v)
+	if err != nil {
+		return err
+	}
+	emit(line)
+	return nil
+}
+
+// writeFn rewrites the golden file with the sorted lines of the collection.
+type writeFn struct {
+	Path string `json:"path"`
+}
+
+func (f *writeFn) ProcessElement(_ []byte, lines func(*string) bool) error {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	buf.WriteString("\n")
+	for _, line := range readSorted(lines) {
+		buf.WriteString(line)
+		buf.WriteString("\n")
+	}
+	if err := os.MkdirAll(filepath.Dir(f.Path), 0755); err != nil {
+		return errors.Wrapf(err, "golden: creating directory for %v", f.Path)
+	}
+	if err := os.WriteFile(f.Path, buf.Bytes(), 0644); err != nil {
+		return errors.Wrapf(err, "golden: writing %v", f.Path)
+	}
+	return nil
+}
+
+// checkFn fails if there are any unexpected or missing lines, reporting the
+// differences per element.
+type checkFn struct {
+	Path    string `json:"path"`
+	Missing bool   `json:"missing,omitempty"`
+}
+
+func (f *checkFn) ProcessElement(_ []byte, unexpected, correct, missing func(*string) bool) error {
+	got, want := readSorted(unexpected), readSorted(missing)
+	if len(got) == 0 && len(want) == 0 {
+		return nil
+	}
+	good := len(readSorted(correct))
+
+	var sb strings.Builder
+	if f.Missing {
+		fmt.Fprintf(&sb, "golden file %v does not exist\n", f.Path)
+	}
+	fmt.Fprintf(&sb, "actual output differs from golden file %v: %d correct entries, %d unexpected, %d missing\n", f.Path, good, len(got), len(want))
+	sb.WriteString(diff(got, want))
+	sb.WriteString("run the test with -update to rewrite the golden file")
+	return errors.New(sb.String())
+}
+
+// readLines reads the element lines of a golden file.
+func readLines(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	lines := []string{}
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	sc.Buffer(nil, len(data)+1)
+	for sc.Scan() {
+		line := sc.Text()
+		if line == "" || strings.HasPrefix(line, commentPrefix) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, sc.Err()
+}
+
+func readSorted(iter func(*string) bool) []string {
+	var ret []string
+	var line string
+	for iter(&line) {
+		ret = append(ret, line)
+	}
+	sort.Strings(ret)
+	return ret
+}
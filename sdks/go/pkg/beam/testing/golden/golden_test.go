@@ -0,0 +1,244 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golden
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+)
+
+func init() {
+	register.Function1x2(purchaseAmount)
+}
+
+func TestMain(m *testing.M) {
+	ptest.Main(m)
+}
+
+type purchase struct {
+	User   string
+	Item   string
+	Amount int
+	Tags   []string
+}
+
+func purchaseAmount(p purchase) (string, int) {
+	return p.User, p.Amount
+}
+
+var purchases = []purchase{
+	{User: "alice", Item: "apple", Amount: 3, Tags: []string{"fruit"}},
+	{User: "bob", Item: "bread", Amount: 1},
+	{User: "carol", Item: "cheese", Amount: 2, Tags: []string{"dairy", "aged"}},
+}
+
+func TestAssert(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.Create(s, "c", "a", "b", "a")
+	Assert(s, col, "words")
+	ptest.RunAndValidate(t, p)
+}
+
+func TestAssert_Rows(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.CreateList(s, purchases)
+	Assert(s, col, "purchases")
+	ptest.RunAndValidate(t, p)
+}
+
+func TestAssert_KV(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.ParDo(s, purchaseAmount, beam.CreateList(s, purchases))
+	Assert(s, col, "amounts")
+	ptest.RunAndValidate(t, p)
+}
+
+func TestAssert_Update(t *testing.T) {
+	defer func(d string, u bool) { dir, *update = d, u }(dir, *update)
+	dir = t.TempDir()
+
+	*update = true
+	p, s := beam.NewPipelineWithRoot()
+	Assert(s, beam.Create(s, 2, 3, 1), "update")
+	ptest.RunAndValidate(t, p)
+
+	data, err := os.ReadFile(filepath.Join(dir, "update.golden"))
+	if err != nil {
+		t.Fatalf("reading updated golden file: %v", err)
+	}
+	if got, want := string(data), header+"\n1\n2\n3\n"; got != want {
+		t.Errorf("updated golden file = %q, want %q", got, want)
+	}
+
+	*update = false
+	p, s = beam.NewPipelineWithRoot()
+	Assert(s, beam.Create(s, 3, 1, 2), "update")
+	ptest.RunAndValidate(t, p)
+}
+
+func TestReadLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "x.golden")
+	if err := os.WriteFile(path, []byte("# comment\n\"a\"\n\n\"b\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := readLines(path)
+	if err != nil {
+		t.Fatalf("readLines(%v) failed: %v", path, err)
+	}
+	if len(got) != 2 || got[0] != `"a"` || got[1] != `"b"` {
+		t.Errorf("readLines(%v) = %q, want [\"a\" \"b\"]", path, got)
+	}
+}
+
+type secret struct {
+	value int
+}
+
+type row struct {
+	Name   string `beam:"name"`
+	Score  float64
+	Data   []byte
+	hidden int
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{"string", "a<b", `"a\u003cb"`},
+		{"NaN", math.NaN(), `"NaN"`},
+		{"infinities", []float32{float32(math.Inf(1)), float32(math.Inf(-1)), 0.1}, `["+Inf","-Inf",0.1]`},
+		{"text bytes", []byte("text"), `"text"`},
+		{"binary bytes", []byte{0xff, 0}, `[255,0]`},
+		{"byte array", [2]byte{1, 2}, `[1,2]`},
+		{"schema row", row{Name: "a", Score: math.Inf(1), Data: []byte("x"), hidden: 1}, `{"name":"a","Score":"+Inf","Data":"x"}`},
+		{"pointer", &row{}, `{"name":"","Score":0,"Data":null}`},
+		{"nil pointer", (*row)(nil), "null"},
+		{"map", map[int]string{10: "b", 2: "a"}, `{"10":"b","2":"a"}`},
+		{"text marshaler", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), `"2024-01-02T03:04:05Z"`},
+		{"complex", complex(1, 2), `"(1+2i)"`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := format(test.v)
+			if err != nil {
+				t.Fatalf("format(%v) failed: %v", test.v, err)
+			}
+			if got != test.want {
+				t.Errorf("format(%v) = %v, want %v", test.v, got, test.want)
+			}
+		})
+	}
+}
+
+func TestFormat_Invalid(t *testing.T) {
+	for _, v := range []any{secret{value: 1}, []any{secret{}}, func() {}} {
+		if got, err := format(v); err == nil {
+			t.Errorf("format(%#v) = %v, want error", v, got)
+		}
+	}
+}
+
+func TestCheckType(t *testing.T) {
+	tests := []struct {
+		v    any
+		want bool
+	}{
+		{purchase{}, true},
+		{row{}, true},
+		{time.Time{}, true},
+		{[]any{}, true},
+		{secret{}, false},
+		{map[string][]*secret{}, false},
+		{struct{ F func() }{}, false},
+	}
+	for _, test := range tests {
+		typ := reflect.TypeOf(test.v)
+		if err := checkType(typ); (err == nil) != test.want {
+			t.Errorf("checkType(%v) = %v, want ok = %v", typ, err, test.want)
+		}
+	}
+}
+
+func TestFormat_UnexportedFields(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil || !strings.Contains(fmt.Sprint(r), "no exported fields") {
+			t.Errorf("Format() of a struct without exported fields panicked with %v, want a panic about exported fields", r)
+		}
+	}()
+	_, s := beam.NewPipelineWithRoot()
+	Format(s, beam.Create(s, "a", "b"))
+	Format(s, beam.ParDo(s, func(string) secret { return secret{} }, beam.Create(s, "a")))
+}
+
+func TestDiff(t *testing.T) {
+	line := func(p purchase) string {
+		s, err := format(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	changed := purchases[2]
+	changed.Amount = 5
+	changed.Tags = []string{"dairy", "fresh"}
+
+	got := diff(
+		[]string{line(changed), `"stray"`},
+		[]string{line(purchases[2]), line(purchases[0])},
+	)
+	for _, want := range []string{
+		"changed:\n\t" + line(purchases[2]),
+		".Amount: got 5, want 2",
+		`.Tags[1]: got "fresh", want "aged"`,
+		"unexpected:\n\t\"stray\"",
+		"missing:\n\t" + line(purchases[0]),
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("diff() = \n%v\nwant it to contain %q", got, want)
+		}
+	}
+	if strings.Contains(got, ".User") || strings.Contains(got, ".Item") {
+		t.Errorf("diff() = \n%v\nwant only differing fields", got)
+	}
+}
+
+func TestCompare_Fields(t *testing.T) {
+	got := decodeAll([]string{`{"A":1,"B":{"C":"x"},"D":true}`})[0]
+	want := decodeAll([]string{`{"A":1,"B":{"C":"y"},"E":false}`})[0]
+	var out []string
+	compare("", got, want, &out)
+	wantOut := []string{
+		`.B.C: got "x", want "y"`,
+		".D: got true, want no field",
+		".E: missing, want false",
+	}
+	if strings.Join(out, "\n") != strings.Join(wantOut, "\n") {
+		t.Errorf("compare() = %q, want %q", out, wantOut)
+	}
+}
@@ -0,0 +1,155 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passert
+
+import (
+	"fmt"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+func init() {
+	register.DoFn4x0[typex.PaneInfo, typex.Window, beam.T, func(beam.T)]((*paneFilterFn)(nil))
+	register.DoFn5x0[typex.PaneInfo, typex.Window, beam.X, beam.Y, func(beam.X, beam.Y)]((*paneFilterKVFn)(nil))
+	register.Emitter1[beam.T]()
+	register.Emitter2[beam.X, beam.Y]()
+}
+
+// paneKind identifies the panes of a window that are kept by a scoped assertion.
+type paneKind int
+
+const (
+	anyPane paneKind = iota
+	onTimePane
+	finalPane
+	earlyPanes
+	latePanes
+)
+
+// InWindow returns the elements of col in the window w, re-windowed into the global window,
+// so that the other assertions verify the contents of that window only. The window must be
+// a window.IntervalWindow or a window.GlobalWindow. For example, to verify the sum of a
+// fixed window of 5 minutes starting at the epoch:
+//
+//	w := window.IntervalWindow{Start: 0, End: mtime.FromDuration(5 * time.Minute)}
+//	passert.Equals(s, passert.InWindow(s, sums, w), 42)
+func InWindow(s beam.Scope, col beam.PCollection, w typex.Window) beam.PCollection {
+	return inPanes(s.Scope("passert.InWindow"), col, w, anyPane)
+}
+
+// InOnTimePane returns the elements of col in the on-time pane of the window w, which is
+// fired when the watermark passes the end of the window, re-windowed into the global
+// window.
+func InOnTimePane(s beam.Scope, col beam.PCollection, w typex.Window) beam.PCollection {
+	return inPanes(s.Scope("passert.InOnTimePane"), col, w, onTimePane)
+}
+
+// InFinalPane returns the elements of col in the final pane of the window w, re-windowed
+// into the global window.
+func InFinalPane(s beam.Scope, col beam.PCollection, w typex.Window) beam.PCollection {
+	return inPanes(s.Scope("passert.InFinalPane"), col, w, finalPane)
+}
+
+// InEarlyPanes returns the elements of col in all the early panes of the window w, which are
+// fired before the watermark passes the end of the window, re-windowed into the global
+// window.
+func InEarlyPanes(s beam.Scope, col beam.PCollection, w typex.Window) beam.PCollection {
+	return inPanes(s.Scope("passert.InEarlyPanes"), col, w, earlyPanes)
+}
+
+// InLatePane returns the elements of col in the late panes of the window w, which are fired
+// for data arriving after the watermark passed the end of the window, re-windowed into the
+// global window.
+func InLatePane(s beam.Scope, col beam.PCollection, w typex.Window) beam.PCollection {
+	return inPanes(s.Scope("passert.InLatePane"), col, w, latePanes)
+}
+
+func inPanes(s beam.Scope, col beam.PCollection, w typex.Window, kind paneKind) beam.PCollection {
+	filter := paneFilter{Kind: kind}
+	switch w := w.(type) {
+	case window.GlobalWindow:
+		filter.Global = true
+	case window.IntervalWindow:
+		filter.Start, filter.End = int64(w.Start), int64(w.End)
+	default:
+		panic(fmt.Sprintf("passert: window must be a window.IntervalWindow or window.GlobalWindow, got %T", w))
+	}
+
+	var filtered beam.PCollection
+	switch {
+	case typex.IsKV(col.Type()):
+		filtered = beam.ParDo(s, &paneFilterKVFn{paneFilter: filter}, col)
+	case typex.IsCoGBK(col.Type()):
+		panic(fmt.Sprintf("passert: PCollection must not be a CoGBK, got %v", col.Type()))
+	default:
+		filtered = beam.ParDo(s, &paneFilterFn{paneFilter: filter}, col)
+	}
+	return beam.WindowInto(s, window.NewGlobalWindows(), filtered)
+}
+
+// paneFilter matches the elements of a window and its panes.
+type paneFilter struct {
+	Kind   paneKind `json:"kind"`
+	Global bool     `json:"global,omitempty"`
+	Start  int64    `json:"start,omitempty"`
+	End    int64    `json:"end,omitempty"`
+}
+
+func (f *paneFilter) matches(pane typex.PaneInfo, w typex.Window) bool {
+	var want typex.Window = window.GlobalWindow{}
+	if !f.Global {
+		want = window.IntervalWindow{Start: typex.EventTime(f.Start), End: typex.EventTime(f.End)}
+	}
+	if !w.Equals(want) {
+		return false
+	}
+
+	switch f.Kind {
+	case onTimePane:
+		return pane.Timing == typex.PaneOnTime
+	case finalPane:
+		return pane.IsLast
+	case earlyPanes:
+		return pane.Timing == typex.PaneEarly
+	case latePanes:
+		return pane.Timing == typex.PaneLate
+	default:
+		return true
+	}
+}
+
+type paneFilterFn struct {
+	paneFilter
+}
+
+func (f *paneFilterFn) ProcessElement(pane typex.PaneInfo, w typex.Window, elm beam.T, emit func(beam.T)) {
+	if f.matches(pane, w) {
+		emit(elm)
+	}
+}
+
+type paneFilterKVFn struct {
+	paneFilter
+}
+
+func (f *paneFilterKVFn) ProcessElement(pane typex.PaneInfo, w typex.Window, k beam.X, v beam.Y, emit func(beam.X, beam.Y)) {
+	if f.matches(pane, w) {
+		emit(k, v)
+	}
+}
@@ -0,0 +1,139 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+)
+
+func init() {
+	register.Function2x0(timestampMinutes)
+	register.Function2x1(sumInts)
+	register.Emitter2[beam.EventTime, int]()
+}
+
+// timestampMinutes timestamps each value with that many minutes after the epoch.
+func timestampMinutes(v int, emit func(beam.EventTime, int)) {
+	emit(mtime.FromDuration(time.Duration(v)*time.Minute), v)
+}
+
+func sumInts(a, b int) int {
+	return a + b
+}
+
+func minutesWindow(start, end int) window.IntervalWindow {
+	return window.IntervalWindow{
+		Start: mtime.FromDuration(time.Duration(start) * time.Minute),
+		End:   mtime.FromDuration(time.Duration(end) * time.Minute),
+	}
+}
+
+// windowedSums returns the sums of the values 1 to 9 in fixed windows of 5 minutes.
+func windowedSums(s beam.Scope) beam.PCollection {
+	values := beam.ParDo(s, timestampMinutes, beam.Create(s, 1, 2, 3, 4, 5, 6, 7, 8, 9))
+	windowed := beam.WindowInto(s, window.NewFixedWindows(5*time.Minute), values)
+	return beam.Combine(s, sumInts, windowed)
+}
+
+func TestInWindow(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	sums := windowedSums(s)
+	Equals(s, InWindow(s, sums, minutesWindow(0, 5)), 10)
+	Equals(s, InWindow(s, sums, minutesWindow(5, 10)), 35)
+	Empty(s, InWindow(s, sums, minutesWindow(10, 15)))
+	Equals(s, InWindow(s, beam.Create(s, "a", "b"), window.GlobalWindow{}), "a", "b")
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestInPanes(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	sums := windowedSums(s)
+	Equals(s, InOnTimePane(s, sums, minutesWindow(0, 5)), 10)
+	Equals(s, InFinalPane(s, sums, minutesWindow(5, 10)), 35)
+	Empty(s, InEarlyPanes(s, sums, minutesWindow(0, 5)))
+	Empty(s, InLatePane(s, sums, minutesWindow(0, 5)))
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestInPanes_KV(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	keyed := beam.AddFixedKey(s, windowedSums(s))
+	Count(s, InOnTimePane(s, keyed, minutesWindow(5, 10)), "on time", 1)
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestPaneFilter(t *testing.T) {
+	w := minutesWindow(0, 5)
+	early := typex.PaneInfo{Timing: typex.PaneEarly, IsFirst: true}
+	onTime := typex.PaneInfo{Timing: typex.PaneOnTime, Index: 1}
+	late := typex.PaneInfo{Timing: typex.PaneLate, IsLast: true, Index: 2}
+	noFiring := typex.NoFiringPane()
+
+	tests := []struct {
+		kind paneKind
+		want []typex.PaneInfo
+	}{
+		{kind: anyPane, want: []typex.PaneInfo{early, onTime, late, noFiring}},
+		{kind: onTimePane, want: []typex.PaneInfo{onTime}},
+		{kind: finalPane, want: []typex.PaneInfo{late, noFiring}},
+		{kind: earlyPanes, want: []typex.PaneInfo{early}},
+		{kind: latePanes, want: []typex.PaneInfo{late}},
+	}
+	for _, tt := range tests {
+		f := &paneFilter{Kind: tt.kind, Start: int64(w.Start), End: int64(w.End)}
+		var got []typex.PaneInfo
+		for _, pane := range []typex.PaneInfo{early, onTime, late, noFiring} {
+			if f.matches(pane, w) {
+				got = append(got, pane)
+			}
+			if f.matches(pane, minutesWindow(5, 10)) || f.matches(pane, window.GlobalWindow{}) {
+				t.Errorf("paneFilter(%v) matches %+v of another window", tt.kind, pane)
+			}
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("paneFilter(%v) matches %+v, want %+v", tt.kind, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("paneFilter(%v) matches %+v, want %+v", tt.kind, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestInWindow_invalidWindow(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("InWindow() with an unsupported window should panic")
+		}
+	}()
+
+	_, s := beam.NewPipelineWithRoot()
+	InWindow(s, beam.Create(s, 1), nil)
+}
@@ -0,0 +1,336 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package teststream
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+)
+
+// TimestampedValue is an element of a TestStream with its event time.
+type TimestampedValue[T any] struct {
+	Value     T
+	Timestamp time.Time
+	// Window is the window the element is expected in, if set. It must be one of the windows
+	// the timestamp is assigned to by the windowing of the Builder, and restricts the element
+	// to that window when deriving the expected panes.
+	Window typex.Window
+}
+
+// builderElement is an element added to a Builder, with the watermark at the time it was added.
+type builderElement[T any] struct {
+	TimestampedValue[T]
+	watermark mtime.Time
+}
+
+// Builder builds the events of a TestStream of elements of type T, with fluent chaining.
+// Invalid events are reported when the TestStream is built, by Build or Create. For example:
+//
+//	start := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+//	b := teststream.New[string]().
+//		WithWindowing(window.NewFixedWindows(5*time.Minute)).
+//		AddElements(start, "a", "b").
+//		AdvanceWatermark(start.Add(5*time.Minute)).
+//		AddElements(start.Add(time.Minute), "late").
+//		AdvanceWatermarkToInfinity()
+//	col := b.Create(s)
+type Builder[T any] struct {
+	config    Config
+	windowFn  *window.Fn
+	lateness  time.Duration
+	watermark mtime.Time
+	elements  []builderElement[T]
+	errs      []error
+}
+
+// New returns a Builder of a TestStream of elements of type T, which must have a coder.
+func New[T any]() *Builder[T] {
+	return &Builder[T]{
+		config:    NewConfig(),
+		windowFn:  window.NewGlobalWindows(),
+		watermark: mtime.MinTimestamp,
+	}
+}
+
+func (b *Builder[T]) fail(format string, args ...any) *Builder[T] {
+	b.errs = append(b.errs, fmt.Errorf(format, args...))
+	return b
+}
+
+// WithWindowing sets the windowing applied to the elements of the TestStream by Create. Elements
+// are in the global window by default.
+func (b *Builder[T]) WithWindowing(fn *window.Fn) *Builder[T] {
+	if fn == nil {
+		return b.fail("windowing must not be nil")
+	}
+	b.windowFn = fn
+	return b
+}
+
+// WithAllowedLateness sets the allowed lateness of the windowing applied by Create. Elements
+// later than the allowed lateness are dropped by aggregations. It defaults to 0.
+func (b *Builder[T]) WithAllowedLateness(d time.Duration) *Builder[T] {
+	if d < 0 {
+		return b.fail("allowed lateness must not be negative, got %v", d)
+	}
+	b.lateness = d
+	return b
+}
+
+// AddElements adds elements with the same event time.
+func (b *Builder[T]) AddElements(timestamp time.Time, values ...T) *Builder[T] {
+	tvs := make([]TimestampedValue[T], len(values))
+	for i, v := range values {
+		tvs[i] = TimestampedValue[T]{Value: v, Timestamp: timestamp}
+	}
+	return b.AddTimestampedValues(tvs...)
+}
+
+// AddTimestampedValues adds elements with their own event times, and optionally their windows.
+func (b *Builder[T]) AddTimestampedValues(values ...TimestampedValue[T]) *Builder[T] {
+	if len(values) == 0 {
+		return b.fail("at least one element must be added")
+	}
+
+	elements := make([]any, len(values))
+	timestamps := make([]int64, len(values))
+	for i, v := range values {
+		ts := mtime.Time(v.Timestamp.UnixMilli())
+		if ts < mtime.MinTimestamp || ts > mtime.MaxTimestamp {
+			return b.fail("timestamp of element %v is out of range, got %v", v.Value, v.Timestamp)
+		}
+		if v.Window != nil && !containsWindow(b.assignWindows(ts), v.Window) {
+			return b.fail("element %v at %v isn't assigned to window %v by %v", v.Value, v.Timestamp, v.Window, b.windowFn)
+		}
+		elements[i] = v.Value
+		timestamps[i] = ts.Milliseconds()
+	}
+
+	if err := b.addElements(timestamps, elements); err != nil {
+		return b.fail("%v", err)
+	}
+	for _, v := range values {
+		b.elements = append(b.elements, builderElement[T]{TimestampedValue: v, watermark: b.watermark})
+	}
+	return b
+}
+
+// addElements adds the elements to the Config, reporting a type without a coder as an error.
+func (b *Builder[T]) addElements(timestamps []int64, elements []any) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("elements of type %v can't be encoded: %v", reflect.TypeOf(elements[0]), r)
+		}
+	}()
+	return b.config.addElements("", timestamps, elements)
+}
+
+// AdvanceWatermark advances the watermark to the given time, which must be later than the
+// current watermark.
+func (b *Builder[T]) AdvanceWatermark(t time.Time) *Builder[T] {
+	return b.advanceWatermark(mtime.Time(t.UnixMilli()))
+}
+
+// AdvanceWatermarkBy advances the watermark by the given duration, which must be positive.
+// The watermark must have been advanced to a time before.
+func (b *Builder[T]) AdvanceWatermarkBy(d time.Duration) *Builder[T] {
+	if b.watermark == mtime.MinTimestamp {
+		return b.fail("watermark must be advanced to a time before advancing it by %v", d)
+	}
+	return b.advanceWatermark(b.watermark.Add(d))
+}
+
+// AdvanceWatermarkToInfinity advances the watermark to the maximum timestamp, which closes all
+// windows.
+func (b *Builder[T]) AdvanceWatermarkToInfinity() *Builder[T] {
+	return b.advanceWatermark(mtime.MaxTimestamp)
+}
+
+func (b *Builder[T]) advanceWatermark(wm mtime.Time) *Builder[T] {
+	if wm < mtime.MinTimestamp || wm > mtime.MaxTimestamp {
+		return b.fail("watermark is out of range, got %v", wm.ToTime())
+	}
+	if err := b.config.AdvanceWatermark(wm.Milliseconds()); err != nil {
+		return b.fail("%v", err)
+	}
+	b.watermark = wm
+	return b
+}
+
+// AdvanceProcessingTime advances the processing time by the given duration, which must be
+// positive.
+func (b *Builder[T]) AdvanceProcessingTime(d time.Duration) *Builder[T] {
+	if d <= 0 {
+		return b.fail("processing time must be advanced by a positive duration, got %v", d)
+	}
+	b.config.AdvanceProcessingTime(d.Milliseconds())
+	return b
+}
+
+// Build validates the events and returns the Config of the TestStream.
+func (b *Builder[T]) Build() (Config, error) {
+	if len(b.errs) != 0 {
+		return Config{}, fmt.Errorf("teststream: invalid events: %w", errors.Join(b.errs...))
+	}
+	if b.config.elmType == nil {
+		b.config.elmType = typex.New(reflect.TypeOf((*T)(nil)).Elem())
+	}
+	return b.config, nil
+}
+
+// Create inserts the TestStream into a pipeline, and applies the windowing of the Builder to
+// its output PCollection<T>. It panics if the events are invalid. The TestStream must be the
+// first PTransform in the pipeline.
+func (b *Builder[T]) Create(s beam.Scope) beam.PCollection {
+	c, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	col := Create(s, c)
+	if b.windowFn.Kind == window.GlobalWindows && b.lateness == 0 {
+		return col
+	}
+	return beam.WindowInto(s, b.windowFn, col, beam.AllowedLateness(b.lateness))
+}
+
+// Pane holds the elements of a TestStream expected in a pane of a window, once they are
+// aggregated with the default trigger.
+type Pane[T any] struct {
+	Window typex.Window
+	// Timing is typex.PaneOnTime for the elements added before the watermark passed the end of
+	// the window, and typex.PaneLate for the elements added after it, within the allowed
+	// lateness. Late elements may be spread over several late panes.
+	Timing   typex.PaneTiming
+	Elements []T
+}
+
+// Assert verifies that the elements of col in the pane, such as the values of a GroupByKey of
+// the TestStream, are the expected ones.
+func (p Pane[T]) Assert(s beam.Scope, col beam.PCollection) {
+	var inPane beam.PCollection
+	if p.Timing == typex.PaneLate {
+		inPane = passert.InLatePane(s, col, p.Window)
+	} else {
+		inPane = passert.InOnTimePane(s, col, p.Window)
+	}
+	passert.EqualsList(s, inPane, p.Elements)
+}
+
+// Panes derives the panes of each window that the elements are expected in, ordered by window
+// and timing, from the windowing of the Builder and the watermark at the time each element was
+// added. Elements later than the allowed lateness are dropped. Merging windows, such as
+// sessions, aren't supported.
+func (b *Builder[T]) Panes() ([]Pane[T], error) {
+	if b.windowFn.Kind == window.Sessions {
+		return nil, fmt.Errorf("teststream: panes of merging windows %v aren't supported", b.windowFn)
+	}
+	if _, err := b.Build(); err != nil {
+		return nil, err
+	}
+
+	var panes []Pane[T]
+	index := make(map[string]int)
+	for _, e := range b.elements {
+		windows := b.assignWindows(mtime.FromTime(e.Timestamp))
+		if e.Window != nil {
+			windows = []typex.Window{e.Window}
+		}
+		for _, w := range windows {
+			timing := typex.PaneOnTime
+			if e.watermark > w.MaxTimestamp() {
+				if e.watermark > w.MaxTimestamp().Add(b.lateness) {
+					continue
+				}
+				timing = typex.PaneLate
+			}
+
+			key := fmt.Sprintf("%v/%v", w, timing)
+			i, ok := index[key]
+			if !ok {
+				i = len(panes)
+				index[key] = i
+				panes = append(panes, Pane[T]{Window: w, Timing: timing})
+			}
+			panes[i].Elements = append(panes[i].Elements, e.Value)
+		}
+	}
+
+	sort.SliceStable(panes, func(i, j int) bool {
+		if si, sj := windowStart(panes[i].Window), windowStart(panes[j].Window); si != sj {
+			return si < sj
+		}
+		if mi, mj := panes[i].Window.MaxTimestamp(), panes[j].Window.MaxTimestamp(); mi != mj {
+			return mi < mj
+		}
+		return panes[i].Timing < panes[j].Timing
+	})
+	return panes, nil
+}
+
+func windowStart(w typex.Window) mtime.Time {
+	if iw, ok := w.(window.IntervalWindow); ok {
+		return iw.Start
+	}
+	return mtime.MinTimestamp
+}
+
+// assignWindows returns the windows of an element with the given timestamp.
+func (b *Builder[T]) assignWindows(ts mtime.Time) []typex.Window {
+	switch b.windowFn.Kind {
+	case window.FixedWindows:
+		size := mtime.FromDuration(b.windowFn.Size)
+		start := ts - mod(ts, size)
+		return []typex.Window{window.IntervalWindow{Start: start, End: start.Add(b.windowFn.Size)}}
+	case window.SlidingWindows:
+		var windows []typex.Window
+		period := mtime.FromDuration(b.windowFn.Period)
+		for start := ts - mod(ts, period); start > ts.Subtract(b.windowFn.Size); start -= period {
+			windows = append(windows, window.IntervalWindow{Start: start, End: start.Add(b.windowFn.Size)})
+		}
+		return windows
+	case window.Sessions:
+		return []typex.Window{window.IntervalWindow{Start: ts, End: ts.Add(b.windowFn.Gap)}}
+	default:
+		return window.SingleGlobalWindow
+	}
+}
+
+// mod returns the non-negative remainder of ts divided by d.
+func mod(ts, d mtime.Time) mtime.Time {
+	r := ts % d
+	if r < 0 {
+		r += d
+	}
+	return r
+}
+
+func containsWindow(windows []typex.Window, w typex.Window) bool {
+	for _, o := range windows {
+		if o.Equals(w) {
+			return true
+		}
+	}
+	return false
+}
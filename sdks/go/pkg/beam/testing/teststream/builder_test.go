@@ -0,0 +1,236 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package teststream
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+)
+
+func init() {
+	register.Function3x0(emitValues)
+	register.Iter1[string]()
+	register.Emitter1[string]()
+}
+
+func TestMain(m *testing.M) {
+	ptest.Main(m)
+}
+
+var start = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+func minuteWindow(minutes int) window.IntervalWindow {
+	s := mtime.FromTime(start.Add(time.Duration(minutes) * time.Minute))
+	return window.IntervalWindow{Start: s, End: s.Add(time.Minute)}
+}
+
+func TestBuilder_Build(t *testing.T) {
+	c, err := New[string]().
+		AddElements(start, "a", "b").
+		AdvanceWatermark(start.Add(time.Minute)).
+		AddTimestampedValues(TimestampedValue[string]{Value: "c", Timestamp: start.Add(time.Second)}).
+		AdvanceWatermarkBy(time.Minute).
+		AdvanceProcessingTime(time.Second).
+		AdvanceWatermarkToInfinity().
+		Build()
+	if err != nil {
+		t.Fatalf("Build() failed, got %v", err)
+	}
+	if got, want := c.elmType.Type(), reflect.TypeOf(""); got != want {
+		t.Errorf("element type is %v, want %v", got, want)
+	}
+
+	var got []int64
+	for _, e := range c.events {
+		switch {
+		case e.GetElementEvent() != nil:
+			for _, el := range e.GetElementEvent().GetElements() {
+				got = append(got, el.GetTimestamp())
+			}
+		case e.GetWatermarkEvent() != nil:
+			got = append(got, e.GetWatermarkEvent().GetNewWatermark())
+		case e.GetProcessingTimeEvent() != nil:
+			got = append(got, e.GetProcessingTimeEvent().GetAdvanceDuration())
+		}
+	}
+	ms := start.UnixMilli()
+	want := []int64{ms, ms, ms + 60000, ms + 1000, ms + 120000, 1000, mtime.MaxTimestamp.Milliseconds()}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("event timestamps mismatch, want %v, got %v", want, got)
+	}
+}
+
+func TestBuilder_Build_NoElements(t *testing.T) {
+	c, err := New[int64]().AdvanceWatermarkToInfinity().Build()
+	if err != nil {
+		t.Fatalf("Build() failed, got %v", err)
+	}
+	if got, want := c.elmType.Type(), reflect.TypeOf(int64(0)); got != want {
+		t.Errorf("element type is %v, want %v", got, want)
+	}
+}
+
+func TestBuilder_Build_Bad(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func() (Config, error)
+		want  string
+	}{
+		{
+			"watermark going back",
+			New[string]().AdvanceWatermark(start).AdvanceWatermark(start.Add(-time.Second)).Build,
+			"monotonally increasing",
+		},
+		{
+			"watermark advanced by before set",
+			New[string]().AdvanceWatermarkBy(time.Second).Build,
+			"must be advanced to a time before",
+		},
+		{
+			"watermark out of range",
+			New[string]().AdvanceWatermark(time.UnixMilli(mtime.MaxTimestamp.Milliseconds() + 1)).Build,
+			"out of range",
+		},
+		{
+			"no elements",
+			New[string]().AddElements(start).Build,
+			"at least one element",
+		},
+		{
+			"timestamp out of range",
+			New[string]().AddElements(time.UnixMilli(mtime.MaxTimestamp.Milliseconds()+1), "a").Build,
+			"out of range",
+		},
+		{
+			"window mismatch",
+			New[string]().WithWindowing(window.NewFixedWindows(time.Minute)).
+				AddTimestampedValues(TimestampedValue[string]{Value: "a", Timestamp: start, Window: minuteWindow(1)}).Build,
+			"isn't assigned to window",
+		},
+		{
+			"type without coder",
+			New[chan int]().AddElements(start, make(chan int)).Build,
+			"can't be encoded",
+		},
+		{
+			"negative allowed lateness",
+			New[string]().WithAllowedLateness(-time.Second).Build,
+			"must not be negative",
+		},
+	}
+	for _, tc := range tests {
+		_, err := tc.build()
+		if err == nil {
+			t.Errorf("%v: Build() succeeded when it should have failed", tc.name)
+			continue
+		}
+		if !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%v: Build() failed but got unexpected error message, got %v", tc.name, err)
+		}
+	}
+}
+
+func TestBuilder_Panes(t *testing.T) {
+	b := New[string]().
+		WithWindowing(window.NewFixedWindows(time.Minute)).
+		WithAllowedLateness(time.Minute).
+		AddElements(start, "a").
+		AddElements(start.Add(90*time.Second), "b").
+		AdvanceWatermark(start.Add(time.Minute)).
+		AddElements(start.Add(30*time.Second), "late").
+		AddElements(start.Add(70*time.Second), "c").
+		AdvanceWatermark(start.Add(3*time.Minute)).
+		AddElements(start, "dropped").
+		AdvanceWatermarkToInfinity()
+
+	got, err := b.Panes()
+	if err != nil {
+		t.Fatalf("Panes() failed, got %v", err)
+	}
+	want := []Pane[string]{
+		{Window: minuteWindow(0), Timing: typex.PaneOnTime, Elements: []string{"a"}},
+		{Window: minuteWindow(0), Timing: typex.PaneLate, Elements: []string{"late"}},
+		{Window: minuteWindow(1), Timing: typex.PaneOnTime, Elements: []string{"b", "c"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Panes() mismatch, want %+v, got %+v", want, got)
+	}
+}
+
+func TestBuilder_Panes_ExplicitWindow(t *testing.T) {
+	sliding := New[string]().
+		WithWindowing(window.NewSlidingWindows(time.Minute, 2*time.Minute)).
+		AddElements(start.Add(90*time.Second), "a").
+		AddTimestampedValues(TimestampedValue[string]{Value: "b", Timestamp: start.Add(90 * time.Second), Window: window.IntervalWindow{
+			Start: mtime.FromTime(start), End: mtime.FromTime(start.Add(2 * time.Minute)),
+		}})
+
+	got, err := sliding.Panes()
+	if err != nil {
+		t.Fatalf("Panes() failed, got %v", err)
+	}
+	var elements [][]string
+	for _, p := range got {
+		elements = append(elements, p.Elements)
+	}
+	if want := [][]string{{"a", "b"}, {"a"}}; !reflect.DeepEqual(elements, want) {
+		t.Errorf("Panes() elements mismatch, want %v, got %v", want, elements)
+	}
+}
+
+func TestBuilder_Panes_Sessions(t *testing.T) {
+	_, err := New[string]().WithWindowing(window.NewSessions(time.Minute)).AddElements(start, "a").Panes()
+	if err == nil {
+		t.Fatal("Panes() of sessions succeeded when it should have failed")
+	}
+}
+
+func emitValues(_ int, values func(*string) bool, emit func(string)) {
+	var v string
+	for values(&v) {
+		emit(v)
+	}
+}
+
+func TestBuilder_Create(t *testing.T) {
+	b := New[string]().
+		WithWindowing(window.NewFixedWindows(time.Minute)).
+		AddElements(start, "a", "b").
+		AddElements(start.Add(90*time.Second), "c").
+		AdvanceWatermarkToInfinity()
+
+	p, s := beam.NewPipelineWithRoot()
+	grouped := beam.GroupByKey(s, beam.AddFixedKey(s, b.Create(s)))
+	values := beam.ParDo(s, emitValues, grouped)
+
+	panes, err := b.Panes()
+	if err != nil {
+		t.Fatalf("Panes() failed, got %v", err)
+	}
+	for _, pane := range panes {
+		pane.Assert(s, values)
+	}
+	ptest.RunAndValidate(t, p)
+}
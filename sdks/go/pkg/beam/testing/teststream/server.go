@@ -0,0 +1,92 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package teststream
+
+import (
+	"fmt"
+	"net"
+
+	pipepb "github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/model/pipeline_v1"
+	"google.golang.org/grpc"
+)
+
+// Server is a TestStreamService that streams the events of a Config to the runner on demand,
+// for use with CreateWithEndpoint and CreateTaggedWithEndpoint. Each request streams the events
+// from the start, so a Server can be used by several pipelines.
+type Server struct {
+	pipepb.UnimplementedTestStreamServiceServer
+
+	lis        net.Listener
+	grpcServer *grpc.Server
+	events     []*pipepb.TestStreamPayload_Event
+}
+
+// NewServer starts a TestStreamService serving the events of the Config on a local port.
+// The service isn't authenticated, so it should be accessed in a trusted context.
+func NewServer(c Config) (*Server, error) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen for TestStreamService: %w", err)
+	}
+
+	s := &Server{lis: lis, grpcServer: grpc.NewServer(), events: c.events}
+	pipepb.RegisterTestStreamServiceServer(s.grpcServer, s)
+	go s.grpcServer.Serve(lis)
+	return s, nil
+}
+
+// Endpoint returns the address of the TestStreamService.
+func (s *Server) Endpoint() string {
+	return s.lis.Addr().String()
+}
+
+// Stop stops the TestStreamService, cancelling any requests in progress.
+func (s *Server) Stop() {
+	s.grpcServer.Stop()
+}
+
+// Events streams the events of the Config, implementing TestStreamService.Events. If the request
+// has output IDs, tagged element and watermark events of other tags are skipped. Untagged events
+// and processing time events are always streamed.
+func (s *Server) Events(req *pipepb.EventsRequest, stream pipepb.TestStreamService_EventsServer) error {
+	ids := make(map[string]bool)
+	for _, id := range req.GetOutputIds() {
+		ids[id] = true
+	}
+
+	for _, e := range s.events {
+		if tag := eventTag(e); tag != "" && len(ids) != 0 && !ids[tag] {
+			continue
+		}
+		if err := stream.Send(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// eventTag returns the tag of element and watermark events. Processing time events apply to
+// all outputs, so they have the empty tag.
+func eventTag(e *pipepb.TestStreamPayload_Event) string {
+	switch ev := e.GetEvent().(type) {
+	case *pipepb.TestStreamPayload_Event_ElementEvent:
+		return ev.ElementEvent.GetTag()
+	case *pipepb.TestStreamPayload_Event_WatermarkEvent:
+		return ev.WatermarkEvent.GetTag()
+	default:
+		return ""
+	}
+}
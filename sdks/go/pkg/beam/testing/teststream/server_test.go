@@ -0,0 +1,85 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package teststream
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	pipepb "github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/model/pipeline_v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func readEvents(t *testing.T, endpoint string, ids ...string) []*pipepb.TestStreamPayload_Event {
+	t.Helper()
+
+	conn, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to dial server, got %v", err)
+	}
+	defer conn.Close()
+
+	stream, err := pipepb.NewTestStreamServiceClient(conn).Events(context.Background(), &pipepb.EventsRequest{OutputIds: ids})
+	if err != nil {
+		t.Fatalf("failed to request events, got %v", err)
+	}
+	var events []*pipepb.TestStreamPayload_Event
+	for {
+		e, err := stream.Recv()
+		if err == io.EOF {
+			return events
+		}
+		if err != nil {
+			t.Fatalf("failed to receive events, got %v", err)
+		}
+		events = append(events, e)
+	}
+}
+
+func TestServer(t *testing.T) {
+	con := NewConfig()
+	con.AddTaggedElements("clicks", 100, "a")
+	con.AddTaggedElements("impressions", 100, "a", "b")
+	con.AdvanceProcessingTime(10)
+	con.AdvanceTaggedWatermark("impressions", 200)
+
+	srv, err := NewServer(con)
+	if err != nil {
+		t.Fatalf("failed to start server, got %v", err)
+	}
+	defer srv.Stop()
+
+	if got, want := len(readEvents(t, srv.Endpoint())), 4; got != want {
+		t.Errorf("server streamed %d events without output IDs, want %d", got, want)
+	}
+
+	// Events are streamed from the start on each request.
+	events := readEvents(t, srv.Endpoint(), "impressions")
+	if got, want := len(events), 3; got != want {
+		t.Fatalf("server streamed %d events for impressions, want %d", got, want)
+	}
+	if got := events[0].GetElementEvent().GetTag(); got != "impressions" {
+		t.Errorf("first event has tag %q, want impressions", got)
+	}
+	if events[1].GetProcessingTimeEvent() == nil {
+		t.Errorf("second event is %v, want the processing time event", events[1])
+	}
+	if got := events[2].GetWatermarkEvent().GetNewWatermark(); got != 200 {
+		t.Errorf("watermark event advances to %v, want 200", got)
+	}
+}
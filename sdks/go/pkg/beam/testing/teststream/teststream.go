@@ -18,9 +18,13 @@
 //
 // See https://beam.apache.org/blog/test-stream/ for more information.
 //
+// New returns a typed Builder of the events, which validates them when the TestStream is
+// created and derives the panes the elements are expected in, for use with passert.
+//
 // TestStream is supported on the Flink, and Prism runners.
 // Use on Flink currently supports int64, float64, and boolean types, while
-// Prism supports arbitrary types.
+// Prism supports arbitrary types. TestStreams with tagged outputs, and TestStreams
+// reading their events from a TestStreamService such as Server, are supported on Prism.
 package teststream
 
 import (
@@ -39,20 +43,27 @@ import (
 const urn = "beam:transform:teststream:v1"
 
 // Config holds information used to create a TestStreamPayload object.
+//
+// Events are either untagged, for a TestStream with a single output created by Create, or
+// tagged, for a TestStream with an output per tag created by CreateTagged. All outputs have
+// the same element type, since a TestStreamPayload has a single coder.
 type Config struct {
-	elmType   beam.FullType
-	events    []*pipepb.TestStreamPayload_Event
-	endpoint  *pipepb.ApiServiceDescriptor
-	watermark int64
+	elmType    beam.FullType
+	events     []*pipepb.TestStreamPayload_Event
+	endpoint   *pipepb.ApiServiceDescriptor
+	watermark  int64
+	tags       []string
+	watermarks map[string]int64
 }
 
 // NewConfig returns a Config to build a sequence of a test stream's events.
 // Requires that users provide the coder for the elements they are trying to emit.
 func NewConfig() Config {
 	return Config{elmType: nil,
-		events:    []*pipepb.TestStreamPayload_Event{},
-		endpoint:  &pipepb.ApiServiceDescriptor{},
-		watermark: mtime.MinTimestamp.Milliseconds(),
+		events:     []*pipepb.TestStreamPayload_Event{},
+		endpoint:   &pipepb.ApiServiceDescriptor{},
+		watermark:  mtime.MinTimestamp.Milliseconds(),
+		watermarks: map[string]int64{},
 	}
 }
 
@@ -70,22 +81,75 @@ func (c *Config) createPayload() *pipepb.TestStreamPayload {
 	return &pipepb.TestStreamPayload{CoderId: "c0", Events: c.events, Endpoint: c.endpoint}
 }
 
+// useTag records that the events of the given tag are used, and checks that untagged and
+// tagged events aren't mixed.
+func (c *Config) useTag(tag string) error {
+	for _, t := range c.tags {
+		if t == tag {
+			return nil
+		}
+		if t == "" || tag == "" {
+			return fmt.Errorf("untagged and tagged events can't be mixed, got tags %q and %q", t, tag)
+		}
+	}
+	c.tags = append(c.tags, tag)
+	return nil
+}
+
+// isTagged reports whether the Config holds tagged events.
+func (c *Config) isTagged() bool {
+	return len(c.tags) > 0 && c.tags[0] != ""
+}
+
 // AdvanceWatermark adds an event to the Config Events struct advancing the watermark for the PCollection
 // to the given timestamp. Timestamp is in milliseconds
 func (c *Config) AdvanceWatermark(timestamp int64) error {
+	if err := c.useTag(""); err != nil {
+		return err
+	}
 	if c.watermark >= timestamp {
 		return fmt.Errorf("watermark must be monotonally increasing, is at %v, got %v", c.watermark, timestamp)
 	}
-	watermarkAdvance := &pipepb.TestStreamPayload_Event_AdvanceWatermark{NewWatermark: timestamp}
-	watermarkEvent := &pipepb.TestStreamPayload_Event_WatermarkEvent{WatermarkEvent: watermarkAdvance}
-	c.events = append(c.events, &pipepb.TestStreamPayload_Event{Event: watermarkEvent})
+	c.addWatermarkEvent("", timestamp)
 	c.watermark = timestamp
 	return nil
 }
 
-// AdvanceWatermarkToInfinity advances the watermark to the maximum timestamp.
+// AdvanceTaggedWatermark adds an event advancing the watermark of the output with the given tag
+// to the given timestamp, in milliseconds. The watermarks of the other outputs are unaffected.
+func (c *Config) AdvanceTaggedWatermark(tag string, timestamp int64) error {
+	if tag == "" {
+		return fmt.Errorf("tag must not be empty, use AdvanceWatermark for untagged events")
+	}
+	if err := c.useTag(tag); err != nil {
+		return err
+	}
+	if w, ok := c.watermarks[tag]; ok && w >= timestamp {
+		return fmt.Errorf("watermark of tag %q must be monotonally increasing, is at %v, got %v", tag, w, timestamp)
+	}
+	c.addWatermarkEvent(tag, timestamp)
+	c.watermarks[tag] = timestamp
+	return nil
+}
+
+func (c *Config) addWatermarkEvent(tag string, timestamp int64) {
+	watermarkAdvance := &pipepb.TestStreamPayload_Event_AdvanceWatermark{NewWatermark: timestamp, Tag: tag}
+	watermarkEvent := &pipepb.TestStreamPayload_Event_WatermarkEvent{WatermarkEvent: watermarkAdvance}
+	c.events = append(c.events, &pipepb.TestStreamPayload_Event{Event: watermarkEvent})
+}
+
+// AdvanceWatermarkToInfinity advances the watermark to the maximum timestamp. For tagged events,
+// the watermarks of all the outputs used so far are advanced.
 func (c *Config) AdvanceWatermarkToInfinity() error {
-	return c.AdvanceWatermark(mtime.MaxTimestamp.Milliseconds())
+	if !c.isTagged() {
+		return c.AdvanceWatermark(mtime.MaxTimestamp.Milliseconds())
+	}
+	for _, tag := range c.tags {
+		if err := c.AdvanceTaggedWatermark(tag, mtime.MaxTimestamp.Milliseconds()); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // AdvanceProcessingTime adds an event advancing the processing time by a given duration.
@@ -110,6 +174,33 @@ func (c *Config) AdvanceProcessingTimeToInfinity() {
 //
 // Element types must have built-in coders in Beam.
 func (c *Config) AddElements(timestamp int64, elements ...any) error {
+	return c.addElements("", sameTimestamps(timestamp, len(elements)), elements)
+}
+
+// AddTaggedElements adds a number of elements to the output with the given tag at the specified
+// event timestamp. Must be called with at least one element.
+//
+// Elements of all tags must be of the same type, which is inferred on the first call.
+func (c *Config) AddTaggedElements(tag string, timestamp int64, elements ...any) error {
+	if tag == "" {
+		return fmt.Errorf("tag must not be empty, use AddElements for untagged events")
+	}
+	return c.addElements(tag, sameTimestamps(timestamp, len(elements)), elements)
+}
+
+func sameTimestamps(timestamp int64, n int) []int64 {
+	timestamps := make([]int64, n)
+	for i := range timestamps {
+		timestamps[i] = timestamp
+	}
+	return timestamps
+}
+
+// addElements adds an event of the elements with the given timestamps to the output with the tag.
+func (c *Config) addElements(tag string, timestamps []int64, elements []any) error {
+	if len(elements) == 0 {
+		return fmt.Errorf("at least one element must be added")
+	}
 	t := reflect.TypeOf(elements[0])
 	if c.elmType == nil {
 		c.elmType = typex.New(t)
@@ -121,16 +212,19 @@ func (c *Config) AddElements(timestamp int64, elements ...any) error {
 			return fmt.Errorf("element %d was type %T, previous additions were of type %v", i, ele, c.elmType)
 		}
 	}
+	if err := c.useTag(tag); err != nil {
+		return err
+	}
 	newElements := []*pipepb.TestStreamPayload_TimestampedElement{}
 	enc := beam.NewElementEncoder(t)
-	for _, e := range elements {
+	for i, e := range elements {
 		var buf bytes.Buffer
 		if err := enc.Encode(e, &buf); err != nil {
 			return fmt.Errorf("encoding value %v failed, got %v", e, err)
 		}
-		newElements = append(newElements, &pipepb.TestStreamPayload_TimestampedElement{EncodedElement: buf.Bytes(), Timestamp: timestamp})
+		newElements = append(newElements, &pipepb.TestStreamPayload_TimestampedElement{EncodedElement: buf.Bytes(), Timestamp: timestamps[i]})
 	}
-	addElementsEvent := &pipepb.TestStreamPayload_Event_AddElements{Elements: newElements}
+	addElementsEvent := &pipepb.TestStreamPayload_Event_AddElements{Elements: newElements, Tag: tag}
 	elementEvent := &pipepb.TestStreamPayload_Event_ElementEvent{ElementEvent: addElementsEvent}
 	c.events = append(c.events, &pipepb.TestStreamPayload_Event{Event: elementEvent})
 	return nil
@@ -143,22 +237,43 @@ func (c *Config) AddElements(timestamp int64, elements ...any) error {
 //
 // Element types must have built-in coders in Beam.
 func (c *Config) AddElementList(timestamp int64, elements any) error {
+	inputs, err := toList(elements)
+	if err != nil {
+		return err
+	}
+	return c.AddElements(timestamp, inputs...)
+}
+
+// AddTaggedElementList inserts a slice of elements into the output with the given tag at the
+// specified event timestamp. Must be called with at least one element.
+func (c *Config) AddTaggedElementList(tag string, timestamp int64, elements any) error {
+	inputs, err := toList(elements)
+	if err != nil {
+		return err
+	}
+	return c.AddTaggedElements(tag, timestamp, inputs...)
+}
+
+func toList(elements any) ([]any, error) {
 	val := reflect.ValueOf(elements)
 	if val.Kind() != reflect.Slice && val.Kind() != reflect.Array {
-		return fmt.Errorf("input %v must be a slice or array", elements)
+		return nil, fmt.Errorf("input %v must be a slice or array", elements)
 	}
 
 	var inputs []any
 	for i := 0; i < val.Len(); i++ {
 		inputs = append(inputs, val.Index(i).Interface())
 	}
-	return c.AddElements(timestamp, inputs...)
+	return inputs, nil
 }
 
 // Create inserts a TestStream primitive into a pipeline, taking a scope and a Config object and
 // producing an output PCollection. The TestStream must be the first PTransform in the
 // pipeline.
 func Create(s beam.Scope, c Config) beam.PCollection {
+	if c.isTagged() {
+		panic(fmt.Sprintf("teststream.Create: Config has tagged events for %v, use CreateTagged", c.tags))
+	}
 	pyld := protox.MustEncode(c.createPayload())
 	outputs := []beam.FullType{c.elmType}
 
@@ -168,6 +283,45 @@ func Create(s beam.Scope, c Config) beam.PCollection {
 	return output[0]
 }
 
+// CreateTagged inserts a TestStream primitive with an output PCollection per tag into a pipeline,
+// taking a scope and a Config object of tagged events, and returning the output PCollections by tag.
+// The outputs are the tags used by the Config and any additional tags passed in, which allows
+// outputs without events. The TestStream must be the first PTransform in the pipeline.
+//
+// For example, to drive clicks and impressions with interleaved watermarks:
+//
+//	con := teststream.NewConfig()
+//	con.AddTaggedElements("impressions", 100, "ad1", "ad2")
+//	con.AddTaggedElements("clicks", 150, "ad1")
+//	con.AdvanceTaggedWatermark("impressions", 200)
+//	con.AddTaggedElements("clicks", 250, "ad2")
+//	con.AdvanceWatermarkToInfinity()
+//
+//	outputs := teststream.CreateTagged(s, con)
+//	clicks, impressions := outputs["clicks"], outputs["impressions"]
+func CreateTagged(s beam.Scope, c Config, tags ...string) map[string]beam.PCollection {
+	for _, tag := range tags {
+		if tag == "" {
+			panic("teststream.CreateTagged: tags must not be empty")
+		}
+		if err := c.useTag(tag); err != nil {
+			panic(fmt.Sprintf("teststream.CreateTagged: %v", err))
+		}
+	}
+	if !c.isTagged() {
+		panic("teststream.CreateTagged: Config has no tagged events, use Create")
+	}
+	if c.elmType == nil {
+		panic("teststream.CreateTagged: Config has no elements to infer the element type")
+	}
+	pyld := protox.MustEncode(c.createPayload())
+	outputs := make(map[string]beam.FullType)
+	for _, tag := range c.tags {
+		outputs[tag] = c.elmType
+	}
+	return beam.ExternalTagged(s, urn, pyld, nil, outputs, false)
+}
+
 // CreateWithEndpoint inserts a TestStream primitive into a pipeline, taking a scope, a url to a
 // TestStreamService, and a FullType object describing the elements that will be returned by the
 // TestStreamService. Authentication is currently not supported, so the service the URL points to
@@ -178,3 +332,13 @@ func CreateWithEndpoint(s beam.Scope, url string, elementType beam.FullType) bea
 	c.elmType = elementType
 	return Create(s, c)
 }
+
+// CreateTaggedWithEndpoint inserts a TestStream primitive with an output PCollection per tag into
+// a pipeline, taking a scope, a url to a TestStreamService, a FullType object describing the
+// elements of all outputs, and the tags of the outputs. See CreateWithEndpoint.
+func CreateTaggedWithEndpoint(s beam.Scope, url string, elementType beam.FullType, tags ...string) map[string]beam.PCollection {
+	c := NewConfig()
+	c.setEndpoint(url)
+	c.elmType = elementType
+	return CreateTagged(s, c, tags...)
+}
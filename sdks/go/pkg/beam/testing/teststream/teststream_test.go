@@ -17,6 +17,7 @@ package teststream
 
 import (
 	"bytes"
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
@@ -170,3 +171,117 @@ func TestAddElementList_Bad(t *testing.T) {
 		t.Errorf("pipeline failed but got unexpected error message, got %v", err)
 	}
 }
+
+func TestAddTaggedElements(t *testing.T) {
+	con := NewConfig()
+	if err := con.AddTaggedElements("clicks", 100, "a"); err != nil {
+		t.Fatalf("failed to add clicks, got %v", err)
+	}
+	if err := con.AddTaggedElementList("impressions", 100, []string{"a", "b"}); err != nil {
+		t.Fatalf("failed to add impressions, got %v", err)
+	}
+	if err := con.AdvanceTaggedWatermark("clicks", 200); err != nil {
+		t.Fatalf("failed to advance clicks watermark, got %v", err)
+	}
+	if err := con.AdvanceTaggedWatermark("impressions", 150); err != nil {
+		t.Fatalf("failed to advance impressions watermark, got %v", err)
+	}
+	if err := con.AdvanceWatermarkToInfinity(); err != nil {
+		t.Fatalf("failed to advance watermarks to infinity, got %v", err)
+	}
+
+	var got []string
+	for _, e := range con.events {
+		switch {
+		case e.GetElementEvent() != nil:
+			got = append(got, fmt.Sprintf("elements %v: %d", e.GetElementEvent().GetTag(), len(e.GetElementEvent().GetElements())))
+		case e.GetWatermarkEvent() != nil:
+			got = append(got, fmt.Sprintf("watermark %v", e.GetWatermarkEvent().GetTag()))
+		}
+	}
+	want := []string{
+		"elements clicks: 1",
+		"elements impressions: 2",
+		"watermark clicks",
+		"watermark impressions",
+		"watermark clicks",
+		"watermark impressions",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("events mismatch, want %v, got %v", want, got)
+	}
+}
+
+func TestAddTaggedElements_Bad(t *testing.T) {
+	tests := []struct {
+		name   string
+		events func(con *Config) error
+		want   string
+	}{
+		{
+			"empty tag",
+			func(con *Config) error { return con.AddTaggedElements("", 100, "a") },
+			"tag must not be empty",
+		},
+		{
+			"mixed with untagged elements",
+			func(con *Config) error {
+				con.AddElements(100, "a")
+				return con.AddTaggedElements("clicks", 100, "a")
+			},
+			"can't be mixed",
+		},
+		{
+			"mixed with untagged watermark",
+			func(con *Config) error {
+				con.AddTaggedElements("clicks", 100, "a")
+				return con.AdvanceWatermark(200)
+			},
+			"can't be mixed",
+		},
+		{
+			"type mismatch across tags",
+			func(con *Config) error {
+				con.AddTaggedElements("clicks", 100, "a")
+				return con.AddTaggedElements("impressions", 100, 1)
+			},
+			"element type mismatch",
+		},
+		{
+			"watermark going back",
+			func(con *Config) error {
+				con.AdvanceTaggedWatermark("clicks", 200)
+				return con.AdvanceTaggedWatermark("clicks", 100)
+			},
+			"monotonally increasing",
+		},
+	}
+	for _, tc := range tests {
+		con := NewConfig()
+		err := tc.events(&con)
+		if err == nil {
+			t.Errorf("%v: succeeded when it should have failed", tc.name)
+			continue
+		}
+		if !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%v: failed but got unexpected error message, got %v", tc.name, err)
+		}
+	}
+}
+
+func TestCreateTagged(t *testing.T) {
+	con := NewConfig()
+	con.AddTaggedElements("clicks", 100, "a")
+	con.AdvanceWatermarkToInfinity()
+
+	_, s := beam.NewPipelineWithRoot()
+	outputs := CreateTagged(s, con, "impressions")
+	if len(outputs) != 2 {
+		t.Fatalf("CreateTagged returned %d outputs, want clicks and impressions", len(outputs))
+	}
+	for _, tag := range []string{"clicks", "impressions"} {
+		if col, ok := outputs[tag]; !ok || col.Type().Type() != reflect.TypeOf("") {
+			t.Errorf("output %v = %v, want a PCollection<string>", tag, col)
+		}
+	}
+}
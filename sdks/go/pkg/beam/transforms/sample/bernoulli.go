@@ -0,0 +1,117 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sample
+
+import (
+	"fmt"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+func init() {
+	register.DoFn2x1[beam.T, func(beam.T), error]((*bernoulliFn)(nil))
+	register.DoFn3x1[beam.X, beam.Y, func(beam.X, beam.Y), error]((*bernoulliKVFn)(nil))
+	register.Emitter1[beam.T]()
+	register.Emitter2[beam.X, beam.Y]()
+}
+
+// Bernoulli returns a sample of a PCollection<A> or PCollection<KV<A,B>>,
+// containing each element independently with the given probability. The
+// sample has fraction times as many elements as the input in expectation.
+//
+// For example:
+//
+//	col := beam.CreateList(s, values)
+//	tenth := sample.Bernoulli(s, col, 0.1)
+func Bernoulli(s beam.Scope, col beam.PCollection, fraction float64, opts ...Option) beam.PCollection {
+	s = s.Scope("sample.Bernoulli")
+
+	if !(fraction >= 0 && fraction <= 1) {
+		panic(fmt.Sprintf("fraction must be in [0, 1]: %v", fraction))
+	}
+	o := makeOptions(opts)
+	if typex.IsKV(col.Type()) {
+		k, v := beam.ValidateKVType(col)
+		fn := &bernoulliKVFn{Fraction: fraction, Seed: o.seed, Seeded: o.seeded, KeyType: beam.EncodedType{T: k.Type()}, ValueType: beam.EncodedType{T: v.Type()}}
+		return beam.ParDo(s, fn, col)
+	}
+	t := beam.ValidateNonCompositeType(col)
+	fn := &bernoulliFn{Fraction: fraction, Seed: o.seed, Seeded: o.seeded, Type: beam.EncodedType{T: t.Type()}}
+	return beam.ParDo(s, fn, col)
+}
+
+// bernoulliFn emits each element with probability Fraction.
+type bernoulliFn struct {
+	Fraction float64          `json:"fraction"`
+	Seed     int64            `json:"seed"`
+	Seeded   bool             `json:"seeded"`
+	Type     beam.EncodedType `json:"type"`
+
+	enc   beam.ElementEncoder
+	state uint64
+}
+
+func (f *bernoulliFn) Setup() {
+	f.enc = beam.NewElementEncoder(f.Type.T)
+	f.state = initialState(f.Seed, f.Seeded)
+}
+
+func (f *bernoulliFn) ProcessElement(elm beam.T, emit func(beam.T)) error {
+	b, err := encode(f.enc, elm)
+	if err != nil {
+		return errors.WithContextf(err, "sample.Bernoulli: marshalling %v", elm)
+	}
+	if uniform(&f.state, b) <= f.Fraction {
+		emit(elm)
+	}
+	return nil
+}
+
+// bernoulliKVFn emits each key value pair with probability Fraction.
+type bernoulliKVFn struct {
+	Fraction  float64          `json:"fraction"`
+	Seed      int64            `json:"seed"`
+	Seeded    bool             `json:"seeded"`
+	KeyType   beam.EncodedType `json:"keyType"`
+	ValueType beam.EncodedType `json:"valueType"`
+
+	keyEnc, valueEnc beam.ElementEncoder
+	state            uint64
+}
+
+func (f *bernoulliKVFn) Setup() {
+	f.keyEnc = beam.NewElementEncoder(f.KeyType.T)
+	f.valueEnc = beam.NewElementEncoder(f.ValueType.T)
+	f.state = initialState(f.Seed, f.Seeded)
+}
+
+func (f *bernoulliKVFn) ProcessElement(k beam.X, v beam.Y, emit func(beam.X, beam.Y)) error {
+	kb, err := encode(f.keyEnc, k)
+	if err != nil {
+		return errors.WithContextf(err, "sample.Bernoulli: marshalling %v", k)
+	}
+	vb, err := encode(f.valueEnc, v)
+	if err != nil {
+		return errors.WithContextf(err, "sample.Bernoulli: marshalling %v", v)
+	}
+	if uniform(&f.state, append(kb, vb...)) <= f.Fraction {
+		emit(k, v)
+	}
+	return nil
+}
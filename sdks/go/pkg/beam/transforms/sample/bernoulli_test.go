@@ -0,0 +1,102 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sample
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/util/reflectx"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+)
+
+func TestMain(m *testing.M) {
+	ptest.Main(m)
+}
+
+func init() {
+	register.Function1x2(squareKV)
+}
+
+func squareKV(v int) (int, int) {
+	return v, v * v
+}
+
+func count(fn *bernoulliFn, values []int) int {
+	n := 0
+	for _, v := range values {
+		if err := fn.ProcessElement(v, func(beam.T) { n++ }); err != nil {
+			panic(err)
+		}
+	}
+	return n
+}
+
+func ints(n int) []int {
+	var values []int
+	for i := 0; i < n; i++ {
+		values = append(values, i)
+	}
+	return values
+}
+
+// TestBernoulliFn verifies that about the given fraction of elements is
+// kept, and that seeded samples are deterministic.
+func TestBernoulliFn(t *testing.T) {
+	values := ints(10000)
+	for _, fraction := range []float64{0, 0.01, 0.3, 0.5, 1} {
+		fn := &bernoulliFn{Fraction: fraction, Seed: 42, Seeded: true, Type: beam.EncodedType{T: reflectx.Int}}
+		fn.Setup()
+		got := count(fn, values)
+		if want := fraction * float64(len(values)); math.Abs(float64(got)-want) > 4*math.Sqrt(want*(1-fraction))+0.5 {
+			t.Errorf("Bernoulli(%v) kept %v of %v elements, want about %v", fraction, got, len(values), want)
+		}
+
+		again := &bernoulliFn{Fraction: fraction, Seed: 42, Seeded: true, Type: beam.EncodedType{T: reflectx.Int}}
+		again.Setup()
+		if n := count(again, values); n != got {
+			t.Errorf("Bernoulli(%v) with the same seed kept %v elements, want %v", fraction, n, got)
+		}
+	}
+}
+
+func TestBernoulli(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.Create(s, 1, 2, 3, 4, 5)
+	passert.Equals(s, Bernoulli(s, col, 1), 1, 2, 3, 4, 5)
+	passert.Empty(s, Bernoulli(s, col, 0, WithSeed(1)))
+	passert.Count(s, Bernoulli(s, beam.ParDo(s, squareKV, col), 1), "kvs", 5)
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestBernoulli_invalidFraction(t *testing.T) {
+	for _, fraction := range []float64{-0.1, 1.1, math.NaN()} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Bernoulli(%v) should panic", fraction)
+				}
+			}()
+
+			_, s := beam.NewPipelineWithRoot()
+			Bernoulli(s, beam.Create(s, 1), fraction)
+		}()
+	}
+}
@@ -0,0 +1,307 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sample
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/funcx"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/util/reflectx"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+func init() {
+	reservoirType := reflect.TypeOf((**reservoir)(nil)).Elem()
+	beam.RegisterType(reservoirType)
+	beam.RegisterCoder(reservoirType, encodeReservoir, decodeReservoir)
+	register.Combiner3[*reservoir, beam.T, []beam.T]((*uniformFn)(nil))
+	register.Combiner3[*reservoir, beam.T, []beam.T]((*weightedFn)(nil))
+}
+
+var (
+	weightSig = &funcx.Signature{Args: []reflect.Type{beam.TType}, Return: []reflect.Type{reflectx.Float64}} // T -> float64
+)
+
+// FixedSize returns a uniform random sample of N elements of a
+// PCollection<T>, without replacement. It returns a single-element
+// PCollection<[]T> per window, with all elements if there are at most N.
+//
+// Each element is kept with its own random priority, so FixedSize needs
+// memory proportional to N only and combines in parallel.
+//
+// Example use:
+//
+//	col := beam.CreateList(s, values)
+//	sample := sample.FixedSize(s, col, 100)  // PCollection<[]T> with 100 of the values.
+func FixedSize(s beam.Scope, col beam.PCollection, n int, opts ...Option) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("sample.FixedSize(%v)", n))
+
+	t := beam.ValidateNonCompositeType(col)
+	return beam.Combine(s, &uniformFn{newReservoirFn(n, t.Type(), opts)}, col)
+}
+
+// FixedSizePerKey returns a uniform random sample of N values for each key of
+// a PCollection<KV<K,T>>, without replacement. It returns a
+// PCollection<KV<K,[]T>>.
+func FixedSizePerKey(s beam.Scope, col beam.PCollection, n int, opts ...Option) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("sample.FixedSizePerKey(%v)", n))
+
+	_, t := beam.ValidateKVType(col)
+	return beam.CombinePerKey(s, &uniformFn{newReservoirFn(n, t.Type(), opts)}, col)
+}
+
+// Weighted returns a random sample of N elements of a PCollection<T>,
+// without replacement, where the weight of each element is given by
+// weight : T -> float64. It returns a single-element PCollection<[]T> per
+// window.
+//
+// An element is more likely to be sampled the larger its weight is relative
+// to the other elements. Elements with a weight that is not positive are
+// never sampled. Weighted uses the A-Res algorithm of Efraimidis and
+// Spirakis, which needs memory proportional to N only.
+//
+// Example use:
+//
+//	sample := sample.Weighted(s, orders, 10, orderTotal)  // 10 orders, favouring large ones.
+func Weighted(s beam.Scope, col beam.PCollection, n int, weight any, opts ...Option) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("sample.Weighted(%v)", n))
+
+	t := beam.ValidateNonCompositeType(col)
+	return beam.Combine(s, newWeightedFn(n, t.Type(), weight, opts), col)
+}
+
+// WeightedPerKey returns a weighted random sample of N values for each key
+// of a PCollection<KV<K,T>>, without replacement, where the weight of each
+// value is given by weight : T -> float64. It returns a
+// PCollection<KV<K,[]T>>.
+func WeightedPerKey(s beam.Scope, col beam.PCollection, n int, weight any, opts ...Option) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("sample.WeightedPerKey(%v)", n))
+
+	_, t := beam.ValidateKVType(col)
+	return beam.CombinePerKey(s, newWeightedFn(n, t.Type(), weight, opts), col)
+}
+
+func newReservoirFn(n int, t reflect.Type, opts []Option) reservoirFn {
+	if n < 1 {
+		panic("n must be > 0")
+	}
+	o := makeOptions(opts)
+	fn := reservoirFn{N: n, Seed: o.seed, Seeded: o.seeded, Type: beam.EncodedType{T: t}}
+	// Running Setup at pipeline construction validates that T is encodable.
+	fn.Setup()
+	return fn
+}
+
+func newWeightedFn(n int, t reflect.Type, weight any, opts []Option) *weightedFn {
+	funcx.MustSatisfy(weight, funcx.Replace(weightSig, beam.TType, t))
+	return &weightedFn{reservoirFn: newReservoirFn(n, t, opts), Weight: beam.EncodedFunc{Fn: reflectx.MakeFunc(weight)}}
+}
+
+// item is an encoded element with its random sampling priority. A reservoir
+// keeps the items with the smallest priorities.
+type item struct {
+	Priority float64
+	Elem     []byte
+}
+
+// reservoir holds up to n items in a max-heap by priority, so that the item
+// to evict is at the root.
+type reservoir struct {
+	n     int
+	items []item
+}
+
+func newReservoir(n int) *reservoir {
+	return &reservoir{n: n}
+}
+
+func (r *reservoir) Len() int           { return len(r.items) }
+func (r *reservoir) Less(i, j int) bool { return r.items[i].Priority > r.items[j].Priority }
+func (r *reservoir) Swap(i, j int)      { r.items[i], r.items[j] = r.items[j], r.items[i] }
+func (r *reservoir) Push(x any)         { r.items = append(r.items, x.(item)) }
+func (r *reservoir) Pop() any {
+	old := r.items
+	it := old[len(old)-1]
+	r.items = old[:len(old)-1]
+	return it
+}
+
+// add offers an item to the reservoir, evicting the item with the largest
+// priority if the reservoir is full.
+func (r *reservoir) add(it item) {
+	switch {
+	case len(r.items) < r.n:
+		heap.Push(r, it)
+	case it.Priority < r.items[0].Priority:
+		r.items[0] = it
+		heap.Fix(r, 0)
+	}
+}
+
+// merge adds the items of other to the reservoir. Since the priorities are
+// independent of the order of the elements, the result is a sample of the
+// union of both inputs.
+func (r *reservoir) merge(other *reservoir) *reservoir {
+	for _, it := range other.items {
+		r.add(it)
+	}
+	return r
+}
+
+// sorted returns the items by increasing priority.
+func (r *reservoir) sorted() []item {
+	list := append([]item(nil), r.items...)
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Priority < list[j].Priority
+	})
+	return list
+}
+
+func encodeReservoir(r *reservoir) ([]byte, error) {
+	var b []byte
+	b = binary.AppendUvarint(b, uint64(r.n))
+	b = binary.AppendUvarint(b, uint64(len(r.items)))
+	for _, it := range r.items {
+		b = binary.BigEndian.AppendUint64(b, math.Float64bits(it.Priority))
+		b = binary.AppendUvarint(b, uint64(len(it.Elem)))
+		b = append(b, it.Elem...)
+	}
+	return b, nil
+}
+
+func decodeReservoir(data []byte) (*reservoir, error) {
+	rd := bytes.NewReader(data)
+	n, err := binary.ReadUvarint(rd)
+	if err != nil {
+		return nil, errors.WithContext(err, "sample.reservoir: decoding capacity")
+	}
+	size, err := binary.ReadUvarint(rd)
+	if err != nil {
+		return nil, errors.WithContext(err, "sample.reservoir: decoding size")
+	}
+	if size > n || size > uint64(len(data)) {
+		return nil, errors.Errorf("sample.reservoir: invalid size %v for capacity %v", size, n)
+	}
+	r := &reservoir{n: int(n), items: make([]item, size)}
+	var priority [8]byte
+	for i := range r.items {
+		if _, err := io.ReadFull(rd, priority[:]); err != nil {
+			return nil, errors.WithContextf(err, "sample.reservoir: decoding item %v", i)
+		}
+		r.items[i].Priority = math.Float64frombits(binary.BigEndian.Uint64(priority[:]))
+		l, err := binary.ReadUvarint(rd)
+		if err != nil {
+			return nil, errors.WithContextf(err, "sample.reservoir: decoding item %v", i)
+		}
+		if l > uint64(rd.Len()) {
+			return nil, errors.Errorf("sample.reservoir: invalid element size %v", l)
+		}
+		r.items[i].Elem = make([]byte, l)
+		if _, err := io.ReadFull(rd, r.items[i].Elem); err != nil {
+			return nil, errors.WithContextf(err, "sample.reservoir: decoding item %v", i)
+		}
+	}
+	heap.Init(r)
+	return r, nil
+}
+
+// reservoirFn is the base of the CombineFns that maintain reservoirs of
+// encoded elements of the underlying type, T.
+type reservoirFn struct {
+	// N is the sample size.
+	N int `json:"n"`
+	// Seed is the seed of the random priorities, if Seeded.
+	Seed   int64 `json:"seed"`
+	Seeded bool  `json:"seeded"`
+	// Type is the element type T.
+	Type beam.EncodedType `json:"type"`
+
+	enc   beam.ElementEncoder
+	state uint64
+}
+
+func (f *reservoirFn) Setup() {
+	f.enc = beam.NewElementEncoder(f.Type.T)
+	f.state = initialState(f.Seed, f.Seeded)
+}
+
+func (f *reservoirFn) CreateAccumulator() *reservoir {
+	return newReservoir(f.N)
+}
+
+// add offers an element with the given weight to the reservoir.
+func (f *reservoirFn) add(r *reservoir, val beam.T, weight float64) (*reservoir, error) {
+	if !(weight > 0) {
+		return r, nil
+	}
+	b, err := encode(f.enc, val)
+	if err != nil {
+		return nil, errors.WithContextf(err, "sample: marshalling %v", val)
+	}
+	r.add(item{Priority: exponential(uniform(&f.state, b), weight), Elem: b})
+	return r, nil
+}
+
+func (f *reservoirFn) MergeAccumulators(a, b *reservoir) *reservoir {
+	return a.merge(b)
+}
+
+func (f *reservoirFn) ExtractOutput(r *reservoir) ([]beam.T, error) {
+	dec := beam.NewElementDecoder(f.Type.T)
+	var ret []beam.T
+	for _, it := range r.sorted() {
+		elm, err := dec.Decode(bytes.NewBuffer(it.Elem))
+		if err != nil {
+			return nil, errors.WithContext(err, "sample: unmarshalling")
+		}
+		ret = append(ret, elm)
+	}
+	return ret, nil
+}
+
+// uniformFn is a CombineFn that samples elements uniformly.
+type uniformFn struct {
+	reservoirFn
+}
+
+func (f *uniformFn) AddInput(r *reservoir, val beam.T) (*reservoir, error) {
+	return f.add(r, val, 1)
+}
+
+// weightedFn is a CombineFn that samples elements by their Weight.
+type weightedFn struct {
+	reservoirFn
+	// Weight is the weight function, T -> float64.
+	Weight beam.EncodedFunc `json:"weight"`
+
+	weight reflectx.Func1x1
+}
+
+func (f *weightedFn) AddInput(r *reservoir, val beam.T) (*reservoir, error) {
+	if f.weight == nil {
+		f.weight = reflectx.ToFunc1x1(f.Weight.Fn)
+	}
+	return f.add(r, val, f.weight.Call1x1(val).(float64))
+}
@@ -0,0 +1,266 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sample
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/util/reflectx"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+)
+
+func init() {
+	register.Function1x1(sortInts)
+	register.Function1x1(sampleSize)
+	register.Function1x1(weightOf)
+	register.Function1x2(modKey)
+	register.Function2x0(timestampMinutes)
+	register.Emitter2[beam.EventTime, int]()
+}
+
+func sortInts(values []int) []int {
+	sort.Ints(values)
+	return values
+}
+
+func sampleSize(values []int) int {
+	return len(values)
+}
+
+// weightOf weighs even values by themselves, and never samples odd values.
+func weightOf(v int) float64 {
+	if v%2 != 0 {
+		return 0
+	}
+	return float64(v)
+}
+
+func modKey(v int) (int, int) {
+	return v % 3, v
+}
+
+// timestampMinutes timestamps each value with that many minutes after the epoch.
+func timestampMinutes(v int, emit func(beam.EventTime, int)) {
+	emit(mtime.FromDuration(time.Duration(v)*time.Minute), v)
+}
+
+func newUniformFn(n int, opts ...Option) *uniformFn {
+	return &uniformFn{newReservoirFn(n, reflectx.Int, opts)}
+}
+
+func load(t *testing.T, fn interface {
+	CreateAccumulator() *reservoir
+	AddInput(*reservoir, beam.T) (*reservoir, error)
+}, values ...int) *reservoir {
+	t.Helper()
+	r := fn.CreateAccumulator()
+	for _, v := range values {
+		var err error
+		if r, err = fn.AddInput(r, v); err != nil {
+			t.Fatalf("AddInput(%v) failed: %v", v, err)
+		}
+	}
+	return r
+}
+
+func output(t *testing.T, fn *reservoirFn, r *reservoir) []int {
+	t.Helper()
+	out, err := fn.ExtractOutput(r)
+	if err != nil {
+		t.Fatalf("ExtractOutput failed: %v", err)
+	}
+	var ret []int
+	for _, v := range out {
+		ret = append(ret, v.(int))
+	}
+	return ret
+}
+
+func roundTrip(t *testing.T, r *reservoir) *reservoir {
+	t.Helper()
+	b, err := encodeReservoir(r)
+	if err != nil {
+		t.Fatalf("encodeReservoir failed: %v", err)
+	}
+	ret, err := decodeReservoir(b)
+	if err != nil {
+		t.Fatalf("decodeReservoir failed: %v", err)
+	}
+	return ret
+}
+
+// TestUniformFn verifies that the sample holds N distinct input elements,
+// and is deterministic for a seed.
+func TestUniformFn(t *testing.T) {
+	values := ints(1000)
+	fn := newUniformFn(10, WithSeed(7))
+	got := output(t, &fn.reservoirFn, load(t, fn, values...))
+	if len(got) != 10 {
+		t.Fatalf("FixedSize(10) = %v, want 10 elements", got)
+	}
+	seen := make(map[int]bool)
+	for _, v := range got {
+		if v < 0 || v >= len(values) || seen[v] {
+			t.Errorf("FixedSize(10) = %v, want distinct input elements", got)
+		}
+		seen[v] = true
+	}
+
+	again := newUniformFn(10, WithSeed(7))
+	if other := output(t, &again.reservoirFn, load(t, again, values...)); !reflect.DeepEqual(other, got) {
+		t.Errorf("FixedSize(10) with the same seed = %v, want %v", other, got)
+	}
+	other := newUniformFn(10, WithSeed(8))
+	if other := output(t, &other.reservoirFn, load(t, other, values...)); reflect.DeepEqual(other, got) {
+		t.Errorf("FixedSize(10) with another seed = %v, want a different sample", other)
+	}
+
+	small := newUniformFn(10)
+	if got := sortInts(output(t, &small.reservoirFn, load(t, small, 3, 1, 2))); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("FixedSize(10) of [3 1 2] = %v, want all elements", got)
+	}
+}
+
+// TestUniformFnMerge verifies that merging the reservoirs of parts of the
+// input gives the same sample as sampling the whole input.
+func TestUniformFnMerge(t *testing.T) {
+	values := ints(1000)
+	whole := newUniformFn(10, WithSeed(3))
+	want := output(t, &whole.reservoirFn, load(t, whole, values...))
+
+	fn := newUniformFn(10, WithSeed(3))
+	r := fn.CreateAccumulator()
+	for _, part := range [][]int{values[:10], values[10:500], values[500:501], values[501:]} {
+		r = fn.MergeAccumulators(r, roundTrip(t, load(t, fn, part...)))
+	}
+	if got := output(t, &fn.reservoirFn, roundTrip(t, r)); !reflect.DeepEqual(got, want) {
+		t.Errorf("merged FixedSize(10) = %v, want %v", got, want)
+	}
+}
+
+// TestUniformFnDistribution verifies that every element is about equally
+// likely to be sampled.
+func TestUniformFnDistribution(t *testing.T) {
+	const trials = 5000
+	counts := make(map[int]int)
+	for seed := int64(0); seed < trials; seed++ {
+		fn := newUniformFn(2, WithSeed(seed))
+		for _, v := range output(t, &fn.reservoirFn, load(t, fn, ints(10)...)) {
+			counts[v]++
+		}
+	}
+	// Each element is sampled with probability 1/5.
+	for v := 0; v < 10; v++ {
+		if got := counts[v]; got < 850 || got > 1150 {
+			t.Errorf("FixedSize(2) of 10 elements sampled %v %v times in %v trials, want about 1000", v, got, trials)
+		}
+	}
+}
+
+// TestWeightedFn verifies that elements are sampled in proportion to their
+// weights, and never with a weight of zero.
+func TestWeightedFn(t *testing.T) {
+	const trials = 5000
+	counts := make(map[int]int)
+	for seed := int64(0); seed < trials; seed++ {
+		fn := newWeightedFn(1, reflectx.Int, weightOf, []Option{WithSeed(seed)})
+		for _, v := range output(t, &fn.reservoirFn, load(t, fn, 1, 2, 3, 6, 7)) {
+			counts[v]++
+		}
+	}
+	for _, v := range []int{1, 3, 7} {
+		if counts[v] != 0 {
+			t.Errorf("Weighted(1) sampled %v with weight 0 %v times", v, counts[v])
+		}
+	}
+	// 2 and 6 are sampled with probabilities 1/4 and 3/4.
+	if got := counts[2]; got < 1100 || got > 1400 {
+		t.Errorf("Weighted(1) sampled 2 %v times in %v trials, want about 1250", got, trials)
+	}
+	if got := counts[2] + counts[6]; got != trials {
+		t.Errorf("Weighted(1) sampled %v times in %v trials, want %v", got, trials, trials)
+	}
+}
+
+func TestDecodeReservoir_invalid(t *testing.T) {
+	b, err := encodeReservoir(load(t, newUniformFn(3), 1, 2, 3))
+	if err != nil {
+		t.Fatalf("encodeReservoir failed: %v", err)
+	}
+	for _, data := range [][]byte{nil, {3, 4}, b[:len(b)-1]} {
+		if _, err := decodeReservoir(data); err == nil {
+			t.Errorf("decodeReservoir(%v) succeeded, want error", data)
+		}
+	}
+}
+
+func TestFixedSize(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.CreateList(s, ints(100))
+	passert.Equals(s, beam.ParDo(s, sampleSize, FixedSize(s, col, 10)), 10)
+	passert.Equals(s, beam.ParDo(s, sortInts, FixedSize(s, beam.Create(s, 3, 1, 2), 5, WithSeed(1))), []int{1, 2, 3})
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestFixedSizePerKey(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.ParDo(s, modKey, beam.CreateList(s, ints(30)))
+	sizes := beam.ParDo(s, sampleSize, beam.DropKey(s, FixedSizePerKey(s, col, 4)))
+	passert.Equals(s, sizes, 4, 4, 4)
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestFixedSize_windowed(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.ParDo(s, timestampMinutes, beam.Create(s, 1, 2, 3, 4, 6, 7))
+	windowed := beam.WindowInto(s, window.NewFixedWindows(5*time.Minute), col)
+	sizes := beam.ParDo(s, sampleSize, FixedSize(s, windowed, 3))
+	passert.Equals(s, passert.InWindow(s, sizes, window.IntervalWindow{Start: 0, End: mtime.FromDuration(5 * time.Minute)}), 3)
+	passert.Equals(s, passert.InWindow(s, sizes, window.IntervalWindow{Start: mtime.FromDuration(5 * time.Minute), End: mtime.FromDuration(10 * time.Minute)}), 2)
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestWeighted(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.CreateList(s, ints(7))
+	passert.Equals(s, beam.ParDo(s, sortInts, Weighted(s, col, 3, weightOf)), []int{2, 4, 6})
+	keyed := beam.ParDo(s, modKey, beam.CreateList(s, ints(12)))
+	passert.Equals(s, beam.ParDo(s, sampleSize, beam.DropKey(s, WeightedPerKey(s, keyed, 1, weightOf))), 1, 1, 1)
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestWeighted_invalidWeight(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("Weighted() with a weight of the wrong type should panic")
+		}
+	}()
+
+	_, s := beam.NewPipelineWithRoot()
+	Weighted(s, beam.Create(s, "a"), 1, weightOf)
+}
@@ -0,0 +1,103 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sample contains transforms for taking random samples of
+// PCollections: fixed-size uniform samples, weighted samples without
+// replacement, and Bernoulli samples of a fraction of the elements.
+//
+// Samples are random by default. For tests, WithSeed makes them
+// deterministic for a given input order and bundling, such as when running on
+// a single worker.
+package sample
+
+import (
+	"bytes"
+	"hash/fnv"
+	"math"
+	"math/rand"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+)
+
+// Option is an optional setting of a sampling transform.
+type Option func(*options)
+
+type options struct {
+	seed   int64
+	seeded bool
+}
+
+// WithSeed seeds the random choices of a sampling transform.
+func WithSeed(seed int64) Option {
+	return func(o *options) {
+		o.seed = seed
+		o.seeded = true
+	}
+}
+
+func makeOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// initialState returns the initial random state for the given seed, or a
+// random one if no seed was set.
+func initialState(seed int64, seeded bool) uint64 {
+	if seeded {
+		return uint64(seed)
+	}
+	return rand.Uint64()
+}
+
+// next advances the splitmix64 generator state and returns its next value.
+func next(state *uint64) uint64 {
+	*state += 0x9e3779b97f4a7c15
+	return mix(*state)
+}
+
+// mix is the splitmix64 finalizer.
+func mix(z uint64) uint64 {
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// uniform returns a random number in (0, 1] for an encoded element. The
+// element's hash is mixed into the generator output, so that the random
+// streams of workers that start from the same seed are not correlated.
+func uniform(state *uint64, elem []byte) float64 {
+	h := fnv.New64a()
+	h.Write(elem)
+	x := mix(next(state) ^ h.Sum64())
+	return (float64(x>>11) + 1) / (1 << 53)
+}
+
+// encode returns the encoding of the given value.
+func encode(enc beam.ElementEncoder, val any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := enc.Encode(val, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// exponential returns an exponentially distributed random number with the
+// given rate.
+func exponential(u, rate float64) float64 {
+	return -math.Log(u) / rate
+}
@@ -0,0 +1,251 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/util/reflectx"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+func init() {
+	hllType := reflect.TypeOf((**HLL)(nil)).Elem()
+	beam.RegisterType(hllType)
+	beam.RegisterCoder(hllType, encodeHLL, decodeHLL)
+
+	register.Combiner3[*HLL, beam.T, int64]((*countDistinctFn)(nil))
+	register.Combiner3[*HLL, beam.T, []byte]((*hllSketchFn)(nil))
+	register.Combiner3[*HLL, []byte, []byte]((*mergeHLLSketchFn)(nil))
+	register.Function1x2(extractHLLCountFn)
+	register.Function2x3(extractHLLCountPerKeyFn)
+}
+
+// HLLOpts contains settings used to configure HLL++ sketches.
+type HLLOpts struct {
+	// Precision of the sketches, between MinHLLPrecision and MaxHLLPrecision.
+	// If unset, DefaultHLLPrecision is used.
+	Precision int
+}
+
+func (o HLLOpts) precision() int {
+	if o.Precision == 0 {
+		return DefaultHLLPrecision
+	}
+	return o.Precision
+}
+
+// ApproximateCountDistinct estimates the number of distinct elements in a
+// PCollection<A> using a HyperLogLog++ sketch, and returns it as a singleton
+// PCollection<int64>. A must be int, int32, int64, string or []byte.
+//
+// For example:
+//
+//	col := beam.Create(s, "a", "b", "a", "c")
+//	count := stats.ApproximateCountDistinct(s, col, stats.HLLOpts{})   // PCollection<int64> with 3 as the only element.
+func ApproximateCountDistinct(s beam.Scope, col beam.PCollection, opts HLLOpts) beam.PCollection {
+	s = s.Scope("stats.ApproximateCountDistinct")
+	t := beam.ValidateNonCompositeType(col)
+	return beam.Combine(s, &countDistinctFn{newHLLFn(t.Type(), opts)}, col)
+}
+
+// ApproximateCountDistinctPerKey estimates the number of distinct values per
+// key in a PCollection<KV<K,A>> using HyperLogLog++ sketches, and returns a
+// PCollection<KV<K,int64>>. A must be int, int32, int64, string or []byte.
+func ApproximateCountDistinctPerKey(s beam.Scope, col beam.PCollection, opts HLLOpts) beam.PCollection {
+	s = s.Scope("stats.ApproximateCountDistinctPerKey")
+	_, t := beam.ValidateKVType(col)
+	return beam.CombinePerKey(s, &countDistinctFn{newHLLFn(t.Type(), opts)}, col)
+}
+
+// HLLSketch aggregates the elements of a PCollection<A> into a serialized
+// HyperLogLog++ sketch, returned as a singleton PCollection<[]byte>. A must be
+// int, int32, int64, string or []byte; ints are added as int64s.
+//
+// The sketches can be stored and later combined with MergeHLLSketches, or
+// decoded with HLL.UnmarshalBinary. They are compatible with the BigQuery
+// HLL_COUNT functions, although HLL_COUNT.EXTRACT can estimate large sketches
+// slightly differently than HLL.Estimate.
+func HLLSketch(s beam.Scope, col beam.PCollection, opts HLLOpts) beam.PCollection {
+	s = s.Scope("stats.HLLSketch")
+	t := beam.ValidateNonCompositeType(col)
+	return beam.Combine(s, &hllSketchFn{newHLLFn(t.Type(), opts)}, col)
+}
+
+// HLLSketchPerKey aggregates the values per key of a PCollection<KV<K,A>>
+// into serialized HyperLogLog++ sketches, returned as a
+// PCollection<KV<K,[]byte>>.
+func HLLSketchPerKey(s beam.Scope, col beam.PCollection, opts HLLOpts) beam.PCollection {
+	s = s.Scope("stats.HLLSketchPerKey")
+	_, t := beam.ValidateKVType(col)
+	return beam.CombinePerKey(s, &hllSketchFn{newHLLFn(t.Type(), opts)}, col)
+}
+
+// MergeHLLSketches merges the serialized HyperLogLog++ sketches in a
+// PCollection<[]byte> into a singleton PCollection<[]byte>. The merged sketch
+// has the lowest precision of its inputs. Merging sketches of different
+// value types fails.
+func MergeHLLSketches(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.MergeHLLSketches")
+	validateHLLSketchType(beam.ValidateNonCompositeType(col).Type())
+	return beam.Combine(s, &mergeHLLSketchFn{}, col)
+}
+
+// MergeHLLSketchesPerKey merges the serialized HyperLogLog++ sketches per key
+// of a PCollection<KV<K,[]byte>> into a PCollection<KV<K,[]byte>>.
+func MergeHLLSketchesPerKey(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.MergeHLLSketchesPerKey")
+	_, t := beam.ValidateKVType(col)
+	validateHLLSketchType(t.Type())
+	return beam.CombinePerKey(s, &mergeHLLSketchFn{}, col)
+}
+
+// ExtractHLLCount returns the estimated number of distinct values of each
+// serialized HyperLogLog++ sketch in a PCollection<[]byte> as a
+// PCollection<int64>.
+func ExtractHLLCount(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.ExtractHLLCount")
+	validateHLLSketchType(beam.ValidateNonCompositeType(col).Type())
+	return beam.ParDo(s, extractHLLCountFn, col)
+}
+
+// ExtractHLLCountPerKey returns the estimated number of distinct values of
+// the serialized HyperLogLog++ sketches in a PCollection<KV<K,[]byte>> as a
+// PCollection<KV<K,int64>>.
+func ExtractHLLCountPerKey(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.ExtractHLLCountPerKey")
+	_, t := beam.ValidateKVType(col)
+	validateHLLSketchType(t.Type())
+	return beam.ParDo(s, extractHLLCountPerKeyFn, col)
+}
+
+func newHLLFn(t reflect.Type, opts HLLOpts) hllFn {
+	switch t {
+	case reflectx.Int, reflectx.Int32, reflectx.Int64, reflectx.String, reflectx.ByteSlice:
+	default:
+		panic(fmt.Sprintf("type must be int, int32, int64, string or []byte: %v", t))
+	}
+	if err := validateHLLPrecision(opts.precision()); err != nil {
+		panic(err)
+	}
+	return hllFn{Precision: opts.precision()}
+}
+
+func validateHLLSketchType(t reflect.Type) {
+	if t != reflectx.ByteSlice {
+		panic(fmt.Sprintf("type must be a []byte HLL++ sketch: %v", t))
+	}
+}
+
+// hllFn adds values to HLL++ sketches.
+type hllFn struct {
+	Precision int `json:"precision"`
+}
+
+func (f *hllFn) CreateAccumulator() (*HLL, error) {
+	return NewHLL(f.Precision)
+}
+
+func (f *hllFn) AddInput(h *HLL, v beam.T) (*HLL, error) {
+	var err error
+	switch v := v.(type) {
+	case int:
+		err = h.AddInt64(int64(v))
+	case int32:
+		err = h.AddInt32(v)
+	case int64:
+		err = h.AddInt64(v)
+	case string:
+		err = h.AddString(v)
+	case []byte:
+		err = h.AddBytes(v)
+	default:
+		err = errors.Errorf("unsupported HLL++ value type %T", v)
+	}
+	return h, err
+}
+
+func (f *hllFn) MergeAccumulators(a, b *HLL) (*HLL, error) {
+	return a, a.Merge(b)
+}
+
+type countDistinctFn struct {
+	hllFn
+}
+
+func (f *countDistinctFn) ExtractOutput(h *HLL) int64 {
+	return h.Estimate()
+}
+
+type hllSketchFn struct {
+	hllFn
+}
+
+func (f *hllSketchFn) ExtractOutput(h *HLL) ([]byte, error) {
+	return h.MarshalBinary()
+}
+
+// mergeHLLSketchFn merges serialized sketches. Its accumulator starts as the
+// empty zero HLL, which takes on the precision of the first merged sketch.
+type mergeHLLSketchFn struct{}
+
+func (f *mergeHLLSketchFn) CreateAccumulator() *HLL {
+	return &HLL{}
+}
+
+func (f *mergeHLLSketchFn) AddInput(h *HLL, sketch []byte) (*HLL, error) {
+	var other HLL
+	if err := other.UnmarshalBinary(sketch); err != nil {
+		return nil, err
+	}
+	return h, h.Merge(&other)
+}
+
+func (f *mergeHLLSketchFn) MergeAccumulators(a, b *HLL) (*HLL, error) {
+	return a, a.Merge(b)
+}
+
+func (f *mergeHLLSketchFn) ExtractOutput(h *HLL) ([]byte, error) {
+	return h.MarshalBinary()
+}
+
+func extractHLLCountFn(sketch []byte) (int64, error) {
+	var h HLL
+	if err := h.UnmarshalBinary(sketch); err != nil {
+		return 0, err
+	}
+	return h.Estimate(), nil
+}
+
+func extractHLLCountPerKeyFn(key beam.X, sketch []byte) (beam.X, int64, error) {
+	count, err := extractHLLCountFn(sketch)
+	return key, count, err
+}
+
+func encodeHLL(h *HLL) ([]byte, error) {
+	return h.MarshalBinary()
+}
+
+func decodeHLL(data []byte) (*HLL, error) {
+	var h HLL
+	if err := h.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
@@ -0,0 +1,113 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"testing"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+)
+
+func init() {
+	register.Function2x2(toInt64Fn)
+	register.Function2x2(fromInt64Fn)
+}
+
+func toInt64Fn(name string, grade float64) (string, int64) {
+	return name, int64(grade)
+}
+
+func fromInt64Fn(name string, count int64) (string, float64) {
+	return name, float64(count)
+}
+
+// TestApproximateCountDistinct verifies that small cardinalities, which are
+// counted in the sparse representation, are estimated exactly.
+func TestApproximateCountDistinct(t *testing.T) {
+	tests := []struct {
+		in  any
+		exp int64
+	}{
+		{[]string{"a", "b", "a", "c", "b"}, 3},
+		{[]int{1, 2, 3, 4, 1, 2, 3, 4}, 4},
+		{[]int32{-1, 1}, 2},
+		{[][]byte{[]byte("a"), []byte("a")}, 1},
+	}
+
+	for _, test := range tests {
+		p, s := beam.NewPipelineWithRoot()
+		in := beam.CreateList(s, test.in)
+		count := ApproximateCountDistinct(s, in, HLLOpts{})
+		passert.Equals(s, count, test.exp)
+
+		if err := ptest.Run(p); err != nil {
+			t.Errorf("ApproximateCountDistinct(%v) != %v: %v", test.in, test.exp, err)
+		}
+	}
+}
+
+// TestApproximateCountDistinctPerKey verifies that distinct values are
+// estimated per key.
+func TestApproximateCountDistinctPerKey(t *testing.T) {
+	in := []student{{"alpha", 1}, {"alpha", 2}, {"alpha", 1}, {"beta", 3}}
+	exp := []student{{"alpha", 2}, {"beta", 1}}
+
+	p, s, col, want := ptest.CreateList2(in, exp)
+	kvs := beam.ParDo(s, studentToKV, col)
+	count := ApproximateCountDistinctPerKey(s, beam.ParDo(s, toInt64Fn, kvs), HLLOpts{Precision: MinHLLPrecision})
+	passert.Equals(s, beam.ParDo(s, kvToStudent, beam.ParDo(s, fromInt64Fn, count)), want)
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("ApproximateCountDistinctPerKey(%v) != %v: %v", in, exp, err)
+	}
+}
+
+// TestHLLSketch verifies that sketches can be merged and estimated later.
+func TestHLLSketch(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	a := HLLSketch(s, beam.Create(s, "a", "b", "c"), HLLOpts{})
+	b := HLLSketch(s, beam.Create(s, "c", "d"), HLLOpts{Precision: 12})
+	merged := MergeHLLSketches(s, beam.Flatten(s, a, b))
+	passert.Equals(s, ExtractHLLCount(s, merged), int64(4))
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("ExtractHLLCount(MergeHLLSketches(...)) != 4: %v", err)
+	}
+}
+
+// TestHLLSketchPerKey verifies that sketches can be merged and estimated per
+// key.
+func TestHLLSketchPerKey(t *testing.T) {
+	in := []student{{"alpha", 1}, {"alpha", 2}, {"beta", 3}}
+	more := []student{{"alpha", 3}, {"beta", 3}}
+	exp := []student{{"alpha", 3}, {"beta", 1}}
+
+	p, s := beam.NewPipelineWithRoot()
+	sketch := func(list []student) beam.PCollection {
+		kvs := beam.ParDo(s, studentToKV, beam.CreateList(s, list))
+		return HLLSketchPerKey(s, beam.ParDo(s, toInt64Fn, kvs), HLLOpts{})
+	}
+	merged := MergeHLLSketchesPerKey(s, beam.Flatten(s, sketch(in), sketch(more)))
+	count := ExtractHLLCountPerKey(s, merged)
+	passert.Equals(s, beam.ParDo(s, kvToStudent, beam.ParDo(s, fromInt64Fn, count)), beam.CreateList(s, exp))
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("ExtractHLLCountPerKey(MergeHLLSketchesPerKey(...)) != %v: %v", exp, err)
+	}
+}
@@ -0,0 +1,314 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+func init() {
+	sketchType := reflect.TypeOf((**CountMinSketch)(nil)).Elem()
+	beam.RegisterType(sketchType)
+	beam.RegisterCoder(sketchType, encodeCountMinSketch, decodeCountMinSketch)
+	register.Combiner2[*CountMinSketch, beam.T]((*countMinFn)(nil))
+}
+
+const (
+	// DefaultCountMinWidth is the sketch width used when none is set. It
+	// bounds the overestimate of counts to about 0.1% of the total count.
+	DefaultCountMinWidth = 2719
+	// DefaultCountMinDepth is the sketch depth used when none is set. It
+	// keeps the error within the bound with a probability of 99.9%.
+	DefaultCountMinDepth = 7
+)
+
+// CountMinOpts contains settings used to configure Count-Min sketches.
+type CountMinOpts struct {
+	// Width is the number of counters per row. Estimated counts exceed true
+	// counts by at most e/Width times the total count with high probability.
+	// If unset, DefaultCountMinWidth is used.
+	Width int
+	// Depth is the number of rows. The error bound holds with probability
+	// 1 - exp(-Depth). If unset, DefaultCountMinDepth is used.
+	Depth int
+}
+
+// CountMinOptsForError returns the options for sketches that overestimate
+// counts by at most epsilon times the total count with the given confidence.
+// For example, CountMinOptsForError(0.001, 0.999) returns the defaults.
+func CountMinOptsForError(epsilon, confidence float64) CountMinOpts {
+	if epsilon <= 0 || epsilon >= 1 || confidence <= 0 || confidence >= 1 {
+		panic(fmt.Sprintf("epsilon and confidence must be in (0, 1): %v, %v", epsilon, confidence))
+	}
+	return CountMinOpts{
+		Width: int(math.Ceil(math.E / epsilon)),
+		Depth: int(math.Ceil(math.Log(1 / (1 - confidence)))),
+	}
+}
+
+func (o CountMinOpts) withDefaults() CountMinOpts {
+	if o.Width == 0 {
+		o.Width = DefaultCountMinWidth
+	}
+	if o.Depth == 0 {
+		o.Depth = DefaultCountMinDepth
+	}
+	return o
+}
+
+// CountMinSketch is a Count-Min sketch estimating how often elements occur
+// in a stream in sub-linear space. Estimates are never below the true
+// counts. Sketches with the same width and depth can be merged, and they can
+// be serialized with MarshalBinary to be stored and merged later.
+//
+// Elements are identified by their Beam encoding, so estimates must be
+// queried with values of the type the sketch was built from.
+type CountMinSketch struct {
+	width, depth int
+	total        int64
+	// counts holds the depth rows of width counters.
+	counts []int64
+
+	encoders map[reflect.Type]beam.ElementEncoder
+}
+
+// NewCountMinSketch returns an empty Count-Min sketch with the given
+// dimensions. Zero values are replaced with the defaults.
+func NewCountMinSketch(opts CountMinOpts) (*CountMinSketch, error) {
+	opts = opts.withDefaults()
+	if opts.Width < 1 || opts.Depth < 1 {
+		return nil, errors.Errorf("Count-Min sketch dimensions must be positive: width %v, depth %v", opts.Width, opts.Depth)
+	}
+	return &CountMinSketch{width: opts.Width, depth: opts.Depth, counts: make([]int64, opts.Width*opts.Depth)}, nil
+}
+
+// Width returns the number of counters per row.
+func (c *CountMinSketch) Width() int {
+	return c.width
+}
+
+// Depth returns the number of rows.
+func (c *CountMinSketch) Depth() int {
+	return c.depth
+}
+
+// Total returns the sum of all counts added to the sketch.
+func (c *CountMinSketch) Total() int64 {
+	return c.total
+}
+
+// Add adds count occurrences of an element to the sketch.
+func (c *CountMinSketch) Add(elm any, count int64) error {
+	b, err := c.encode(elm)
+	if err != nil {
+		return err
+	}
+	c.addEncoded(b, count)
+	return nil
+}
+
+// Estimate returns the estimated number of occurrences of an element.
+func (c *CountMinSketch) Estimate(elm any) (int64, error) {
+	b, err := c.encode(elm)
+	if err != nil {
+		return 0, err
+	}
+	return c.estimateEncoded(b), nil
+}
+
+func (c *CountMinSketch) encode(elm any) ([]byte, error) {
+	t := reflect.TypeOf(elm)
+	enc, ok := c.encoders[t]
+	if !ok {
+		if c.encoders == nil {
+			c.encoders = map[reflect.Type]beam.ElementEncoder{}
+		}
+		enc = beam.NewElementEncoder(t)
+		c.encoders[t] = enc
+	}
+	var buf bytes.Buffer
+	if err := enc.Encode(elm, &buf); err != nil {
+		return nil, errors.Wrapf(err, "encoding %v", elm)
+	}
+	return buf.Bytes(), nil
+}
+
+// index returns the counter of an encoded element in the given row. It
+// derives the row hashes from one 64-bit hash by double hashing.
+func (c *CountMinSketch) index(hash uint64, row int) int {
+	h1, h2 := uint32(hash), uint32(hash>>32)|1
+	return row*c.width + int((h1+uint32(row)*h2)%uint32(c.width))
+}
+
+func (c *CountMinSketch) addEncoded(b []byte, count int64) {
+	hash := fingerprint2011(b)
+	for row := 0; row < c.depth; row++ {
+		c.counts[c.index(hash, row)] += count
+	}
+	c.total += count
+}
+
+func (c *CountMinSketch) estimateEncoded(b []byte) int64 {
+	hash := fingerprint2011(b)
+	est := int64(math.MaxInt64)
+	for row := 0; row < c.depth; row++ {
+		if n := c.counts[c.index(hash, row)]; n < est {
+			est = n
+		}
+	}
+	return est
+}
+
+// Merge adds the counts of other into the sketch. The sketches must have the
+// same dimensions.
+func (c *CountMinSketch) Merge(other *CountMinSketch) error {
+	if c.width != other.width || c.depth != other.depth {
+		return errors.Errorf("can't merge Count-Min sketches of dimensions %vx%v and %vx%v", c.width, c.depth, other.width, other.depth)
+	}
+	for i, n := range other.counts {
+		c.counts[i] += n
+	}
+	c.total += other.total
+	return nil
+}
+
+// MarshalBinary serializes the sketch as its dimensions, total and counters
+// in varint encoding.
+func (c *CountMinSketch) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, 3*binary.MaxVarintLen64+len(c.counts))
+	b = binary.AppendUvarint(b, uint64(c.width))
+	b = binary.AppendUvarint(b, uint64(c.depth))
+	b = binary.AppendVarint(b, c.total)
+	for _, n := range c.counts {
+		b = binary.AppendVarint(b, n)
+	}
+	return b, nil
+}
+
+// UnmarshalBinary decodes a sketch serialized by MarshalBinary.
+func (c *CountMinSketch) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	width, err := binary.ReadUvarint(r)
+	if err != nil {
+		return errors.Wrap(err, "invalid Count-Min sketch width")
+	}
+	depth, err := binary.ReadUvarint(r)
+	if err != nil {
+		return errors.Wrap(err, "invalid Count-Min sketch depth")
+	}
+	if width < 1 || depth < 1 || width*depth > uint64(len(data)) {
+		return errors.Errorf("invalid Count-Min sketch dimensions %vx%v for %v bytes", width, depth, len(data))
+	}
+	total, err := binary.ReadVarint(r)
+	if err != nil {
+		return errors.Wrap(err, "invalid Count-Min sketch total")
+	}
+	counts := make([]int64, width*depth)
+	for i := range counts {
+		if counts[i], err = binary.ReadVarint(r); err != nil {
+			return errors.Wrapf(err, "invalid Count-Min sketch counter %v", i)
+		}
+	}
+	if r.Len() != 0 {
+		return errors.Errorf("invalid Count-Min sketch: %v trailing bytes", r.Len())
+	}
+	*c = CountMinSketch{width: int(width), depth: int(depth), total: total, counts: counts}
+	return nil
+}
+
+func encodeCountMinSketch(c *CountMinSketch) ([]byte, error) {
+	return c.MarshalBinary()
+}
+
+func decodeCountMinSketch(data []byte) (*CountMinSketch, error) {
+	var c CountMinSketch
+	if err := c.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// CountMin builds a Count-Min sketch of the elements in a PCollection<A>,
+// returned as a singleton PCollection<*CountMinSketch> per window. The
+// sketch estimates how often each element occurs, and can be used as a side
+// input to look up frequencies. A's encoding must be deterministic.
+//
+// For example:
+//
+//	col := beam.Create(s, "a", "b", "a")
+//	sketch := stats.CountMin(s, col, stats.CountMinOpts{})   // sketch.Estimate("a") returns 2.
+func CountMin(s beam.Scope, col beam.PCollection, opts CountMinOpts) beam.PCollection {
+	s = s.Scope("stats.CountMin")
+	t := beam.ValidateNonCompositeType(col)
+	return beam.Combine(s, newCountMinFn(t.Type(), opts), col)
+}
+
+// CountMinPerKey builds a Count-Min sketch of the values for each key of a
+// PCollection<KV<K,A>>, returned as a PCollection<KV<K,*CountMinSketch>>.
+func CountMinPerKey(s beam.Scope, col beam.PCollection, opts CountMinOpts) beam.PCollection {
+	s = s.Scope("stats.CountMinPerKey")
+	_, t := beam.ValidateKVType(col)
+	return beam.CombinePerKey(s, newCountMinFn(t.Type(), opts), col)
+}
+
+func newCountMinFn(t reflect.Type, opts CountMinOpts) *countMinFn {
+	opts = opts.withDefaults()
+	if _, err := NewCountMinSketch(opts); err != nil {
+		panic(err)
+	}
+	fn := &countMinFn{Width: opts.Width, Depth: opts.Depth, Type: beam.EncodedType{T: t}}
+	// Running Setup at pipeline construction validates that A is encodable.
+	fn.Setup()
+	return fn
+}
+
+// countMinFn is a combineFn that adds elements to Count-Min sketches.
+type countMinFn struct {
+	Width int              `json:"width"`
+	Depth int              `json:"depth"`
+	Type  beam.EncodedType `json:"type"`
+
+	enc beam.ElementEncoder
+}
+
+func (f *countMinFn) Setup() {
+	f.enc = beam.NewElementEncoder(f.Type.T)
+}
+
+func (f *countMinFn) CreateAccumulator() (*CountMinSketch, error) {
+	return NewCountMinSketch(CountMinOpts{Width: f.Width, Depth: f.Depth})
+}
+
+func (f *countMinFn) AddInput(c *CountMinSketch, elm beam.T) (*CountMinSketch, error) {
+	var buf bytes.Buffer
+	if err := f.enc.Encode(elm, &buf); err != nil {
+		return nil, errors.Wrapf(err, "encoding %v", elm)
+	}
+	c.addEncoded(buf.Bytes(), 1)
+	return c, nil
+}
+
+func (f *countMinFn) MergeAccumulators(a, b *CountMinSketch) (*CountMinSketch, error) {
+	return a, a.Merge(b)
+}
@@ -0,0 +1,181 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+)
+
+func init() {
+	register.Function2x2(estimateFn)
+	register.Function2x2(estimateKVFn)
+	register.Function2x2(kvToXFn)
+}
+
+func newTestCountMin(t *testing.T, opts CountMinOpts, counts map[string]int64) *CountMinSketch {
+	t.Helper()
+	c, err := NewCountMinSketch(opts)
+	if err != nil {
+		t.Fatalf("NewCountMinSketch(%+v) failed: %v", opts, err)
+	}
+	for elm, n := range counts {
+		if err := c.Add(elm, n); err != nil {
+			t.Fatalf("Add(%v, %v) failed: %v", elm, n, err)
+		}
+	}
+	return c
+}
+
+func checkCountMin(t *testing.T, c *CountMinSketch, counts map[string]int64, maxError int64) {
+	t.Helper()
+	for elm, want := range counts {
+		got, err := c.Estimate(elm)
+		if err != nil {
+			t.Fatalf("Estimate(%v) failed: %v", elm, err)
+		}
+		if got < want || got > want+maxError {
+			t.Errorf("Estimate(%v) = %v, want [%v, %v]", elm, got, want, want+maxError)
+		}
+	}
+}
+
+func skewedCounts(distinct int) map[string]int64 {
+	counts := map[string]int64{}
+	for i := 0; i < distinct; i++ {
+		counts[fmt.Sprint(i)] = int64(10000 / (i + 1))
+	}
+	return counts
+}
+
+func TestCountMinSketch(t *testing.T) {
+	counts := skewedCounts(1000)
+	opts := CountMinOptsForError(0.01, 0.99)
+	c := newTestCountMin(t, opts, counts)
+
+	var total int64
+	for _, n := range counts {
+		total += n
+	}
+	if got := c.Total(); got != total {
+		t.Errorf("Total() = %v, want %v", got, total)
+	}
+	checkCountMin(t, c, counts, int64(0.01*float64(total)))
+	if got, _ := c.Estimate("missing"); got > int64(0.01*float64(total)) {
+		t.Errorf("Estimate(missing) = %v, want at most %v", got, int64(0.01*float64(total)))
+	}
+}
+
+func TestCountMinSketch_Merge(t *testing.T) {
+	a := newTestCountMin(t, CountMinOpts{}, map[string]int64{"a": 3, "b": 1})
+	b := newTestCountMin(t, CountMinOpts{}, map[string]int64{"b": 2, "c": 5})
+	if err := a.Merge(b); err != nil {
+		t.Fatalf("Merge() failed: %v", err)
+	}
+	checkCountMin(t, a, map[string]int64{"a": 3, "b": 3, "c": 5}, 0)
+	if got, want := a.Total(), int64(11); got != want {
+		t.Errorf("Total() = %v, want %v", got, want)
+	}
+
+	other := newTestCountMin(t, CountMinOpts{Width: 10}, nil)
+	if err := a.Merge(other); err == nil {
+		t.Error("Merge() of sketches with different widths succeeded, want error")
+	}
+}
+
+func TestCountMinSketch_MarshalBinary(t *testing.T) {
+	counts := skewedCounts(100)
+	c := newTestCountMin(t, CountMinOpts{Width: 50, Depth: 3}, counts)
+	data, err := c.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+	var got CountMinSketch
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() failed: %v", err)
+	}
+	if got.Width() != 50 || got.Depth() != 3 || got.Total() != c.Total() {
+		t.Errorf("UnmarshalBinary() = %vx%v with total %v, want 50x3 with total %v", got.Width(), got.Depth(), got.Total(), c.Total())
+	}
+	for elm := range counts {
+		want, _ := c.Estimate(elm)
+		if est, _ := got.Estimate(elm); est != want {
+			t.Errorf("decoded Estimate(%v) = %v, want %v", elm, est, want)
+		}
+	}
+	for _, invalid := range [][]byte{nil, data[:len(data)-1], append(data, 0), {0, 1}} {
+		var c CountMinSketch
+		if err := c.UnmarshalBinary(invalid); err == nil {
+			t.Errorf("UnmarshalBinary(%v) succeeded, want error", invalid)
+		}
+	}
+}
+
+func TestCountMinOptsForError(t *testing.T) {
+	if got, want := CountMinOptsForError(0.001, 0.999), (CountMinOpts{Width: DefaultCountMinWidth, Depth: DefaultCountMinDepth}); got != want {
+		t.Errorf("CountMinOptsForError(0.001, 0.999) = %+v, want %+v", got, want)
+	}
+}
+
+func estimateFn(query string, sketch *CountMinSketch) (int64, error) {
+	return sketch.Estimate(query)
+}
+
+func estimateKVFn(key string, sketch *CountMinSketch) (string, int64) {
+	n, _ := sketch.Estimate("x")
+	return key, n
+}
+
+// TestCountMin verifies that sketches built by CountMin can be used as side
+// inputs to estimate frequencies.
+func TestCountMin(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	sketch := CountMin(s, beam.Create(s, "a", "b", "a", "c", "a"), CountMinOpts{Width: 100, Depth: 3})
+	estimates := beam.ParDo(s, estimateFn, beam.Create(s, "a", "b", "d"), beam.SideInput{Input: sketch})
+	passert.Equals(s, estimates, int64(3), int64(1), int64(0))
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("CountMin() estimates != [3, 1, 0]: %v", err)
+	}
+}
+
+// TestCountMinPerKey verifies that CountMinPerKey builds a sketch per key.
+func TestCountMinPerKey(t *testing.T) {
+	in := []student{{"alpha", 1}, {"alpha", 1}, {"beta", 2}}
+
+	p, s := beam.NewPipelineWithRoot()
+	kvs := beam.ParDo(s, studentToKV, beam.CreateList(s, in))
+	sketches := CountMinPerKey(s, beam.ParDo(s, kvToXFn, kvs), CountMinOpts{})
+	passert.Equals(s, beam.ParDo(s, kvToStudent, beam.ParDo(s, fromInt64Fn, beam.ParDo(s, estimateKVFn, sketches))),
+		beam.CreateList(s, []student{{"alpha", 2}, {"beta", 0}}))
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("CountMinPerKey() estimates != [alpha:2, beta:0]: %v", err)
+	}
+}
+
+// kvToXFn maps grades of 1 to "x" and other grades to "y".
+func kvToXFn(name string, grade float64) (string, string) {
+	if grade == 1 {
+		return name, "x"
+	}
+	return name, "y"
+}
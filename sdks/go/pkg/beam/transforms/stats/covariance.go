@@ -0,0 +1,166 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+func init() {
+	register.Combiner3[covarianceAccum, Pair, float64]((*covarianceFn)(nil))
+}
+
+// Pair is a pair of observations of two variables, as used by Covariance and
+// Correlation.
+type Pair struct {
+	X, Y float64
+}
+
+// Covariance returns the population covariance of the pairs in a collection.
+// It expects a PCollection<Pair> as input and returns a singleton
+// PCollection<float64>.
+//
+// For example:
+//
+//	col := beam.Create(s, stats.Pair{1, 2}, stats.Pair{2, 4}, stats.Pair{3, 6})
+//	cov := stats.Covariance(s, col)   // PCollection<float64> with 4/3 as the only element.
+func Covariance(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.Covariance")
+	validatePairType(beam.ValidateNonCompositeType(col))
+	return beam.Combine(s, &covarianceFn{}, col)
+}
+
+// CovariancePerKey returns the population covariance of the pairs for each
+// key in a collection. It expects a PCollection<KV<A,Pair>> as input and
+// returns a PCollection<KV<A,float64>>.
+func CovariancePerKey(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.CovariancePerKey")
+	_, t := beam.ValidateKVType(col)
+	validatePairType(t)
+	return beam.CombinePerKey(s, &covarianceFn{}, col)
+}
+
+// Correlation returns the Pearson correlation coefficient of the pairs in a
+// collection. It expects a PCollection<Pair> as input and returns a singleton
+// PCollection<float64>. The correlation is NaN if either variable has no
+// variance.
+//
+// For example:
+//
+//	col := beam.Create(s, stats.Pair{1, 2}, stats.Pair{2, 4}, stats.Pair{3, 6})
+//	corr := stats.Correlation(s, col)   // PCollection<float64> with 1 as the only element.
+func Correlation(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.Correlation")
+	validatePairType(beam.ValidateNonCompositeType(col))
+	return beam.Combine(s, &covarianceFn{Correlation: true}, col)
+}
+
+// CorrelationPerKey returns the Pearson correlation coefficient of the pairs
+// for each key in a collection. It expects a PCollection<KV<A,Pair>> as input
+// and returns a PCollection<KV<A,float64>>.
+func CorrelationPerKey(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.CorrelationPerKey")
+	_, t := beam.ValidateKVType(col)
+	validatePairType(t)
+	return beam.CombinePerKey(s, &covarianceFn{Correlation: true}, col)
+}
+
+func validatePairType(t typex.FullType) {
+	if t.Type() != reflect.TypeOf(Pair{}) {
+		panic(fmt.Sprintf("type must be stats.Pair: %v", t))
+	}
+}
+
+// covarianceAccum holds the count, means, co-moment and sums of squared
+// differences from the means of pairs, extending Welford's algorithm.
+type covarianceAccum struct {
+	Count        int64
+	MeanX, MeanY float64
+	C            float64
+	M2X, M2Y     float64
+}
+
+func (a covarianceAccum) add(p Pair) covarianceAccum {
+	a.Count++
+	n := float64(a.Count)
+	dx := p.X - a.MeanX
+	dy := p.Y - a.MeanY
+	a.MeanX += dx / n
+	a.MeanY += dy / n
+	a.C += dx * (p.Y - a.MeanY)
+	a.M2X += dx * (p.X - a.MeanX)
+	a.M2Y += dy * (p.Y - a.MeanY)
+	return a
+}
+
+func (a covarianceAccum) merge(b covarianceAccum) covarianceAccum {
+	if a.Count == 0 {
+		return b
+	}
+	if b.Count == 0 {
+		return a
+	}
+	count := a.Count + b.Count
+	n := float64(count)
+	dx := b.MeanX - a.MeanX
+	dy := b.MeanY - a.MeanY
+	w := float64(a.Count) * float64(b.Count) / n
+	return covarianceAccum{
+		Count: count,
+		MeanX: a.MeanX + dx*float64(b.Count)/n,
+		MeanY: a.MeanY + dy*float64(b.Count)/n,
+		C:     a.C + b.C + dx*dy*w,
+		M2X:   a.M2X + b.M2X + dx*dx*w,
+		M2Y:   a.M2Y + b.M2Y + dy*dy*w,
+	}
+}
+
+// covarianceFn is a combineFn that computes the covariance or correlation of
+// pairs.
+type covarianceFn struct {
+	Correlation bool `json:"correlation"`
+}
+
+func (f *covarianceFn) CreateAccumulator() covarianceAccum {
+	return covarianceAccum{}
+}
+
+func (f *covarianceFn) AddInput(a covarianceAccum, p Pair) covarianceAccum {
+	return a.add(p)
+}
+
+func (f *covarianceFn) MergeAccumulators(a, b covarianceAccum) covarianceAccum {
+	return a.merge(b)
+}
+
+func (f *covarianceFn) ExtractOutput(a covarianceAccum) float64 {
+	if a.Count == 0 {
+		return 0
+	}
+	if f.Correlation {
+		if a.M2X == 0 || a.M2Y == 0 {
+			return math.NaN()
+		}
+		return a.C / math.Sqrt(a.M2X*a.M2Y)
+	}
+	return a.C / float64(a.Count)
+}
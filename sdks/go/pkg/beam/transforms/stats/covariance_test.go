@@ -0,0 +1,108 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+)
+
+func init() {
+	register.Function1x2(pairKVFn)
+}
+
+type keyedPair struct {
+	Key string
+	X   float64
+	Y   float64
+}
+
+func pairKVFn(p keyedPair) (string, Pair) {
+	return p.Key, Pair{p.X, p.Y}
+}
+
+// TestCovariance verifies that Covariance and Correlation work correctly.
+func TestCovariance(t *testing.T) {
+	tests := []struct {
+		in          []Pair
+		covariance  float64
+		correlation float64
+	}{
+		{[]Pair{{1, 2}, {2, 4}, {3, 6}}, roundFn(4.0 / 3), 1},
+		{[]Pair{{1, 3}, {2, 2}, {3, 1}}, roundFn(-2.0 / 3), -1},
+		{[]Pair{{1, 1}, {2, 3}, {3, 2}, {4, 4}}, 1, 0.8},
+	}
+
+	for _, test := range tests {
+		p, s := beam.NewPipelineWithRoot()
+		in := beam.CreateList(s, test.in)
+		passert.Equals(s, beam.ParDo(s, roundFn, Covariance(s, in)), test.covariance)
+		passert.Equals(s, beam.ParDo(s, roundFn, Correlation(s, in)), test.correlation)
+
+		if err := ptest.Run(p); err != nil {
+			t.Errorf("Covariance(%v), Correlation(%v) != %v, %v: %v", test.in, test.in, test.covariance, test.correlation, err)
+		}
+	}
+}
+
+// TestCovarianceKeyed verifies that CovariancePerKey and CorrelationPerKey
+// work correctly for KV values.
+func TestCovarianceKeyed(t *testing.T) {
+	in := []keyedPair{{"alpha", 1, 2}, {"alpha", 3, 6}, {"beta", 0, 1}, {"beta", 2, -1}}
+	covariance := []student{{"alpha", 2}, {"beta", -1}}
+	correlation := []student{{"alpha", 1}, {"beta", -1}}
+
+	p, s := beam.NewPipelineWithRoot()
+	kv := beam.ParDo(s, pairKVFn, beam.CreateList(s, in))
+	passert.Equals(s, beam.ParDo(s, kvToStudent, beam.ParDo(s, roundKVFn, CovariancePerKey(s, kv))), beam.CreateList(s, covariance))
+	passert.Equals(s, beam.ParDo(s, kvToStudent, beam.ParDo(s, roundKVFn, CorrelationPerKey(s, kv))), beam.CreateList(s, correlation))
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("CovariancePerKey(%v), CorrelationPerKey(%v) != %v, %v: %v", in, in, covariance, correlation, err)
+	}
+}
+
+// TestCovarianceAccum verifies that merging accumulators is equivalent to
+// adding all pairs to one, and that constant variables have no correlation.
+func TestCovarianceAccum(t *testing.T) {
+	pairs := []Pair{{1, 5}, {4, 3}, {2, 8}, {7, 1}, {3, 3}}
+	var all, a, b covarianceAccum
+	for i, p := range pairs {
+		all = all.add(p)
+		if i < 2 {
+			a = a.add(p)
+		} else {
+			b = b.add(p)
+		}
+	}
+	for _, fn := range []*covarianceFn{{}, {Correlation: true}} {
+		want := fn.ExtractOutput(all)
+		if got := fn.ExtractOutput(fn.MergeAccumulators(a, b)); math.Abs(got-want) > 1e-12 {
+			t.Errorf("%+v: merged output = %v, want %v", fn, got, want)
+		}
+	}
+
+	var constant covarianceAccum
+	constant = constant.add(Pair{1, 2}).add(Pair{1, 3})
+	if got := (&covarianceFn{Correlation: true}).ExtractOutput(constant); !math.IsNaN(got) {
+		t.Errorf("correlation of a constant = %v, want NaN", got)
+	}
+}
@@ -0,0 +1,173 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// fingerprint2011 is a port of the 64-bit Fingerprint2011 hash used by
+// ZetaSketch to hash values before adding them to an HLL++ sketch. Sketches
+// are only compatible with BigQuery and ZetaSketch if values hash the same.
+
+const (
+	fpK0 uint64 = 0xa5b85c5e198ed849
+	fpK1 uint64 = 0x8d58ac26afe12e47
+	fpK2 uint64 = 0xc47b6e9e3a970ed3
+	fpK3 uint64 = 0xc6a4a7935bd1e995
+)
+
+func fingerprint2011(b []byte) uint64 {
+	n := len(b)
+	var result uint64
+	switch {
+	case n <= 32:
+		result = murmurHash64WithSeed(b, fpK0^fpK1^fpK2)
+	case n <= 64:
+		result = fpHashLength33To64(b)
+	default:
+		result = fpFullFingerprint(b)
+	}
+	u, v := fpK0, fpK0
+	if n >= 8 {
+		u = load64(b, 0)
+	}
+	if n >= 9 {
+		v = load64(b, n-8)
+	}
+	result = hash128to64(result+v, u)
+	if result == 0 || result == 1 {
+		return result + ^uint64(1)
+	}
+	return result
+}
+
+func load64(b []byte, off int) uint64 {
+	return binary.LittleEndian.Uint64(b[off:])
+}
+
+// load64Safely loads fewer than 8 bytes as a little endian integer.
+func load64Safely(b []byte) uint64 {
+	var result uint64
+	for i := len(b) - 1; i >= 0; i-- {
+		result = result<<8 | uint64(b[i])
+	}
+	return result
+}
+
+func rotateRight(x uint64, k int) uint64 {
+	return bits.RotateLeft64(x, -k)
+}
+
+func shiftMix(x uint64) uint64 {
+	return x ^ (x >> 47)
+}
+
+func hash128to64(high, low uint64) uint64 {
+	a := (low ^ high) * fpK3
+	a ^= a >> 47
+	b := (high ^ a) * fpK3
+	b ^= b >> 47
+	return b * fpK3
+}
+
+func murmurHash64WithSeed(b []byte, seed uint64) uint64 {
+	const mul = fpK3
+	n := len(b)
+	aligned := n &^ 7
+	hash := seed ^ (uint64(n) * mul)
+	for i := 0; i < aligned; i += 8 {
+		hash ^= shiftMix(load64(b, i)*mul) * mul
+		hash *= mul
+	}
+	if aligned != n {
+		hash ^= load64Safely(b[aligned:])
+		hash *= mul
+	}
+	hash = shiftMix(hash) * mul
+	return shiftMix(hash)
+}
+
+func fpHashLength33To64(b []byte) uint64 {
+	n := len(b)
+	z := load64(b, 24)
+	a := load64(b, 0) + (uint64(n)+load64(b, n-16))*fpK0
+	c := rotateRight(a, 37)
+	bb := rotateRight(a+z, 52)
+	a += load64(b, 8)
+	c += rotateRight(a, 7)
+	a += load64(b, 16)
+	vf := a + z
+	vs := bb + rotateRight(a, 31) + c
+	a = load64(b, 16) + load64(b, n-32)
+	z = load64(b, n-8)
+	bb = rotateRight(a+z, 52)
+	c = rotateRight(a, 37)
+	a += load64(b, n-24)
+	c += rotateRight(a, 7)
+	a += load64(b, n-16)
+	wf := a + z
+	ws := bb + rotateRight(a, 31) + c
+	r := shiftMix((vf+ws)*fpK2 + (wf+vs)*fpK0)
+	return shiftMix(r*fpK0+vs) * fpK2
+}
+
+func weakHashLength32WithSeeds(b []byte, off int, seedA, seedB uint64) (uint64, uint64) {
+	part1 := load64(b, off)
+	part2 := load64(b, off+8)
+	part3 := load64(b, off+16)
+	part4 := load64(b, off+24)
+
+	seedA += part1
+	seedB = rotateRight(seedB+seedA+part4, 51)
+	c := seedA
+	seedA += part2
+	seedA += part3
+	seedB += rotateRight(seedA, 23)
+	return seedA + part4, seedB + c
+}
+
+func fpFullFingerprint(b []byte) uint64 {
+	n := len(b)
+	// For lengths over 64 bytes the end is hashed first, and then 56 bytes
+	// of state (v, w, x, y and z) are kept while looping over 64 byte chunks.
+	x := load64(b, 0)
+	y := load64(b, n-16) ^ fpK1
+	z := load64(b, n-56) ^ fpK0
+	v0, v1 := weakHashLength32WithSeeds(b, n-64, uint64(n), y)
+	w0, w1 := weakHashLength32WithSeeds(b, n-32, uint64(n)*fpK1, fpK0)
+	z += shiftMix(v1) * fpK1
+	x = rotateRight(z+x, 39) * fpK1
+	y = rotateRight(y, 33) * fpK1
+
+	// Round the length down to the nearest multiple of 64.
+	remaining := (n - 1) &^ 63
+	off := 0
+	for remaining != 0 {
+		x = rotateRight(x+y+v0+load64(b, off+16), 37) * fpK1
+		y = rotateRight(y+v1+load64(b, off+48), 42) * fpK1
+		x ^= w1
+		y ^= v0
+		z = rotateRight(z^w0, 33)
+		v0, v1 = weakHashLength32WithSeeds(b, off, v1*fpK1, x+w0)
+		w0, w1 = weakHashLength32WithSeeds(b, off+32, z+w1, y)
+		z, x = x, z
+		off += 64
+		remaining -= 64
+	}
+	return hash128to64(hash128to64(v0, w0)+shiftMix(y)*fpK1+z, hash128to64(v1, w1)+x)
+}
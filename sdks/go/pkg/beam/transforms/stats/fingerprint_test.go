@@ -0,0 +1,40 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestFingerprint2011 checks fingerprint2011 against the values of Guava's
+// Fingerprint2011Test.testReallySimpleFingerprints, which cover the hashing of
+// values of up to 32 bytes and of over 64 bytes.
+func TestFingerprint2011(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int64
+	}{
+		{in: "test", want: 8473225671271759044},
+		{in: strings.Repeat("test", 8), want: 7345148637025587076},
+		{in: strings.Repeat("test", 64), want: 4904844928629814570},
+	}
+	for _, tt := range tests {
+		if got := int64(fingerprint2011([]byte(tt.in))); got != tt.want {
+			t.Errorf("fingerprint2011(%d bytes) = %v, want %v", len(tt.in), got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+)
+
+//go:generate specialize --input=histogram_switch.tmpl --x=integers,floats
+//go:generate gofmt -w histogram_switch.go
+
+// Buckets defines the buckets of a histogram by their boundaries. Bucket i
+// holds the values in [Bounds[i], Bounds[i+1]).
+type Buckets struct {
+	Bounds []float64
+}
+
+// LinearBuckets returns n buckets of the given width, the first of which
+// starts at start.
+func LinearBuckets(start, width float64, n int) Buckets {
+	if width <= 0 || n < 1 {
+		panic(fmt.Sprintf("linear buckets need a positive width and count: width %v, count %v", width, n))
+	}
+	bounds := make([]float64, n+1)
+	for i := range bounds {
+		bounds[i] = start + float64(i)*width
+	}
+	return Buckets{Bounds: bounds}
+}
+
+// ExponentialBuckets returns n buckets, the first of which starts at start,
+// and each of which is growth times as wide as the previous one.
+//
+// For example, ExponentialBuckets(1, 10, 3) has the buckets [1, 10), [10, 100)
+// and [100, 1000).
+func ExponentialBuckets(start, growth float64, n int) Buckets {
+	if start <= 0 || growth <= 1 || n < 1 {
+		panic(fmt.Sprintf("exponential buckets need a positive start, a growth over 1 and a positive count: start %v, growth %v, count %v", start, growth, n))
+	}
+	bounds := make([]float64, n+1)
+	for i := range bounds {
+		bounds[i] = start * math.Pow(growth, float64(i))
+	}
+	return Buckets{Bounds: bounds}
+}
+
+func (b Buckets) validate() {
+	if len(b.Bounds) < 2 {
+		panic(fmt.Sprintf("buckets need at least two bounds: %v", b.Bounds))
+	}
+	for i := 1; i < len(b.Bounds); i++ {
+		if !(b.Bounds[i-1] < b.Bounds[i]) {
+			panic(fmt.Sprintf("bucket bounds must be strictly increasing: %v", b.Bounds))
+		}
+	}
+}
+
+// BucketCounts is the output of Histogram: the number of values in each
+// bucket, and the number of values below and above all buckets. NaNs are
+// counted as Overflow.
+type BucketCounts struct {
+	Bounds    []float64
+	Counts    []int64
+	Underflow int64
+	Overflow  int64
+}
+
+// Histogram counts the elements of a collection in the given buckets. It
+// expects a PCollection<A> as input and returns a singleton
+// PCollection<BucketCounts>. It can only be used for numbers, such as int,
+// uint16, float32, etc.
+//
+// For example:
+//
+//	col := beam.Create(s, 1, 5, 15, 25, 120)
+//	hist := stats.Histogram(s, col, stats.LinearBuckets(0, 10, 3))   // PCollection<BucketCounts> with counts [2, 1, 1] and 1 overflow.
+func Histogram(s beam.Scope, col beam.PCollection, buckets Buckets) beam.PCollection {
+	s = s.Scope("stats.Histogram")
+	buckets.validate()
+	return combine(s, histogramFnMaker(buckets), col)
+}
+
+// HistogramPerKey counts the values for each key of a collection in the given
+// buckets. It expects a PCollection<KV<A,B>> as input and returns a
+// PCollection<KV<A,BucketCounts>>. It can only be used for value numbers, such
+// as int, uint16, float32, etc.
+func HistogramPerKey(s beam.Scope, col beam.PCollection, buckets Buckets) beam.PCollection {
+	s = s.Scope("stats.HistogramPerKey")
+	buckets.validate()
+	return combinePerKey(s, histogramFnMaker(buckets), col)
+}
+
+func histogramFnMaker(buckets Buckets) func(reflect.Type) any {
+	return func(t reflect.Type) any {
+		return findHistogramFn(t, buckets)
+	}
+}
+
+// histogramFn is the base of the combineFns that count numbers in buckets.
+// Its accumulator holds the underflow count, the count of each bucket, and the
+// overflow count. The generated histogram<Type>Fns add the AddInput method for
+// each number type.
+type histogramFn struct {
+	Buckets Buckets `json:"buckets"`
+}
+
+func (f *histogramFn) CreateAccumulator() []int64 {
+	return make([]int64, len(f.Buckets.Bounds)+1)
+}
+
+func (f *histogramFn) add(a []int64, x float64) []int64 {
+	if math.IsNaN(x) {
+		a[len(a)-1]++
+		return a
+	}
+	// Search returns the number of bounds not greater than x, which is the
+	// accumulator index of x.
+	a[sort.Search(len(f.Buckets.Bounds), func(i int) bool { return f.Buckets.Bounds[i] > x })]++
+	return a
+}
+
+func (f *histogramFn) MergeAccumulators(a, b []int64) []int64 {
+	for i := range a {
+		a[i] += b[i]
+	}
+	return a
+}
+
+func (f *histogramFn) ExtractOutput(a []int64) BucketCounts {
+	return BucketCounts{
+		Bounds:    f.Buckets.Bounds,
+		Counts:    a[1 : len(a)-1],
+		Underflow: a[0],
+		Overflow:  a[len(a)-1],
+	}
+}
@@ -0,0 +1,167 @@
+// File generated by specialize. Do not edit.
+
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+func init() {
+	register.Combiner3[[]int64, int, BucketCounts](&histogramIntFn{})
+	register.Combiner3[[]int64, int8, BucketCounts](&histogramInt8Fn{})
+	register.Combiner3[[]int64, int16, BucketCounts](&histogramInt16Fn{})
+	register.Combiner3[[]int64, int32, BucketCounts](&histogramInt32Fn{})
+	register.Combiner3[[]int64, int64, BucketCounts](&histogramInt64Fn{})
+	register.Combiner3[[]int64, uint, BucketCounts](&histogramUintFn{})
+	register.Combiner3[[]int64, uint8, BucketCounts](&histogramUint8Fn{})
+	register.Combiner3[[]int64, uint16, BucketCounts](&histogramUint16Fn{})
+	register.Combiner3[[]int64, uint32, BucketCounts](&histogramUint32Fn{})
+	register.Combiner3[[]int64, uint64, BucketCounts](&histogramUint64Fn{})
+	register.Combiner3[[]int64, float32, BucketCounts](&histogramFloat32Fn{})
+	register.Combiner3[[]int64, float64, BucketCounts](&histogramFloat64Fn{})
+}
+
+func findHistogramFn(t reflect.Type, buckets Buckets) any {
+	switch t.String() {
+	case "int":
+		return &histogramIntFn{histogramFn{Buckets: buckets}}
+	case "int8":
+		return &histogramInt8Fn{histogramFn{Buckets: buckets}}
+	case "int16":
+		return &histogramInt16Fn{histogramFn{Buckets: buckets}}
+	case "int32":
+		return &histogramInt32Fn{histogramFn{Buckets: buckets}}
+	case "int64":
+		return &histogramInt64Fn{histogramFn{Buckets: buckets}}
+	case "uint":
+		return &histogramUintFn{histogramFn{Buckets: buckets}}
+	case "uint8":
+		return &histogramUint8Fn{histogramFn{Buckets: buckets}}
+	case "uint16":
+		return &histogramUint16Fn{histogramFn{Buckets: buckets}}
+	case "uint32":
+		return &histogramUint32Fn{histogramFn{Buckets: buckets}}
+	case "uint64":
+		return &histogramUint64Fn{histogramFn{Buckets: buckets}}
+	case "float32":
+		return &histogramFloat32Fn{histogramFn{Buckets: buckets}}
+	case "float64":
+		return &histogramFloat64Fn{histogramFn{Buckets: buckets}}
+	default:
+		panic(fmt.Sprintf("Unexpected number type: %v", t))
+	}
+}
+
+type histogramIntFn struct {
+	histogramFn
+}
+
+func (f *histogramIntFn) AddInput(a []int64, x int) []int64 {
+	return f.add(a, float64(x))
+}
+
+type histogramInt8Fn struct {
+	histogramFn
+}
+
+func (f *histogramInt8Fn) AddInput(a []int64, x int8) []int64 {
+	return f.add(a, float64(x))
+}
+
+type histogramInt16Fn struct {
+	histogramFn
+}
+
+func (f *histogramInt16Fn) AddInput(a []int64, x int16) []int64 {
+	return f.add(a, float64(x))
+}
+
+type histogramInt32Fn struct {
+	histogramFn
+}
+
+func (f *histogramInt32Fn) AddInput(a []int64, x int32) []int64 {
+	return f.add(a, float64(x))
+}
+
+type histogramInt64Fn struct {
+	histogramFn
+}
+
+func (f *histogramInt64Fn) AddInput(a []int64, x int64) []int64 {
+	return f.add(a, float64(x))
+}
+
+type histogramUintFn struct {
+	histogramFn
+}
+
+func (f *histogramUintFn) AddInput(a []int64, x uint) []int64 {
+	return f.add(a, float64(x))
+}
+
+type histogramUint8Fn struct {
+	histogramFn
+}
+
+func (f *histogramUint8Fn) AddInput(a []int64, x uint8) []int64 {
+	return f.add(a, float64(x))
+}
+
+type histogramUint16Fn struct {
+	histogramFn
+}
+
+func (f *histogramUint16Fn) AddInput(a []int64, x uint16) []int64 {
+	return f.add(a, float64(x))
+}
+
+type histogramUint32Fn struct {
+	histogramFn
+}
+
+func (f *histogramUint32Fn) AddInput(a []int64, x uint32) []int64 {
+	return f.add(a, float64(x))
+}
+
+type histogramUint64Fn struct {
+	histogramFn
+}
+
+func (f *histogramUint64Fn) AddInput(a []int64, x uint64) []int64 {
+	return f.add(a, float64(x))
+}
+
+type histogramFloat32Fn struct {
+	histogramFn
+}
+
+func (f *histogramFloat32Fn) AddInput(a []int64, x float32) []int64 {
+	return f.add(a, float64(x))
+}
+
+type histogramFloat64Fn struct {
+	histogramFn
+}
+
+func (f *histogramFloat64Fn) AddInput(a []int64, x float64) []int64 {
+	return f.add(a, float64(x))
+}
@@ -0,0 +1,128 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func init() {
+	register.Function2x1(bucketCountsToKV)
+}
+
+type keyedCounts struct {
+	Key    string
+	Counts BucketCounts
+}
+
+func bucketCountsToKV(k string, c BucketCounts) keyedCounts {
+	return keyedCounts{k, c}
+}
+
+func TestBuckets(t *testing.T) {
+	if got, want := LinearBuckets(-1, 0.5, 4).Bounds, []float64{-1, -0.5, 0, 0.5, 1}; !cmp.Equal(got, want) {
+		t.Errorf("LinearBuckets(-1, 0.5, 4) = %v, want %v", got, want)
+	}
+	if got, want := ExponentialBuckets(1, 10, 3).Bounds, []float64{1, 10, 100, 1000}; !cmp.Equal(got, want) {
+		t.Errorf("ExponentialBuckets(1, 10, 3) = %v, want %v", got, want)
+	}
+}
+
+// TestHistogram verifies that Histogram works correctly for ints and floats.
+func TestHistogram(t *testing.T) {
+	tests := []struct {
+		in      any
+		buckets Buckets
+		exp     BucketCounts
+	}{
+		{
+			[]int{1, 5, 15, 25, 120, -3, 30},
+			LinearBuckets(0, 10, 3),
+			BucketCounts{Bounds: []float64{0, 10, 20, 30}, Counts: []int64{2, 1, 1}, Underflow: 1, Overflow: 2},
+		},
+		{
+			[]uint64{1, 9, 10, 99, 100, 5000},
+			ExponentialBuckets(1, 10, 3),
+			BucketCounts{Bounds: []float64{1, 10, 100, 1000}, Counts: []int64{2, 2, 1}, Overflow: 1},
+		},
+		{
+			[]float64{0.5, math.NaN(), math.Inf(-1), 1.5, 1.75},
+			Buckets{Bounds: []float64{0, 1, 2}},
+			BucketCounts{Bounds: []float64{0, 1, 2}, Counts: []int64{1, 2}, Underflow: 1, Overflow: 1},
+		},
+	}
+
+	for _, test := range tests {
+		p, s := beam.NewPipelineWithRoot()
+		hist := Histogram(s, beam.CreateList(s, test.in), test.buckets)
+		passert.Equals(s, hist, test.exp)
+
+		if err := ptest.Run(p); err != nil {
+			t.Errorf("Histogram(%v, %v) != %v: %v", test.in, test.buckets, test.exp, err)
+		}
+	}
+}
+
+// TestHistogramKeyed verifies that HistogramPerKey works correctly for KV
+// values.
+func TestHistogramKeyed(t *testing.T) {
+	in := []student{{"alpha", 1}, {"alpha", 3}, {"alpha", 3.5}, {"beta", 4}}
+	bounds := []float64{0, 2, 4}
+	exp := []keyedCounts{
+		{"alpha", BucketCounts{Bounds: bounds, Counts: []int64{1, 2}}},
+		{"beta", BucketCounts{Bounds: bounds, Counts: []int64{0, 0}, Overflow: 1}},
+	}
+
+	p, s := beam.NewPipelineWithRoot()
+	kv := beam.ParDo(s, studentToKV, beam.CreateList(s, in))
+	hist := HistogramPerKey(s, kv, LinearBuckets(0, 2, 2))
+	passert.Equals(s, beam.ParDo(s, bucketCountsToKV, hist), beam.CreateList(s, exp))
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("HistogramPerKey(%v) != %v: %v", in, exp, err)
+	}
+}
+
+func TestHistogram_InvalidBuckets(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"no linear buckets", func() { LinearBuckets(0, 1, 0) }},
+		{"zero width", func() { LinearBuckets(0, 0, 2) }},
+		{"no growth", func() { ExponentialBuckets(1, 1, 2) }},
+		{"zero start", func() { ExponentialBuckets(0, 2, 2) }},
+		{"unsorted bounds", func() { Buckets{Bounds: []float64{0, 2, 1}}.validate() }},
+		{"single bound", func() { Buckets{Bounds: []float64{0}}.validate() }},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("invalid buckets didn't panic")
+				}
+			}()
+			test.fn()
+		})
+	}
+}
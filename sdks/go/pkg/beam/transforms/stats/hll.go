@@ -0,0 +1,701 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+// The HLL++ sketch is implemented based on
+// https://static.googleusercontent.com/media/research.google.com/en//pubs/archive/40671.pdf
+// and is serialized in the ZetaSketch format used by BigQuery's HLL_COUNT
+// functions. Normal sketches are estimated with the estimator from
+// https://arxiv.org/abs/1702.01284 instead of the one of ZetaSketch.
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	// MinHLLPrecision is the smallest supported HLL++ precision.
+	MinHLLPrecision = 10
+	// MaxHLLPrecision is the largest supported HLL++ precision.
+	MaxHLLPrecision = 24
+	// DefaultHLLPrecision is the HLL++ precision used when none is set. It
+	// matches the BigQuery default and gives a relative error of about 0.5%.
+	DefaultHLLPrecision = 15
+
+	// maxSparsePrecision is the largest sparse precision ZetaSketch accepts.
+	maxSparsePrecision = 25
+	// sparsePrecisionDelta is the default difference between the sparse and
+	// the normal precision.
+	sparsePrecisionDelta = 5
+	// rhoBits is the number of bits used to store rho(w') in sparse values.
+	rhoBits = 6
+)
+
+// Field numbers of the ZetaSketch AggregatorStateProto message.
+const (
+	aggTypeField      protowire.Number = 1
+	aggNumValuesField protowire.Number = 2
+	aggEncodingField  protowire.Number = 3
+	aggValueTypeField protowire.Number = 4
+	aggHLLStateField  protowire.Number = 112
+)
+
+// Field numbers of the ZetaSketch HyperLogLogPlusUniqueStateProto message.
+const (
+	hllSparseSizeField protowire.Number = 2
+	hllPrecisionField  protowire.Number = 3
+	hllSparsePrecField protowire.Number = 4
+	hllDataField       protowire.Number = 5
+	hllSparseDataField protowire.Number = 6
+)
+
+const (
+	// hllPlusUniqueType is the HYPERLOGLOG_PLUS_UNIQUE aggregator type.
+	hllPlusUniqueType = 112
+	// hllEncodingVersion is the encoding version of HLL++ sketches. Version
+	// 1 is the protocol buffer default.
+	hllEncodingVersion   = 2
+	hllDefaultEncVersion = 1
+)
+
+// Value types of the ZetaSketch DefaultOpsType.Id enum.
+const (
+	hllValueTypeUnknown hllValueType = 0
+	hllValueTypeInt32   hllValueType = 1
+	hllValueTypeInt64   hllValueType = 2
+	hllValueTypeBytes   hllValueType = 11 // BYTES_OR_UTF8_STRING
+)
+
+// hllValueType identifies the type of the values hashed into a sketch.
+// Sketches of different value types can't be merged.
+type hllValueType int32
+
+func (t hllValueType) String() string {
+	switch t {
+	case hllValueTypeInt32:
+		return "int32"
+	case hllValueTypeInt64:
+		return "int64"
+	case hllValueTypeBytes:
+		return "string or []byte"
+	default:
+		return "unknown"
+	}
+}
+
+// HLL is a HyperLogLog++ sketch estimating the number of distinct values
+// added to it. Sketches are mergeable and can be serialized with
+// MarshalBinary to be stored and merged again later. The serialized form is
+// compatible with ZetaSketch and the BigQuery HLL_COUNT functions: values
+// added with AddInt32, AddInt64, AddString and AddBytes hash to the same
+// registers as INT32, INT64, STRING and BYTES values do in BigQuery. Only
+// the estimates of large sketches differ, see Estimate.
+//
+// Small cardinalities are kept in a sparse representation of higher
+// precision, which is converted to one register per bucket once it grows
+// larger than the normal representation would be.
+//
+// The zero HLL is an empty sketch, serialized as an empty byte slice like
+// empty sketches in BigQuery. Values added to it use DefaultHLLPrecision and
+// merging a sketch into it copies that sketch.
+type HLL struct {
+	precision       int
+	sparsePrecision int
+	valueType       hllValueType
+	numValues       int64
+
+	// registers holds the normal representation. It is nil while the sketch
+	// is sparse.
+	registers []byte
+	// sparse holds sorted, deduplicated sparse values and buffer newly added
+	// ones that haven't been merged into sparse yet.
+	sparse []uint32
+	buffer []uint32
+}
+
+// NewHLL returns an empty HLL++ sketch with the given precision, which must be
+// between MinHLLPrecision and MaxHLLPrecision. Higher precisions are more
+// accurate and use more memory: the relative error is about 1.04/sqrt(2^p)
+// and a sketch uses at most 2^p bytes.
+func NewHLL(precision int) (*HLL, error) {
+	if err := validateHLLPrecision(precision); err != nil {
+		return nil, err
+	}
+	sp := precision + sparsePrecisionDelta
+	if sp > maxSparsePrecision {
+		sp = maxSparsePrecision
+	}
+	return &HLL{precision: precision, sparsePrecision: sp}, nil
+}
+
+func validateHLLPrecision(precision int) error {
+	if precision < MinHLLPrecision || precision > MaxHLLPrecision {
+		return errors.Errorf("HLL++ precision %v out of range [%v, %v]", precision, MinHLLPrecision, MaxHLLPrecision)
+	}
+	return nil
+}
+
+// Precision returns the normal precision of the sketch.
+func (h *HLL) Precision() int {
+	return h.precision
+}
+
+// NumValues returns the number of values, including duplicates, that were
+// added to the sketch and the sketches merged into it.
+func (h *HLL) NumValues() int64 {
+	return h.numValues
+}
+
+// AddInt32 adds an int32 value to the sketch. It fails if the sketch holds
+// values of another type.
+func (h *HLL) AddInt32(v int32) error {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], uint32(v))
+	return h.addHash(hllValueTypeInt32, fingerprint2011(b[:]))
+}
+
+// AddInt64 adds an int64 value to the sketch. It fails if the sketch holds
+// values of another type.
+func (h *HLL) AddInt64(v int64) error {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(v))
+	return h.addHash(hllValueTypeInt64, fingerprint2011(b[:]))
+}
+
+// AddString adds the UTF-8 bytes of a string to the sketch. Strings and byte
+// slices with the same content are the same value. It fails if the sketch
+// holds values of another type.
+func (h *HLL) AddString(v string) error {
+	return h.addHash(hllValueTypeBytes, fingerprint2011([]byte(v)))
+}
+
+// AddBytes adds a byte slice to the sketch. It fails if the sketch holds
+// values of another type.
+func (h *HLL) AddBytes(v []byte) error {
+	return h.addHash(hllValueTypeBytes, fingerprint2011(v))
+}
+
+func (h *HLL) addHash(t hllValueType, hash uint64) error {
+	if h.precision == 0 {
+		h.precision, h.sparsePrecision = DefaultHLLPrecision, DefaultHLLPrecision+sparsePrecisionDelta
+	}
+	if err := h.checkValueType(t); err != nil {
+		return err
+	}
+	h.numValues++
+	h.insert(hash)
+	return nil
+}
+
+func (h *HLL) checkValueType(t hllValueType) error {
+	switch {
+	case t == hllValueTypeUnknown:
+	case h.valueType == hllValueTypeUnknown:
+		h.valueType = t
+	case h.valueType != t:
+		return errors.Errorf("HLL++ sketch of %v values can't hold %v values", h.valueType, t)
+	}
+	return nil
+}
+
+// insert adds a hash, or a hash reconstructed from another sketch, to the
+// registers or the sparse buffer.
+func (h *HLL) insert(hash uint64) {
+	if h.registers != nil {
+		idx, rho := normalEncode(h.precision, hash)
+		if rho > h.registers[idx] {
+			h.registers[idx] = rho
+		}
+		return
+	}
+	h.buffer = append(h.buffer, sparseEncode(h.precision, h.sparsePrecision, hash))
+	if len(h.buffer)*4 >= h.maxSparseBytes() {
+		h.flush()
+	}
+}
+
+// maxSparseBytes is the size above which the encoded sparse representation
+// is converted to the normal one. It is a bit smaller than the normal
+// representation to account for the work of maintaining sparse values.
+func (h *HLL) maxSparseBytes() int {
+	return (1 << h.precision) * 3 / 4
+}
+
+// flush merges the sparse buffer into the sorted sparse values, and
+// converts the sketch to the normal representation if the sparse one
+// has grown too large.
+func (h *HLL) flush() {
+	if h.registers != nil || len(h.buffer) == 0 {
+		return
+	}
+	vals := append(h.sparse, h.buffer...)
+	h.buffer = nil
+	sort.Slice(vals, func(i, j int) bool { return vals[i] < vals[j] })
+	// Keep the largest value per sparse index. Values encoding rho(w') sort
+	// after all others, and in order of increasing rho(w') per index.
+	out := vals[:0]
+	for _, v := range vals {
+		if n := len(out); n > 0 && h.sparseIndex(out[n-1]) == h.sparseIndex(v) {
+			out[n-1] = v
+			continue
+		}
+		out = append(out, v)
+	}
+	h.sparse = out
+	if sparseDataSize(h.sparse) > h.maxSparseBytes() {
+		h.toNormal()
+	}
+}
+
+// sparseIndex returns the index at the sparse precision of a sparse value.
+func (h *HLL) sparseIndex(v uint32) uint32 {
+	flag := rhoFlag(h.precision, h.sparsePrecision)
+	if v&flag == 0 {
+		return v
+	}
+	return (v ^ flag) >> rhoBits << (h.sparsePrecision - h.precision)
+}
+
+// toNormal converts a sparse sketch to the normal representation.
+func (h *HLL) toNormal() {
+	if h.registers != nil {
+		return
+	}
+	sparse := append(h.sparse, h.buffer...)
+	h.sparse, h.buffer = nil, nil
+	h.registers = make([]byte, 1<<h.precision)
+	for _, v := range sparse {
+		h.insert(sparseHash(h.precision, h.sparsePrecision, v))
+	}
+}
+
+// hashes returns a hash for every non-empty register or sparse value that
+// encodes identically to the hashes originally added to the sketch.
+func (h *HLL) hashes() []uint64 {
+	var ret []uint64
+	if h.registers != nil {
+		for idx, rho := range h.registers {
+			if rho != 0 {
+				ret = append(ret, normalHash(h.precision, uint32(idx), rho))
+			}
+		}
+		return ret
+	}
+	for _, vals := range [][]uint32{h.sparse, h.buffer} {
+		for _, v := range vals {
+			ret = append(ret, sparseHash(h.precision, h.sparsePrecision, v))
+		}
+	}
+	return ret
+}
+
+// downgrade lowers the precisions of the sketch, so that sketches of different
+// precision can be merged.
+func (h *HLL) downgrade(precision, sparsePrecision int) {
+	if precision == h.precision && sparsePrecision == h.sparsePrecision {
+		return
+	}
+	hashes := h.hashes()
+	normal := h.registers != nil || sparsePrecision == 0
+	h.precision, h.sparsePrecision = precision, sparsePrecision
+	h.registers, h.sparse, h.buffer = nil, nil, nil
+	if normal {
+		h.registers = make([]byte, 1<<precision)
+	}
+	for _, hash := range hashes {
+		h.insert(hash)
+	}
+	h.flush()
+}
+
+// Merge merges other into the sketch. If the sketches have different
+// precisions, the result has the lower one. Merge fails if the sketches hold
+// values of different types. The other sketch is not modified.
+func (h *HLL) Merge(other *HLL) error {
+	if err := h.checkValueType(other.valueType); err != nil {
+		return err
+	}
+	if other.precision == 0 {
+		return nil
+	}
+	if h.precision == 0 {
+		*h = HLL{
+			precision:       other.precision,
+			sparsePrecision: other.sparsePrecision,
+			valueType:       other.valueType,
+			numValues:       other.numValues,
+			registers:       append([]byte(nil), other.registers...),
+			sparse:          append([]uint32(nil), other.sparse...),
+			buffer:          append([]uint32(nil), other.buffer...),
+		}
+		return nil
+	}
+	precision, sparsePrecision := h.precision, h.sparsePrecision
+	if other.precision < precision {
+		precision = other.precision
+	}
+	if other.sparsePrecision < sparsePrecision {
+		sparsePrecision = other.sparsePrecision
+	}
+	h.downgrade(precision, sparsePrecision)
+	if other.registers != nil {
+		h.toNormal()
+	}
+	for _, hash := range other.hashes() {
+		h.insert(hash)
+	}
+	h.flush()
+	h.numValues += other.numValues
+	return nil
+}
+
+// Estimate returns the estimated number of distinct values in the sketch.
+//
+// Sparse sketches are estimated with linear counting at the sparse
+// precision, as in ZetaSketch. Normal sketches use the improved raw estimator
+// of Ertl instead of the bias corrected estimator of ZetaSketch, which needs
+// large empirical tables. Both have a relative error of about 1.04/sqrt(2^p),
+// but the estimates of a normal sketch can differ slightly from those of
+// BigQuery's HLL_COUNT.EXTRACT for the same sketch.
+func (h *HLL) Estimate() int64 {
+	if h.precision == 0 {
+		return 0
+	}
+	if h.registers == nil {
+		h.flush()
+	}
+	if h.registers == nil {
+		// Linear counting at the sparse precision.
+		m := float64(int64(1) << h.sparsePrecision)
+		return int64(math.Round(m * math.Log(m/(m-float64(len(h.sparse))))))
+	}
+	return int64(math.Round(ertlEstimate(h.precision, h.registers)))
+}
+
+// ertlEstimate computes the improved raw estimate of section 4 of
+// https://arxiv.org/abs/1702.01284, which needs no empirical bias correction.
+func ertlEstimate(precision int, registers []byte) float64 {
+	q := 64 - precision
+	counts := make([]float64, q+2)
+	for _, r := range registers {
+		counts[r]++
+	}
+	m := float64(len(registers))
+	z := m * hllTau(1-counts[q+1]/m)
+	for k := q; k >= 1; k-- {
+		z = 0.5 * (z + counts[k])
+	}
+	z += m * hllSigma(counts[0]/m)
+	return m * m / (2 * math.Ln2 * z)
+}
+
+func hllSigma(x float64) float64 {
+	if x == 1 {
+		return math.Inf(1)
+	}
+	y, z := 1.0, x
+	for {
+		x *= x
+		prev := z
+		z += x * y
+		y += y
+		if z == prev {
+			return z
+		}
+	}
+}
+
+func hllTau(x float64) float64 {
+	if x == 0 || x == 1 {
+		return 0
+	}
+	y, z := 1.0, 1-x
+	for {
+		x = math.Sqrt(x)
+		prev := z
+		y *= 0.5
+		z -= (1 - x) * (1 - x) * y
+		if z == prev {
+			return z / 3
+		}
+	}
+}
+
+// normalEncode returns the register index and rho(w) of a hash.
+func normalEncode(precision int, hash uint64) (uint32, byte) {
+	idx := uint32(hash >> (64 - precision))
+	rho := bits.LeadingZeros64(hash<<precision|1<<(precision-1)) + 1
+	return idx, byte(rho)
+}
+
+// normalHash returns a hash with the given register index and rho(w).
+func normalHash(precision int, idx uint32, rho byte) uint64 {
+	hash := uint64(idx) << (64 - precision)
+	if int(rho) <= 64-precision {
+		hash |= 1 << (64 - precision - int(rho))
+	}
+	return hash
+}
+
+// rhoFlag is the bit marking sparse values that encode rho(w').
+func rhoFlag(precision, sparsePrecision int) uint32 {
+	if sparsePrecision > precision+rhoBits {
+		return 1 << sparsePrecision
+	}
+	return 1 << (precision + rhoBits)
+}
+
+// sparseEncode encodes a hash as a sparse value. If the bits of the sparse
+// index beyond the normal precision are not all zero, rho(w) can be derived
+// from them and the value is the sparse index itself. Otherwise the value is
+// the normal index and rho(w') of the bits beyond the sparse precision,
+// marked with the rho flag.
+func sparseEncode(precision, sparsePrecision int, hash uint64) uint32 {
+	sidx := uint32(hash >> (64 - sparsePrecision))
+	if sidx&(1<<(sparsePrecision-precision)-1) != 0 {
+		return sidx
+	}
+	nidx := sidx >> (sparsePrecision - precision)
+	rho := uint32(bits.LeadingZeros64(hash<<sparsePrecision|1<<(sparsePrecision-1)) + 1)
+	return rhoFlag(precision, sparsePrecision) | nidx<<rhoBits | rho
+}
+
+// sparseHash returns a hash that encodes to the given sparse value.
+func sparseHash(precision, sparsePrecision int, v uint32) uint64 {
+	flag := rhoFlag(precision, sparsePrecision)
+	if v&flag == 0 {
+		return uint64(v) << (64 - sparsePrecision)
+	}
+	v ^= flag
+	hash := uint64(v>>rhoBits) << (64 - precision)
+	if rho := int(v & (1<<rhoBits - 1)); rho <= 64-sparsePrecision {
+		hash |= 1 << (64 - sparsePrecision - rho)
+	}
+	return hash
+}
+
+// sparseDataSize returns the size of the difference encoded sparse values.
+func sparseDataSize(vals []uint32) int {
+	size := 0
+	var prev uint32
+	for _, v := range vals {
+		size += protowire.SizeVarint(uint64(v - prev))
+		prev = v
+	}
+	return size
+}
+
+// MarshalBinary serializes the sketch as a ZetaSketch AggregatorStateProto.
+func (h *HLL) MarshalBinary() ([]byte, error) {
+	if h.precision == 0 {
+		return []byte{}, nil
+	}
+	h.flush()
+	var state []byte
+	if h.registers == nil {
+		state = protowire.AppendTag(state, hllSparseSizeField, protowire.VarintType)
+		state = protowire.AppendVarint(state, uint64(len(h.sparse)))
+	}
+	state = protowire.AppendTag(state, hllPrecisionField, protowire.VarintType)
+	state = protowire.AppendVarint(state, uint64(h.precision))
+	state = protowire.AppendTag(state, hllSparsePrecField, protowire.VarintType)
+	state = protowire.AppendVarint(state, uint64(h.sparsePrecision))
+	if h.registers != nil {
+		state = protowire.AppendTag(state, hllDataField, protowire.BytesType)
+		state = protowire.AppendBytes(state, h.registers)
+	} else {
+		data := make([]byte, 0, sparseDataSize(h.sparse))
+		var prev uint32
+		for _, v := range h.sparse {
+			data = protowire.AppendVarint(data, uint64(v-prev))
+			prev = v
+		}
+		state = protowire.AppendTag(state, hllSparseDataField, protowire.BytesType)
+		state = protowire.AppendBytes(state, data)
+	}
+
+	var b []byte
+	b = protowire.AppendTag(b, aggTypeField, protowire.VarintType)
+	b = protowire.AppendVarint(b, hllPlusUniqueType)
+	b = protowire.AppendTag(b, aggNumValuesField, protowire.VarintType)
+	b = protowire.AppendVarint(b, uint64(h.numValues))
+	b = protowire.AppendTag(b, aggEncodingField, protowire.VarintType)
+	b = protowire.AppendVarint(b, hllEncodingVersion)
+	if h.valueType != hllValueTypeUnknown {
+		b = protowire.AppendTag(b, aggValueTypeField, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(h.valueType))
+	}
+	b = protowire.AppendTag(b, aggHLLStateField, protowire.BytesType)
+	b = protowire.AppendBytes(b, state)
+	return b, nil
+}
+
+// UnmarshalBinary decodes a sketch serialized by MarshalBinary, ZetaSketch
+// or BigQuery. Empty data decodes to the empty zero HLL.
+func (h *HLL) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		*h = HLL{}
+		return nil
+	}
+	var (
+		aggType, encoding  uint64 = 0, hllDefaultEncVersion
+		numValues          uint64
+		valueType          uint64
+		state              []byte
+		precision, sparseP uint64
+		sparseSize         uint64
+		regs, sparseData   []byte
+	)
+	err := consumeFields(data, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == aggTypeField && typ == protowire.VarintType:
+			return consumeVarint(b, &aggType)
+		case num == aggNumValuesField && typ == protowire.VarintType:
+			return consumeVarint(b, &numValues)
+		case num == aggEncodingField && typ == protowire.VarintType:
+			return consumeVarint(b, &encoding)
+		case num == aggValueTypeField && typ == protowire.VarintType:
+			return consumeVarint(b, &valueType)
+		case num == aggHLLStateField && typ == protowire.BytesType:
+			return consumeBytes(b, &state)
+		}
+		return -1, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "invalid HLL++ sketch")
+	}
+	if aggType != hllPlusUniqueType {
+		return errors.Errorf("invalid HLL++ sketch: aggregator type %v, want %v", aggType, hllPlusUniqueType)
+	}
+	if encoding != hllEncodingVersion {
+		return errors.Errorf("invalid HLL++ sketch: encoding version %v, want %v", encoding, hllEncodingVersion)
+	}
+	err = consumeFields(state, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch {
+		case num == hllSparseSizeField && typ == protowire.VarintType:
+			return consumeVarint(b, &sparseSize)
+		case num == hllPrecisionField && typ == protowire.VarintType:
+			return consumeVarint(b, &precision)
+		case num == hllSparsePrecField && typ == protowire.VarintType:
+			return consumeVarint(b, &sparseP)
+		case num == hllDataField && typ == protowire.BytesType:
+			return consumeBytes(b, &regs)
+		case num == hllSparseDataField && typ == protowire.BytesType:
+			return consumeBytes(b, &sparseData)
+		}
+		return -1, nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "invalid HLL++ sketch state")
+	}
+
+	ret := HLL{
+		precision:       int(precision),
+		sparsePrecision: int(sparseP),
+		valueType:       hllValueType(valueType),
+		numValues:       int64(numValues),
+	}
+	if err := validateHLLPrecision(ret.precision); err != nil {
+		return errors.Wrap(err, "invalid HLL++ sketch")
+	}
+	if ret.sparsePrecision != 0 && (ret.sparsePrecision < ret.precision || ret.sparsePrecision > maxSparsePrecision) {
+		return errors.Errorf("invalid HLL++ sketch: sparse precision %v out of range [%v, %v]", ret.sparsePrecision, ret.precision, maxSparsePrecision)
+	}
+	switch {
+	case regs != nil && sparseData != nil:
+		return errors.New("invalid HLL++ sketch: both normal and sparse data set")
+	case regs != nil || ret.sparsePrecision == 0:
+		if len(regs) != 1<<ret.precision {
+			return errors.Errorf("invalid HLL++ sketch: %v registers, want %v", len(regs), 1<<ret.precision)
+		}
+		for i, r := range regs {
+			if int(r) > 65-ret.precision {
+				return errors.Errorf("invalid HLL++ sketch: register %v has value %v", i, r)
+			}
+		}
+		ret.registers = append([]byte(nil), regs...)
+	default:
+		limit := uint64(rhoFlag(ret.precision, ret.sparsePrecision)) << 1
+		var v uint64
+		for len(sparseData) > 0 {
+			d, n := protowire.ConsumeVarint(sparseData)
+			if n < 0 {
+				return errors.Wrap(protowire.ParseError(n), "invalid HLL++ sparse data")
+			}
+			sparseData = sparseData[n:]
+			if v += d; v >= limit {
+				return errors.Errorf("invalid HLL++ sketch: sparse value %v out of range", v)
+			}
+			ret.buffer = append(ret.buffer, uint32(v))
+		}
+		if uint64(len(ret.buffer)) != sparseSize {
+			return errors.Errorf("invalid HLL++ sketch: %v sparse values, want %v", len(ret.buffer), sparseSize)
+		}
+		ret.flush()
+	}
+	*h = ret
+	return nil
+}
+
+// consumeFields calls fn for every field of the protocol buffer message b.
+// fn returns the number of bytes it consumed, or -1 to skip the field.
+func consumeFields(b []byte, fn func(protowire.Number, protowire.Type, []byte) (int, error)) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		n, err := fn(num, typ, b)
+		if err != nil {
+			return err
+		}
+		if n < 0 {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+func consumeVarint(b []byte, v *uint64) (int, error) {
+	var n int
+	*v, n = protowire.ConsumeVarint(b)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	return n, nil
+}
+
+func consumeBytes(b []byte, v *[]byte) (int, error) {
+	var n int
+	*v, n = protowire.ConsumeBytes(b)
+	if n < 0 {
+		return 0, protowire.ParseError(n)
+	}
+	if *v == nil {
+		*v = []byte{}
+	}
+	return n, nil
+}
@@ -0,0 +1,321 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math"
+	"strings"
+	"testing"
+)
+
+func newTestHLL(t *testing.T, precision int, from, to int64) *HLL {
+	t.Helper()
+	h, err := NewHLL(precision)
+	if err != nil {
+		t.Fatalf("NewHLL(%v) failed: %v", precision, err)
+	}
+	for i := from; i < to; i++ {
+		if err := h.AddInt64(i); err != nil {
+			t.Fatalf("AddInt64(%v) failed: %v", i, err)
+		}
+	}
+	return h
+}
+
+func marshalHLL(t *testing.T, h *HLL) []byte {
+	t.Helper()
+	b, err := h.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() failed: %v", err)
+	}
+	return b
+}
+
+func checkEstimate(t *testing.T, h *HLL, want int64) {
+	t.Helper()
+	// Allow four standard errors.
+	tolerance := 4 * 1.04 / math.Sqrt(float64(int64(1)<<h.Precision())) * float64(want)
+	if tolerance < 1 {
+		tolerance = 1
+	}
+	if got := h.Estimate(); math.Abs(float64(got-want)) > tolerance {
+		t.Errorf("Estimate() = %v, want %v ± %v", got, want, tolerance)
+	}
+}
+
+func TestHLLEstimate(t *testing.T) {
+	for _, precision := range []int{MinHLLPrecision, DefaultHLLPrecision} {
+		for _, n := range []int64{0, 1, 10, 100, 1000, 10000, 100000} {
+			h := newTestHLL(t, precision, 0, n)
+			// Duplicates must not change the estimate.
+			for i := int64(0); i < n; i += 2 {
+				h.AddInt64(i)
+			}
+			checkEstimate(t, h, n)
+		}
+	}
+}
+
+func TestHLLMerge(t *testing.T) {
+	tests := []struct {
+		name             string
+		a, b             [2]int64
+		distinct, values int64
+	}{
+		{"sparse", [2]int64{0, 100}, [2]int64{50, 200}, 200, 250},
+		{"sparse into normal", [2]int64{0, 50000}, [2]int64{49900, 50100}, 50100, 50200},
+		{"normal into sparse", [2]int64{0, 200}, [2]int64{100, 50000}, 50000, 50100},
+		{"normal", [2]int64{0, 40000}, [2]int64{20000, 60000}, 60000, 80000},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := newTestHLL(t, DefaultHLLPrecision, test.a[0], test.a[1])
+			b := newTestHLL(t, DefaultHLLPrecision, test.b[0], test.b[1])
+			before := marshalHLL(t, b)
+			if err := a.Merge(b); err != nil {
+				t.Fatalf("Merge() failed: %v", err)
+			}
+			checkEstimate(t, a, test.distinct)
+			if got := a.NumValues(); got != test.values {
+				t.Errorf("NumValues() = %v, want %v", got, test.values)
+			}
+			if !bytes.Equal(marshalHLL(t, b), before) {
+				t.Error("Merge() modified its argument")
+			}
+			// Merging must be equivalent to adding all values to one sketch.
+			union := newTestHLL(t, DefaultHLLPrecision, test.a[0], test.a[1])
+			for i := test.b[0]; i < test.b[1]; i++ {
+				union.AddInt64(i)
+			}
+			union.numValues = a.numValues
+			if !bytes.Equal(marshalHLL(t, a), marshalHLL(t, union)) {
+				t.Error("merged sketch differs from a sketch of all values")
+			}
+		})
+	}
+}
+
+func TestHLLMerge_Precision(t *testing.T) {
+	for _, n := range []int64{10, 300, 100000} {
+		low := newTestHLL(t, 12, 0, n)
+		high := newTestHLL(t, 18, 0, n)
+		if err := high.Merge(&HLL{}); err != nil {
+			t.Fatalf("Merge(empty) failed: %v", err)
+		}
+		if got, want := high.Precision(), 18; got != want {
+			t.Errorf("Precision() after merging an empty sketch = %v, want %v", got, want)
+		}
+		if err := high.Merge(newTestHLL(t, 12, 0, 0)); err != nil {
+			t.Fatalf("Merge() failed: %v", err)
+		}
+		if got, want := high.Precision(), 12; got != want {
+			t.Errorf("Precision() = %v, want %v", got, want)
+		}
+		// A downgraded sketch must be the same as one built at the lower precision.
+		high.numValues = low.numValues
+		if !bytes.Equal(marshalHLL(t, high), marshalHLL(t, low)) {
+			t.Errorf("n=%v: downgraded sketch differs from a sketch built with precision 12", n)
+		}
+	}
+}
+
+func TestHLLMerge_ValueTypes(t *testing.T) {
+	ints := newTestHLL(t, DefaultHLLPrecision, 0, 10)
+	strs, _ := NewHLL(DefaultHLLPrecision)
+	strs.AddString("a")
+	if err := ints.Merge(strs); err == nil {
+		t.Error("Merge() of int64 and string sketches succeeded, want error")
+	}
+	if err := ints.AddInt32(1); err == nil {
+		t.Error("AddInt32() on an int64 sketch succeeded, want error")
+	}
+	if err := strs.AddBytes([]byte("a")); err != nil {
+		t.Errorf("AddBytes() on a string sketch failed: %v", err)
+	}
+	if got, want := strs.Estimate(), int64(1); got != want {
+		t.Errorf("Estimate() after adding \"a\" as string and bytes = %v, want %v", got, want)
+	}
+}
+
+func TestHLLToNormal(t *testing.T) {
+	h := newTestHLL(t, MinHLLPrecision, 0, 100)
+	want := make([]byte, 1<<MinHLLPrecision)
+	for _, hash := range h.hashes() {
+		idx, rho := normalEncode(MinHLLPrecision, hash)
+		if rho > want[idx] {
+			want[idx] = rho
+		}
+	}
+	h.toNormal()
+	if !bytes.Equal(h.registers, want) {
+		t.Error("converting the sparse representation changed the registers")
+	}
+	// Compare with a sketch built directly in the normal representation.
+	direct := newTestHLL(t, MinHLLPrecision, 0, 0)
+	direct.registers = make([]byte, 1<<MinHLLPrecision)
+	for i := int64(0); i < 100; i++ {
+		direct.AddInt64(i)
+	}
+	if !bytes.Equal(h.registers, direct.registers) {
+		t.Error("converted registers differ from directly computed registers")
+	}
+}
+
+func TestHLLMarshalBinary(t *testing.T) {
+	tests := []struct {
+		name string
+		h    *HLL
+	}{
+		{"zero", &HLL{}},
+		{"empty", newTestHLL(t, DefaultHLLPrecision, 0, 0)},
+		{"sparse", newTestHLL(t, DefaultHLLPrecision, 0, 1000)},
+		{"normal", newTestHLL(t, MinHLLPrecision, 0, 10000)},
+		{"max precision", newTestHLL(t, MaxHLLPrecision, 0, 1000)},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := marshalHLL(t, test.h)
+			var got HLL
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary() failed: %v", err)
+			}
+			if !bytes.Equal(marshalHLL(t, &got), data) {
+				t.Error("round trip changed the encoding")
+			}
+			if got.Estimate() != test.h.Estimate() || got.NumValues() != test.h.NumValues() || got.Precision() != test.h.Precision() {
+				t.Errorf("round trip got (%v, %v, %v), want (%v, %v, %v)",
+					got.Estimate(), got.NumValues(), got.Precision(),
+					test.h.Estimate(), test.h.NumValues(), test.h.Precision())
+			}
+		})
+	}
+}
+
+// goldenHLLStrings are added to the golden sketches, which are written out
+// field by field following the ZetaSketch AggregatorStateProto and
+// HyperLogLogPlusUniqueStateProto messages. Their fingerprints are checked
+// against Guava in TestFingerprint2011.
+var goldenHLLStrings = []string{"test", strings.Repeat("test", 8), strings.Repeat("test", 64)}
+
+// goldenNormalHLL returns the encoding of a normal sketch of goldenHLLStrings
+// with precision 10 and sparse precision 15.
+func goldenNormalHLL() []byte {
+	// type 112, 3 values, encoding version 2, value type 11, state of 1031
+	// bytes: precision 10, sparse precision 15 and 1024 registers.
+	b, _ := hex.DecodeString("087010031802200b82078708180a200f2a8008")
+	regs := make([]byte, 1<<10)
+	// The fingerprints have indexes 470, 407 and 272, followed by 1, 0 and 1
+	// leading zeros.
+	regs[470], regs[407], regs[272] = 2, 1, 2
+	return append(b, regs...)
+}
+
+func TestHLLMarshalBinary_Golden(t *testing.T) {
+	tests := []struct {
+		name   string
+		h      func(h *HLL)
+		golden []byte
+	}{
+		{
+			// type 112, 3 values, encoding version 2, value type 11, state of
+			// 17 bytes: 3 sparse values, precision 15, sparse precision 20 and
+			// the sparse indexes 278808, 417523 and 481647 as differences.
+			name:   "sparse",
+			golden: mustDecodeHex(t, "087010031802200b8207111003180f20143209988211dbbb08fcf403"),
+		},
+		{
+			name:   "normal",
+			h:      (*HLL).toNormal,
+			golden: goldenNormalHLL(),
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			precision := DefaultHLLPrecision
+			if test.h != nil {
+				precision = MinHLLPrecision
+			}
+			h, _ := NewHLL(precision)
+			for _, v := range goldenHLLStrings {
+				if err := h.AddString(v); err != nil {
+					t.Fatalf("AddString() failed: %v", err)
+				}
+			}
+			if test.h != nil {
+				test.h(h)
+			}
+			if got := marshalHLL(t, h); !bytes.Equal(got, test.golden) {
+				t.Errorf("MarshalBinary() = %x, want %x", got, test.golden)
+			}
+
+			var got HLL
+			if err := got.UnmarshalBinary(test.golden); err != nil {
+				t.Fatalf("UnmarshalBinary() failed: %v", err)
+			}
+			if !bytes.Equal(marshalHLL(t, &got), test.golden) {
+				t.Error("round trip changed the golden encoding")
+			}
+			if got, want := got.Estimate(), int64(len(goldenHLLStrings)); got != want {
+				t.Errorf("Estimate() = %v, want %v", got, want)
+			}
+			if err := got.AddInt64(1); err == nil {
+				t.Error("AddInt64() on a decoded string sketch succeeded, want error")
+			}
+		})
+	}
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("invalid hex %q: %v", s, err)
+	}
+	return b
+}
+
+func TestHLLUnmarshalBinary_Invalid(t *testing.T) {
+	valid := marshalHLL(t, newTestHLL(t, DefaultHLLPrecision, 0, 10))
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"garbage", []byte{0xff, 0xff}},
+		{"truncated", valid[:len(valid)-2]},
+		// type = 113
+		{"wrong type", []byte{0x08, 0x71}},
+		// type = 112, encoding version 2, state with precision 30
+		{"bad precision", []byte{0x08, 0x70, 0x18, 0x02, 0x82, 0x07, 0x02, 0x18, 0x1e}},
+		// type = 112, encoding version 2, state with precision 10 and 1 register
+		{"bad registers", []byte{0x08, 0x70, 0x18, 0x02, 0x82, 0x07, 0x05, 0x18, 0x0a, 0x2a, 0x01, 0x01}},
+	}
+	for _, test := range tests {
+		var h HLL
+		if err := h.UnmarshalBinary(test.data); err == nil {
+			t.Errorf("UnmarshalBinary(%v) succeeded, want error", test.name)
+		}
+	}
+}
+
+func TestNewHLL_Invalid(t *testing.T) {
+	for _, precision := range []int{0, MinHLLPrecision - 1, MaxHLLPrecision + 1} {
+		if _, err := NewHLL(precision); err == nil {
+			t.Errorf("NewHLL(%v) succeeded, want error", precision)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"math"
+	"reflect"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+)
+
+//go:generate specialize --input=variance_switch.tmpl --x=integers,floats
+//go:generate gofmt -w variance_switch.go
+
+// Variance returns the population variance of the elements in a collection.
+// It expects a PCollection<A> as input and returns a singleton
+// PCollection<float64>. It can only be used for numbers, such as int, uint16,
+// float32, etc.
+//
+// The variance is computed with Welford's online algorithm, which is
+// numerically stable even if the variance is small relative to the mean.
+//
+// For example:
+//
+//	col := beam.Create(s, 2, 4, 4, 4, 5, 5, 7, 9)
+//	variance := stats.Variance(s, col)   // PCollection<float64> with 4 as the only element.
+func Variance(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.Variance")
+	return combine(s, varianceFnMaker(false), col)
+}
+
+// VariancePerKey returns the population variance for each key of the elements
+// in a collection. It expects a PCollection<KV<A,B>> as input and returns a
+// PCollection<KV<A,float64>>. It can only be used for value numbers, such as
+// int, uint16, float32, etc.
+func VariancePerKey(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.VariancePerKey")
+	return combinePerKey(s, varianceFnMaker(false), col)
+}
+
+// StdDev returns the population standard deviation of the elements in a
+// collection. It expects a PCollection<A> as input and returns a singleton
+// PCollection<float64>. It can only be used for numbers, such as int, uint16,
+// float32, etc.
+//
+// For example:
+//
+//	col := beam.Create(s, 2, 4, 4, 4, 5, 5, 7, 9)
+//	stddev := stats.StdDev(s, col)   // PCollection<float64> with 2 as the only element.
+func StdDev(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.StdDev")
+	return combine(s, varianceFnMaker(true), col)
+}
+
+// StdDevPerKey returns the population standard deviation for each key of the
+// elements in a collection. It expects a PCollection<KV<A,B>> as input and
+// returns a PCollection<KV<A,float64>>. It can only be used for value numbers,
+// such as int, uint16, float32, etc.
+func StdDevPerKey(s beam.Scope, col beam.PCollection) beam.PCollection {
+	s = s.Scope("stats.StdDevPerKey")
+	return combinePerKey(s, varianceFnMaker(true), col)
+}
+
+func varianceFnMaker(stdDev bool) func(reflect.Type) any {
+	return func(t reflect.Type) any {
+		return findVarianceFn(t, stdDev)
+	}
+}
+
+// varianceAccum holds the count, mean and sum of squared differences from the
+// mean (M2) of numbers, as in Welford's algorithm.
+type varianceAccum struct {
+	Count int64
+	Mean  float64
+	M2    float64
+}
+
+func (a varianceAccum) add(x float64) varianceAccum {
+	a.Count++
+	delta := x - a.Mean
+	a.Mean += delta / float64(a.Count)
+	a.M2 += delta * (x - a.Mean)
+	return a
+}
+
+// merge combines two accumulators with the parallel algorithm of Chan et al.
+func (a varianceAccum) merge(b varianceAccum) varianceAccum {
+	if a.Count == 0 {
+		return b
+	}
+	if b.Count == 0 {
+		return a
+	}
+	count := a.Count + b.Count
+	delta := b.Mean - a.Mean
+	return varianceAccum{
+		Count: count,
+		Mean:  a.Mean + delta*float64(b.Count)/float64(count),
+		M2:    a.M2 + b.M2 + delta*delta*float64(a.Count)*float64(b.Count)/float64(count),
+	}
+}
+
+// varianceFn is the base of the combineFns that compute the variance or
+// standard deviation of numbers. The generated variance<Type>Fns add the
+// AddInput method for each number type.
+type varianceFn struct {
+	StdDev bool `json:"stddev"`
+}
+
+func (f *varianceFn) CreateAccumulator() varianceAccum {
+	return varianceAccum{}
+}
+
+func (f *varianceFn) MergeAccumulators(a, b varianceAccum) varianceAccum {
+	return a.merge(b)
+}
+
+func (f *varianceFn) ExtractOutput(a varianceAccum) float64 {
+	if a.Count == 0 {
+		return 0
+	}
+	variance := a.M2 / float64(a.Count)
+	if f.StdDev {
+		return math.Sqrt(variance)
+	}
+	return variance
+}
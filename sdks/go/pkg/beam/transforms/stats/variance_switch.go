@@ -0,0 +1,167 @@
+// File generated by specialize. Do not edit.
+
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+func init() {
+	register.Combiner3[varianceAccum, int, float64](&varianceIntFn{})
+	register.Combiner3[varianceAccum, int8, float64](&varianceInt8Fn{})
+	register.Combiner3[varianceAccum, int16, float64](&varianceInt16Fn{})
+	register.Combiner3[varianceAccum, int32, float64](&varianceInt32Fn{})
+	register.Combiner3[varianceAccum, int64, float64](&varianceInt64Fn{})
+	register.Combiner3[varianceAccum, uint, float64](&varianceUintFn{})
+	register.Combiner3[varianceAccum, uint8, float64](&varianceUint8Fn{})
+	register.Combiner3[varianceAccum, uint16, float64](&varianceUint16Fn{})
+	register.Combiner3[varianceAccum, uint32, float64](&varianceUint32Fn{})
+	register.Combiner3[varianceAccum, uint64, float64](&varianceUint64Fn{})
+	register.Combiner3[varianceAccum, float32, float64](&varianceFloat32Fn{})
+	register.Combiner3[varianceAccum, float64, float64](&varianceFloat64Fn{})
+}
+
+func findVarianceFn(t reflect.Type, stdDev bool) any {
+	switch t.String() {
+	case "int":
+		return &varianceIntFn{varianceFn{StdDev: stdDev}}
+	case "int8":
+		return &varianceInt8Fn{varianceFn{StdDev: stdDev}}
+	case "int16":
+		return &varianceInt16Fn{varianceFn{StdDev: stdDev}}
+	case "int32":
+		return &varianceInt32Fn{varianceFn{StdDev: stdDev}}
+	case "int64":
+		return &varianceInt64Fn{varianceFn{StdDev: stdDev}}
+	case "uint":
+		return &varianceUintFn{varianceFn{StdDev: stdDev}}
+	case "uint8":
+		return &varianceUint8Fn{varianceFn{StdDev: stdDev}}
+	case "uint16":
+		return &varianceUint16Fn{varianceFn{StdDev: stdDev}}
+	case "uint32":
+		return &varianceUint32Fn{varianceFn{StdDev: stdDev}}
+	case "uint64":
+		return &varianceUint64Fn{varianceFn{StdDev: stdDev}}
+	case "float32":
+		return &varianceFloat32Fn{varianceFn{StdDev: stdDev}}
+	case "float64":
+		return &varianceFloat64Fn{varianceFn{StdDev: stdDev}}
+	default:
+		panic(fmt.Sprintf("Unexpected number type: %v", t))
+	}
+}
+
+type varianceIntFn struct {
+	varianceFn
+}
+
+func (f *varianceIntFn) AddInput(a varianceAccum, x int) varianceAccum {
+	return a.add(float64(x))
+}
+
+type varianceInt8Fn struct {
+	varianceFn
+}
+
+func (f *varianceInt8Fn) AddInput(a varianceAccum, x int8) varianceAccum {
+	return a.add(float64(x))
+}
+
+type varianceInt16Fn struct {
+	varianceFn
+}
+
+func (f *varianceInt16Fn) AddInput(a varianceAccum, x int16) varianceAccum {
+	return a.add(float64(x))
+}
+
+type varianceInt32Fn struct {
+	varianceFn
+}
+
+func (f *varianceInt32Fn) AddInput(a varianceAccum, x int32) varianceAccum {
+	return a.add(float64(x))
+}
+
+type varianceInt64Fn struct {
+	varianceFn
+}
+
+func (f *varianceInt64Fn) AddInput(a varianceAccum, x int64) varianceAccum {
+	return a.add(float64(x))
+}
+
+type varianceUintFn struct {
+	varianceFn
+}
+
+func (f *varianceUintFn) AddInput(a varianceAccum, x uint) varianceAccum {
+	return a.add(float64(x))
+}
+
+type varianceUint8Fn struct {
+	varianceFn
+}
+
+func (f *varianceUint8Fn) AddInput(a varianceAccum, x uint8) varianceAccum {
+	return a.add(float64(x))
+}
+
+type varianceUint16Fn struct {
+	varianceFn
+}
+
+func (f *varianceUint16Fn) AddInput(a varianceAccum, x uint16) varianceAccum {
+	return a.add(float64(x))
+}
+
+type varianceUint32Fn struct {
+	varianceFn
+}
+
+func (f *varianceUint32Fn) AddInput(a varianceAccum, x uint32) varianceAccum {
+	return a.add(float64(x))
+}
+
+type varianceUint64Fn struct {
+	varianceFn
+}
+
+func (f *varianceUint64Fn) AddInput(a varianceAccum, x uint64) varianceAccum {
+	return a.add(float64(x))
+}
+
+type varianceFloat32Fn struct {
+	varianceFn
+}
+
+func (f *varianceFloat32Fn) AddInput(a varianceAccum, x float32) varianceAccum {
+	return a.add(float64(x))
+}
+
+type varianceFloat64Fn struct {
+	varianceFn
+}
+
+func (f *varianceFloat64Fn) AddInput(a varianceAccum, x float64) varianceAccum {
+	return a.add(float64(x))
+}
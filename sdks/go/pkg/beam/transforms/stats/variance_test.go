@@ -0,0 +1,110 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+)
+
+func init() {
+	register.Function1x1(roundFn)
+	register.Function2x2(roundKVFn)
+}
+
+// roundFn rounds away floating point error, so that results can be compared
+// exactly.
+func roundFn(x float64) float64 {
+	return math.Round(x*1e9) / 1e9
+}
+
+func roundKVFn(k string, x float64) (string, float64) {
+	return k, roundFn(x)
+}
+
+// TestVariance verifies that Variance and StdDev work correctly for ints and
+// floats.
+func TestVariance(t *testing.T) {
+	tests := []struct {
+		in       any
+		variance float64
+		stdDev   float64
+	}{
+		{[]int{2, 4, 4, 4, 5, 5, 7, 9}, 4, 2},
+		{[]uint8{3}, 0, 0},
+		{[]float64{1.5, 2.5}, 0.25, 0.5},
+		{[]float32{-1, 1, -1, 1}, 1, 1},
+	}
+
+	for _, test := range tests {
+		p, s := beam.NewPipelineWithRoot()
+		in := beam.CreateList(s, test.in)
+		passert.Equals(s, beam.ParDo(s, roundFn, Variance(s, in)), test.variance)
+		passert.Equals(s, beam.ParDo(s, roundFn, StdDev(s, in)), test.stdDev)
+
+		if err := ptest.Run(p); err != nil {
+			t.Errorf("Variance(%v), StdDev(%v) != %v, %v: %v", test.in, test.in, test.variance, test.stdDev, err)
+		}
+	}
+}
+
+// TestVarianceKeyed verifies that VariancePerKey and StdDevPerKey work
+// correctly for KV values.
+func TestVarianceKeyed(t *testing.T) {
+	in := []student{{"alpha", 1}, {"alpha", 3}, {"beta", 4}, {"charlie", 0}, {"charlie", 6}, {"charlie", 3}}
+	variance := []student{{"alpha", 1}, {"beta", 0}, {"charlie", 6}}
+	stdDev := []student{{"alpha", 1}, {"beta", 0}, {"charlie", roundFn(math.Sqrt(6))}}
+
+	p, s := beam.NewPipelineWithRoot()
+	kv := beam.ParDo(s, studentToKV, beam.CreateList(s, in))
+	passert.Equals(s, beam.ParDo(s, kvToStudent, beam.ParDo(s, roundKVFn, VariancePerKey(s, kv))), beam.CreateList(s, variance))
+	passert.Equals(s, beam.ParDo(s, kvToStudent, beam.ParDo(s, roundKVFn, StdDevPerKey(s, kv))), beam.CreateList(s, stdDev))
+
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("VariancePerKey(%v), StdDevPerKey(%v) != %v, %v: %v", in, in, variance, stdDev, err)
+	}
+}
+
+// TestVarianceAccum verifies that merging accumulators is equivalent to adding
+// all values to one, and that the algorithm is stable for large offsets.
+func TestVarianceAccum(t *testing.T) {
+	const offset = 1e9
+	values := []float64{4, 7, 13, 16}
+	var all, a, b varianceAccum
+	for i, v := range values {
+		all = all.add(offset + v)
+		if i%2 == 0 {
+			a = a.add(offset + v)
+		} else {
+			b = b.add(offset + v)
+		}
+	}
+	fn := &varianceFn{}
+	if got := fn.ExtractOutput(all); got != 22.5 {
+		t.Errorf("variance = %v, want 22.5", got)
+	}
+	if got := fn.ExtractOutput(fn.MergeAccumulators(a, b)); got != 22.5 {
+		t.Errorf("merged variance = %v, want 22.5", got)
+	}
+	if got := fn.MergeAccumulators(varianceAccum{}, all); got != all {
+		t.Errorf("merging into an empty accumulator = %v, want %v", got, all)
+	}
+}
@@ -0,0 +1,390 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package top
+
+import (
+	"bytes"
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+func init() {
+	summaryType := reflect.TypeOf((**summary)(nil)).Elem()
+	beam.RegisterType(summaryType)
+	beam.RegisterCoder(summaryType, encodeSummary, decodeSummary)
+	register.Combiner3[*summary, beam.T, []beam.T]((*heavyHittersFn)(nil))
+	register.Combiner3[*summary, beam.T, []entry]((*heavyHitterCountsFn)(nil))
+	register.DoFn2x1[[]entry, func(beam.T, int64), error]((*emitCountsFn)(nil))
+	register.Emitter2[beam.T, int64]()
+}
+
+// minCapacity is the smallest number of candidates tracked for heavy hitters.
+const minCapacity = 100
+
+// capacityFactor is the number of candidates tracked per heavy hitter.
+const capacityFactor = 10
+
+// HeavyHitters returns the approximately N most frequent elements of a
+// PCollection<T>, in order of decreasing frequency. It returns a
+// single-element PCollection<[]T> per window. T's encoding must be
+// deterministic.
+//
+// Unlike counting all elements and using Largest, HeavyHitters needs memory
+// proportional to N only. It uses the Space-Saving algorithm with
+// max(10*N, 100) counters, which finds every element that makes up more than
+// 1/max(10*N, 100) of the collection.
+//
+// Example use:
+//
+//	col := beam.Create(s, "a", "b", "a", "c", "a", "b")
+//	top2 := top.HeavyHitters(s, col, 2)  // PCollection<[]string> with ["a", "b"] as the only element.
+func HeavyHitters(s beam.Scope, col beam.PCollection, n int) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("top.HeavyHitters(%v)", n))
+
+	t := beam.ValidateNonCompositeType(col)
+	return beam.Combine(s, &heavyHittersFn{newSummaryFn(n, t.Type())}, col)
+}
+
+// HeavyHittersPerKey returns the approximately N most frequent values for
+// each key of a PCollection<KV<K,T>>, in order of decreasing frequency. It
+// returns a PCollection<KV<K,[]T>>.
+func HeavyHittersPerKey(s beam.Scope, col beam.PCollection, n int) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("top.HeavyHittersPerKey(%v)", n))
+
+	_, t := beam.ValidateKVType(col)
+	return beam.CombinePerKey(s, &heavyHittersFn{newSummaryFn(n, t.Type())}, col)
+}
+
+// HeavyHitterCounts returns the approximately N most frequent elements of a
+// PCollection<T> with their estimated counts as a PCollection<KV<T,int64>>
+// per window. Estimated counts are never below the true counts, and exceed
+// them by at most the collection size divided by max(10*N, 100).
+func HeavyHitterCounts(s beam.Scope, col beam.PCollection, n int) beam.PCollection {
+	s = s.Scope(fmt.Sprintf("top.HeavyHitterCounts(%v)", n))
+
+	t := beam.ValidateNonCompositeType(col)
+	fn := newSummaryFn(n, t.Type())
+	counters := beam.Combine(s, &heavyHitterCountsFn{fn}, col)
+	return beam.ParDo(s, &emitCountsFn{Type: fn.Type}, counters, beam.TypeDefinition{Var: beam.TType, T: t.Type()})
+}
+
+func newSummaryFn(n int, t reflect.Type) summaryFn {
+	if n < 1 {
+		panic("n must be > 0")
+	}
+	capacity := capacityFactor * n
+	if capacity < minCapacity {
+		capacity = minCapacity
+	}
+	fn := summaryFn{N: n, Capacity: capacity, Type: beam.EncodedType{T: t}}
+	// Running Setup at pipeline construction validates that T is encodable.
+	fn.Setup()
+	return fn
+}
+
+// entry is an encoded element with its estimated count, as tracked by the
+// Space-Saving algorithm. The estimate exceeds the true count by at most Err.
+type entry struct {
+	Elem  []byte
+	Count int64
+	Err   int64
+}
+
+// heapEntry is an entry in the min-heap of a summary.
+type heapEntry struct {
+	entry
+	index int
+}
+
+// summary is the Space-Saving summary of a stream. It tracks at most capacity
+// counters in a min-heap ordered by count.
+type summary struct {
+	capacity int
+	counters map[string]*heapEntry
+	heap     entryHeap
+}
+
+func newSummary(capacity int) *summary {
+	return &summary{capacity: capacity, counters: map[string]*heapEntry{}}
+}
+
+type entryHeap []*heapEntry
+
+func (h entryHeap) Len() int { return len(h) }
+func (h entryHeap) Less(i, j int) bool {
+	if h[i].Count != h[j].Count {
+		return h[i].Count < h[j].Count
+	}
+	// Evict the largest encoding first among equal counts, so that results
+	// are deterministic.
+	return bytes.Compare(h[i].Elem, h[j].Elem) > 0
+}
+func (h entryHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+func (h *entryHeap) Push(x any) {
+	c := x.(*heapEntry)
+	c.index = len(*h)
+	*h = append(*h, c)
+}
+func (h *entryHeap) Pop() any {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
+
+// add counts one occurrence of an encoded element. If the summary is full,
+// the element replaces the one with the lowest count, and inherits its count
+// as error.
+func (s *summary) add(elem []byte) {
+	if c, ok := s.counters[string(elem)]; ok {
+		c.Count++
+		heap.Fix(&s.heap, c.index)
+		return
+	}
+	if len(s.heap) < s.capacity {
+		c := &heapEntry{entry: entry{Elem: elem, Count: 1}}
+		s.counters[string(elem)] = c
+		heap.Push(&s.heap, c)
+		return
+	}
+	c := s.heap[0]
+	delete(s.counters, string(c.Elem))
+	c.Elem, c.Err = elem, c.Count
+	c.Count++
+	s.counters[string(elem)] = c
+	heap.Fix(&s.heap, 0)
+}
+
+// min returns the count that any element not in the summary may have.
+func (s *summary) min() int64 {
+	if len(s.heap) < s.capacity {
+		return 0
+	}
+	return s.heap[0].Count
+}
+
+// merge combines two summaries as described in "A parallel space saving
+// algorithm for frequent items and the Hurwitz zeta distribution" by Cafaro et
+// al: elements missing from a full summary are assumed to have its minimum
+// count, and the counters with the largest counts are kept.
+func (s *summary) merge(other *summary) *summary {
+	minA, minB := s.min(), other.min()
+	merged := map[string]*entry{}
+	for _, c := range s.heap {
+		merged[string(c.Elem)] = &entry{Elem: c.Elem, Count: c.Count + minB, Err: c.Err + minB}
+	}
+	for _, c := range other.heap {
+		if m, ok := merged[string(c.Elem)]; ok {
+			m.Count += c.Count - minB
+			m.Err += c.Err - minB
+			continue
+		}
+		merged[string(c.Elem)] = &entry{Elem: c.Elem, Count: c.Count + minA, Err: c.Err + minA}
+	}
+	list := make([]entry, 0, len(merged))
+	for _, c := range merged {
+		list = append(list, *c)
+	}
+	capacity := s.capacity
+	if other.capacity > capacity {
+		capacity = other.capacity
+	}
+	return fromEntries(capacity, list)
+}
+
+// fromEntries returns a summary of the counters with the largest counts.
+func fromEntries(capacity int, list []entry) *summary {
+	sortEntries(list)
+	if len(list) > capacity {
+		list = list[:capacity]
+	}
+	ret := newSummary(capacity)
+	for _, c := range list {
+		c := &heapEntry{entry: c}
+		ret.counters[string(c.Elem)] = c
+		heap.Push(&ret.heap, c)
+	}
+	return ret
+}
+
+// sorted returns the counters in order of decreasing count.
+func (s *summary) sorted() []entry {
+	list := make([]entry, 0, len(s.heap))
+	for _, c := range s.heap {
+		list = append(list, c.entry)
+	}
+	sortEntries(list)
+	return list
+}
+
+// sortEntries sorts counters by decreasing count, and then by encoding.
+func sortEntries(list []entry) {
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Count != list[j].Count {
+			return list[i].Count > list[j].Count
+		}
+		return bytes.Compare(list[i].Elem, list[j].Elem) < 0
+	})
+}
+
+func encodeSummary(s *summary) ([]byte, error) {
+	var b []byte
+	b = binary.AppendUvarint(b, uint64(s.capacity))
+	b = binary.AppendUvarint(b, uint64(len(s.heap)))
+	for _, c := range s.sorted() {
+		b = binary.AppendUvarint(b, uint64(len(c.Elem)))
+		b = append(b, c.Elem...)
+		b = binary.AppendVarint(b, c.Count)
+		b = binary.AppendVarint(b, c.Err)
+	}
+	return b, nil
+}
+
+func decodeSummary(data []byte) (*summary, error) {
+	r := bytes.NewReader(data)
+	capacity, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, errors.WithContext(err, "top.summary: decoding capacity")
+	}
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, errors.WithContext(err, "top.summary: decoding size")
+	}
+	if n > capacity || n > uint64(len(data)) {
+		return nil, errors.Errorf("top.summary: invalid size %v for capacity %v", n, capacity)
+	}
+	list := make([]entry, n)
+	for i := range list {
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, errors.WithContextf(err, "top.summary: decoding entry %v", i)
+		}
+		if size > uint64(r.Len()) {
+			return nil, errors.Errorf("top.summary: invalid element size %v", size)
+		}
+		list[i].Elem = make([]byte, size)
+		if _, err := io.ReadFull(r, list[i].Elem); err != nil {
+			return nil, errors.WithContextf(err, "top.summary: decoding entry %v", i)
+		}
+		if list[i].Count, err = binary.ReadVarint(r); err != nil {
+			return nil, errors.WithContextf(err, "top.summary: decoding entry %v", i)
+		}
+		if list[i].Err, err = binary.ReadVarint(r); err != nil {
+			return nil, errors.WithContextf(err, "top.summary: decoding entry %v", i)
+		}
+	}
+	return fromEntries(int(capacity), list), nil
+}
+
+// summaryFn is the base of the CombineFns that maintain Space-Saving
+// summaries of encoded elements of the underlying type, T.
+type summaryFn struct {
+	// N is the number of heavy hitters to return.
+	N int `json:"n"`
+	// Capacity is the number of counters to track.
+	Capacity int `json:"capacity"`
+	// Type is the element type T.
+	Type beam.EncodedType `json:"type"`
+
+	enc beam.ElementEncoder
+}
+
+func (f *summaryFn) Setup() {
+	f.enc = beam.NewElementEncoder(f.Type.T)
+}
+
+func (f *summaryFn) CreateAccumulator() *summary {
+	return newSummary(f.Capacity)
+}
+
+func (f *summaryFn) AddInput(s *summary, val beam.T) (*summary, error) {
+	var buf bytes.Buffer
+	if err := f.enc.Encode(val, &buf); err != nil {
+		return nil, errors.WithContextf(err, "top.HeavyHitters: marshalling %v", val)
+	}
+	s.add(buf.Bytes())
+	return s, nil
+}
+
+func (f *summaryFn) MergeAccumulators(a, b *summary) *summary {
+	return a.merge(b)
+}
+
+// top returns the counters of the N heavy hitters.
+func (f *summaryFn) top(s *summary) []entry {
+	list := s.sorted()
+	if len(list) > f.N {
+		list = list[:f.N]
+	}
+	return list
+}
+
+// heavyHittersFn is a CombineFn that outputs the decoded heavy hitters.
+type heavyHittersFn struct {
+	summaryFn
+}
+
+func (f *heavyHittersFn) ExtractOutput(s *summary) ([]beam.T, error) {
+	dec := beam.NewElementDecoder(f.Type.T)
+	var ret []beam.T
+	for _, c := range f.top(s) {
+		elm, err := dec.Decode(bytes.NewBuffer(c.Elem))
+		if err != nil {
+			return nil, errors.WithContext(err, "top.HeavyHitters: unmarshalling")
+		}
+		ret = append(ret, elm)
+	}
+	return ret, nil
+}
+
+// heavyHitterCountsFn is a CombineFn that outputs the counters of the heavy
+// hitters, to be decoded by emitCountsFn.
+type heavyHitterCountsFn struct {
+	summaryFn
+}
+
+func (f *heavyHitterCountsFn) ExtractOutput(s *summary) []entry {
+	return f.top(s)
+}
+
+// emitCountsFn decodes and emits the heavy hitters with their counts.
+type emitCountsFn struct {
+	Type beam.EncodedType `json:"type"`
+}
+
+func (f *emitCountsFn) ProcessElement(counters []entry, emit func(beam.T, int64)) error {
+	dec := beam.NewElementDecoder(f.Type.T)
+	for _, c := range counters {
+		elm, err := dec.Decode(bytes.NewBuffer(c.Elem))
+		if err != nil {
+			return errors.WithContext(err, "top.HeavyHitterCounts: unmarshalling")
+		}
+		emit(elm, c.Count)
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package top
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func init() {
+	register.Function2x0(timestampMinutes)
+	register.Emitter2[beam.EventTime, string]()
+	register.Function2x1(formatCountFn)
+}
+
+// skewedStream returns a stream in which element i occurs 1000/(i+1) times,
+// interleaved, along with the true counts.
+func skewedStream(distinct int) ([][]byte, map[string]int64) {
+	var stream [][]byte
+	counts := map[string]int64{}
+	for round := 0; round < 1000; round++ {
+		for i := 0; i < distinct; i++ {
+			if round < 1000/(i+1) {
+				elm := fmt.Sprintf("e%03d", i)
+				stream = append(stream, []byte(elm))
+				counts[elm]++
+			}
+		}
+	}
+	return stream, counts
+}
+
+// checkSummary verifies the Space-Saving guarantees, and that the n most
+// frequent elements are found in order.
+func checkSummary(t *testing.T, s *summary, counts map[string]int64, n int) {
+	t.Helper()
+	for _, e := range s.sorted() {
+		if want := counts[string(e.Elem)]; e.Count < want || e.Count-e.Err > want {
+			t.Errorf("entry %s: count %v, error %v, want true count %v within bounds", e.Elem, e.Count, e.Err, want)
+		}
+	}
+	for i, e := range s.sorted()[:n] {
+		if got, want := string(e.Elem), fmt.Sprintf("e%03d", i); got != want {
+			t.Errorf("heavy hitter %v = %v, want %v", i, got, want)
+		}
+	}
+}
+
+func TestSummary(t *testing.T) {
+	stream, counts := skewedStream(500)
+	s := newSummary(100)
+	for _, elm := range stream {
+		s.add(elm)
+	}
+	if got, want := len(s.heap), 100; got != want {
+		t.Errorf("summary has %v entries, want %v", got, want)
+	}
+	checkSummary(t, s, counts, 5)
+
+	// Summaries with spare capacity count exactly.
+	exact := newSummary(100)
+	for _, elm := range stream[:50] {
+		exact.add(elm)
+	}
+	for _, e := range exact.sorted() {
+		if e.Err != 0 {
+			t.Errorf("entry %s has error %v in a summary with spare capacity, want 0", e.Elem, e.Err)
+		}
+	}
+}
+
+func TestSummaryMerge(t *testing.T) {
+	stream, counts := skewedStream(500)
+	var parts []*summary
+	for i := 0; i < 4; i++ {
+		parts = append(parts, newSummary(100))
+	}
+	for i, elm := range stream {
+		parts[i%len(parts)].add(elm)
+	}
+	merged := parts[0].merge(parts[1]).merge(parts[2].merge(parts[3]))
+	checkSummary(t, merged, counts, 5)
+
+	before := parts[0].sorted()
+	if merged := parts[0].merge(newSummary(100)); !cmp.Equal(merged.sorted(), before) {
+		t.Error("merging an empty summary changed the entries")
+	}
+}
+
+func TestSummaryEncoding(t *testing.T) {
+	stream, _ := skewedStream(50)
+	s := newSummary(20)
+	for _, elm := range stream {
+		s.add(elm)
+	}
+	data, err := encodeSummary(s)
+	if err != nil {
+		t.Fatalf("encodeSummary() failed: %v", err)
+	}
+	got, err := decodeSummary(data)
+	if err != nil {
+		t.Fatalf("decodeSummary() failed: %v", err)
+	}
+	if got.capacity != s.capacity || !cmp.Equal(got.sorted(), s.sorted()) {
+		t.Errorf("decodeSummary(encodeSummary(s)) = %v, want %v", got.sorted(), s.sorted())
+	}
+	// The decoded summary must keep working.
+	got.add([]byte("e000"))
+	if got, want := got.sorted()[0].Count, s.sorted()[0].Count+1; got != want {
+		t.Errorf("count after adding to decoded summary = %v, want %v", got, want)
+	}
+	if _, err := decodeSummary(data[:len(data)-1]); err == nil {
+		t.Error("decodeSummary() of truncated data succeeded, want error")
+	}
+}
+
+// TestHeavyHitters checks that the HeavyHitters transform outputs the most
+// frequent elements in order.
+func TestHeavyHitters(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.Create(s, "a", "b", "a", "c", "a", "b")
+	topTwo := HeavyHitters(s, col, 2)
+	passert.Equals(s, topTwo, []string{"a", "b"})
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("pipeline failed but should have succeeded, got %v", err)
+	}
+}
+
+// TestHeavyHittersPerKey checks that the HeavyHittersPerKey transform
+// outputs the most frequent values of each key.
+func TestHeavyHittersPerKey(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	keyedZero := addKey(s, beam.Create(s, 1, 2, 2, 3, 3, 3), 0)
+	keyedOne := addKey(s, beam.Create(s, 4, 5, 5), 1)
+
+	col := beam.Flatten(s, keyedZero, keyedOne)
+	out := beam.DropKey(s, HeavyHittersPerKey(s, col, 2))
+	passert.Equals(s, out, []int{3, 2}, []int{5, 4})
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("pipeline failed but should have succeeded, got %v", err)
+	}
+}
+
+// TestHeavyHitterCounts checks that the HeavyHitterCounts transform outputs
+// the most frequent elements with their counts.
+func TestHeavyHitterCounts(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.Create(s, "a", "b", "a", "c", "a", "b")
+	counts := HeavyHitterCounts(s, col, 2)
+	passert.Equals(s, beam.ParDo(s, formatCountFn, counts), "a:3", "b:2")
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("pipeline failed but should have succeeded, got %v", err)
+	}
+}
+
+func formatCountFn(elm string, count int64) string {
+	return fmt.Sprintf("%v:%v", elm, count)
+}
+
+// timestampMinutes timestamps values of the form "<minutes>:<value>".
+func timestampMinutes(v string, emit func(beam.EventTime, string)) {
+	var minutes int
+	var value string
+	fmt.Sscanf(v, "%d:%s", &minutes, &value)
+	emit(mtime.FromDuration(time.Duration(minutes)*time.Minute), value)
+}
+
+// TestHeavyHitters_Windowed checks that heavy hitters are computed per window.
+func TestHeavyHitters_Windowed(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+	col := beam.ParDo(s, timestampMinutes, beam.Create(s, "1:a", "2:b", "3:b", "6:c", "7:a", "8:c"))
+	windowed := beam.WindowInto(s, window.NewFixedWindows(5*time.Minute), col)
+	hitters := HeavyHitters(s, windowed, 1)
+	passert.Equals(s, passert.InWindow(s, hitters, window.IntervalWindow{Start: 0, End: mtime.FromDuration(5 * time.Minute)}), []string{"b"})
+	passert.Equals(s, passert.InWindow(s, hitters, window.IntervalWindow{Start: mtime.FromDuration(5 * time.Minute), End: mtime.FromDuration(10 * time.Minute)}), []string{"c"})
+	if err := ptest.Run(p); err != nil {
+		t.Errorf("pipeline failed but should have succeeded, got %v", err)
+	}
+}
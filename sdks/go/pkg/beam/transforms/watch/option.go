@@ -0,0 +1,85 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidTotalTime          = errors.New("total time must be greater than 0")
+	errInvalidTimeSinceNewOutput = errors.New("time since new output must be greater than 0")
+	errInvalidPolls              = errors.New("number of polls must be greater than 0")
+)
+
+type growthOption struct {
+	TotalTime          time.Duration
+	TimeSinceNewOutput time.Duration
+	Polls              int
+	OutputKey          any
+}
+
+// OptionFn is a function that can be passed to Growth to configure options for watching
+// the inputs.
+type OptionFn func(option *growthOption) error
+
+// AfterTotalOf stops watching an input once the given time has passed since its first poll.
+func AfterTotalOf(d time.Duration) OptionFn {
+	return func(o *growthOption) error {
+		if d <= 0 {
+			return errInvalidTotalTime
+		}
+
+		o.TotalTime = d
+		return nil
+	}
+}
+
+// AfterTimeSinceNewOutput stops watching an input once the given time has passed without
+// any new output being observed, counted from the first poll if no output was observed yet.
+func AfterTimeSinceNewOutput(d time.Duration) OptionFn {
+	return func(o *growthOption) error {
+		if d <= 0 {
+			return errInvalidTimeSinceNewOutput
+		}
+
+		o.TimeSinceNewOutput = d
+		return nil
+	}
+}
+
+// AfterPolls stops watching an input once it has been polled the given number of times.
+func AfterPolls(n int) OptionFn {
+	return func(o *growthOption) error {
+		if n <= 0 {
+			return errInvalidPolls
+		}
+
+		o.Polls = n
+		return nil
+	}
+}
+
+// WithOutputKey sets the function used to identify outputs when deduplicating them, which
+// must be of the form func(O) string and must be registered. By default, outputs are
+// identified by their encoding, so an output that changes is observed as a new output.
+func WithOutputKey(key any) OptionFn {
+	return func(o *growthOption) error {
+		o.OutputKey = key
+		return nil
+	}
+}
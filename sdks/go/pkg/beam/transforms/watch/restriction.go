@@ -0,0 +1,103 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+// growthState is the restriction of an input being watched. It records the outputs observed
+// by previous polls, so that only new outputs are emitted, and the state of the termination
+// conditions.
+type growthState struct {
+	// Observed holds the keys of the outputs observed so far.
+	Observed map[string]bool
+	// Start is the time of the first poll, in milliseconds since the epoch.
+	Start int64
+	// LastNewOutput is the time of the last poll observing a new output, in milliseconds
+	// since the epoch.
+	LastNewOutput int64
+	// Polls is the number of polls so far.
+	Polls int
+	// Done is set once the input doesn't need to be polled anymore.
+	Done bool
+}
+
+// next returns a copy of the state to be updated by a poll.
+func (s growthState) next() growthState {
+	observed := make(map[string]bool, len(s.Observed))
+	for key := range s.Observed {
+		observed[key] = true
+	}
+	s.Observed = observed
+	return s
+}
+
+// growthTracker is a restriction tracker of a growthState. Each poll claims the updated
+// state, and the tracker only supports checkpointing, which leaves the claimed state as the
+// residual to poll again.
+type growthTracker struct {
+	rest growthState
+	done bool
+}
+
+func newGrowthTracker(rest growthState) *growthTracker {
+	return &growthTracker{rest: rest}
+}
+
+// TryClaim replaces the restriction with the updated growthState passed as pos, unless the
+// tracker is done.
+func (rt *growthTracker) TryClaim(pos any) bool {
+	state, ok := pos.(growthState)
+	if !ok || rt.IsDone() {
+		return false
+	}
+
+	rt.rest = state
+	return true
+}
+
+func (rt *growthTracker) GetError() error {
+	return nil
+}
+
+// TrySplit only splits for checkpointing, with a fraction of 0. The primary is done, and
+// the residual is the state claimed so far.
+func (rt *growthTracker) TrySplit(fraction float64) (primary, residual any, err error) {
+	if fraction != 0 || rt.IsDone() {
+		return rt.rest, nil, nil
+	}
+
+	residual = rt.rest
+	rt.rest = growthState{Done: true}
+	rt.done = true
+	return rt.rest, residual, nil
+}
+
+func (rt *growthTracker) GetProgress() (done, remaining float64) {
+	if rt.IsDone() {
+		return 1, 0
+	}
+	return 0, 1
+}
+
+func (rt *growthTracker) IsDone() bool {
+	return rt.done || rt.rest.Done
+}
+
+func (rt *growthTracker) GetRestriction() any {
+	return rt.rest
+}
+
+func (rt *growthTracker) IsBounded() bool {
+	return false
+}
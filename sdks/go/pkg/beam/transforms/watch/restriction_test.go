@@ -0,0 +1,71 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGrowthTracker_TryClaim(t *testing.T) {
+	rt := newGrowthTracker(growthState{})
+	next := growthState{Observed: map[string]bool{"a": true}, Polls: 1}
+	if !rt.TryClaim(next) {
+		t.Fatal("TryClaim() = false, want true")
+	}
+	if diff := cmp.Diff(next, rt.GetRestriction()); diff != "" {
+		t.Errorf("GetRestriction() mismatch (-want +got):\n%s", diff)
+	}
+	if rt.TryClaim("a") {
+		t.Error("TryClaim() of an invalid position = true, want false")
+	}
+}
+
+func TestGrowthTracker_TrySplit(t *testing.T) {
+	state := growthState{Observed: map[string]bool{"a": true}, Polls: 1}
+	rt := newGrowthTracker(state)
+
+	if _, residual, _ := rt.TrySplit(0.5); residual != nil {
+		t.Errorf("TrySplit(0.5) residual = %v, want nil", residual)
+	}
+
+	primary, residual, err := rt.TrySplit(0)
+	if err != nil {
+		t.Fatalf("TrySplit(0) error = %v", err)
+	}
+	if diff := cmp.Diff(growthState{Done: true}, primary); diff != "" {
+		t.Errorf("TrySplit(0) primary mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(state, residual); diff != "" {
+		t.Errorf("TrySplit(0) residual mismatch (-want +got):\n%s", diff)
+	}
+	if !rt.IsDone() {
+		t.Error("IsDone() after checkpoint = false, want true")
+	}
+	if rt.TryClaim(state) {
+		t.Error("TryClaim() after checkpoint = true, want false")
+	}
+}
+
+func TestGrowthState_Next(t *testing.T) {
+	state := growthState{Observed: map[string]bool{"a": true}}
+	next := state.next()
+	next.Observed["b"] = true
+	if len(state.Observed) != 1 {
+		t.Errorf("next() shares the observed outputs with the state: %v", state.Observed)
+	}
+}
@@ -0,0 +1,355 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package watch contains a transform that repeatedly polls each input for a growing set of
+// outputs, such as the files matching a pattern, the objects under a prefix, the rows of a
+// table or the items of a REST listing, and emits each output once.
+package watch
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/sdf"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/util/reflectx"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+)
+
+var (
+	typeOfString = reflect.TypeOf("")
+	typeOfError  = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+func init() {
+	register.DoFn4x2[
+		*watermarkEstimator, *sdf.LockRTracker, beam.X, func(beam.EventTime, beam.X, beam.Y),
+		sdf.ProcessContinuation, error,
+	](
+		&growthFn{},
+	)
+	register.Emitter3[beam.EventTime, beam.X, beam.Y]()
+
+	beam.RegisterType(reflect.TypeOf((*growthState)(nil)).Elem())
+}
+
+// PollResult is the result of polling an input.
+type PollResult[O any] struct {
+	// Outputs are the outputs observed by the poll. Outputs observed by previous polls are
+	// ignored.
+	Outputs []O
+	// Timestamps are the event times of the outputs. If empty, the outputs are timestamped
+	// with the time of the poll.
+	Timestamps []time.Time
+	// Watermark is a lower bound on the event times of the outputs of later polls. If zero,
+	// the watermark isn't advanced until the input is done.
+	Watermark time.Time
+	// Complete reports whether the outputs are final, in which case the input isn't polled
+	// anymore.
+	Complete bool
+}
+
+// Complete returns a PollResult with the final outputs of an input.
+func Complete[O any](outputs ...O) PollResult[O] {
+	return PollResult[O]{Outputs: outputs, Complete: true}
+}
+
+// Incomplete returns a PollResult with the outputs of an input observed so far.
+func Incomplete[O any](outputs ...O) PollResult[O] {
+	return PollResult[O]{Outputs: outputs}
+}
+
+// WithTimestamps returns a copy of the PollResult with the event times of the outputs.
+func (r PollResult[O]) WithTimestamps(timestamps ...time.Time) PollResult[O] {
+	r.Timestamps = timestamps
+	return r
+}
+
+// WithWatermark returns a copy of the PollResult with the watermark.
+func (r PollResult[O]) WithWatermark(watermark time.Time) PollResult[O] {
+	r.Watermark = watermark
+	return r
+}
+
+// Growth repeatedly polls each element of a PCollection<I> every interval, and emits the
+// new outputs observed by each poll. The outputs are returned by the function poll, which
+// must be of the form func(I) (PollResult[O], error), and must be registered. Growth
+// returns an unbounded PCollection<KV<I, O>> of the inputs and their outputs. For example:
+//
+//	func listObjects(prefix string) (watch.PollResult[string], error) {
+//		names, err := list(prefix)
+//		if err != nil {
+//			return watch.PollResult[string]{}, err
+//		}
+//		return watch.Incomplete(names...), nil
+//	}
+//
+//	func init() { register.Function1x2(listObjects) }
+//
+//	objects := watch.Growth(s, prefixes, listObjects, time.Minute, watch.AfterTotalOf(time.Hour))
+//
+// An input is polled until a poll reports a complete result, or until one of its
+// termination conditions is met. The outputs observed for an input are kept in its
+// restriction, so they are emitted once even across checkpoints, and the restriction grows
+// with the number of distinct outputs.
+//
+// Growth takes a variable number of OptionFn to configure the watch:
+//   - AfterTotalOf: stop watching an input after a time since its first poll.
+//   - AfterTimeSinceNewOutput: stop watching an input after a time without new outputs.
+//   - AfterPolls: stop watching an input after a number of polls.
+//   - WithOutputKey: function identifying the outputs. Defaults to their encoding.
+//
+// Inputs are watched until their results are complete if no termination condition is set.
+func Growth(s beam.Scope, col beam.PCollection, poll any, interval time.Duration, opts ...OptionFn) beam.PCollection {
+	s = s.Scope("watch.Growth")
+
+	if interval <= 0 {
+		panic(fmt.Sprintf("watch.Growth: interval must be greater than 0, got %v", interval))
+	}
+
+	beam.ValidateNonCompositeType(col)
+	in := col.Type().Type()
+	out := mustHavePollSignature(poll, in)
+
+	option := &growthOption{}
+	for _, opt := range opts {
+		if err := opt(option); err != nil {
+			panic(fmt.Sprintf("watch.Growth: invalid option: %v", err))
+		}
+	}
+
+	fn := &growthFn{
+		Poll:               beam.EncodedFunc{Fn: reflectx.MakeFunc(poll)},
+		Type:               beam.EncodedType{T: out},
+		Interval:           interval,
+		TotalTime:          option.TotalTime,
+		TimeSinceNewOutput: option.TimeSinceNewOutput,
+		Polls:              option.Polls,
+	}
+	if option.OutputKey != nil {
+		mustHaveSignature("output key", option.OutputKey, []reflect.Type{out}, []reflect.Type{typeOfString})
+		fn.OutputKey = &beam.EncodedFunc{Fn: reflectx.MakeFunc(option.OutputKey)}
+	}
+
+	return beam.ParDo(s, fn, col, beam.TypeDefinition{Var: beam.YType, T: out})
+}
+
+// mustHavePollSignature checks that poll is a func(I) (PollResult[O], error), and returns
+// the type O.
+func mustHavePollSignature(poll any, in reflect.Type) reflect.Type {
+	t := reflect.TypeOf(poll)
+	if t == nil || t.Kind() != reflect.Func || t.NumIn() != 1 || t.NumOut() != 2 {
+		panic(fmt.Sprintf("watch.Growth: poll must be a func(%v) (PollResult[O], error), got %v", in, t))
+	}
+
+	res := t.Out(0)
+	valid := t.In(0) == in && t.Out(1) == typeOfError &&
+		res.PkgPath() == reflect.TypeOf(PollResult[any]{}).PkgPath() &&
+		strings.HasPrefix(res.Name(), "PollResult[")
+	if !valid {
+		panic(fmt.Sprintf("watch.Growth: poll must be a func(%v) (PollResult[O], error), got %v", in, t))
+	}
+
+	field, _ := res.FieldByName("Outputs")
+	out := field.Type.Elem()
+	if typex.IsUniversal(out) {
+		panic(fmt.Sprintf("watch.Growth: output type of poll must be concrete, got %v", out))
+	}
+	return out
+}
+
+func mustHaveSignature(param string, fn any, in, out []reflect.Type) {
+	want := reflect.FuncOf(in, out, false)
+	if got := reflect.TypeOf(fn); got != want {
+		panic(fmt.Sprintf("watch.Growth: %v must be a %v, got %v", param, want, got))
+	}
+}
+
+type growthFn struct {
+	Poll               beam.EncodedFunc
+	OutputKey          *beam.EncodedFunc
+	Type               beam.EncodedType
+	Interval           time.Duration
+	TotalTime          time.Duration
+	TimeSinceNewOutput time.Duration
+	Polls              int
+	poll               reflectx.Func1x2
+	key                func(any) (string, error)
+	now                func() time.Time
+}
+
+func (fn *growthFn) Setup() {
+	fn.poll = reflectx.ToFunc1x2(fn.Poll.Fn)
+	fn.now = time.Now
+
+	if fn.OutputKey != nil {
+		key := reflectx.ToFunc1x1(fn.OutputKey.Fn)
+		fn.key = func(v any) (string, error) {
+			return hash([]byte(key.Call1x1(v).(string))), nil
+		}
+		return
+	}
+
+	enc := beam.NewElementEncoder(fn.Type.T)
+	fn.key = func(v any) (string, error) {
+		var buf strings.Builder
+		if err := enc.Encode(v, &buf); err != nil {
+			return "", err
+		}
+		return hash([]byte(buf.String())), nil
+	}
+}
+
+// hash returns a fixed size key for the outputs, so the size of the restriction doesn't
+// depend on the size of the outputs.
+func hash(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:16])
+}
+
+func (fn *growthFn) CreateInitialRestriction(_ beam.X) growthState {
+	return growthState{}
+}
+
+func (fn *growthFn) SplitRestriction(_ beam.X, rest growthState) []growthState {
+	return []growthState{rest}
+}
+
+func (fn *growthFn) RestrictionSize(_ beam.X, rest growthState) float64 {
+	if rest.Done {
+		return 0
+	}
+	return 1
+}
+
+func (fn *growthFn) CreateTracker(rest growthState) *sdf.LockRTracker {
+	return sdf.NewLockRTracker(newGrowthTracker(rest))
+}
+
+func (fn *growthFn) TruncateRestriction(_ *sdf.LockRTracker, _ beam.X) growthState {
+	return growthState{Done: true}
+}
+
+func (fn *growthFn) InitialWatermarkEstimatorState(et beam.EventTime, _ growthState, _ beam.X) int64 {
+	return et.Milliseconds()
+}
+
+func (fn *growthFn) CreateWatermarkEstimator(ms int64) *watermarkEstimator {
+	return &watermarkEstimator{state: ms}
+}
+
+func (fn *growthFn) WatermarkEstimatorState(we *watermarkEstimator) int64 {
+	return we.state
+}
+
+// ProcessElement polls the input once, emits the outputs that weren't observed before, and
+// either stops or resumes after the poll interval.
+func (fn *growthFn) ProcessElement(
+	we *watermarkEstimator,
+	rt *sdf.LockRTracker,
+	elem beam.X,
+	emit func(beam.EventTime, beam.X, beam.Y),
+) (sdf.ProcessContinuation, error) {
+	state := rt.GetRestriction().(growthState)
+	if state.Done {
+		return sdf.StopProcessing(), nil
+	}
+
+	now := fn.now()
+	v, err := fn.poll.Call1x2(elem)
+	if err != nil {
+		return sdf.StopProcessing(), fmt.Errorf("error polling %v: %w", elem, err.(error))
+	}
+	res := reflect.ValueOf(v)
+	outputs := res.FieldByName("Outputs")
+	timestamps := res.FieldByName("Timestamps").Interface().([]time.Time)
+	watermark := res.FieldByName("Watermark").Interface().(time.Time)
+	complete := res.FieldByName("Complete").Bool()
+	if len(timestamps) != 0 && len(timestamps) != outputs.Len() {
+		return sdf.StopProcessing(), fmt.Errorf("error polling %v: got %d timestamps for %d outputs", elem, len(timestamps), outputs.Len())
+	}
+
+	next := state.next()
+	next.Polls++
+	if next.Start == 0 {
+		next.Start = now.UnixMilli()
+	}
+
+	var pending []int
+	for i := 0; i < outputs.Len(); i++ {
+		key, err := fn.key(outputs.Index(i).Interface())
+		if err != nil {
+			return sdf.StopProcessing(), fmt.Errorf("error identifying output of %v: %w", elem, err)
+		}
+		if next.Observed[key] {
+			continue
+		}
+		next.Observed[key] = true
+		pending = append(pending, i)
+	}
+	if len(pending) > 0 {
+		next.LastNewOutput = now.UnixMilli()
+	}
+	next.Done = complete || fn.terminated(next, now)
+
+	if !rt.TryClaim(next) {
+		return sdf.StopProcessing(), nil
+	}
+
+	for _, i := range pending {
+		et := now
+		if len(timestamps) != 0 {
+			et = timestamps[i]
+		}
+		emit(beam.EventTime(et.UnixMilli()), elem, outputs.Index(i).Interface())
+	}
+
+	if next.Done {
+		return sdf.StopProcessing(), nil
+	}
+	if !watermark.IsZero() {
+		we.advance(watermark)
+	}
+	return sdf.ResumeProcessingIn(fn.Interval), nil
+}
+
+// terminated reports whether one of the termination conditions is met for the state.
+func (fn *growthFn) terminated(state growthState, now time.Time) bool {
+	elapsed := func(ms int64) time.Duration {
+		return now.Sub(time.UnixMilli(ms))
+	}
+
+	if fn.Polls > 0 && state.Polls >= fn.Polls {
+		return true
+	}
+	if fn.TotalTime > 0 && elapsed(state.Start) >= fn.TotalTime {
+		return true
+	}
+	if fn.TimeSinceNewOutput > 0 {
+		last := state.LastNewOutput
+		if last == 0 {
+			last = state.Start
+		}
+		if elapsed(last) >= fn.TimeSinceNewOutput {
+			return true
+		}
+	}
+	return false
+}
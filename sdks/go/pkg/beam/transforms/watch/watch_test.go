@@ -0,0 +1,297 @@
+// Licensed to the Apache Software Foundation (ASF) under one or more
+// contributor license agreements.  See the NOTICE file distributed with
+// this work for additional information regarding copyright ownership.
+// The ASF licenses this file to You under the Apache License, Version 2.0
+// (the "License"); you may not use this file except in compliance with
+// the License.  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/util/reflectx"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/ptest"
+	"github.com/google/go-cmp/cmp"
+)
+
+func init() {
+	register.Function1x2(pollItems)
+	register.Function2x1(formatKV)
+}
+
+func TestMain(m *testing.M) {
+	ptest.Main(m)
+}
+
+var (
+	mu    sync.Mutex
+	polls = make(map[string]int)
+)
+
+// pollItems returns one more item of the input on each poll, until the input has 3 items.
+func pollItems(in string) (PollResult[string], error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	polls[in]++
+	var items []string
+	for i := 0; i < polls[in] && i < 3; i++ {
+		items = append(items, fmt.Sprintf("%s%d", in, i))
+	}
+	if len(items) == 3 {
+		return Complete(items...), nil
+	}
+	return Incomplete(items...), nil
+}
+
+func formatKV(k, v string) string {
+	return k + "/" + v
+}
+
+func TestGrowth(t *testing.T) {
+	p, s := beam.NewPipelineWithRoot()
+
+	inputs := beam.Create(s, "a", "b")
+	outputs := Growth(s, inputs, pollItems, 10*time.Millisecond)
+	passert.Equals(s, beam.ParDo(s, formatKV, outputs), "a/a0", "a/a1", "a/a2", "b/b0", "b/b1", "b/b2")
+
+	ptest.RunAndValidate(t, p)
+}
+
+func TestGrowth_InvalidPoll(t *testing.T) {
+	tests := []struct {
+		name string
+		poll any
+	}{
+		{name: "not a function", poll: "poll"},
+		{name: "input type", poll: func(int) (PollResult[string], error) { return PollResult[string]{}, nil }},
+		{name: "result type", poll: func(string) ([]string, error) { return nil, nil }},
+		{name: "no error", poll: func(string) (PollResult[string], bool) { return PollResult[string]{}, false }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Growth() should panic")
+				}
+			}()
+
+			_, s := beam.NewPipelineWithRoot()
+			Growth(s, beam.Create(s, "a"), tt.poll, time.Second)
+		})
+	}
+}
+
+type result struct {
+	Outputs []string
+	Times   []beam.EventTime
+	Resume  bool
+	State   growthState
+}
+
+func newTestGrowthFn(poll any, now *time.Time) *growthFn {
+	fn := &growthFn{
+		Poll:     beam.EncodedFunc{Fn: reflectx.MakeFunc(poll)},
+		Type:     beam.EncodedType{T: typeOfString},
+		Interval: time.Second,
+	}
+	fn.Setup()
+	fn.now = func() time.Time { return *now }
+	return fn
+}
+
+func process(t *testing.T, fn *growthFn, we *watermarkEstimator, state growthState) result {
+	t.Helper()
+
+	var res result
+	emit := func(et beam.EventTime, in beam.X, out beam.Y) {
+		if in != "in" {
+			t.Errorf("Input of %v = %v, want in", out, in)
+		}
+		res.Outputs = append(res.Outputs, out.(string))
+		res.Times = append(res.Times, et)
+	}
+
+	rt := fn.CreateTracker(state)
+	pc, err := fn.ProcessElement(we, rt, "in", emit)
+	if err != nil {
+		t.Fatalf("ProcessElement() error = %v", err)
+	}
+	res.Resume = pc.ShouldResume()
+	res.State = rt.GetRestriction().(growthState)
+	if !res.Resume && !rt.IsDone() {
+		t.Error("Tracker should be done when ProcessElement stops")
+	}
+	return res
+}
+
+func TestGrowthFn_ProcessElement(t *testing.T) {
+	now := time.UnixMilli(1000)
+	var next PollResult[string]
+	fn := newTestGrowthFn(func(string) (PollResult[string], error) { return next, nil }, &now)
+	we := fn.CreateWatermarkEstimator(0)
+
+	next = Incomplete("x", "y")
+	got := process(t, fn, we, fn.CreateInitialRestriction("in"))
+	if diff := cmp.Diff([]string{"x", "y"}, got.Outputs); diff != "" {
+		t.Errorf("Outputs of first poll mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]beam.EventTime{1000, 1000}, got.Times); diff != "" {
+		t.Errorf("Event times of first poll mismatch (-want +got):\n%s", diff)
+	}
+	if !got.Resume {
+		t.Error("ProcessElement() should resume after an incomplete poll")
+	}
+	if got.State.Polls != 1 || got.State.Start != 1000 || got.State.LastNewOutput != 1000 {
+		t.Errorf("State after first poll = %+v, want 1 poll started and with new output at 1000", got.State)
+	}
+
+	now = now.Add(time.Second)
+	next = Incomplete("y", "z").WithTimestamps(time.UnixMilli(1500), time.UnixMilli(1700)).WithWatermark(time.UnixMilli(1600))
+	got = process(t, fn, we, got.State)
+	if diff := cmp.Diff([]string{"z"}, got.Outputs); diff != "" {
+		t.Errorf("Outputs of second poll mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]beam.EventTime{1700}, got.Times); diff != "" {
+		t.Errorf("Event times of second poll mismatch (-want +got):\n%s", diff)
+	}
+	if got, want := we.CurrentWatermark(), time.UnixMilli(1600); !got.Equal(want) {
+		t.Errorf("CurrentWatermark() = %v, want %v", got, want)
+	}
+
+	now = now.Add(time.Second)
+	next = Complete("x", "y", "z")
+	got = process(t, fn, we, got.State)
+	if len(got.Outputs) != 0 {
+		t.Errorf("Outputs of last poll = %v, want none", got.Outputs)
+	}
+	if got.Resume {
+		t.Error("ProcessElement() should stop after a complete poll")
+	}
+}
+
+func TestGrowthFn_ProcessElement_Termination(t *testing.T) {
+	tests := []struct {
+		name   string
+		option OptionFn
+		// polls is the number of polls that resume, one second apart.
+		polls int
+	}{
+		{name: "after polls", option: AfterPolls(3), polls: 2},
+		{name: "after total time", option: AfterTotalOf(3 * time.Second), polls: 3},
+		{name: "after time since new output", option: AfterTimeSinceNewOutput(2 * time.Second), polls: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			option := &growthOption{}
+			if err := tt.option(option); err != nil {
+				t.Fatalf("option error = %v", err)
+			}
+
+			now := time.UnixMilli(1000)
+			n := 0
+			fn := newTestGrowthFn(func(string) (PollResult[string], error) {
+				// Only the first two polls observe new outputs.
+				n++
+				if n > 2 {
+					n = 2
+				}
+				return Incomplete(fmt.Sprint(n)), nil
+			}, &now)
+			fn.TotalTime = option.TotalTime
+			fn.TimeSinceNewOutput = option.TimeSinceNewOutput
+			fn.Polls = option.Polls
+			we := fn.CreateWatermarkEstimator(0)
+
+			state := fn.CreateInitialRestriction("in")
+			for i := 0; i < tt.polls; i++ {
+				got := process(t, fn, we, state)
+				if !got.Resume {
+					t.Fatalf("ProcessElement() should resume on poll %d", i+1)
+				}
+				state = got.State
+				now = now.Add(time.Second)
+			}
+			if got := process(t, fn, we, state); got.Resume {
+				t.Errorf("ProcessElement() should stop on poll %d", tt.polls+1)
+			}
+		})
+	}
+}
+
+func TestGrowthFn_ProcessElement_OutputKey(t *testing.T) {
+	now := time.UnixMilli(1000)
+	var next PollResult[string]
+	fn := newTestGrowthFn(func(string) (PollResult[string], error) { return next, nil }, &now)
+	fn.OutputKey = &beam.EncodedFunc{Fn: reflectx.MakeFunc(func(s string) string { return s[:1] })}
+	fn.Setup()
+	fn.now = func() time.Time { return now }
+
+	next = Incomplete("a1", "b1")
+	got := process(t, fn, fn.CreateWatermarkEstimator(0), fn.CreateInitialRestriction("in"))
+	next = Incomplete("a2", "b1", "c1")
+	got = process(t, fn, fn.CreateWatermarkEstimator(0), got.State)
+	if diff := cmp.Diff([]string{"c1"}, got.Outputs); diff != "" {
+		t.Errorf("Outputs mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGrowthFn_ProcessElement_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		poll func(string) (PollResult[string], error)
+	}{
+		{
+			name: "poll error",
+			poll: func(string) (PollResult[string], error) { return PollResult[string]{}, errors.New("unavailable") },
+		},
+		{
+			name: "timestamps mismatch",
+			poll: func(string) (PollResult[string], error) {
+				return Incomplete("x", "y").WithTimestamps(time.UnixMilli(0)), nil
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.UnixMilli(1000)
+			fn := newTestGrowthFn(tt.poll, &now)
+			rt := fn.CreateTracker(fn.CreateInitialRestriction("in"))
+			emit := func(beam.EventTime, beam.X, beam.Y) {
+				t.Error("ProcessElement() should not emit")
+			}
+			if _, err := fn.ProcessElement(fn.CreateWatermarkEstimator(0), rt, "in", emit); err == nil {
+				t.Error("ProcessElement() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestGrowthFn_ProcessElement_Truncated(t *testing.T) {
+	now := time.UnixMilli(1000)
+	fn := newTestGrowthFn(func(string) (PollResult[string], error) {
+		t.Error("poll should not be called")
+		return PollResult[string]{}, nil
+	}, &now)
+
+	got := process(t, fn, fn.CreateWatermarkEstimator(0), fn.TruncateRestriction(nil, "in"))
+	if got.Resume {
+		t.Error("ProcessElement() should stop for a truncated restriction")
+	}
+}
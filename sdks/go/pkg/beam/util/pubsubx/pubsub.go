@@ -19,13 +19,21 @@ package pubsubx
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	"cloud.google.com/go/pubsub"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/internal/errors"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/log"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
+// emulatorHostEnv is the environment variable the Pub/Sub client libraries read
+// the address of the Pub/Sub emulator from.
+const emulatorHostEnv = "PUBSUB_EMULATOR_HOST"
+
 // MakeQualifiedTopicName returns a fully-qualified topic name for
 // the given project and topic.
 func MakeQualifiedTopicName(project, topic string) string {
@@ -38,6 +46,23 @@ func MakeQualifiedSubscriptionName(project, subscription string) string {
 	return fmt.Sprintf("projects/%s/subscriptions/%s", project, subscription)
 }
 
+// ClientOptions returns the options for connecting a Pub/Sub API client, such as
+// those in cloud.google.com/go/pubsub/apiv1, to the Pub/Sub emulator when the
+// PUBSUB_EMULATOR_HOST environment variable is set, as pubsub.NewClient does.
+// Otherwise it returns no options.
+func ClientOptions() []option.ClientOption {
+	addr := os.Getenv(emulatorHostEnv)
+	if addr == "" {
+		return nil
+	}
+	return []option.ClientOption{
+		option.WithEndpoint(addr),
+		option.WithoutAuthentication(),
+		option.WithGRPCDialOption(grpc.WithTransportCredentials(insecure.NewCredentials())),
+		option.WithTelemetryDisabled(),
+	}
+}
+
 // EnsureTopic creates a new topic, if it doesn't exist.
 func EnsureTopic(ctx context.Context, client *pubsub.Client, topic string) (*pubsub.Topic, error) {
 	ret := client.Topic(topic)
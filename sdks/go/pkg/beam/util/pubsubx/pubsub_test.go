@@ -23,6 +23,8 @@ import (
 	"testing"
 
 	"cloud.google.com/go/pubsub"
+	vkit "cloud.google.com/go/pubsub/apiv1"
+	pb "cloud.google.com/go/pubsub/apiv1/pubsubpb"
 	"cloud.google.com/go/pubsub/pstest"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/api/option"
@@ -189,3 +191,34 @@ func TestPublish(t *testing.T) {
 		t.Fatalf("publish failed: diff\n%v", d)
 	}
 }
+
+func TestClientOptions(t *testing.T) {
+	t.Setenv(emulatorHostEnv, "")
+	if got := ClientOptions(); len(got) != 0 {
+		t.Errorf("ClientOptions() without emulator = %v, want no options", got)
+	}
+
+	srv := pstest.NewServer()
+	t.Cleanup(func() { srv.Close() })
+	t.Setenv(emulatorHostEnv, srv.Addr)
+
+	ctx := context.Background()
+	client, err := pubsub.NewClient(ctx, "project")
+	if err != nil {
+		t.Fatalf("pubsub.NewClient() failed: %v", err)
+	}
+	defer client.Close()
+	if _, err := client.CreateTopic(ctx, "test_topic"); err != nil {
+		t.Fatalf("client.CreateTopic() failed: %v", err)
+	}
+
+	pub, err := vkit.NewPublisherClient(ctx, ClientOptions()...)
+	if err != nil {
+		t.Fatalf("NewPublisherClient() failed: %v", err)
+	}
+	defer pub.Close()
+	topic := MakeQualifiedTopicName("project", "test_topic")
+	if _, err := pub.GetTopic(ctx, &pb.GetTopicRequest{Topic: topic}); err != nil {
+		t.Errorf("GetTopic(%q) through emulator failed: %v", topic, err)
+	}
+}
@@ -19,6 +19,8 @@ import (
 	"fmt"
 
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/typex"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/util/reflectx"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/passert"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/testing/teststream"
@@ -246,3 +248,40 @@ func TestStreamTimersEventTime(s beam.Scope) {
 		return teststream.Create(s, c)
 	})(s)
 }
+
+// taggedClicksConfig returns a Config of clicks and impressions on ads, with interleaved
+// elements and watermarks.
+func taggedClicksConfig() teststream.Config {
+	con := teststream.NewConfig()
+	con.AddTaggedElements("impressions", 100, "ad1", "ad2", "ad3")
+	con.AddTaggedElements("clicks", 110, "ad1")
+	con.AdvanceTaggedWatermark("impressions", 120)
+	con.AddTaggedElements("clicks", 130, "ad3")
+	con.AdvanceTaggedWatermark("clicks", 140)
+	con.AddTaggedElements("impressions", 150, "ad4")
+	con.AdvanceWatermarkToInfinity()
+	return con
+}
+
+// TestStreamTaggedSequences tests a TestStream with two tagged outputs, inserting
+// elements and advancing watermarks independently for each output.
+func TestStreamTaggedSequences(s beam.Scope) {
+	outputs := teststream.CreateTagged(s, taggedClicksConfig())
+
+	passert.Equals(s, outputs["clicks"], "ad1", "ad3")
+	passert.Equals(s, outputs["impressions"], "ad1", "ad2", "ad3", "ad4")
+}
+
+// TestStreamTaggedWithEndpoint tests a TestStream with two tagged outputs whose events
+// are streamed by a TestStreamService.
+func TestStreamTaggedWithEndpoint(s beam.Scope) {
+	srv, err := teststream.NewServer(taggedClicksConfig())
+	if err != nil {
+		panic(err)
+	}
+	// The server is left running, as the pipeline reads the events once it's executed.
+	outputs := teststream.CreateTaggedWithEndpoint(s, srv.Endpoint(), typex.New(reflectx.String), "clicks", "impressions")
+
+	passert.Equals(s, outputs["clicks"], "ad1", "ad3")
+	passert.Equals(s, outputs["impressions"], "ad1", "ad2", "ad3", "ad4")
+}
@@ -91,3 +91,13 @@ func TestTestStreamTimersEventTime(t *testing.T) {
 	integration.CheckFilters(t)
 	ptest.BuildAndRun(t, TestStreamTimersEventTime)
 }
+
+func TestTestStreamTaggedSequences(t *testing.T) {
+	integration.CheckFilters(t)
+	ptest.BuildAndRun(t, TestStreamTaggedSequences)
+}
+
+func TestTestStreamTaggedWithEndpoint(t *testing.T) {
+	integration.CheckFilters(t)
+	ptest.BuildAndRun(t, TestStreamTaggedWithEndpoint)
+}
@@ -21,6 +21,8 @@ import (
 	"time"
 
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/mtime"
+	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/graph/window"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/state"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/core/timers"
 	"github.com/Beamdust/beam-fork/sdks/v3/go/pkg/beam/register"
@@ -33,7 +35,9 @@ import (
 func init() {
 	register.DoFn2x0[[]byte, func(string, int)](&inputFn[string, int]{})
 	register.DoFn6x0[beam.Window, state.Provider, timers.Provider, string, int, func(kv[string, int])](&eventTimeFn{})
+	register.Function3x0(timestampByValue)
 	register.Emitter2[string, int]()
+	register.Emitter3[beam.EventTime, string, int]()
 	register.Emitter1[kv[string, int]]()
 }
 
@@ -56,6 +60,11 @@ func (fn *inputFn[K, V]) ProcessElement(_ []byte, emit func(K, V)) {
 	}
 }
 
+// timestampByValue outputs elements at the timestamp of ten seconds per value.
+func timestampByValue(key string, value int, emit func(beam.EventTime, string, int)) {
+	emit(mtime.FromDuration(time.Duration(value)*10*time.Second), key, value)
+}
+
 type eventTimeFn struct {
 	Callback timers.EventTime
 	MyKey    state.Value[string]
@@ -150,3 +159,40 @@ func TimersEventTimeUnbounded(s beam.Scope) {
 		return periodic.Impulse(s, now, now.Add(10*time.Second), 0, false)
 	})(s)
 }
+
+// TimersEventTimeWindowed validates event time timers set in fixed windows,
+// which fire once per key and window.
+func TimersEventTimeWindowed(s beam.Scope) {
+	var inputs, wantOutputs []kv[string, int]
+
+	offset := 5000
+	timerOutput := 4093
+
+	numKeys := 10
+	numWindows := 2
+
+	for key := 0; key < numKeys; key++ {
+		k := strconv.Itoa(key)
+		for w := 0; w < numWindows; w++ {
+			wantOutputs = append(wantOutputs, kvfn(k, timerOutput))
+		}
+		// Values 0 to 5 are in the first minute, and 6 to 11 in the second.
+		for i := 0; i < 6*numWindows; i++ {
+			inputs = append(inputs, kvfn(k, i))
+			wantOutputs = append(wantOutputs, kvfn(k, i+offset))
+		}
+	}
+
+	keyed := beam.ParDo(s, &inputFn[string, int]{
+		Inputs: inputs,
+	}, beam.Impulse(s))
+	timestamped := beam.ParDo(s, timestampByValue, keyed)
+	windowed := beam.WindowInto(s, window.NewFixedWindows(time.Minute), timestamped)
+	times := beam.ParDo(s, &eventTimeFn{
+		Offset:      offset,
+		TimerOutput: timerOutput,
+		Callback:    timers.InEventTime("Callback"),
+		MyKey:       state.MakeValueState[string]("MyKey"),
+	}, windowed)
+	passert.EqualsList(s, beam.WindowInto(s, window.NewGlobalWindows(), times), wantOutputs)
+}
@@ -32,4 +32,9 @@ func TestTimers_EventTime_Unbounded(t *testing.T) {
 	ptest.BuildAndRun(t, TimersEventTimeUnbounded)
 }
 
+func TestTimers_EventTime_Windowed(t *testing.T) {
+	integration.CheckFilters(t)
+	ptest.BuildAndRun(t, TimersEventTimeWindowed)
+}
+
 // TODO(https://github.com/apache/beam/issues/29772): Add ProcessingTime Timer tests.